@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/qthuy2k1/product-management/internal/controllers"
 	"github.com/qthuy2k1/product-management/internal/repositories"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
@@ -32,6 +35,19 @@ func main() {
 	redisPass := os.Getenv("REDIS_PASSWORD")
 	redis := repositories.RedisInitialize(redisPort, redisPass)
 
+	// background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler := controllers.NewScheduler(repositories.NewRepository(database, redis), controllers.SchedulerConfig{
+		PendingOrderTimeout:          durationEnv("PENDING_ORDER_TIMEOUT", 24*time.Hour),
+		CancelStaleOrdersInterval:    durationEnv("CANCEL_STALE_ORDERS_INTERVAL", 10*time.Minute),
+		ApplyScheduledPricesInterval: durationEnv("APPLY_SCHEDULED_PRICES_INTERVAL", time.Minute),
+		LowStockDigestInterval:       durationEnv("LOW_STOCK_DIGEST_INTERVAL", 24*time.Hour),
+		RelatedProductsInterval:      durationEnv("RELATED_PRODUCTS_INTERVAL", 6*time.Hour),
+		BackInStockInterval:          durationEnv("BACK_IN_STOCK_INTERVAL", 5*time.Minute),
+	})
+	scheduler.Start(ctx)
+
 	routerHandlers := InitRoutes(database, redis)
 
 	log.Printf("Started server on %d", PORT)
@@ -39,3 +55,18 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// durationEnv returns the duration of the environment variable such as "30m" or "24h", or the default one if it is not set
+func durationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Fatalf("Invalid %s: %q must be a positive duration such as 30m or 24h", key, value)
+	}
+
+	return d
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/qthuy2k1/product-management/internal/controllers"
 	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/storage"
 
 	graphHandler "github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -36,6 +37,10 @@ func InitRoutes(db *sql.DB, redis *redis.Client) http.Handler {
 	initRest(r, controller)
 	initGraph(r, controller)
 
+	// serve the uploaded images from the local storage
+	uploads := storage.NewLocalStorageFromEnv()
+	r.Handle(uploads.Prefix()+"/*", uploads.Handler())
+
 	return r
 }
 
@@ -47,24 +52,134 @@ func initRest(r *chi.Mux, controller controllers.IController) {
 	r.Route("/users", func(r chi.Router) {
 		r.Post("/", restHandler.CreateUser)
 		r.Get("/{userID}", restHandler.GetUser)
+		r.Route("/{userID}/addresses", func(r chi.Router) {
+			r.Post("/", restHandler.CreateAddress)
+			r.Get("/", restHandler.GetAddresses)
+			r.Delete("/{addressID}", restHandler.DeleteAddress)
+		})
+		r.Route("/{userID}/wishlist", func(r chi.Router) {
+			r.Post("/", restHandler.AddToWishlist)
+			r.Get("/", restHandler.GetWishlist)
+			r.Delete("/{productID}", restHandler.RemoveFromWishlist)
+			r.Put("/{productID}/back-in-stock", restHandler.SubscribeBackInStock)
+			r.Delete("/{productID}/back-in-stock", restHandler.UnsubscribeBackInStock)
+		})
 	})
 
 	//* product category router
 	r.Route("/product-categories", func(r chi.Router) {
 		r.Post("/", restHandler.CreateProductCategory)
+		r.Route("/{categoryID}/attributes", func(r chi.Router) {
+			r.Post("/", restHandler.CreateAttributeDefinition)
+			r.Get("/", restHandler.GetAttributeDefinitions)
+		})
 	})
 
 	//* product router
 	r.Route("/products", func(r chi.Router) {
 		r.Post("/", restHandler.CreateProduct)
 		r.Get("/", restHandler.GetProducts)
+		r.Get("/search", restHandler.SearchProducts)
+		r.Get("/sku/{sku}", restHandler.GetProductBySKU)
+		r.Get("/slug/{slug}", restHandler.GetProductBySlug)
+		r.Route("/bulk", func(r chi.Router) {
+			r.Post("/price", restHandler.BulkChangePrice)
+			r.Post("/category", restHandler.BulkChangeCategory)
+			r.Post("/stock", restHandler.BulkUpdateStock)
+			r.Post("/archive", restHandler.BulkArchiveProducts)
+		})
 		r.Route("/{productID}", func(r chi.Router) {
+			r.Get("/", restHandler.GetProduct)
 			r.Put("/", restHandler.UpdateProduct)
+			r.Patch("/", restHandler.PatchProduct)
 			r.Delete("/", restHandler.DeleteProduct)
+			r.Post("/restore", restHandler.RestoreProduct)
+			r.Route("/variants", func(r chi.Router) {
+				r.Post("/", restHandler.CreateVariant)
+				r.Get("/", restHandler.GetVariants)
+				r.Put("/{variantID}", restHandler.UpdateVariant)
+			})
+			r.Route("/images", func(r chi.Router) {
+				r.Post("/", restHandler.UploadProductImage)
+				r.Get("/", restHandler.GetProductImages)
+				r.Put("/order", restHandler.ReorderProductImages)
+				r.Delete("/{imageID}", restHandler.DeleteProductImage)
+			})
+			r.Route("/prices", func(r chi.Router) {
+				r.Post("/", restHandler.SchedulePriceChange)
+				r.Get("/", restHandler.GetProductPriceHistory)
+				r.Get("/effective", restHandler.GetProductPriceAt)
+				r.Delete("/{priceID}", restHandler.CancelScheduledPrice)
+			})
+			r.Route("/reviews", func(r chi.Router) {
+				r.Post("/", restHandler.CreateProductReview)
+				r.Get("/", restHandler.GetProductReviews)
+			})
+			r.Post("/stock-adjustments", restHandler.AdjustStock)
+			r.Post("/stock-transfers", restHandler.TransferStock)
+			r.Get("/stock-report", restHandler.GetStockReport)
+			r.Get("/related", restHandler.GetRelatedProducts)
 		})
 		r.Post("/import-csv", restHandler.ImportProductsFromCSV)
 		r.Get("/export-csv", restHandler.ExportProductsToCSV)
 	})
+
+	//* exchange rate router
+	r.Route("/exchange-rates", func(r chi.Router) {
+		r.Post("/", restHandler.CreateExchangeRate)
+		r.Get("/", restHandler.GetExchangeRates)
+		r.Post("/import-csv", restHandler.ImportExchangeRatesFromCSV)
+	})
+
+	//* warehouse router
+	r.Route("/warehouses", func(r chi.Router) {
+		r.Post("/", restHandler.CreateWarehouse)
+		r.Get("/", restHandler.GetWarehouses)
+	})
+
+	//* shipping method router
+	r.Route("/shipping-methods", func(r chi.Router) {
+		r.Post("/", restHandler.CreateShippingMethod)
+		r.Get("/", restHandler.GetShippingMethods)
+	})
+
+	//* tax rule router
+	r.Route("/tax-rules", func(r chi.Router) {
+		r.Post("/", restHandler.CreateTaxRule)
+		r.Get("/", restHandler.GetTaxRules)
+	})
+
+	//* order router
+	r.Route("/orders", func(r chi.Router) {
+		r.Get("/export", restHandler.ExportOrders)
+	})
+
+	//* return request router
+	r.Route("/returns", func(r chi.Router) {
+		r.Post("/", restHandler.CreateReturnRequest)
+		r.Get("/", restHandler.GetReturnRequests)
+		r.Put("/{returnRequestID}/status", restHandler.UpdateReturnStatus)
+	})
+
+	//* product review router
+	r.Route("/reviews", func(r chi.Router) {
+		r.Put("/{reviewID}/status", restHandler.ModerateProductReview)
+	})
+
+	//* analytics router
+	r.Route("/analytics", func(r chi.Router) {
+		r.Get("/revenue", restHandler.GetRevenue)
+		r.Get("/top-products", restHandler.GetTopProducts)
+		r.Get("/top-categories", restHandler.GetTopCategories)
+		r.Get("/average-order-value", restHandler.GetAverageOrderValue)
+	})
+
+	//* admin router
+	r.Route("/admin", func(r chi.Router) {
+		r.Get("/jobs", restHandler.GetJobRuns)
+		r.Get("/products/archived", restHandler.GetArchivedProducts)
+		r.Delete("/products/archived", restHandler.PurgeArchivedProducts)
+	})
 }
 
 // initGraph initializes the GraphQL API for the application
@@ -0,0 +1,10 @@
+ALTER TABLE orders
+DROP COLUMN tax_price,
+DROP COLUMN subtotal_price,
+DROP COLUMN region;
+
+ALTER TABLE order_items
+DROP COLUMN tax_amount,
+DROP COLUMN tax_rate;
+
+DROP TABLE IF EXISTS "tax_rules";
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "tax_rules" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    category_id INT NOT NULL,
+    region VARCHAR(255) NOT NULL DEFAULT '',
+    rate NUMERIC(5,4) NOT NULL,
+    effective_from TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    effective_to TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (category_id) REFERENCES "product_categories"(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tax_rules_category_region ON tax_rules(category_id, region);
+
+ALTER TABLE order_items
+ADD COLUMN tax_rate NUMERIC(5,4) NOT NULL DEFAULT 0,
+ADD COLUMN tax_amount NUMERIC(17,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE orders
+ADD COLUMN region VARCHAR(255) NOT NULL DEFAULT '',
+ADD COLUMN subtotal_price NUMERIC(17,2),
+ADD COLUMN tax_price NUMERIC(17,2);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS "shipments";
+
+ALTER TABLE orders
+DROP COLUMN shipping_price,
+DROP COLUMN shipping_method_id,
+DROP COLUMN address_id;
+
+DROP TABLE IF EXISTS "shipping_methods";
+
+DROP TABLE IF EXISTS "addresses";
+
+ALTER TABLE products
+DROP COLUMN weight;
@@ -0,0 +1,47 @@
+ALTER TABLE products
+ADD COLUMN weight NUMERIC(10,3) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "addresses" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    user_id INT NOT NULL,
+    recipient_name VARCHAR(255) NOT NULL,
+    phone VARCHAR(50) NOT NULL,
+    street VARCHAR(255) NOT NULL,
+    city VARCHAR(255) NOT NULL,
+    region VARCHAR(255) NOT NULL DEFAULT '',
+    postal_code VARCHAR(50) NOT NULL DEFAULT '',
+    country VARCHAR(255) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "users"(id)
+);
+
+CREATE TABLE IF NOT EXISTS "shipping_methods" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    carrier VARCHAR(255) NOT NULL,
+    rate_type VARCHAR(50) NOT NULL,
+    base_rate NUMERIC(17,2) NOT NULL,
+    rate_per_kg NUMERIC(17,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE orders
+ADD COLUMN address_id INT REFERENCES "addresses"(id),
+ADD COLUMN shipping_method_id INT REFERENCES "shipping_methods"(id),
+ADD COLUMN shipping_price NUMERIC(17,2);
+
+CREATE TABLE IF NOT EXISTS "shipments" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    order_id INT NOT NULL,
+    carrier VARCHAR(255) NOT NULL,
+    tracking_number VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    shipped_at TIMESTAMP,
+    delivered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (order_id) REFERENCES "orders"(id)
+);
@@ -0,0 +1,8 @@
+ALTER TABLE orders
+DROP COLUMN exchange_rate,
+DROP COLUMN currency;
+
+DROP TABLE IF EXISTS "exchange_rates";
+
+ALTER TABLE products
+DROP COLUMN currency;
@@ -0,0 +1,16 @@
+ALTER TABLE products
+ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'VND';
+
+CREATE TABLE IF NOT EXISTS "exchange_rates" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(18,6) NOT NULL,
+    effective_from TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (currency, effective_from)
+);
+
+ALTER TABLE orders
+ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'VND',
+ADD COLUMN exchange_rate NUMERIC(18,6);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS "return_requests" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    order_id INT NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    reason TEXT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    refund_amount NUMERIC(17,2),
+    replacement_order_id INT,
+    received_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (order_id) REFERENCES "orders"(id),
+    FOREIGN KEY (replacement_order_id) REFERENCES "orders"(id)
+);
+
+CREATE INDEX IF NOT EXISTS return_requests_status_idx ON "return_requests"(status);
+
+CREATE TABLE IF NOT EXISTS "return_items" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    return_request_id INT NOT NULL,
+    order_item_id INT NOT NULL,
+    quantity INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (return_request_id) REFERENCES "return_requests"(id),
+    FOREIGN KEY (order_item_id) REFERENCES "order_items"(id)
+);
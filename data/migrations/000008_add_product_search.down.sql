@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS products_name_trgm_idx;
+DROP INDEX IF EXISTS products_search_vector_idx;
+
+ALTER TABLE products
+DROP COLUMN search_vector;
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS product_search;
+DROP FUNCTION IF EXISTS immutable_unaccent(text);
@@ -0,0 +1,21 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent is only STABLE as its dictionary can be changed, this wrapper lets it be used in index expressions
+CREATE OR REPLACE FUNCTION immutable_unaccent(text) RETURNS text AS $$
+    SELECT public.unaccent('public.unaccent', $1)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- there is no Vietnamese dictionary, the words are unaccented and kept as they are
+CREATE TEXT SEARCH CONFIGURATION product_search (COPY = simple);
+ALTER TEXT SEARCH CONFIGURATION product_search
+ALTER MAPPING FOR hword, hword_part, word WITH unaccent, simple;
+
+ALTER TABLE products
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('product_search', name), 'A') ||
+    setweight(to_tsvector('product_search', description), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS products_search_vector_idx ON "products" USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS products_name_trgm_idx ON "products" USING GIN (lower(immutable_unaccent(name)) gin_trgm_ops);
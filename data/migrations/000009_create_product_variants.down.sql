@@ -0,0 +1,6 @@
+ALTER TABLE order_items
+DROP COLUMN variant_id;
+
+DROP TABLE IF EXISTS "variant_option_values";
+DROP TABLE IF EXISTS "product_variants";
+DROP TABLE IF EXISTS "option_types";
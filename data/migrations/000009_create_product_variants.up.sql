@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS "option_types" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_option_type_name UNIQUE (product_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS "product_variants" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    sku VARCHAR(64) NOT NULL,
+    price NUMERIC(17,2),
+    quantity INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_variant_sku UNIQUE (sku)
+);
+
+CREATE INDEX IF NOT EXISTS product_variants_product_id_idx ON "product_variants"(product_id);
+
+CREATE TABLE IF NOT EXISTS "variant_option_values" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    variant_id INT NOT NULL,
+    option_type_id INT NOT NULL,
+    value VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (variant_id) REFERENCES "product_variants"(id) ON DELETE CASCADE,
+    FOREIGN KEY (option_type_id) REFERENCES "option_types"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_variant_option_type UNIQUE (variant_id, option_type_id)
+);
+
+ALTER TABLE order_items
+ADD COLUMN variant_id INT REFERENCES "product_variants"(id);
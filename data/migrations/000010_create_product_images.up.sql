@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "product_images" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    storage_key VARCHAR(255) NOT NULL,
+    content_type VARCHAR(32) NOT NULL,
+    size INT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_product_image_storage_key UNIQUE (storage_key)
+);
+
+CREATE INDEX IF NOT EXISTS product_images_product_id_position_idx ON "product_images"(product_id, position);
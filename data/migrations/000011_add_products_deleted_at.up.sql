@@ -0,0 +1,5 @@
+ALTER TABLE products
+ADD COLUMN deleted_at TIMESTAMP NULL;
+
+-- only the archived products are indexed, they are listed and purged by the time they were archived
+CREATE INDEX IF NOT EXISTS products_deleted_at_idx ON "products"(deleted_at) WHERE deleted_at IS NOT NULL;
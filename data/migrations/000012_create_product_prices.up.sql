@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "product_prices" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    price NUMERIC(17,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    effective_from TIMESTAMP NOT NULL,
+    effective_to TIMESTAMP,
+    applied_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS product_prices_product_id_effective_from_idx ON "product_prices"(product_id, effective_from);
+
+-- the scheduled prices which have not been applied to the products yet are looked up by the job that applies them
+CREATE INDEX IF NOT EXISTS product_prices_effective_from_idx ON "product_prices"(effective_from) WHERE applied_at IS NULL;
+
+-- the current price of each product starts its history
+INSERT INTO "product_prices"(product_id, price, currency, effective_from, applied_at)
+SELECT id, price, currency, created_at, created_at FROM "products";
@@ -0,0 +1,25 @@
+-- the movements are never updated or deleted, the quantity of a product or variant is the sum of the deltas of its movements
+CREATE TABLE IF NOT EXISTS "stock_movements" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    variant_id INT,
+    delta INT NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    reference_id INT,
+    actor_id INT,
+    note TEXT NOT NULL DEFAULT '',
+    quantity_after INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    FOREIGN KEY (variant_id) REFERENCES "product_variants"(id) ON DELETE CASCADE,
+    FOREIGN KEY (actor_id) REFERENCES "users"(id)
+);
+
+CREATE INDEX IF NOT EXISTS stock_movements_product_id_variant_id_idx ON "stock_movements"(product_id, variant_id, id);
+
+-- the current quantities open the ledger
+INSERT INTO "stock_movements"(product_id, delta, reason, note, quantity_after)
+SELECT id, quantity, 'adjustment', 'opening balance', quantity FROM "products" WHERE quantity <> 0;
+
+INSERT INTO "stock_movements"(product_id, variant_id, delta, reason, note, quantity_after)
+SELECT product_id, id, quantity, 'adjustment', 'opening balance', quantity FROM "product_variants" WHERE quantity <> 0;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "low_stock_alerts";
+
+ALTER TABLE products
+DROP COLUMN reorder_threshold;
@@ -0,0 +1,18 @@
+-- the quantity of a product below its reorder threshold raises a low stock alert, a threshold of 0 disables the alerts
+ALTER TABLE products
+ADD COLUMN reorder_threshold INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "low_stock_alerts" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    quantity INT NOT NULL,
+    threshold INT NOT NULL,
+    notified_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE
+);
+
+-- a product has at most one open alert until its quantity is back to the threshold
+CREATE UNIQUE INDEX IF NOT EXISTS low_stock_alerts_open_product_id_idx ON "low_stock_alerts"(product_id) WHERE resolved_at IS NULL;
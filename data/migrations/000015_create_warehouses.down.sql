@@ -0,0 +1,9 @@
+ALTER TABLE order_items
+DROP COLUMN warehouse_id;
+
+ALTER TABLE stock_movements
+DROP COLUMN warehouse_id;
+
+DROP TABLE IF EXISTS "warehouse_stocks";
+
+DROP TABLE IF EXISTS "warehouses";
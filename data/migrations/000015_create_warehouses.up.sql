@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS "warehouses" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    city VARCHAR(255) NOT NULL DEFAULT '',
+    region VARCHAR(255) NOT NULL DEFAULT '',
+    country VARCHAR(255) NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- the stock which is not given a warehouse is kept in the default warehouse, there is only one
+CREATE UNIQUE INDEX IF NOT EXISTS warehouses_is_default_idx ON "warehouses"(is_default) WHERE is_default;
+
+-- the quantity of a product is the sum of its quantities in the warehouses
+CREATE TABLE IF NOT EXISTS "warehouse_stocks" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    warehouse_id INT NOT NULL,
+    product_id INT NOT NULL,
+    quantity INT NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (warehouse_id, product_id),
+    FOREIGN KEY (warehouse_id) REFERENCES "warehouses"(id),
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE
+);
+
+ALTER TABLE stock_movements
+ADD COLUMN warehouse_id INT REFERENCES "warehouses"(id);
+
+-- the warehouse an order item is fulfilled from, the stock of a variant is not kept by warehouse
+ALTER TABLE order_items
+ADD COLUMN warehouse_id INT REFERENCES "warehouses"(id);
+
+-- the existing stock is kept in the default warehouse
+INSERT INTO "warehouses" (name, is_default) VALUES ('Main warehouse', true);
+
+INSERT INTO "warehouse_stocks" (warehouse_id, product_id, quantity)
+SELECT w.id, p.id, p.quantity FROM "products" p, "warehouses" w WHERE w.is_default AND p.quantity > 0;
+
+UPDATE stock_movements SET warehouse_id = (SELECT id FROM "warehouses" WHERE is_default) WHERE variant_id IS NULL;
+
+UPDATE order_items SET warehouse_id = (SELECT id FROM "warehouses" WHERE is_default) WHERE variant_id IS NULL;
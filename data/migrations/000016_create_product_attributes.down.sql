@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "product_tags";
+
+DROP TABLE IF EXISTS "product_attribute_values";
+
+DROP TABLE IF EXISTS "attribute_definitions";
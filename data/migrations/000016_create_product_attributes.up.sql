@@ -0,0 +1,41 @@
+-- the attributes a product of a category can be described with, such as the RAM size of a smartphone
+CREATE TABLE IF NOT EXISTS "attribute_definitions" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    category_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('text', 'number', 'boolean')),
+    unit VARCHAR(32) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (category_id) REFERENCES "product_categories"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_attribute_definition_name UNIQUE (category_id, name)
+);
+
+-- the value of a number attribute is also kept as a number so that it can be filtered by range
+CREATE TABLE IF NOT EXISTS "product_attribute_values" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    attribute_id INT NOT NULL,
+    value TEXT NOT NULL,
+    value_number NUMERIC(17,4),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    FOREIGN KEY (attribute_id) REFERENCES "attribute_definitions"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_product_attribute UNIQUE (product_id, attribute_id)
+);
+
+CREATE INDEX IF NOT EXISTS product_attribute_values_value_idx ON "product_attribute_values"(attribute_id, lower(value));
+CREATE INDEX IF NOT EXISTS product_attribute_values_value_number_idx ON "product_attribute_values"(attribute_id, value_number) WHERE value_number IS NOT NULL;
+
+-- the free-form tags of a product, they are kept lowercase
+CREATE TABLE IF NOT EXISTS "product_tags" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    tag VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    CONSTRAINT unique_product_tag UNIQUE (product_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS product_tags_tag_idx ON "product_tags"(tag, product_id);
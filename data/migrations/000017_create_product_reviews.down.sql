@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "product_reviews";
+
+ALTER TABLE products
+DROP COLUMN rating_average,
+DROP COLUMN rating_total,
+DROP COLUMN review_count;
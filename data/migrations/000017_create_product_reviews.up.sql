@@ -0,0 +1,25 @@
+-- the rating of a product is kept up to date from its approved reviews, the average is 0 until the product has one
+ALTER TABLE products
+ADD COLUMN review_count INT NOT NULL DEFAULT 0,
+ADD COLUMN rating_total INT NOT NULL DEFAULT 0,
+ADD COLUMN rating_average NUMERIC(3,2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "product_reviews" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    user_id INT NOT NULL,
+    rating INT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    body TEXT NOT NULL DEFAULT '',
+    status VARCHAR(50) NOT NULL DEFAULT 'PENDING',
+    note TEXT NOT NULL DEFAULT '',
+    moderated_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES "users"(id),
+    -- a customer reviews a product once
+    UNIQUE (product_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS product_reviews_product_id_status_idx ON "product_reviews"(product_id, status, created_at);
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS orders_bump_version ON orders;
+DROP TRIGGER IF EXISTS products_bump_version ON products;
+DROP FUNCTION IF EXISTS bump_version();
+
+ALTER TABLE orders
+DROP COLUMN version;
+
+ALTER TABLE products
+DROP COLUMN version;
@@ -0,0 +1,25 @@
+-- the version of a product or an order is bumped by every update of its row, an update is rejected if the version
+-- the client read is out of date
+ALTER TABLE products
+ADD COLUMN version INT NOT NULL DEFAULT 1;
+
+ALTER TABLE orders
+ADD COLUMN version INT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION bump_version()
+RETURNS TRIGGER
+LANGUAGE plpgsql AS
+$func$
+BEGIN
+    NEW.version := OLD.version + 1;
+    RETURN NEW;
+END
+$func$;
+
+CREATE TRIGGER products_bump_version
+BEFORE UPDATE ON products
+FOR EACH ROW EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER orders_bump_version
+BEFORE UPDATE ON orders
+FOR EACH ROW EXECUTE FUNCTION bump_version();
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS products_set_sku_and_slug ON products;
+DROP FUNCTION IF EXISTS set_product_sku_and_slug();
+DROP FUNCTION IF EXISTS slugify(text);
+
+DROP TABLE IF EXISTS "product_slug_redirects";
+
+ALTER TABLE products
+DROP COLUMN slug,
+DROP COLUMN sku;
@@ -0,0 +1,72 @@
+-- the sku of a product is given when it is created or generated from its id, it never changes so that it can be used
+-- as the key of the product in the CSV files. The slug is generated from the name and changed when the product is
+-- renamed, the old slugs redirect to the product
+ALTER TABLE products
+ADD COLUMN sku VARCHAR(64) NOT NULL DEFAULT '',
+ADD COLUMN slug VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE TABLE "product_slug_redirects" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    product_id INT NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS product_slug_redirects_product_id_idx ON "product_slug_redirects"(product_id);
+
+-- the accents are removed and every run of other characters than letters and digits is replaced by a -
+CREATE OR REPLACE FUNCTION slugify(text) RETURNS text AS $$
+    SELECT trim(both '-' from regexp_replace(lower(immutable_unaccent($1)), '[^a-z0-9]+', '-', 'g'))
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+CREATE OR REPLACE FUNCTION set_product_sku_and_slug()
+RETURNS TRIGGER
+LANGUAGE plpgsql AS
+$func$
+DECLARE
+    base TEXT;
+    n INT := 1;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        IF NEW.sku = '' THEN
+            NEW.sku := 'P' || lpad(NEW.id::text, 8, '0');
+        END IF;
+    ELSIF NEW.sku <> OLD.sku THEN
+        RAISE EXCEPTION 'the sku of product % cannot be changed', OLD.id USING ERRCODE = 'check_violation';
+    END IF;
+
+    IF TG_OP = 'INSERT' OR NEW.name <> OLD.name THEN
+        -- the slug of another product with a similar name is kept, a number is added to the new one
+        base := coalesce(nullif(left(slugify(NEW.name), 240), ''), 'product');
+        NEW.slug := base;
+        WHILE EXISTS (SELECT 1 FROM products WHERE slug = NEW.slug AND id <> NEW.id) LOOP
+            n := n + 1;
+            NEW.slug := base || '-' || n;
+        END LOOP;
+
+        IF TG_OP = 'UPDATE' AND NEW.slug <> OLD.slug THEN
+            INSERT INTO product_slug_redirects(product_id, slug) VALUES (NEW.id, OLD.slug)
+            ON CONFLICT (slug) DO UPDATE SET product_id = EXCLUDED.product_id, created_at = CURRENT_TIMESTAMP;
+        END IF;
+
+        -- the current slug of a product takes precedence over the old slugs of any product
+        DELETE FROM product_slug_redirects WHERE slug = NEW.slug;
+    END IF;
+
+    RETURN NEW;
+END
+$func$;
+
+-- the existing products get the sku generated from their id, and the slug of their name numbered by id if it is taken
+UPDATE products SET sku = 'P' || lpad(id::text, 8, '0'), slug = coalesce(nullif(left(slugify(name), 240), ''), 'product');
+UPDATE products p SET slug = p.slug || '-' || p.id
+WHERE EXISTS (SELECT 1 FROM products q WHERE q.slug = p.slug AND q.id < p.id);
+
+ALTER TABLE products
+ADD CONSTRAINT products_sku_key UNIQUE (sku),
+ADD CONSTRAINT products_slug_key UNIQUE (slug);
+
+CREATE TRIGGER products_set_sku_and_slug
+BEFORE INSERT OR UPDATE ON products
+FOR EACH ROW EXECUTE FUNCTION set_product_sku_and_slug();
@@ -0,0 +1,20 @@
+-- a user saves products to their wishlist, a wishlisted product out of stock can be subscribed to so that the user
+-- is emailed once it is back in stock
+CREATE TABLE IF NOT EXISTS "wishlist_items" (
+    id SERIAL PRIMARY KEY NOT NULL,
+    user_id INT NOT NULL,
+    product_id INT NOT NULL,
+    notify_back_in_stock BOOLEAN NOT NULL DEFAULT false,
+    -- restocked_at is the time the quantity of the product came back above 0 while the item was subscribed,
+    -- the subscription is dispatched and cleared by the back in stock job
+    restocked_at TIMESTAMP,
+    notified_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "users"(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES "products"(id) ON DELETE CASCADE,
+    -- a product is saved to a wishlist once
+    UNIQUE (user_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS wishlist_items_product_id_idx ON "wishlist_items"(product_id) WHERE notify_back_in_stock;
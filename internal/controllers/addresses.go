@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+)
+
+type AddressInput struct {
+	UserID        int
+	RecipientName string
+	Phone         string
+	Street        string
+	City          string
+	Region        string
+	PostalCode    string
+	Country       string
+	IsDefault     bool
+}
+
+// CreateAddress adds an address to the address book of a user
+func (c *Controller) CreateAddress(ctx context.Context, aInput AddressInput) error {
+	// check user exists
+	if _, err := c.Repository.GetUser(ctx, aInput.UserID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	_, err := c.Repository.CreateAddress(ctx, repositories.Address{
+		UserID:        aInput.UserID,
+		RecipientName: aInput.RecipientName,
+		Phone:         aInput.Phone,
+		Street:        aInput.Street,
+		City:          aInput.City,
+		Region:        aInput.Region,
+		PostalCode:    aInput.PostalCode,
+		Country:       aInput.Country,
+		IsDefault:     aInput.IsDefault,
+	})
+	return err
+}
+
+type AddressOutput struct {
+	ID            int
+	UserID        int
+	RecipientName string
+	Phone         string
+	Street        string
+	City          string
+	Region        string
+	PostalCode    string
+	Country       string
+	IsDefault     bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// GetAddresses retrieves the address book of a user
+func (c *Controller) GetAddresses(ctx context.Context, userID int) ([]AddressOutput, error) {
+	// check user exists
+	if _, err := c.Repository.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	addresses, err := c.Repository.GetAddresses(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var aOutput []AddressOutput
+	for _, a := range addresses {
+		aOutput = append(aOutput, AddressOutput{
+			ID:            a.ID,
+			UserID:        a.UserID,
+			RecipientName: a.RecipientName,
+			Phone:         a.Phone,
+			Street:        a.Street,
+			City:          a.City,
+			Region:        a.Region,
+			PostalCode:    a.PostalCode,
+			Country:       a.Country,
+			IsDefault:     a.IsDefault,
+			CreatedAt:     a.CreatedAt,
+			UpdatedAt:     a.UpdatedAt,
+		})
+	}
+
+	return aOutput, nil
+}
+
+// DeleteAddress removes an address from the address book of a user
+func (c *Controller) DeleteAddress(ctx context.Context, userID, addressID int) error {
+	// check the address belongs to the user
+	if _, err := c.getUserAddress(ctx, userID, addressID); err != nil {
+		return err
+	}
+
+	return c.Repository.DeleteAddress(ctx, addressID)
+}
+
+// getUserAddress retrieves an address of the address book of a user
+func (c *Controller) getUserAddress(ctx context.Context, userID, addressID int) (AddressOutput, error) {
+	address, err := c.Repository.GetAddress(ctx, addressID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAddressNotFound) {
+			return AddressOutput{}, ErrAddressNotFound
+		}
+		return AddressOutput{}, err
+	}
+
+	if address.UserID != userID {
+		return AddressOutput{}, ErrAddressNotFound
+	}
+
+	return AddressOutput{
+		ID:            address.ID,
+		UserID:        address.UserID,
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
+		Street:        address.Street,
+		City:          address.City,
+		Region:        address.Region,
+		PostalCode:    address.PostalCode,
+		Country:       address.Country,
+		IsDefault:     address.IsDefault,
+		CreatedAt:     address.CreatedAt,
+		UpdatedAt:     address.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test DeleteAddress in Controller layer
+func Test_AddressController_DeleteAddress(t *testing.T) {
+	type mockAddressRepo struct {
+		output models.Address
+		err    error
+	}
+	tests := map[string]struct {
+		userID          int
+		addressID       int
+		mockAddressRepo mockAddressRepo
+		expDelete       bool
+		expErr          error
+	}{
+		"success": {
+			userID:    1,
+			addressID: 1,
+			mockAddressRepo: mockAddressRepo{
+				output: models.Address{ID: 1, UserID: 1},
+			},
+			expDelete: true,
+		},
+		"address of another user": {
+			userID:    2,
+			addressID: 1,
+			mockAddressRepo: mockAddressRepo{
+				output: models.Address{ID: 1, UserID: 1},
+			},
+			expErr: ErrAddressNotFound,
+		},
+		"address not found": {
+			userID:    1,
+			addressID: 1,
+			mockAddressRepo: mockAddressRepo{
+				err: repositories.ErrAddressNotFound,
+			},
+			expErr: ErrAddressNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			mockRepo.On("GetAddress", context.Background(), tc.addressID).Return(tc.mockAddressRepo.output, tc.mockAddressRepo.err)
+			if tc.expDelete {
+				mockRepo.On("DeleteAddress", context.Background(), tc.addressID).Return(nil)
+			}
+
+			err := controller.DeleteAddress(context.Background(), tc.userID, tc.addressID)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
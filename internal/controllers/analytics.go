@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+)
+
+// periods the revenue can be grouped by
+const (
+	AnalyticsPeriodDay   = "day"
+	AnalyticsPeriodWeek  = "week"
+	AnalyticsPeriodMonth = "month"
+)
+
+// measures the top products and categories can be sorted by
+const (
+	AnalyticsSortRevenue = "revenue"
+	AnalyticsSortUnits   = "units"
+)
+
+// number of top products and categories returned by default and at most
+const (
+	defaultTopSellersLimit = 10
+	maxTopSellersLimit     = 100
+)
+
+// salesOrderStatuses are the statuses of the orders counted as sales, the orders which are not paid or cancelled are left out
+var salesOrderStatuses = []string{OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered}
+
+type AnalyticsFilterCtrl struct {
+	// StartDate and EndDate are the first and last days of the range, both included
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type RevenuePeriodOutput struct {
+	Period     time.Time
+	Revenue    decimal.Decimal
+	OrderCount int
+}
+
+// GetRevenueByPeriod retrieves the revenue in VND and the number of paid orders of each day, week or month of the range
+func (c *Controller) GetRevenueByPeriod(ctx context.Context, filter AnalyticsFilterCtrl, period string) ([]RevenuePeriodOutput, error) {
+	if period != AnalyticsPeriodDay && period != AnalyticsPeriodWeek && period != AnalyticsPeriodMonth {
+		return nil, ErrInvalidAnalyticsPeriod
+	}
+
+	repoFilter, err := toAnalyticsFilterRepo(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, err := c.Repository.GetRevenueByPeriod(ctx, repoFilter, period)
+	if err != nil {
+		return nil, err
+	}
+
+	var rOutput []RevenuePeriodOutput
+	for _, r := range revenue {
+		rOutput = append(rOutput, RevenuePeriodOutput{
+			Period:     r.Period,
+			Revenue:    r.Revenue,
+			OrderCount: r.OrderCount,
+		})
+	}
+
+	return rOutput, nil
+}
+
+type TopSellerOutput struct {
+	ID      int
+	Name    string
+	Units   int
+	Revenue decimal.Decimal
+}
+
+// GetTopProducts retrieves the products with the highest revenue in VND or the most units sold in the range
+func (c *Controller) GetTopProducts(ctx context.Context, filter AnalyticsFilterCtrl, sortBy string, limit int) ([]TopSellerOutput, error) {
+	repoFilter, limit, err := validateTopSellersQuery(filter, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	topProducts, err := c.Repository.GetTopProducts(ctx, repoFilter, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTopSellersOutput(topProducts), nil
+}
+
+// GetTopCategories retrieves the product categories with the highest revenue in VND or the most units sold in the range
+func (c *Controller) GetTopCategories(ctx context.Context, filter AnalyticsFilterCtrl, sortBy string, limit int) ([]TopSellerOutput, error) {
+	repoFilter, limit, err := validateTopSellersQuery(filter, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	topCategories, err := c.Repository.GetTopCategories(ctx, repoFilter, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTopSellersOutput(topCategories), nil
+}
+
+type OrderValueOutput struct {
+	OrderCount        int
+	Revenue           decimal.Decimal
+	AverageOrderValue decimal.Decimal
+}
+
+// GetAverageOrderValue retrieves the number of paid orders, the revenue and the average order value in VND of the range
+func (c *Controller) GetAverageOrderValue(ctx context.Context, filter AnalyticsFilterCtrl) (OrderValueOutput, error) {
+	repoFilter, err := toAnalyticsFilterRepo(filter)
+	if err != nil {
+		return OrderValueOutput{}, err
+	}
+
+	summary, err := c.Repository.GetOrderValueSummary(ctx, repoFilter)
+	if err != nil {
+		return OrderValueOutput{}, err
+	}
+
+	return OrderValueOutput{
+		OrderCount:        summary.OrderCount,
+		Revenue:           summary.Revenue,
+		AverageOrderValue: summary.AverageOrderValue,
+	}, nil
+}
+
+// toAnalyticsFilterRepo validates the date range and converts the filter to the one in repository layer
+func toAnalyticsFilterRepo(filter AnalyticsFilterCtrl) (repositories.AnalyticsFilter, error) {
+	if filter.StartDate.IsZero() || filter.EndDate.IsZero() {
+		return repositories.AnalyticsFilter{}, ErrMissingDateRange
+	}
+
+	if filter.StartDate.After(filter.EndDate) {
+		return repositories.AnalyticsFilter{}, ErrStartDateAfterEndDate
+	}
+
+	return repositories.AnalyticsFilter{
+		StartDate: filter.StartDate,
+		EndDate:   filter.EndDate,
+		Statuses:  salesOrderStatuses,
+	}, nil
+}
+
+// validateTopSellersQuery validates the query of the top products and categories, the limit is defaulted if not given
+func validateTopSellersQuery(filter AnalyticsFilterCtrl, sortBy string, limit int) (repositories.AnalyticsFilter, int, error) {
+	if sortBy != AnalyticsSortRevenue && sortBy != AnalyticsSortUnits {
+		return repositories.AnalyticsFilter{}, 0, ErrInvalidAnalyticsSort
+	}
+
+	if limit == 0 {
+		limit = defaultTopSellersLimit
+	}
+	if limit < 0 || limit > maxTopSellersLimit {
+		return repositories.AnalyticsFilter{}, 0, ErrInvalidAnalyticsLimit
+	}
+
+	repoFilter, err := toAnalyticsFilterRepo(filter)
+	if err != nil {
+		return repositories.AnalyticsFilter{}, 0, err
+	}
+
+	return repoFilter, limit, nil
+}
+
+// toTopSellersOutput converts the top sellers in repository layer to the ones in controller layer
+func toTopSellersOutput(topSellers []repositories.TopSeller) []TopSellerOutput {
+	var tsOutput []TopSellerOutput
+	for _, ts := range topSellers {
+		tsOutput = append(tsOutput, TopSellerOutput{
+			ID:      ts.ID,
+			Name:    ts.Name,
+			Units:   ts.Units,
+			Revenue: ts.Revenue,
+		})
+	}
+
+	return tsOutput
+}
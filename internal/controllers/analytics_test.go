@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test GetRevenueByPeriod in Controller layer
+func Test_AnalyticsController_GetRevenueByPeriod(t *testing.T) {
+	startDate := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC)
+	repoFilter := repositories.AnalyticsFilter{StartDate: startDate, EndDate: endDate, Statuses: salesOrderStatuses}
+
+	type mockAnalyticsRepo struct {
+		expCall bool
+		output  []repositories.RevenuePeriod
+		err     error
+	}
+	tests := map[string]struct {
+		filter            AnalyticsFilterCtrl
+		period            string
+		mockAnalyticsRepo mockAnalyticsRepo
+		expOutput         []RevenuePeriodOutput
+		expErr            error
+	}{
+		"revenue by week": {
+			filter: AnalyticsFilterCtrl{StartDate: startDate, EndDate: endDate},
+			period: AnalyticsPeriodWeek,
+			mockAnalyticsRepo: mockAnalyticsRepo{
+				expCall: true,
+				output: []repositories.RevenuePeriod{
+					{Period: time.Date(2023, 6, 26, 0, 0, 0, 0, time.UTC), Revenue: decimal.NewFromInt(250000), OrderCount: 2},
+					{Period: time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC), Revenue: decimal.NewFromInt(100000), OrderCount: 1},
+				},
+			},
+			expOutput: []RevenuePeriodOutput{
+				{Period: time.Date(2023, 6, 26, 0, 0, 0, 0, time.UTC), Revenue: decimal.NewFromInt(250000), OrderCount: 2},
+				{Period: time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC), Revenue: decimal.NewFromInt(100000), OrderCount: 1},
+			},
+		},
+		"repository error": {
+			filter: AnalyticsFilterCtrl{StartDate: startDate, EndDate: endDate},
+			period: AnalyticsPeriodMonth,
+			mockAnalyticsRepo: mockAnalyticsRepo{
+				expCall: true,
+				err:     errors.New("something went wrong"),
+			},
+			expErr: errors.New("something went wrong"),
+		},
+		"invalid period": {
+			filter: AnalyticsFilterCtrl{StartDate: startDate, EndDate: endDate},
+			period: "year",
+			expErr: ErrInvalidAnalyticsPeriod,
+		},
+		"missing date range": {
+			period: AnalyticsPeriodDay,
+			expErr: ErrMissingDateRange,
+		},
+		"start date after end date": {
+			filter: AnalyticsFilterCtrl{StartDate: endDate, EndDate: startDate},
+			period: AnalyticsPeriodDay,
+			expErr: ErrStartDateAfterEndDate,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockAnalyticsRepo.expCall {
+				mockRepo.On("GetRevenueByPeriod", context.Background(), repoFilter, tc.period).Return(tc.mockAnalyticsRepo.output, tc.mockAnalyticsRepo.err)
+			}
+
+			output, err := controller.GetRevenueByPeriod(context.Background(), tc.filter, tc.period)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test GetTopProducts in Controller layer
+func Test_AnalyticsController_GetTopProducts(t *testing.T) {
+	startDate := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC)
+	repoFilter := repositories.AnalyticsFilter{StartDate: startDate, EndDate: endDate, Statuses: salesOrderStatuses}
+
+	type mockAnalyticsRepo struct {
+		expCall bool
+		limit   int
+		output  []repositories.TopSeller
+	}
+	tests := map[string]struct {
+		sortBy            string
+		limit             int
+		mockAnalyticsRepo mockAnalyticsRepo
+		expOutput         []TopSellerOutput
+		expErr            error
+	}{
+		"top products by units with default limit": {
+			sortBy: AnalyticsSortUnits,
+			mockAnalyticsRepo: mockAnalyticsRepo{
+				expCall: true,
+				limit:   defaultTopSellersLimit,
+				output:  []repositories.TopSeller{{ID: 1, Name: "Phone", Units: 5, Revenue: decimal.NewFromInt(500000)}},
+			},
+			expOutput: []TopSellerOutput{{ID: 1, Name: "Phone", Units: 5, Revenue: decimal.NewFromInt(500000)}},
+		},
+		"top products by revenue": {
+			sortBy: AnalyticsSortRevenue,
+			limit:  3,
+			mockAnalyticsRepo: mockAnalyticsRepo{
+				expCall: true,
+				limit:   3,
+			},
+		},
+		"invalid sort": {
+			sortBy: "profit",
+			expErr: ErrInvalidAnalyticsSort,
+		},
+		"limit too large": {
+			sortBy: AnalyticsSortRevenue,
+			limit:  maxTopSellersLimit + 1,
+			expErr: ErrInvalidAnalyticsLimit,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockAnalyticsRepo.expCall {
+				mockRepo.On("GetTopProducts", context.Background(), repoFilter, tc.sortBy, tc.mockAnalyticsRepo.limit).Return(tc.mockAnalyticsRepo.output, nil)
+			}
+
+			output, err := controller.GetTopProducts(context.Background(), AnalyticsFilterCtrl{StartDate: startDate, EndDate: endDate}, tc.sortBy, tc.limit)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test GetAverageOrderValue in Controller layer
+func Test_AnalyticsController_GetAverageOrderValue(t *testing.T) {
+	startDate := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := repositories.NewMockIRepository(t)
+	controller := NewController(mockRepo)
+	mockRepo.On("GetOrderValueSummary", context.Background(), repositories.AnalyticsFilter{StartDate: startDate, EndDate: endDate, Statuses: salesOrderStatuses}).
+		Return(repositories.OrderValueSummary{OrderCount: 4, Revenue: decimal.NewFromInt(400000), AverageOrderValue: decimal.NewFromInt(100000)}, nil)
+
+	output, err := controller.GetAverageOrderValue(context.Background(), AnalyticsFilterCtrl{StartDate: startDate, EndDate: endDate})
+	assert.NoError(t, err)
+	assert.Equal(t, OrderValueOutput{OrderCount: 4, Revenue: decimal.NewFromInt(400000), AverageOrderValue: decimal.NewFromInt(100000)}, output)
+}
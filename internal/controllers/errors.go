@@ -22,4 +22,96 @@ var (
 	ErrOrderNotFound                   = errors.New("order not found")
 	ErrOrderItemNotFound               = errors.New("order item not found")
 	ErrInvalidOrderID                  = errors.New("invalid order id")
+	ErrInvalidEffectiveDate            = errors.New("effective end date must be after the effective start date")
+	ErrAddressNotFound                 = errors.New("address not found")
+	ErrShippingMethodNotFound          = errors.New("shipping method not found")
+	ErrInvalidShippingRateType         = errors.New("invalid shipping rate type, rate type must be FLAT or WEIGHT")
+	ErrShipmentNotFound                = errors.New("shipment not found")
+	ErrOrderNotShippable               = errors.New("only a paid order can be shipped")
+	ErrMissingCarrier                  = errors.New("carrier cannot be blank")
+	ErrInvalidShipmentStatus           = errors.New("invalid shipment status")
+	ErrInvalidCurrency                 = errors.New("invalid currency, currency must be VND or USD")
+	ErrInvalidExchangeRate             = errors.New("exchange rate must be greater than 0")
+	ErrInvalidEffectiveFromDate        = errors.New("invalid effective date, the date format must be yyyy-mm-dd")
+	ErrIncorrectExchangeRateColumns    = errors.New("incorrect column names, the file must have columns named: Currency, Rate and optionally EffectiveFrom")
+	ErrExchangeRateNotFound            = errors.New("exchange rate not found")
+	ErrOrderCurrencyChanged            = errors.New("the currency of an order cannot be changed")
+	ErrInvalidExportFormat             = errors.New("invalid export format, format must be csv or xlsx")
+	ErrReturnRequestNotFound           = errors.New("return request not found")
+	ErrOrderNotReturnable              = errors.New("only a delivered order can be returned")
+	ErrMissingReturnReason             = errors.New("return reason cannot be blank")
+	ErrMissingReturnItems              = errors.New("a return must have at least one item")
+	ErrInvalidReturnQuantity           = errors.New("return quantity must be greater than 0 and not more than the quantity ordered")
+	ErrInvalidReturnStatus             = errors.New("invalid return status")
+	ErrMissingDateRange                = errors.New("start date and end date cannot be blank")
+	ErrStartDateAfterEndDate           = errors.New("start date must not be after the end date")
+	ErrInvalidAnalyticsPeriod          = errors.New("invalid period, period must be day, week or month")
+	ErrInvalidAnalyticsSort            = errors.New("invalid sort, sort must be revenue or units")
+	ErrInvalidAnalyticsLimit           = errors.New("limit must be between 1 and 100")
+	ErrInvalidProductSort              = errors.New("invalid sort, products can be sorted by name, price, quantity or created_at")
+	ErrInvalidProductLimit             = errors.New("limit must be between 1 and 100")
+	ErrInvalidOffset                   = errors.New("offset must be non-negative")
+	ErrInvalidCursor                   = errors.New("invalid cursor")
+	ErrCursorWithOffset                = errors.New("cursor cannot be used with offset")
+	ErrInvalidPriceRange               = errors.New("min price must not be greater than max price")
+	ErrMissingSearchQuery              = errors.New("search query cannot be blank")
+	ErrSearchQueryTooLong              = errors.New("search query too long")
+	ErrVariantNotFound                 = errors.New("variant not found")
+	ErrMissingSKU                      = errors.New("sku cannot be blank")
+	ErrSKUTooLong                      = errors.New("sku must not be longer than 64 characters")
+	ErrSKUExists                       = errors.New("sku already exists")
+	ErrNameExists                      = errors.New("name already belongs to a product of another sku")
+	ErrMissingVariantOptions           = errors.New("a variant must have at least one option with a name and a value")
+	ErrVariantOptionsMismatch          = errors.New("the options of a variant must match the option types of the product")
+	ErrDuplicateVariant                = errors.New("a variant with the same options already exists")
+	ErrVariantRequired                 = errors.New("the product has variants, a variant must be given")
+	ErrInvalidVariantOptions           = errors.New("invalid options, options must be written as Name=Value pairs separated by ;")
+	ErrProductImageNotFound            = errors.New("product image not found")
+	ErrImageTooLarge                   = errors.New("image must not be larger than 5MB")
+	ErrUnsupportedImageType            = errors.New("unsupported image type, image must be jpeg, png or gif")
+	ErrInvalidImage                    = errors.New("invalid image")
+	ErrImageDimensionsTooLarge         = errors.New("image dimensions too large")
+	ErrInvalidImageOrder               = errors.New("the image ids must be all the images of the product, each given once")
+	ErrProductNotArchived              = errors.New("product is not archived")
+	ErrProductArchived                 = errors.New("product is archived and cannot be ordered")
+	ErrMissingArchivedBefore           = errors.New("the before date the products were archived before must be given")
+	ErrProductPriceNotFound            = errors.New("product price not found")
+	ErrPriceChangeNotInFuture          = errors.New("a price change must be scheduled in the future")
+	ErrPriceNotScheduled               = errors.New("only a scheduled price which is not in effect yet can be cancelled")
+	ErrInvalidStockDelta               = errors.New("stock adjustment must not be 0")
+	ErrInvalidReorderThreshold         = errors.New("reorder threshold must not be negative")
+	ErrWarehouseNotFound               = errors.New("warehouse not found")
+	ErrWarehouseExists                 = errors.New("a warehouse with the same name already exists")
+	ErrInvalidTransferQuantity         = errors.New("transfer quantity must be greater than 0")
+	ErrSameWarehouseTransfer           = errors.New("stock cannot be transferred to the same warehouse")
+	ErrVariantWarehouse                = errors.New("the stock of a variant is not kept by warehouse")
+	ErrInvalidAttributeType            = errors.New("invalid attribute type, type must be text, number or boolean")
+	ErrAttributeExists                 = errors.New("an attribute with the same name already exists in the category")
+	ErrUnknownAttribute                = errors.New("the attribute is not defined for the category of the product")
+	ErrInvalidAttributeValue           = errors.New("invalid attribute value, the value must be of the type of the attribute")
+	ErrInvalidAttributes               = errors.New("invalid attributes, attributes must be written as Name=Value pairs separated by ;")
+	ErrInvalidAttributeFilter          = errors.New("invalid attribute filter, the min and max must be numbers and the min must not be greater than the max")
+	ErrInvalidTag                      = errors.New("invalid tag, a tag must not be longer than 64 characters or contain , or ;")
+	ErrTooManyTags                     = errors.New("a product must not have more than 20 tags")
+	ErrInvalidBulkSelection            = errors.New("the products must be selected by either a list of positive IDs or a filter")
+	ErrTooManyBulkProducts             = errors.New("a bulk operation must not change more than 1000 products")
+	ErrInvalidBulkPriceChange          = errors.New("either a non-zero amount or a non-zero percent greater than -100 must be given")
+	ErrInvalidBulkStockChange          = errors.New("either a quantity of at least 0 or a non-zero delta must be given")
+	ErrBulkRolledBack                  = errors.New("not changed, the bulk operation failed on another product")
+	ErrProductReviewNotFound           = errors.New("product review not found")
+	ErrInvalidRating                   = errors.New("rating must be between 1 and 5")
+	ErrMissingReviewBody               = errors.New("review text cannot be blank")
+	ErrReviewTooLong                   = errors.New("review title must not be longer than 255 characters and text not longer than 5000 characters")
+	ErrReviewerNotVerified             = errors.New("only a customer who bought the product can review it")
+	ErrProductReviewExists             = errors.New("the customer has already reviewed the product")
+	ErrInvalidReviewStatus             = errors.New("invalid review status")
+	ErrInvalidReviewLimit              = errors.New("limit must be between 1 and 100")
+	ErrProductVersionConflict          = errors.New("the product has been changed since it was read, reload it and try again")
+	ErrOrderVersionConflict            = errors.New("the order has been changed since it was read, reload it and try again")
+	ErrInvalidSKU                      = errors.New("sku must only contain letters, digits, ., _ and -")
+	ErrSKUImmutable                    = errors.New("the sku of a product cannot be changed")
+	ErrWishlistItemNotFound            = errors.New("the product is not in the wishlist")
+	ErrWishlistItemExists              = errors.New("the product is already in the wishlist")
+	ErrProductInStock                  = errors.New("the product is in stock, only an out of stock product can be subscribed to")
+	ErrInvalidRelatedProductsLimit     = errors.New("limit must be between 1 and 20")
 )
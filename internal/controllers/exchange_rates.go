@@ -0,0 +1,195 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+)
+
+// supported currencies, an exchange rate is the number of VND per one unit of the foreign currency
+const (
+	// CurrencyVND is the base currency which every exchange rate is quoted in
+	CurrencyVND = "VND"
+	CurrencyUSD = "USD"
+)
+
+// IsSupportedCurrency reports whether the currency code is one of the supported currencies
+func IsSupportedCurrency(currency string) bool {
+	return currency == CurrencyVND || currency == CurrencyUSD
+}
+
+type ExchangeRateInput struct {
+	Currency      string
+	Rate          decimal.Decimal
+	EffectiveFrom time.Time
+}
+
+// CreateExchangeRate creates an exchange rate of a foreign currency against VND
+func (c *Controller) CreateExchangeRate(ctx context.Context, erInput ExchangeRateInput) error {
+	exchangeRate, err := validateExchangeRate(erInput)
+	if err != nil {
+		return err
+	}
+
+	return c.Repository.CreateExchangeRate(ctx, exchangeRate)
+}
+
+type ExchangeRateIndexHeader struct {
+	Currency      int
+	Rate          int
+	EffectiveFrom int
+}
+
+// ImportExchangeRatesFromCSV imports list of exchange rates from a CSV file.
+// The file must have the Currency and Rate columns, the EffectiveFrom column (yyyy-mm-dd) is optional.
+// Nothing is imported if any row is invalid
+func (c *Controller) ImportExchangeRatesFromCSV(ctx context.Context, file multipart.File) error {
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil || len(records) == 0 {
+		return ErrCSVFileFormat
+	}
+
+	erHeader := ExchangeRateIndexHeader{Currency: -1, Rate: -1, EffectiveFrom: -1}
+	for i, r := range records[0] {
+		switch strings.TrimSpace(r) {
+		case "Currency":
+			erHeader.Currency = i
+		case "Rate":
+			erHeader.Rate = i
+		case "EffectiveFrom":
+			erHeader.EffectiveFrom = i
+		}
+	}
+	if erHeader.Currency == -1 || erHeader.Rate == -1 {
+		return ErrIncorrectExchangeRateColumns
+	}
+
+	var exchangeRates []repositories.ExchangeRate
+	// skip the first index, which is the header
+	for _, record := range records[1:] {
+		rate, err := decimal.NewFromString(strings.TrimSpace(record[erHeader.Rate]))
+		if err != nil {
+			return ErrInvalidExchangeRate
+		}
+
+		erInput := ExchangeRateInput{
+			Currency: strings.ToUpper(strings.TrimSpace(record[erHeader.Currency])),
+			Rate:     rate,
+		}
+		if erHeader.EffectiveFrom != -1 && strings.TrimSpace(record[erHeader.EffectiveFrom]) != "" {
+			erInput.EffectiveFrom, err = time.Parse("2006-01-02", strings.TrimSpace(record[erHeader.EffectiveFrom]))
+			if err != nil {
+				return ErrInvalidEffectiveFromDate
+			}
+		}
+
+		exchangeRate, err := validateExchangeRate(erInput)
+		if err != nil {
+			return err
+		}
+		exchangeRates = append(exchangeRates, exchangeRate)
+	}
+
+	if len(exchangeRates) == 0 {
+		return nil
+	}
+
+	return c.Repository.UpsertExchangeRates(ctx, exchangeRates)
+}
+
+// validateExchangeRate validates the exchange rate input and converts it to the exchange rate in repository layer,
+// the rate is effective from now if no effective time is given
+func validateExchangeRate(erInput ExchangeRateInput) (repositories.ExchangeRate, error) {
+	// the rate of the base currency is always 1
+	if !IsSupportedCurrency(erInput.Currency) || erInput.Currency == CurrencyVND {
+		return repositories.ExchangeRate{}, ErrInvalidCurrency
+	}
+
+	if !erInput.Rate.IsPositive() {
+		return repositories.ExchangeRate{}, ErrInvalidExchangeRate
+	}
+
+	if erInput.EffectiveFrom.IsZero() {
+		erInput.EffectiveFrom = time.Now()
+	}
+
+	return repositories.ExchangeRate{
+		Currency:      erInput.Currency,
+		Rate:          erInput.Rate,
+		EffectiveFrom: erInput.EffectiveFrom,
+	}, nil
+}
+
+type ExchangeRateOutput struct {
+	ID            int
+	Currency      string
+	Rate          decimal.Decimal
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// GetExchangeRates retrieves the exchange rates, filtered by currency if given
+func (c *Controller) GetExchangeRates(ctx context.Context, currency string) ([]ExchangeRateOutput, error) {
+	exchangeRates, err := c.Repository.GetExchangeRates(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var erOutput []ExchangeRateOutput
+	for _, er := range exchangeRates {
+		erOutput = append(erOutput, ExchangeRateOutput{
+			ID:            er.ID,
+			Currency:      er.Currency,
+			Rate:          er.Rate,
+			EffectiveFrom: er.EffectiveFrom,
+			CreatedAt:     er.CreatedAt,
+			UpdatedAt:     er.UpdatedAt,
+		})
+	}
+
+	return erOutput, nil
+}
+
+// priceConverter converts prices into a target currency. The exchange rate is looked up on the first
+// conversion and reused for every later one, so all the prices of a response or an order use the same rate
+type priceConverter struct {
+	controller *Controller
+	currency   string
+	rate       decimal.NullDecimal
+}
+
+// convert converts the amount in the given currency into the target currency of the converter
+func (pc *priceConverter) convert(ctx context.Context, amount decimal.Decimal, currency string) (decimal.Decimal, error) {
+	if currency == pc.currency {
+		return amount, nil
+	}
+
+	if !pc.rate.Valid {
+		// one of the two currencies is always VND, the rate of the other one is needed
+		foreign := currency
+		if foreign == CurrencyVND {
+			foreign = pc.currency
+		}
+
+		exchangeRate, err := pc.controller.Repository.GetEffectiveExchangeRate(ctx, foreign, time.Now())
+		if err != nil {
+			if errors.Is(err, repositories.ErrExchangeRateNotFound) {
+				return decimal.Zero, ErrExchangeRateNotFound
+			}
+			return decimal.Zero, err
+		}
+		pc.rate = decimal.NewNullDecimal(exchangeRate.Rate)
+	}
+
+	if currency == CurrencyVND {
+		return amount.Div(pc.rate.Decimal).Round(2), nil
+	}
+	return amount.Mul(pc.rate.Decimal).Round(2), nil
+}
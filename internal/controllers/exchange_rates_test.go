@@ -0,0 +1,240 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test CreateExchangeRate in Controller layer
+func Test_ExchangeRateController_CreateExchangeRate(t *testing.T) {
+	effectiveFrom := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockExchangeRateRepo struct {
+		expCall bool
+		input   repositories.ExchangeRate
+		err     error
+	}
+	tests := map[string]struct {
+		erInput              ExchangeRateInput
+		mockExchangeRateRepo mockExchangeRateRepo
+		expErr               error
+	}{
+		"success": {
+			erInput: ExchangeRateInput{
+				Currency:      CurrencyUSD,
+				Rate:          decimal.NewFromInt(24000),
+				EffectiveFrom: effectiveFrom,
+			},
+			mockExchangeRateRepo: mockExchangeRateRepo{
+				expCall: true,
+				input: repositories.ExchangeRate{
+					Currency:      CurrencyUSD,
+					Rate:          decimal.NewFromInt(24000),
+					EffectiveFrom: effectiveFrom,
+				},
+			},
+		},
+		"rate of the base currency": {
+			erInput: ExchangeRateInput{
+				Currency: CurrencyVND,
+				Rate:     decimal.NewFromInt(1),
+			},
+			expErr: ErrInvalidCurrency,
+		},
+		"unsupported currency": {
+			erInput: ExchangeRateInput{
+				Currency: "EUR",
+				Rate:     decimal.NewFromInt(26000),
+			},
+			expErr: ErrInvalidCurrency,
+		},
+		"non-positive rate": {
+			erInput: ExchangeRateInput{
+				Currency: CurrencyUSD,
+				Rate:     decimal.Zero,
+			},
+			expErr: ErrInvalidExchangeRate,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockExchangeRateRepo.expCall {
+				mockRepo.On("CreateExchangeRate", context.Background(), tc.mockExchangeRateRepo.input).Return(tc.mockExchangeRateRepo.err)
+			}
+
+			err := controller.CreateExchangeRate(context.Background(), tc.erInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test ImportExchangeRatesFromCSV in Controller layer
+func Test_ExchangeRateController_ImportExchangeRatesFromCSV(t *testing.T) {
+	tests := map[string]struct {
+		csvData   []string
+		expCall   bool
+		expUpsert []repositories.ExchangeRate
+		expErr    error
+	}{
+		"success": {
+			csvData: []string{`Currency,Rate,EffectiveFrom`,
+				`USD,23500,2023-06-01`,
+				`usd,23550.5,2023-06-02`},
+			expCall: true,
+			expUpsert: []repositories.ExchangeRate{
+				{Currency: CurrencyUSD, Rate: decimal.NewFromInt(23500), EffectiveFrom: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)},
+				{Currency: CurrencyUSD, Rate: decimal.RequireFromString("23550.5"), EffectiveFrom: time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		"missing rate column": {
+			csvData: []string{`Currency,EffectiveFrom`,
+				`USD,2023-06-01`},
+			expErr: ErrIncorrectExchangeRateColumns,
+		},
+		"invalid rate": {
+			csvData: []string{`Currency,Rate`,
+				`USD,abc`},
+			expErr: ErrInvalidExchangeRate,
+		},
+		"invalid effective date": {
+			csvData: []string{`Currency,Rate,EffectiveFrom`,
+				`USD,23500,01-06-2023`},
+			expErr: ErrInvalidEffectiveFromDate,
+		},
+		"unsupported currency": {
+			csvData: []string{`Currency,Rate`,
+				`EUR,26000`},
+			expErr: ErrInvalidCurrency,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			f, err := os.CreateTemp("", "exchange_rates.csv")
+			assert.NoError(t, err)
+
+			// clean up
+			defer os.Remove(f.Name())
+
+			for _, s := range tc.csvData {
+				_, err := f.WriteString(s + "\n")
+				assert.NoError(t, err)
+			}
+			f.Close()
+
+			csvData, err := os.Open(f.Name())
+			assert.NoError(t, err)
+			defer csvData.Close()
+
+			if tc.expCall {
+				mockRepo.On("UpsertExchangeRates", context.Background(), tc.expUpsert).Return(nil)
+			}
+
+			err = controller.ImportExchangeRatesFromCSV(context.Background(), csvData)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test priceConverter in Controller layer
+func Test_ExchangeRateController_priceConverter(t *testing.T) {
+	type mockExchangeRateRepo struct {
+		expCall bool
+		output  models.ExchangeRate
+		err     error
+	}
+	tests := map[string]struct {
+		currency             string
+		rate                 decimal.NullDecimal
+		amount               decimal.Decimal
+		amountCurrency       string
+		mockExchangeRateRepo mockExchangeRateRepo
+		expAmount            decimal.Decimal
+		expErr               error
+	}{
+		"same currency": {
+			currency:       CurrencyVND,
+			amount:         decimal.NewFromInt(150000),
+			amountCurrency: CurrencyVND,
+			expAmount:      decimal.NewFromInt(150000),
+		},
+		"VND to USD": {
+			currency:       CurrencyUSD,
+			amount:         decimal.NewFromInt(100000),
+			amountCurrency: CurrencyVND,
+			mockExchangeRateRepo: mockExchangeRateRepo{
+				expCall: true,
+				output:  models.ExchangeRate{Currency: CurrencyUSD, Rate: decimal.NewFromInt(24000)},
+			},
+			expAmount: decimal.RequireFromString("4.17"),
+		},
+		"USD to VND": {
+			currency:       CurrencyVND,
+			amount:         decimal.RequireFromString("12.5"),
+			amountCurrency: CurrencyUSD,
+			mockExchangeRateRepo: mockExchangeRateRepo{
+				expCall: true,
+				output:  models.ExchangeRate{Currency: CurrencyUSD, Rate: decimal.NewFromInt(24000)},
+			},
+			expAmount: decimal.NewFromInt(300000),
+		},
+		"snapshotted rate": {
+			currency:       CurrencyVND,
+			rate:           decimal.NewNullDecimal(decimal.NewFromInt(23000)),
+			amount:         decimal.NewFromInt(10),
+			amountCurrency: CurrencyUSD,
+			expAmount:      decimal.NewFromInt(230000),
+		},
+		"exchange rate not found": {
+			currency:       CurrencyUSD,
+			amount:         decimal.NewFromInt(100000),
+			amountCurrency: CurrencyVND,
+			mockExchangeRateRepo: mockExchangeRateRepo{
+				expCall: true,
+				err:     repositories.ErrExchangeRateNotFound,
+			},
+			expErr: ErrExchangeRateNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+			if tc.mockExchangeRateRepo.expCall {
+				mockRepo.On("GetEffectiveExchangeRate", context.Background(), CurrencyUSD, mock.AnythingOfType("time.Time")).Return(tc.mockExchangeRateRepo.output, tc.mockExchangeRateRepo.err)
+			}
+
+			converter := &priceConverter{controller: controller, currency: tc.currency, rate: tc.rate}
+			amount, err := converter.convert(context.Background(), tc.amount, tc.amountCurrency)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, tc.expAmount.Equal(amount), "expected %s, got %s", tc.expAmount, amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/utils/email"
+)
+
+// UserRoleCatalogManager is the role of the users who get the low stock digest
+const UserRoleCatalogManager = "catalog_manager"
+
+type LowStockProductOutput struct {
+	ID               int
+	Name             string
+	Quantity         int
+	ReorderThreshold int
+	// Shortage is how many items are missing to reach the reorder threshold
+	Shortage int
+}
+
+// GetLowStockProducts retrieves the active products whose quantity is below their reorder threshold,
+// the products furthest below their threshold first
+func (c *Controller) GetLowStockProducts(ctx context.Context) ([]LowStockProductOutput, error) {
+	products, err := c.Repository.GetLowStockProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []LowStockProductOutput
+	for _, p := range products {
+		output = append(output, LowStockProductOutput{
+			ID:               p.ID,
+			Name:             p.Name,
+			Quantity:         p.Quantity,
+			ReorderThreshold: p.ReorderThreshold,
+			Shortage:         p.ReorderThreshold - p.Quantity,
+		})
+	}
+
+	return output, nil
+}
+
+// SendLowStockDigest emails the catalog managers the low stock alerts raised since the last digest, the alerts are
+// only sent once. An alert whose product is no longer below its threshold is dropped from the digest.
+// It returns the number of alerts sent
+func (c *Controller) SendLowStockDigest(ctx context.Context) (int, error) {
+	alerts, err := c.Repository.GetUnnotifiedLowStockAlerts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(alerts) == 0 {
+		return 0, nil
+	}
+
+	// the alerts are kept for the next digest until there is a catalog manager to send them to
+	managers, err := c.Repository.GetUsersByRole(ctx, UserRoleCatalogManager)
+	if err != nil {
+		return 0, err
+	}
+	if len(managers) == 0 {
+		log.Printf("%d low stock alerts not sent as there is no catalog manager", len(alerts))
+		return 0, nil
+	}
+
+	var ids []int
+	var lowStockProducts []models.Product
+	for _, a := range alerts {
+		ids = append(ids, a.ID)
+		if p := a.R.GetProduct(); p != nil && !p.DeletedAt.Valid && p.Quantity < p.ReorderThreshold {
+			lowStockProducts = append(lowStockProducts, *p)
+		}
+	}
+
+	if len(lowStockProducts) > 0 {
+		var emailToList []string
+		for _, m := range managers {
+			emailToList = append(emailToList, m.Email)
+		}
+		if err = c.SendEmailLowStockDigest(emailToList, lowStockProducts); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = c.Repository.MarkLowStockAlertsNotified(ctx, ids, time.Now()); err != nil {
+		return 0, err
+	}
+
+	return len(lowStockProducts), nil
+}
+
+// SendEmailLowStockDigest sends an email to the catalog managers with the products which are below their reorder threshold
+func (c *Controller) SendEmailLowStockDigest(emailToList []string, products []models.Product) error {
+	body := []string{
+		"Hi catalog managers,",
+		"The following products are below their reorder threshold:",
+	}
+	for _, p := range products {
+		body = append(body, fmt.Sprintf("- %s (#%d): %d left, reorder threshold %d", p.Name, p.ID, p.Quantity, p.ReorderThreshold))
+	}
+	body = append(body, "Please restock them soon.", "Thanks!")
+
+	sender := email.NewEmailSender()
+
+	m := email.NewMessage(fmt.Sprintf("Low Stock Digest: %d products", len(products)), strings.Join(body, "\n"))
+	m.To = emailToList
+
+	return sender.Send(m)
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test GetLowStockProducts in Controller layer
+func Test_LowStockController_GetLowStockProducts(t *testing.T) {
+	mockRepo := repositories.NewMockIRepository(t)
+	controller := NewController(mockRepo)
+
+	mockRepo.On("GetLowStockProducts", context.Background()).Return([]models.Product{
+		{ID: 2, Name: "iPhone 14", Quantity: 1, ReorderThreshold: 10},
+		{ID: 1, Name: "iPad", Quantity: 4, ReorderThreshold: 5},
+	}, nil)
+
+	output, err := controller.GetLowStockProducts(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []LowStockProductOutput{
+		{ID: 2, Name: "iPhone 14", Quantity: 1, ReorderThreshold: 10, Shortage: 9},
+		{ID: 1, Name: "iPad", Quantity: 4, ReorderThreshold: 5, Shortage: 1},
+	}, output)
+}
+
+// Test SendLowStockDigest in Controller layer, the cases which do not send an email
+func Test_LowStockController_SendLowStockDigest(t *testing.T) {
+	// alertOf returns an open alert of the product
+	alertOf := func(id int, product models.Product) models.LowStockAlert {
+		alert := models.LowStockAlert{ID: id, ProductID: product.ID, Quantity: product.Quantity, Threshold: product.ReorderThreshold}
+		alert.R = alert.R.NewStruct()
+		alert.R.Product = &product
+		return alert
+	}
+
+	tests := map[string]struct {
+		alerts    []models.LowStockAlert
+		managers  []models.User
+		expMarked []int
+	}{
+		"no alert": {},
+		"no catalog manager": {
+			alerts: []models.LowStockAlert{alertOf(1, models.Product{ID: 1, Quantity: 1, ReorderThreshold: 5})},
+		},
+		"products restocked or archived since the alerts": {
+			alerts: []models.LowStockAlert{
+				alertOf(1, models.Product{ID: 1, Quantity: 8, ReorderThreshold: 5}),
+				alertOf(2, models.Product{ID: 2, Quantity: 1, ReorderThreshold: 5, DeletedAt: null.TimeFrom(time.Now())}),
+			},
+			managers:  []models.User{{ID: 3, Email: "manager@gmail.com", Role: UserRoleCatalogManager}},
+			expMarked: []int{1, 2},
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetUnnotifiedLowStockAlerts", context.Background()).Return(tc.alerts, nil)
+			if len(tc.alerts) > 0 {
+				mockRepo.On("GetUsersByRole", context.Background(), UserRoleCatalogManager).Return(tc.managers, nil)
+			}
+			if tc.expMarked != nil {
+				mockRepo.On("MarkLowStockAlertsNotified", context.Background(), tc.expMarked, mock.AnythingOfType("time.Time")).Return(nil)
+			}
+
+			sent, err := controller.SendLowStockDigest(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 0, sent)
+		})
+	}
+}
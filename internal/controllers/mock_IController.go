@@ -5,28 +5,1349 @@ package controllers
 import (
 	context "context"
 	io "io"
+	multipart "mime/multipart"
+	time "time"
 
+	models "github.com/qthuy2k1/product-management/internal/models"
+	repositories "github.com/qthuy2k1/product-management/internal/repositories"
+	decimal "github.com/shopspring/decimal"
 	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIController is an autogenerated mock type for the IController type
+type MockIController struct {
+	mock.Mock
+}
+
+// AddToWishlist provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIController) AddToWishlist(ctx context.Context, userID int, productID int) error {
+	ret := _m.Called(ctx, userID, productID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdjustStock provides a mock function with given fields: ctx, saInput
+func (_m *MockIController) AdjustStock(ctx context.Context, saInput StockAdjustmentInput) (StockMovementOutput, error) {
+	ret := _m.Called(ctx, saInput)
+
+	var r0 StockMovementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, StockAdjustmentInput) (StockMovementOutput, error)); ok {
+		return rf(ctx, saInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, StockAdjustmentInput) StockMovementOutput); ok {
+		r0 = rf(ctx, saInput)
+	} else {
+		r0 = ret.Get(0).(StockMovementOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, StockAdjustmentInput) error); ok {
+		r1 = rf(ctx, saInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkArchiveProducts provides a mock function with given fields: ctx, selection
+func (_m *MockIController) BulkArchiveProducts(ctx context.Context, selection BulkSelection) (BulkReport, error) {
+	ret := _m.Called(ctx, selection)
+
+	var r0 BulkReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, BulkSelection) (BulkReport, error)); ok {
+		return rf(ctx, selection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, BulkSelection) BulkReport); ok {
+		r0 = rf(ctx, selection)
+	} else {
+		r0 = ret.Get(0).(BulkReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, BulkSelection) error); ok {
+		r1 = rf(ctx, selection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkChangeCategory provides a mock function with given fields: ctx, input
+func (_m *MockIController) BulkChangeCategory(ctx context.Context, input BulkCategoryInput) (BulkReport, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 BulkReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, BulkCategoryInput) (BulkReport, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, BulkCategoryInput) BulkReport); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(BulkReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, BulkCategoryInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkChangePrice provides a mock function with given fields: ctx, input
+func (_m *MockIController) BulkChangePrice(ctx context.Context, input BulkPriceInput) (BulkReport, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 BulkReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, BulkPriceInput) (BulkReport, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, BulkPriceInput) BulkReport); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(BulkReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, BulkPriceInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkUpdateStock provides a mock function with given fields: ctx, input
+func (_m *MockIController) BulkUpdateStock(ctx context.Context, input BulkStockInput) (BulkReport, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 BulkReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, BulkStockInput) (BulkReport, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, BulkStockInput) BulkReport); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(BulkReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, BulkStockInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelScheduledPrice provides a mock function with given fields: ctx, productID, priceID
+func (_m *MockIController) CancelScheduledPrice(ctx context.Context, productID int, priceID int) error {
+	ret := _m.Called(ctx, productID, priceID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, productID, priceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelStaleOrders provides a mock function with given fields: ctx, timeout
+func (_m *MockIController) CancelStaleOrders(ctx context.Context, timeout time.Duration) (int, error) {
+	ret := _m.Called(ctx, timeout)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int, error)); ok {
+		return rf(ctx, timeout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int); ok {
+		r0 = rf(ctx, timeout)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComputeRelatedProducts provides a mock function with given fields: ctx
+func (_m *MockIController) ComputeRelatedProducts(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAddress provides a mock function with given fields: ctx, aInput
+func (_m *MockIController) CreateAddress(ctx context.Context, aInput AddressInput) error {
+	ret := _m.Called(ctx, aInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AddressInput) error); ok {
+		r0 = rf(ctx, aInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateAttributeDefinition provides a mock function with given fields: ctx, adInput
+func (_m *MockIController) CreateAttributeDefinition(ctx context.Context, adInput AttributeDefinitionInput) (AttributeDefinitionOutput, error) {
+	ret := _m.Called(ctx, adInput)
+
+	var r0 AttributeDefinitionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AttributeDefinitionInput) (AttributeDefinitionOutput, error)); ok {
+		return rf(ctx, adInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AttributeDefinitionInput) AttributeDefinitionOutput); ok {
+		r0 = rf(ctx, adInput)
+	} else {
+		r0 = ret.Get(0).(AttributeDefinitionOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AttributeDefinitionInput) error); ok {
+		r1 = rf(ctx, adInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateExchangeRate provides a mock function with given fields: ctx, erInput
+func (_m *MockIController) CreateExchangeRate(ctx context.Context, erInput ExchangeRateInput) error {
+	ret := _m.Called(ctx, erInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ExchangeRateInput) error); ok {
+		r0 = rf(ctx, erInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateOrder provides a mock function with given fields: ctx, orderInput, orderItemsInput
+func (_m *MockIController) CreateOrder(ctx context.Context, orderInput OrderInput, orderItemsInput []OrderItemInput) error {
+	ret := _m.Called(ctx, orderInput, orderItemsInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, OrderInput, []OrderItemInput) error); ok {
+		r0 = rf(ctx, orderInput, orderItemsInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProduct provides a mock function with given fields: ctx, productInput
+func (_m *MockIController) CreateProduct(ctx context.Context, productInput ProductInput) error {
+	ret := _m.Called(ctx, productInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductInput) error); ok {
+		r0 = rf(ctx, productInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProductCategory provides a mock function with given fields: ctx, pCateInput
+func (_m *MockIController) CreateProductCategory(ctx context.Context, pCateInput PCateInput) error {
+	ret := _m.Called(ctx, pCateInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, PCateInput) error); ok {
+		r0 = rf(ctx, pCateInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProductReview provides a mock function with given fields: ctx, input
+func (_m *MockIController) CreateProductReview(ctx context.Context, input ProductReviewInput) (ProductReviewOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	var r0 ProductReviewOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewInput) (ProductReviewOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewInput) ProductReviewOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(ProductReviewOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductReviewInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReturnRequest provides a mock function with given fields: ctx, rInput
+func (_m *MockIController) CreateReturnRequest(ctx context.Context, rInput ReturnRequestInput) error {
+	ret := _m.Called(ctx, rInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ReturnRequestInput) error); ok {
+		r0 = rf(ctx, rInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShipment provides a mock function with given fields: ctx, orderID, sInput
+func (_m *MockIController) CreateShipment(ctx context.Context, orderID int, sInput ShipmentInput) error {
+	ret := _m.Called(ctx, orderID, sInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, ShipmentInput) error); ok {
+		r0 = rf(ctx, orderID, sInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShippingMethod provides a mock function with given fields: ctx, smInput
+func (_m *MockIController) CreateShippingMethod(ctx context.Context, smInput ShippingMethodInput) error {
+	ret := _m.Called(ctx, smInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ShippingMethodInput) error); ok {
+		r0 = rf(ctx, smInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTaxRule provides a mock function with given fields: ctx, trInput
+func (_m *MockIController) CreateTaxRule(ctx context.Context, trInput TaxRuleInput) error {
+	ret := _m.Called(ctx, trInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, TaxRuleInput) error); ok {
+		r0 = rf(ctx, trInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateUser provides a mock function with given fields: ctx, user
+func (_m *MockIController) CreateUser(ctx context.Context, user UserInput) error {
+	ret := _m.Called(ctx, user)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, UserInput) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVariant provides a mock function with given fields: ctx, vInput
+func (_m *MockIController) CreateVariant(ctx context.Context, vInput VariantInput) error {
+	ret := _m.Called(ctx, vInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, VariantInput) error); ok {
+		r0 = rf(ctx, vInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateWarehouse provides a mock function with given fields: ctx, wInput
+func (_m *MockIController) CreateWarehouse(ctx context.Context, wInput WarehouseInput) (WarehouseOutput, error) {
+	ret := _m.Called(ctx, wInput)
+
+	var r0 WarehouseOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, WarehouseInput) (WarehouseOutput, error)); ok {
+		return rf(ctx, wInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, WarehouseInput) WarehouseOutput); ok {
+		r0 = rf(ctx, wInput)
+	} else {
+		r0 = ret.Get(0).(WarehouseOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, WarehouseInput) error); ok {
+		r1 = rf(ctx, wInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAddress provides a mock function with given fields: ctx, userID, addressID
+func (_m *MockIController) DeleteAddress(ctx context.Context, userID int, addressID int) error {
+	ret := _m.Called(ctx, userID, addressID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, addressID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProduct provides a mock function with given fields: ctx, id
+func (_m *MockIController) DeleteProduct(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProductImage provides a mock function with given fields: ctx, productID, imageID
+func (_m *MockIController) DeleteProductImage(ctx context.Context, productID int, imageID int) error {
+	ret := _m.Called(ctx, productID, imageID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, productID, imageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportOrders provides a mock function with given fields: ctx, filter, format
+func (_m *MockIController) ExportOrders(ctx context.Context, filter OrderFilterCtrl, format string) (io.Reader, error) {
+	ret := _m.Called(ctx, filter, format)
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterCtrl, string) (io.Reader, error)); ok {
+		return rf(ctx, filter, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterCtrl, string) io.Reader); ok {
+		r0 = rf(ctx, filter, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, OrderFilterCtrl, string) error); ok {
+		r1 = rf(ctx, filter, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportProductsToCSV provides a mock function with given fields: ctx, filter
+func (_m *MockIController) ExportProductsToCSV(ctx context.Context, filter ProductCtrlFilter) (io.Reader, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) (io.Reader, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) io.Reader); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductCtrlFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAddresses provides a mock function with given fields: ctx, userID
+func (_m *MockIController) GetAddresses(ctx context.Context, userID int) ([]AddressOutput, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []AddressOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]AddressOutput, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []AddressOutput); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AddressOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttributeDefinitions provides a mock function with given fields: ctx, categoryID
+func (_m *MockIController) GetAttributeDefinitions(ctx context.Context, categoryID int) ([]AttributeDefinitionOutput, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 []AttributeDefinitionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]AttributeDefinitionOutput, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []AttributeDefinitionOutput); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AttributeDefinitionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAverageOrderValue provides a mock function with given fields: ctx, filter
+func (_m *MockIController) GetAverageOrderValue(ctx context.Context, filter AnalyticsFilterCtrl) (OrderValueOutput, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 OrderValueOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl) (OrderValueOutput, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl) OrderValueOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(OrderValueOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilterCtrl) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExchangeRates provides a mock function with given fields: ctx, currency
+func (_m *MockIController) GetExchangeRates(ctx context.Context, currency string) ([]ExchangeRateOutput, error) {
+	ret := _m.Called(ctx, currency)
+
+	var r0 []ExchangeRateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]ExchangeRateOutput, error)); ok {
+		return rf(ctx, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []ExchangeRateOutput); ok {
+		r0 = rf(ctx, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ExchangeRateOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJobRuns provides a mock function with given fields: ctx
+func (_m *MockIController) GetJobRuns(ctx context.Context) ([]JobRunOutput, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []JobRunOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]JobRunOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []JobRunOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobRunOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLowStockProducts provides a mock function with given fields: ctx
+func (_m *MockIController) GetLowStockProducts(ctx context.Context) ([]LowStockProductOutput, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []LowStockProductOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]LowStockProductOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []LowStockProductOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]LowStockProductOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrders provides a mock function with given fields: ctx, filter
+func (_m *MockIController) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]OrderOutputGraph, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []OrderOutputGraph
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterCtrl) ([]OrderOutputGraph, int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterCtrl) []OrderOutputGraph); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OrderOutputGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, OrderFilterCtrl) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, OrderFilterCtrl) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetProduct provides a mock function with given fields: ctx, id, currency
+func (_m *MockIController) GetProduct(ctx context.Context, id int, currency string) (ProductOutputGraph, error) {
+	ret := _m.Called(ctx, id, currency)
+
+	var r0 ProductOutputGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (ProductOutputGraph, error)); ok {
+		return rf(ctx, id, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ProductOutputGraph); ok {
+		r0 = rf(ctx, id, currency)
+	} else {
+		r0 = ret.Get(0).(ProductOutputGraph)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductBySKU provides a mock function with given fields: ctx, sku, currency
+func (_m *MockIController) GetProductBySKU(ctx context.Context, sku string, currency string) (ProductOutputGraph, error) {
+	ret := _m.Called(ctx, sku, currency)
+
+	var r0 ProductOutputGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (ProductOutputGraph, error)); ok {
+		return rf(ctx, sku, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ProductOutputGraph); ok {
+		r0 = rf(ctx, sku, currency)
+	} else {
+		r0 = ret.Get(0).(ProductOutputGraph)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, sku, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductBySlug provides a mock function with given fields: ctx, slug, currency
+func (_m *MockIController) GetProductBySlug(ctx context.Context, slug string, currency string) (ProductOutputGraph, error) {
+	ret := _m.Called(ctx, slug, currency)
+
+	var r0 ProductOutputGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (ProductOutputGraph, error)); ok {
+		return rf(ctx, slug, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ProductOutputGraph); ok {
+		r0 = rf(ctx, slug, currency)
+	} else {
+		r0 = ret.Get(0).(ProductOutputGraph)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, slug, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCategoryByName provides a mock function with given fields: ctx, name
+func (_m *MockIController) GetProductCategoryByName(ctx context.Context, name string) (PCateOutput, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 PCateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (PCateOutput, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) PCateOutput); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(PCateOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductImages provides a mock function with given fields: ctx, productID
+func (_m *MockIController) GetProductImages(ctx context.Context, productID int) ([]ProductImageOutput, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []ProductImageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]ProductImageOutput, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []ProductImageOutput); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductImageOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductPriceAt provides a mock function with given fields: ctx, productID, at
+func (_m *MockIController) GetProductPriceAt(ctx context.Context, productID int, at time.Time) (ProductPriceOutput, error) {
+	ret := _m.Called(ctx, productID, at)
+
+	var r0 ProductPriceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (ProductPriceOutput, error)); ok {
+		return rf(ctx, productID, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ProductPriceOutput); ok {
+		r0 = rf(ctx, productID, at)
+	} else {
+		r0 = ret.Get(0).(ProductPriceOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, productID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductPriceHistory provides a mock function with given fields: ctx, productID
+func (_m *MockIController) GetProductPriceHistory(ctx context.Context, productID int) ([]ProductPriceOutput, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []ProductPriceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]ProductPriceOutput, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []ProductPriceOutput); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductPriceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductReviews provides a mock function with given fields: ctx, filter
+func (_m *MockIController) GetProductReviews(ctx context.Context, filter ProductReviewFilterCtrl) ([]ProductReviewOutput, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []ProductReviewOutput
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewFilterCtrl) ([]ProductReviewOutput, int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewFilterCtrl) []ProductReviewOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductReviewOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductReviewFilterCtrl) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ProductReviewFilterCtrl) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetProducts provides a mock function with given fields: ctx, filter
+func (_m *MockIController) GetProducts(ctx context.Context, filter ProductCtrlFilter) ([]ProductOutput, ProductPageInfo, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []ProductOutput
+	var r1 ProductPageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) ([]ProductOutput, ProductPageInfo, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) []ProductOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductCtrlFilter) ProductPageInfo); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(ProductPageInfo)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ProductCtrlFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetProductsGraph provides a mock function with given fields: ctx, pFilter
+func (_m *MockIController) GetProductsGraph(ctx context.Context, pFilter ProductCtrlFilter) ([]ProductOutputGraph, ProductPageInfo, error) {
+	ret := _m.Called(ctx, pFilter)
+
+	var r0 []ProductOutputGraph
+	var r1 ProductPageInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) ([]ProductOutputGraph, ProductPageInfo, error)); ok {
+		return rf(ctx, pFilter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) []ProductOutputGraph); ok {
+		r0 = rf(ctx, pFilter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductOutputGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductCtrlFilter) ProductPageInfo); ok {
+		r1 = rf(ctx, pFilter)
+	} else {
+		r1 = ret.Get(1).(ProductPageInfo)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ProductCtrlFilter) error); ok {
+		r2 = rf(ctx, pFilter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetRelatedProducts provides a mock function with given fields: ctx, productID, limit, currency
+func (_m *MockIController) GetRelatedProducts(ctx context.Context, productID int, limit int, currency string) ([]ProductOutputGraph, error) {
+	ret := _m.Called(ctx, productID, limit, currency)
+
+	var r0 []ProductOutputGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) ([]ProductOutputGraph, error)); ok {
+		return rf(ctx, productID, limit, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) []ProductOutputGraph); ok {
+		r0 = rf(ctx, productID, limit, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductOutputGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
+		r1 = rf(ctx, productID, limit, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnRequests provides a mock function with given fields: ctx, filter
+func (_m *MockIController) GetReturnRequests(ctx context.Context, filter ReturnRequestFilterCtrl) ([]ReturnRequestOutput, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []ReturnRequestOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ReturnRequestFilterCtrl) ([]ReturnRequestOutput, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ReturnRequestFilterCtrl) []ReturnRequestOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ReturnRequestOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ReturnRequestFilterCtrl) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevenueByPeriod provides a mock function with given fields: ctx, filter, period
+func (_m *MockIController) GetRevenueByPeriod(ctx context.Context, filter AnalyticsFilterCtrl, period string) ([]RevenuePeriodOutput, error) {
+	ret := _m.Called(ctx, filter, period)
+
+	var r0 []RevenuePeriodOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl, string) ([]RevenuePeriodOutput, error)); ok {
+		return rf(ctx, filter, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl, string) []RevenuePeriodOutput); ok {
+		r0 = rf(ctx, filter, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]RevenuePeriodOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilterCtrl, string) error); ok {
+		r1 = rf(ctx, filter, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShipments provides a mock function with given fields: ctx, orderID
+func (_m *MockIController) GetShipments(ctx context.Context, orderID int) ([]ShipmentOutput, error) {
+	ret := _m.Called(ctx, orderID)
+
+	var r0 []ShipmentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]ShipmentOutput, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []ShipmentOutput); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ShipmentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShippingMethods provides a mock function with given fields: ctx
+func (_m *MockIController) GetShippingMethods(ctx context.Context) ([]ShippingMethodOutput, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []ShippingMethodOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]ShippingMethodOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []ShippingMethodOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ShippingMethodOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockReport provides a mock function with given fields: ctx, productID, variantID
+func (_m *MockIController) GetStockReport(ctx context.Context, productID int, variantID int) (StockReportOutput, error) {
+	ret := _m.Called(ctx, productID, variantID)
+
+	var r0 StockReportOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (StockReportOutput, error)); ok {
+		return rf(ctx, productID, variantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) StockReportOutput); ok {
+		r0 = rf(ctx, productID, variantID)
+	} else {
+		r0 = ret.Get(0).(StockReportOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, productID, variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaxRules provides a mock function with given fields: ctx, categoryName, region
+func (_m *MockIController) GetTaxRules(ctx context.Context, categoryName string, region string) ([]TaxRuleOutput, error) {
+	ret := _m.Called(ctx, categoryName, region)
+
+	var r0 []TaxRuleOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]TaxRuleOutput, error)); ok {
+		return rf(ctx, categoryName, region)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []TaxRuleOutput); ok {
+		r0 = rf(ctx, categoryName, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TaxRuleOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, categoryName, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopCategories provides a mock function with given fields: ctx, filter, sortBy, limit
+func (_m *MockIController) GetTopCategories(ctx context.Context, filter AnalyticsFilterCtrl, sortBy string, limit int) ([]TopSellerOutput, error) {
+	ret := _m.Called(ctx, filter, sortBy, limit)
+
+	var r0 []TopSellerOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl, string, int) ([]TopSellerOutput, error)); ok {
+		return rf(ctx, filter, sortBy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl, string, int) []TopSellerOutput); ok {
+		r0 = rf(ctx, filter, sortBy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TopSellerOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilterCtrl, string, int) error); ok {
+		r1 = rf(ctx, filter, sortBy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopProducts provides a mock function with given fields: ctx, filter, sortBy, limit
+func (_m *MockIController) GetTopProducts(ctx context.Context, filter AnalyticsFilterCtrl, sortBy string, limit int) ([]TopSellerOutput, error) {
+	ret := _m.Called(ctx, filter, sortBy, limit)
+
+	var r0 []TopSellerOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl, string, int) ([]TopSellerOutput, error)); ok {
+		return rf(ctx, filter, sortBy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilterCtrl, string, int) []TopSellerOutput); ok {
+		r0 = rf(ctx, filter, sortBy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TopSellerOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilterCtrl, string, int) error); ok {
+		r1 = rf(ctx, filter, sortBy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *MockIController) GetUser(ctx context.Context, id int) (UserOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 UserOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (UserOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) UserOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(UserOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariants provides a mock function with given fields: ctx, productID, currency
+func (_m *MockIController) GetVariants(ctx context.Context, productID int, currency string) ([]VariantOutput, error) {
+	ret := _m.Called(ctx, productID, currency)
+
+	var r0 []VariantOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]VariantOutput, error)); ok {
+		return rf(ctx, productID, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []VariantOutput); ok {
+		r0 = rf(ctx, productID, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]VariantOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, productID, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWarehouses provides a mock function with given fields: ctx
+func (_m *MockIController) GetWarehouses(ctx context.Context) ([]WarehouseOutput, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []WarehouseOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]WarehouseOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []WarehouseOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WarehouseOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlist provides a mock function with given fields: ctx, userID
+func (_m *MockIController) GetWishlist(ctx context.Context, userID int) ([]WishlistItemOutput, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []WishlistItemOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]WishlistItemOutput, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []WishlistItemOutput); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WishlistItemOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-	models "github.com/qthuy2k1/product-management/internal/models"
+	return r0, r1
+}
 
-	multipart "mime/multipart"
+// ImportExchangeRatesFromCSV provides a mock function with given fields: ctx, file
+func (_m *MockIController) ImportExchangeRatesFromCSV(ctx context.Context, file multipart.File) error {
+	ret := _m.Called(ctx, file)
 
-	repositories "github.com/qthuy2k1/product-management/internal/repositories"
-)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, multipart.File) error); ok {
+		r0 = rf(ctx, file)
+	} else {
+		r0 = ret.Error(0)
+	}
 
-// MockIController is an autogenerated mock type for the IController type
-type MockIController struct {
-	mock.Mock
+	return r0
 }
 
-// CreateOrder provides a mock function with given fields: ctx, orderInput, orderItemsInput
-func (_m *MockIController) CreateOrder(ctx context.Context, orderInput OrderInput, orderItemsInput []OrderItemInput) error {
-	ret := _m.Called(ctx, orderInput, orderItemsInput)
+// ImportProductsFromCSV provides a mock function with given fields: ctx, file
+func (_m *MockIController) ImportProductsFromCSV(ctx context.Context, file multipart.File) error {
+	ret := _m.Called(ctx, file)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, OrderInput, []OrderItemInput) error); ok {
-		r0 = rf(ctx, orderInput, orderItemsInput)
+	if rf, ok := ret.Get(0).(func(context.Context, multipart.File) error); ok {
+		r0 = rf(ctx, file)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -34,13 +1355,13 @@ func (_m *MockIController) CreateOrder(ctx context.Context, orderInput OrderInpu
 	return r0
 }
 
-// CreateProduct provides a mock function with given fields: ctx, productInput
-func (_m *MockIController) CreateProduct(ctx context.Context, productInput ProductInput) error {
-	ret := _m.Called(ctx, productInput)
+// ModerateProductReview provides a mock function with given fields: ctx, reviewID, status, note
+func (_m *MockIController) ModerateProductReview(ctx context.Context, reviewID int, status string, note string) error {
+	ret := _m.Called(ctx, reviewID, status, note)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductInput) error); ok {
-		r0 = rf(ctx, productInput)
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) error); ok {
+		r0 = rf(ctx, reviewID, status, note)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,13 +1369,61 @@ func (_m *MockIController) CreateProduct(ctx context.Context, productInput Produ
 	return r0
 }
 
-// CreateProductCategory provides a mock function with given fields: ctx, pCateInput
-func (_m *MockIController) CreateProductCategory(ctx context.Context, pCateInput PCateInput) error {
-	ret := _m.Called(ctx, pCateInput)
+// PatchProduct provides a mock function with given fields: ctx, patch
+func (_m *MockIController) PatchProduct(ctx context.Context, patch ProductPatch) (int, error) {
+	ret := _m.Called(ctx, patch)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductPatch) (int, error)); ok {
+		return rf(ctx, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductPatch) int); ok {
+		r0 = rf(ctx, patch)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductPatch) error); ok {
+		r1 = rf(ctx, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeArchivedProducts provides a mock function with given fields: ctx, archivedBefore
+func (_m *MockIController) PurgeArchivedProducts(ctx context.Context, archivedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, archivedBefore)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, archivedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, archivedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, archivedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveFromWishlist provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIController) RemoveFromWishlist(ctx context.Context, userID int, productID int) error {
+	ret := _m.Called(ctx, userID, productID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, PCateInput) error); ok {
-		r0 = rf(ctx, pCateInput)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, productID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -62,13 +1431,13 @@ func (_m *MockIController) CreateProductCategory(ctx context.Context, pCateInput
 	return r0
 }
 
-// CreateUser provides a mock function with given fields: ctx, user
-func (_m *MockIController) CreateUser(ctx context.Context, user UserInput) error {
-	ret := _m.Called(ctx, user)
+// ReorderProductImages provides a mock function with given fields: ctx, productID, imageIDs
+func (_m *MockIController) ReorderProductImages(ctx context.Context, productID int, imageIDs []int) error {
+	ret := _m.Called(ctx, productID, imageIDs)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, UserInput) error); ok {
-		r0 = rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) error); ok {
+		r0 = rf(ctx, productID, imageIDs)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -76,8 +1445,8 @@ func (_m *MockIController) CreateUser(ctx context.Context, user UserInput) error
 	return r0
 }
 
-// DeleteProduct provides a mock function with given fields: ctx, id
-func (_m *MockIController) DeleteProduct(ctx context.Context, id int) error {
+// RestoreProduct provides a mock function with given fields: ctx, id
+func (_m *MockIController) RestoreProduct(ctx context.Context, id int) error {
 	ret := _m.Called(ctx, id)
 
 	var r0 error
@@ -90,25 +1459,23 @@ func (_m *MockIController) DeleteProduct(ctx context.Context, id int) error {
 	return r0
 }
 
-// ExportProductsToCSV provides a mock function with given fields: ctx, filter
-func (_m *MockIController) ExportProductsToCSV(ctx context.Context, filter ProductCtrlFilter) (io.Reader, error) {
-	ret := _m.Called(ctx, filter)
+// SchedulePriceChange provides a mock function with given fields: ctx, ppInput
+func (_m *MockIController) SchedulePriceChange(ctx context.Context, ppInput ProductPriceInput) (ProductPriceOutput, error) {
+	ret := _m.Called(ctx, ppInput)
 
-	var r0 io.Reader
+	var r0 ProductPriceOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) (io.Reader, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, ProductPriceInput) (ProductPriceOutput, error)); ok {
+		return rf(ctx, ppInput)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) io.Reader); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, ProductPriceInput) ProductPriceOutput); ok {
+		r0 = rf(ctx, ppInput)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(io.Reader)
-		}
+		r0 = ret.Get(0).(ProductPriceOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ProductCtrlFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, ProductPriceInput) error); ok {
+		r1 = rf(ctx, ppInput)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,31 +1483,31 @@ func (_m *MockIController) ExportProductsToCSV(ctx context.Context, filter Produ
 	return r0, r1
 }
 
-// GetOrders provides a mock function with given fields: ctx, filter
-func (_m *MockIController) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]OrderOutputGraph, int64, error) {
+// SearchProducts provides a mock function with given fields: ctx, filter
+func (_m *MockIController) SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]ProductSearchOutput, int64, error) {
 	ret := _m.Called(ctx, filter)
 
-	var r0 []OrderOutputGraph
+	var r0 []ProductSearchOutput
 	var r1 int64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterCtrl) ([]OrderOutputGraph, int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ProductSearchFilter) ([]ProductSearchOutput, int64, error)); ok {
 		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterCtrl) []OrderOutputGraph); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ProductSearchFilter) []ProductSearchOutput); ok {
 		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]OrderOutputGraph)
+			r0 = ret.Get(0).([]ProductSearchOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, OrderFilterCtrl) int64); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, ProductSearchFilter) int64); ok {
 		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, OrderFilterCtrl) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, ProductSearchFilter) error); ok {
 		r2 = rf(ctx, filter)
 	} else {
 		r2 = ret.Error(2)
@@ -149,23 +1516,23 @@ func (_m *MockIController) GetOrders(ctx context.Context, filter OrderFilterCtrl
 	return r0, r1, r2
 }
 
-// GetProductCategoryByName provides a mock function with given fields: ctx, name
-func (_m *MockIController) GetProductCategoryByName(ctx context.Context, name string) (PCateOutput, error) {
-	ret := _m.Called(ctx, name)
+// SendBackInStockNotifications provides a mock function with given fields: ctx
+func (_m *MockIController) SendBackInStockNotifications(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
 
-	var r0 PCateOutput
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (PCateOutput, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) PCateOutput); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(PCateOutput)
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,75 +1540,107 @@ func (_m *MockIController) GetProductCategoryByName(ctx context.Context, name st
 	return r0, r1
 }
 
-// GetProducts provides a mock function with given fields: ctx, filter
-func (_m *MockIController) GetProducts(ctx context.Context, filter ProductCtrlFilter) ([]ProductOutput, error) {
-	ret := _m.Called(ctx, filter)
+// SendEmailBackInStock provides a mock function with given fields: user, products
+func (_m *MockIController) SendEmailBackInStock(user models.User, products []models.Product) error {
+	ret := _m.Called(user, products)
 
-	var r0 []ProductOutput
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) ([]ProductOutput, error)); ok {
-		return rf(ctx, filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.User, []models.Product) error); ok {
+		r0 = rf(user, products)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) []ProductOutput); ok {
-		r0 = rf(ctx, filter)
+
+	return r0
+}
+
+// SendEmailLowStockDigest provides a mock function with given fields: emailToList, products
+func (_m *MockIController) SendEmailLowStockDigest(emailToList []string, products []models.Product) error {
+	ret := _m.Called(emailToList, products)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, []models.Product) error); ok {
+		r0 = rf(emailToList, products)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ProductOutput)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ProductCtrlFilter) error); ok {
-		r1 = rf(ctx, filter)
+	return r0
+}
+
+// SendEmailOrder provides a mock function with given fields: ctx, emailTo, order, orderItem
+func (_m *MockIController) SendEmailOrder(ctx context.Context, emailTo string, order models.Order, orderItem []repositories.OrderItem) error {
+	ret := _m.Called(ctx, emailTo, order, orderItem)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.Order, []repositories.OrderItem) error); ok {
+		r0 = rf(ctx, emailTo, order, orderItem)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GetProductsGraph provides a mock function with given fields: ctx, pFilter
-func (_m *MockIController) GetProductsGraph(ctx context.Context, pFilter ProductCtrlFilter) ([]ProductOutputGraph, error) {
-	ret := _m.Called(ctx, pFilter)
+// SendEmailOrderCancelled provides a mock function with given fields: emailTo, order
+func (_m *MockIController) SendEmailOrderCancelled(emailTo string, order models.Order) error {
+	ret := _m.Called(emailTo, order)
 
-	var r0 []ProductOutputGraph
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) ([]ProductOutputGraph, error)); ok {
-		return rf(ctx, pFilter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, models.Order) error); ok {
+		r0 = rf(emailTo, order)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCtrlFilter) []ProductOutputGraph); ok {
-		r0 = rf(ctx, pFilter)
+
+	return r0
+}
+
+// SendEmailOrders provides a mock function with given fields: emailToList, reader, format
+func (_m *MockIController) SendEmailOrders(emailToList []string, reader io.Reader, format string) error {
+	ret := _m.Called(emailToList, reader, format)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, io.Reader, string) error); ok {
+		r0 = rf(emailToList, reader, format)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ProductOutputGraph)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ProductCtrlFilter) error); ok {
-		r1 = rf(ctx, pFilter)
+	return r0
+}
+
+// SendEmailProduct provides a mock function with given fields: emailToList, reader
+func (_m *MockIController) SendEmailProduct(emailToList []string, reader io.Reader) error {
+	ret := _m.Called(emailToList, reader)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, io.Reader) error); ok {
+		r0 = rf(emailToList, reader)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GetUser provides a mock function with given fields: ctx, id
-func (_m *MockIController) GetUser(ctx context.Context, id int) (UserOutput, error) {
-	ret := _m.Called(ctx, id)
+// SendLowStockDigest provides a mock function with given fields: ctx
+func (_m *MockIController) SendLowStockDigest(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
 
-	var r0 UserOutput
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (UserOutput, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) UserOutput); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(UserOutput)
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -249,13 +1648,13 @@ func (_m *MockIController) GetUser(ctx context.Context, id int) (UserOutput, err
 	return r0, r1
 }
 
-// ImportProductsFromCSV provides a mock function with given fields: ctx, file
-func (_m *MockIController) ImportProductsFromCSV(ctx context.Context, file multipart.File) error {
-	ret := _m.Called(ctx, file)
+// SubscribeBackInStock provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIController) SubscribeBackInStock(ctx context.Context, userID int, productID int) error {
+	ret := _m.Called(ctx, userID, productID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, multipart.File) error); ok {
-		r0 = rf(ctx, file)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, productID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -263,27 +1662,39 @@ func (_m *MockIController) ImportProductsFromCSV(ctx context.Context, file multi
 	return r0
 }
 
-// SendEmailOrder provides a mock function with given fields: ctx, emailTo, order, orderItem
-func (_m *MockIController) SendEmailOrder(ctx context.Context, emailTo string, order models.Order, orderItem []repositories.OrderItem) error {
-	ret := _m.Called(ctx, emailTo, order, orderItem)
+// TransferStock provides a mock function with given fields: ctx, stInput
+func (_m *MockIController) TransferStock(ctx context.Context, stInput StockTransferInput) ([]StockMovementOutput, error) {
+	ret := _m.Called(ctx, stInput)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, models.Order, []repositories.OrderItem) error); ok {
-		r0 = rf(ctx, emailTo, order, orderItem)
+	var r0 []StockMovementOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, StockTransferInput) ([]StockMovementOutput, error)); ok {
+		return rf(ctx, stInput)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, StockTransferInput) []StockMovementOutput); ok {
+		r0 = rf(ctx, stInput)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]StockMovementOutput)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, StockTransferInput) error); ok {
+		r1 = rf(ctx, stInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// SendEmailProduct provides a mock function with given fields: emailToList, reader
-func (_m *MockIController) SendEmailProduct(emailToList []string, reader io.Reader) error {
-	ret := _m.Called(emailToList, reader)
+// UnsubscribeBackInStock provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIController) UnsubscribeBackInStock(ctx context.Context, userID int, productID int) error {
+	ret := _m.Called(ctx, userID, productID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]string, io.Reader) error); ok {
-		r0 = rf(emailToList, reader)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, productID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -319,6 +1730,72 @@ func (_m *MockIController) UpdateProduct(ctx context.Context, pInput ProductInpu
 	return r0
 }
 
+// UpdateReturnStatus provides a mock function with given fields: ctx, returnRequestID, status, note
+func (_m *MockIController) UpdateReturnStatus(ctx context.Context, returnRequestID int, status string, note string) error {
+	ret := _m.Called(ctx, returnRequestID, status, note)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) error); ok {
+		r0 = rf(ctx, returnRequestID, status, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateShipmentStatus provides a mock function with given fields: ctx, shipmentID, status
+func (_m *MockIController) UpdateShipmentStatus(ctx context.Context, shipmentID int, status string) error {
+	ret := _m.Called(ctx, shipmentID, status)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, shipmentID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVariant provides a mock function with given fields: ctx, productID, variantID, price, quantity
+func (_m *MockIController) UpdateVariant(ctx context.Context, productID int, variantID int, price decimal.NullDecimal, quantity int) error {
+	ret := _m.Called(ctx, productID, variantID, price, quantity)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, decimal.NullDecimal, int) error); ok {
+		r0 = rf(ctx, productID, variantID, price, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadProductImage provides a mock function with given fields: ctx, productID, file, size
+func (_m *MockIController) UploadProductImage(ctx context.Context, productID int, file io.Reader, size int64) (ProductImageOutput, error) {
+	ret := _m.Called(ctx, productID, file, size)
+
+	var r0 ProductImageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, io.Reader, int64) (ProductImageOutput, error)); ok {
+		return rf(ctx, productID, file, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, io.Reader, int64) ProductImageOutput); ok {
+		r0 = rf(ctx, productID, file, size)
+	} else {
+		r0 = ret.Get(0).(ProductImageOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, io.Reader, int64) error); ok {
+		r1 = rf(ctx, productID, file, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMockIController interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,45 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package controllers
+
+import (
+	context "context"
+	sql "database/sql"
+
+	models "github.com/qthuy2k1/product-management/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockBulkApplyFunc is an autogenerated mock type for the bulkApplyFunc type
+type mockBulkApplyFunc struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, tx, product
+func (_m *mockBulkApplyFunc) Execute(ctx context.Context, tx *sql.Tx, product models.Product) error {
+	ret := _m.Called(ctx, tx, product)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.Product) error); ok {
+		r0 = rf(ctx, tx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTnewMockBulkApplyFunc interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newMockBulkApplyFunc creates a new instance of mockBulkApplyFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newMockBulkApplyFunc(t mockConstructorTestingTnewMockBulkApplyFunc) *mockBulkApplyFunc {
+	mock := &mockBulkApplyFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
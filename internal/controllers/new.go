@@ -4,25 +4,94 @@ import (
 	"context"
 	"io"
 	"mime/multipart"
+	"time"
 
 	"github.com/qthuy2k1/product-management/internal/models"
 
 	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/storage"
+	"github.com/shopspring/decimal"
 )
 
 type IController interface {
 	// CreateProduct creates a product in db given by product model in parameter
 	CreateProduct(ctx context.Context, productInput ProductInput) error
-	// UpdateProduct updates a product in db given by product model in parameter
+	// UpdateProduct updates a product in db given by product model in parameter unless it has been updated since the expected version
 	UpdateProduct(ctx context.Context, pInput ProductInput) error
-	// DeleteProduct deletes a product in db by ID
+	// PatchProduct changes the given fields of a product unless it has been updated since the expected version, and returns the new version
+	PatchProduct(ctx context.Context, patch ProductPatch) (int, error)
+	// DeleteProduct archives a product by ID. The archived product is hidden from the listings but still resolved from the orders,
+	// it can be restored
 	DeleteProduct(ctx context.Context, id int) error
-	// GetProducts retrieves all the products in db
-	GetProducts(ctx context.Context, filter ProductCtrlFilter) ([]ProductOutput, error)
+	// RestoreProduct restores an archived product by ID
+	RestoreProduct(ctx context.Context, id int) error
+	// PurgeArchivedProducts deletes the products archived before the time with their variants and images, and returns the number
+	// of deleted products. The products which have been ordered are kept so that the orders can still be resolved
+	PurgeArchivedProducts(ctx context.Context, archivedBefore time.Time) (int, error)
+	// BulkChangePrice adds an amount or a percentage to the prices of the selected products
+	BulkChangePrice(ctx context.Context, input BulkPriceInput) (BulkReport, error)
+	// BulkChangeCategory moves the selected products to a category
+	BulkChangeCategory(ctx context.Context, input BulkCategoryInput) (BulkReport, error)
+	// BulkUpdateStock sets or adjusts the quantity of the selected products
+	BulkUpdateStock(ctx context.Context, input BulkStockInput) (BulkReport, error)
+	// BulkArchiveProducts archives the selected products
+	BulkArchiveProducts(ctx context.Context, selection BulkSelection) (BulkReport, error)
+	// GetProduct retrieves a product with its author and category by ID, the price is converted to the currency if given
+	GetProduct(ctx context.Context, id int, currency string) (ProductOutputGraph, error)
+	// GetProductBySKU retrieves a product like GetProduct by its sku
+	GetProductBySKU(ctx context.Context, sku string, currency string) (ProductOutputGraph, error)
+	// GetProductBySlug retrieves a product like GetProduct by its current slug or by an old slug of it
+	GetProductBySlug(ctx context.Context, slug string, currency string) (ProductOutputGraph, error)
+	// GetProducts retrieves a page of the products in db matching the filter and the total count of them
+	GetProducts(ctx context.Context, filter ProductCtrlFilter) ([]ProductOutput, ProductPageInfo, error)
+	// SearchProducts retrieves a page of the products whose name or description match the query, ranked by relevance, and the total count of them
+	SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]ProductSearchOutput, int64, error)
+	// CreateVariant creates a variant of a product with its sku, price override, stock and option values
+	CreateVariant(ctx context.Context, vInput VariantInput) error
+	// GetVariants retrieves the variants of a product, the prices are converted to the currency if given
+	GetVariants(ctx context.Context, productID int, currency string) ([]VariantOutput, error)
+	// UpdateVariant updates the price override and the quantity of a variant of a product
+	UpdateVariant(ctx context.Context, productID, variantID int, price decimal.NullDecimal, quantity int) error
+	// UploadProductImage validates an image, stores it with its thumbnails and adds it to the end of the gallery of the product
+	UploadProductImage(ctx context.Context, productID int, file io.Reader, size int64) (ProductImageOutput, error)
+	// GetProductImages retrieves the gallery of a product in order
+	GetProductImages(ctx context.Context, productID int) ([]ProductImageOutput, error)
+	// ReorderProductImages orders the gallery of a product as the image IDs, which must be all the images of the product
+	ReorderProductImages(ctx context.Context, productID int, imageIDs []int) error
+	// DeleteProductImage removes an image from the gallery of a product and deletes its files
+	DeleteProductImage(ctx context.Context, productID, imageID int) error
+	// SchedulePriceChange schedules a new price of a product from a time in the future
+	SchedulePriceChange(ctx context.Context, ppInput ProductPriceInput) (ProductPriceOutput, error)
+	// GetProductPriceHistory retrieves the prices of a product sorted by effective time, the scheduled prices included
+	GetProductPriceHistory(ctx context.Context, productID int) ([]ProductPriceOutput, error)
+	// GetProductPriceAt retrieves the price of a product which is in effect at the given time
+	GetProductPriceAt(ctx context.Context, productID int, at time.Time) (ProductPriceOutput, error)
+	// CancelScheduledPrice removes a scheduled price of a product which is not in effect yet
+	CancelScheduledPrice(ctx context.Context, productID, priceID int) error
+	// AdjustStock manually adds the delta to the stock of a product or of one of its variants
+	AdjustStock(ctx context.Context, saInput StockAdjustmentInput) (StockMovementOutput, error)
+	// GetStockReport retrieves the current stock of a product or of one of its variants with the movements it is derived from
+	GetStockReport(ctx context.Context, productID, variantID int) (StockReportOutput, error)
+	// GetLowStockProducts retrieves the active products whose quantity is below their reorder threshold
+	GetLowStockProducts(ctx context.Context) ([]LowStockProductOutput, error)
+	// SendLowStockDigest emails the catalog managers the low stock alerts raised since the last digest
+	SendLowStockDigest(ctx context.Context) (int, error)
+	// SendEmailLowStockDigest sends an email to the catalog managers with the products which are below their reorder threshold
+	SendEmailLowStockDigest(emailToList []string, products []models.Product) error
+	// CreateWarehouse creates a warehouse, the name of a warehouse is unique
+	CreateWarehouse(ctx context.Context, wInput WarehouseInput) (WarehouseOutput, error)
+	// GetWarehouses retrieves all the warehouses
+	GetWarehouses(ctx context.Context) ([]WarehouseOutput, error)
+	// TransferStock moves a quantity of a product from a warehouse to another and records the transfer on the stock ledger
+	TransferStock(ctx context.Context, stInput StockTransferInput) ([]StockMovementOutput, error)
+	// CreateAttributeDefinition defines an attribute of the products of a category
+	CreateAttributeDefinition(ctx context.Context, adInput AttributeDefinitionInput) (AttributeDefinitionOutput, error)
+	// GetAttributeDefinitions retrieves the attributes defined for the products of a category
+	GetAttributeDefinitions(ctx context.Context, categoryID int) ([]AttributeDefinitionOutput, error)
 	// ImportProductsFromCSV imports list of products data from a CSV file
 	ImportProductsFromCSV(ctx context.Context, file multipart.File) error
-	// GetProductsGraph retrieves all the products and their author, category
-	GetProductsGraph(ctx context.Context, pFilter ProductCtrlFilter) ([]ProductOutputGraph, error)
+	// GetProductsGraph retrieves a page of the products and their author, category, and the total count of the products matching the filter
+	GetProductsGraph(ctx context.Context, pFilter ProductCtrlFilter) ([]ProductOutputGraph, ProductPageInfo, error)
 	// SendEmailProduct send an email to a list of user gmail with a list of products csv file attachment
 	SendEmailProduct(emailToList []string, reader io.Reader) error
 	// ExportProductsToCSV exports all the products in db to a csv file
@@ -42,17 +111,103 @@ type IController interface {
 	CreateOrder(ctx context.Context, orderInput OrderInput, orderItemsInput []OrderItemInput) error
 	// SendEmailOrder sends an email that contains the order detail to the user
 	SendEmailOrder(ctx context.Context, emailTo string, order models.Order, orderItem []repositories.OrderItem) error
-	// UpdateOrder updates an order in db given by order model in parameter
+	// UpdateOrder updates an order in db given by order model in parameter unless it has been updated since the expected version
 	UpdateOrder(ctx context.Context, orderID int, orderInput OrderInput) error
 	// GetOrders retrieves all the orders in db
 	GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]OrderOutputGraph, int64, error)
+	// ExportOrders exports the orders matching the filter to a csv or xlsx file, one row per order item
+	ExportOrders(ctx context.Context, filter OrderFilterCtrl, format string) (io.Reader, error)
+	// SendEmailOrders sends an email to a list of users with the exported orders attached
+	SendEmailOrders(emailToList []string, reader io.Reader, format string) error
+	// CancelStaleOrders cancels the orders which have been PENDING for longer than the timeout and puts their items back to stock
+	CancelStaleOrders(ctx context.Context, timeout time.Duration) (int, error)
+	// SendEmailOrderCancelled sends an email to the user that the order has been cancelled as it was not paid in time
+	SendEmailOrderCancelled(emailTo string, order models.Order) error
+
+	// CreateTaxRule creates a tax rule of a product category
+	CreateTaxRule(ctx context.Context, trInput TaxRuleInput) error
+	// GetTaxRules retrieves the tax rules, filtered by the product category name and region if given
+	GetTaxRules(ctx context.Context, categoryName, region string) ([]TaxRuleOutput, error)
+
+	// CreateAddress adds an address to the address book of a user
+	CreateAddress(ctx context.Context, aInput AddressInput) error
+	// GetAddresses retrieves the address book of a user
+	GetAddresses(ctx context.Context, userID int) ([]AddressOutput, error)
+	// DeleteAddress removes an address from the address book of a user
+	DeleteAddress(ctx context.Context, userID, addressID int) error
+
+	// CreateShippingMethod creates a shipping method in db given by shipping method model in parameter
+	CreateShippingMethod(ctx context.Context, smInput ShippingMethodInput) error
+	// GetShippingMethods retrieves all the shipping methods in db
+	GetShippingMethods(ctx context.Context) ([]ShippingMethodOutput, error)
+
+	// CreateShipment dispatches a paid order and emails the tracking number to the customer
+	CreateShipment(ctx context.Context, orderID int, sInput ShipmentInput) error
+	// UpdateShipmentStatus updates the status of a shipment
+	UpdateShipmentStatus(ctx context.Context, shipmentID int, status string) error
+	// GetShipments retrieves all the shipments of an order
+	GetShipments(ctx context.Context, orderID int) ([]ShipmentOutput, error)
+
+	// CreateReturnRequest requests a return of items of a delivered order
+	CreateReturnRequest(ctx context.Context, rInput ReturnRequestInput) error
+	// UpdateReturnStatus moves a return request to the next status of the workflow and emails the customer
+	UpdateReturnStatus(ctx context.Context, returnRequestID int, status string, note string) error
+	// GetReturnRequests retrieves the return requests, the pending ones if no status or order is given
+	GetReturnRequests(ctx context.Context, filter ReturnRequestFilterCtrl) ([]ReturnRequestOutput, error)
+	// CreateProductReview creates a pending review of a product by a customer who bought it
+	CreateProductReview(ctx context.Context, input ProductReviewInput) (ProductReviewOutput, error)
+	// ModerateProductReview moves a review to the status and updates the rating of the product
+	ModerateProductReview(ctx context.Context, reviewID int, status string, note string) error
+	// GetProductReviews retrieves a page of the reviews of a product in a status and the total count of them
+	GetProductReviews(ctx context.Context, filter ProductReviewFilterCtrl) ([]ProductReviewOutput, int64, error)
+
+	// AddToWishlist saves a product to the wishlist of a user
+	AddToWishlist(ctx context.Context, userID, productID int) error
+	// GetWishlist retrieves the wishlist of a user with the current price and stock of the products
+	GetWishlist(ctx context.Context, userID int) ([]WishlistItemOutput, error)
+	// RemoveFromWishlist removes a product from the wishlist of a user
+	RemoveFromWishlist(ctx context.Context, userID, productID int) error
+	// SubscribeBackInStock subscribes a user to the back in stock email of an out of stock product in their wishlist
+	SubscribeBackInStock(ctx context.Context, userID, productID int) error
+	// UnsubscribeBackInStock unsubscribes a user from the back in stock email of a product in their wishlist
+	UnsubscribeBackInStock(ctx context.Context, userID, productID int) error
+	// SendBackInStockNotifications emails the users subscribed to the products which have been restocked
+	SendBackInStockNotifications(ctx context.Context) (int, error)
+	// SendEmailBackInStock sends an email to a user with the products of their wishlist which are back in stock
+	SendEmailBackInStock(user models.User, products []models.Product) error
+
+	// ComputeRelatedProducts finds the products most often bought together in the sales orders and stores them as related products
+	ComputeRelatedProducts(ctx context.Context) (int, error)
+	// GetRelatedProducts retrieves the products most often bought together with a product, filled up with the best-sellers of its category
+	GetRelatedProducts(ctx context.Context, productID int, limit int, currency string) ([]ProductOutputGraph, error)
+
+	// GetJobRuns retrieves the last run of each background job
+	GetJobRuns(ctx context.Context) ([]JobRunOutput, error)
+
+	// GetRevenueByPeriod retrieves the revenue in VND and the number of paid orders of each day, week or month of the range
+	GetRevenueByPeriod(ctx context.Context, filter AnalyticsFilterCtrl, period string) ([]RevenuePeriodOutput, error)
+	// GetTopProducts retrieves the products with the highest revenue in VND or the most units sold in the range
+	GetTopProducts(ctx context.Context, filter AnalyticsFilterCtrl, sortBy string, limit int) ([]TopSellerOutput, error)
+	// GetTopCategories retrieves the product categories with the highest revenue in VND or the most units sold in the range
+	GetTopCategories(ctx context.Context, filter AnalyticsFilterCtrl, sortBy string, limit int) ([]TopSellerOutput, error)
+	// GetAverageOrderValue retrieves the number of paid orders, the revenue and the average order value in VND of the range
+	GetAverageOrderValue(ctx context.Context, filter AnalyticsFilterCtrl) (OrderValueOutput, error)
+
+	// CreateExchangeRate creates an exchange rate of a foreign currency against VND
+	CreateExchangeRate(ctx context.Context, erInput ExchangeRateInput) error
+	// ImportExchangeRatesFromCSV imports list of exchange rates from a CSV file
+	ImportExchangeRatesFromCSV(ctx context.Context, file multipart.File) error
+	// GetExchangeRates retrieves the exchange rates, filtered by currency if given
+	GetExchangeRates(ctx context.Context, currency string) ([]ExchangeRateOutput, error)
 }
 
 type Controller struct {
 	Repository repositories.IRepository
+	// Storage stores the uploaded images
+	Storage storage.Storage
 }
 
-// NewController creates new Controller
+// NewController creates new Controller, the uploaded images are stored in the local storage configured by the environment
 func NewController(repository repositories.IRepository) IController {
-	return &Controller{Repository: repository}
+	return &Controller{Repository: repository, Storage: storage.NewLocalStorageFromEnv()}
 }
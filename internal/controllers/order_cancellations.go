@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/email"
+)
+
+// CancelStaleOrders cancels the orders which have been PENDING for longer than the timeout and puts their items back to stock,
+// the customer of each cancelled order gets an email. It returns the number of orders cancelled
+func (c *Controller) CancelStaleOrders(ctx context.Context, timeout time.Duration) (int, error) {
+	orders, err := c.Repository.GetStaleOrders(ctx, OrderStatusPending, time.Now().Add(-timeout))
+	if err != nil {
+		return 0, err
+	}
+
+	// an order failing to be cancelled does not stop the others, it is retried at the next run
+	var errs []error
+	cancelled := 0
+	for _, o := range orders {
+		ok, err := c.cancelStaleOrder(ctx, o.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("order #%d: %w", o.ID, err))
+			continue
+		}
+		if ok {
+			cancelled++
+		}
+	}
+
+	return cancelled, errors.Join(errs...)
+}
+
+// cancelStaleOrder cancels an order and restores the quantity of its products if it is still PENDING,
+// it reports whether the order has been cancelled
+func (c *Controller) cancelStaleOrder(ctx context.Context, orderID int) (bool, error) {
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the order is locked so that a payment made meanwhile is not overridden
+	order, err := c.Repository.LockOrder(ctx, tx, orderID)
+	if err != nil {
+		return false, err
+	}
+	if order.Status != OrderStatusPending {
+		return false, nil
+	}
+
+	orderItems, err := c.Repository.GetOrderItemsByOrderID(ctx, order.ID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, oi := range orderItems {
+		if err = c.restockOrderItem(ctx, tx, *oi, oi.Quantity, stockChange{Reason: repositories.StockReasonCancellation, ReferenceID: order.ID}); err != nil {
+			return false, err
+		}
+	}
+
+	order.Status = OrderStatusCancelled
+	if err = c.Repository.UpdateOrder(ctx, tx, order); err != nil {
+		return false, err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return false, err
+	}
+
+	var productIDs []int
+	for _, oi := range orderItems {
+		productIDs = append(productIDs, oi.ProductID)
+	}
+	c.deleteProductCache(ctx, productIDs...)
+
+	// the order is cancelled even if the customer cannot be emailed
+	user, err := c.Repository.GetUser(ctx, order.UserID)
+	if err != nil {
+		log.Println(err)
+		return true, nil
+	}
+	if err = c.SendEmailOrderCancelled(user.Email, order); err != nil {
+		log.Println(err)
+	}
+
+	return true, nil
+}
+
+// SendEmailOrderCancelled sends an email to the user that the order has been cancelled as it was not paid in time
+func (c *Controller) SendEmailOrderCancelled(emailTo string, order models.Order) error {
+	body := []string{
+		"Hi users,",
+		fmt.Sprintf("Your order #%d placed on %s has been cancelled as it was not paid in time.", order.ID, order.CreatedAt.Format(time.DateTime)),
+		"Please place a new order if you still want the items.",
+		"Thanks for choosing us!",
+	}
+
+	sender := email.NewEmailSender()
+
+	m := email.NewMessage(fmt.Sprintf("Order #%d Cancelled", order.ID), strings.Join(body, "\n"))
+	m.To = []string{emailTo}
+
+	return sender.Send(m)
+}
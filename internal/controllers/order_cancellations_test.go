@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test CancelStaleOrders in Controller layer
+func Test_OrderController_CancelStaleOrders(t *testing.T) {
+	tests := map[string]struct {
+		lockedOrder  models.Order
+		variantID    int
+		lockErr      error
+		expCancelled int
+		expErr       error
+	}{
+		"cancel pending order and restore stock": {
+			lockedOrder:  models.Order{ID: 1, UserID: 1, Status: OrderStatusPending},
+			expCancelled: 1,
+		},
+		"cancel pending order and restore stock of variant": {
+			lockedOrder:  models.Order{ID: 1, UserID: 1, Status: OrderStatusPending},
+			variantID:    7,
+			expCancelled: 1,
+		},
+		"order paid meanwhile": {
+			lockedOrder: models.Order{ID: 1, UserID: 1, Status: OrderStatusPaid},
+		},
+		"order cannot be locked": {
+			lockErr: errors.New("something went wrong"),
+			expErr:  errors.New("order #1: something went wrong"),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			mockRepo.On("GetStaleOrders", context.Background(), OrderStatusPending, mock.AnythingOfType("time.Time")).
+				Return(models.OrderSlice{{ID: 1, UserID: 1, Status: OrderStatusPending}}, nil)
+			mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+			mockRepo.On("RollbackTx", &tx).Return(nil)
+			mockRepo.On("LockOrder", context.Background(), &tx, 1).Return(tc.lockedOrder, tc.lockErr)
+
+			if tc.expCancelled > 0 {
+				orderItem := &models.OrderItem{ID: 10, OrderID: 1, ProductID: 100, Quantity: 2}
+				if tc.variantID != 0 {
+					orderItem.VariantID = null.IntFrom(tc.variantID)
+				}
+				mockRepo.On("RecordStockMovement", context.Background(), &tx, repositories.StockMovement{
+					ProductID:   100,
+					VariantID:   orderItem.VariantID,
+					Delta:       2,
+					Reason:      repositories.StockReasonCancellation,
+					ReferenceID: null.IntFrom(1),
+				}).Return(models.StockMovement{}, nil)
+				mockRepo.On("GetOrderItemsByOrderID", context.Background(), 1).Return(models.OrderItemSlice{orderItem}, nil)
+
+				cancelledOrder := tc.lockedOrder
+				cancelledOrder.Status = OrderStatusCancelled
+				mockRepo.On("UpdateOrder", context.Background(), &tx, cancelledOrder).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+				mockRepo.On("DeleteProductCache", context.Background(), 100).Return(nil)
+				// the email is skipped as the user cannot be found
+				mockRepo.On("GetUser", context.Background(), 1).Return(models.User{}, repositories.ErrUserNotFound)
+			}
+
+			cancelled, err := controller.CancelStaleOrders(context.Background(), time.Hour)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expCancelled, cancelled)
+		})
+	}
+}
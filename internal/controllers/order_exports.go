@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/email"
+	"github.com/xuri/excelize/v2"
+)
+
+// file formats of the order export
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// orderExportHeader is the header of the order export, the fields of an order are repeated on every item row
+var orderExportHeader = []string{"OrderID", "CreatedAt", "Status", "UserName", "UserEmail", "Region", "Currency", "Subtotal", "Tax", "Shipping", "Total", "ItemID", "ProductID", "ProductName", "SKU", "Quantity", "Price", "TaxRate", "TaxAmount"}
+
+// ExportOrders exports the orders matching the date range, status and sorting of the filter to a csv or xlsx file,
+// one row per order item. The pagination of the filter is ignored
+func (c *Controller) ExportOrders(ctx context.Context, filter OrderFilterCtrl, format string) (io.Reader, error) {
+	if format != ExportFormatCSV && format != ExportFormatXLSX {
+		return nil, ErrInvalidExportFormat
+	}
+
+	rows, err := c.Repository.GetOrderExportRows(ctx, toOrderFilterRepo(filter))
+	if err != nil {
+		return nil, err
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+
+	// write the orders to the pipe
+	go func() {
+		if format == ExportFormatXLSX {
+			pipeWriter.CloseWithError(writeOrdersXLSX(pipeWriter, rows))
+			return
+		}
+		pipeWriter.CloseWithError(writeOrdersCSV(pipeWriter, rows))
+	}()
+
+	return pipeReader, nil
+}
+
+// writeOrdersCSV writes the header and the order rows to w as csv
+func writeOrdersCSV(w io.Writer, rows []repositories.OrderExportRow) error {
+	csvWriter := csv.NewWriter(w)
+
+	if err := csvWriter.Write(orderExportHeader); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		record := []string{
+			strconv.Itoa(row.OrderID),
+			row.CreatedAt.Format("2006-01-02 15:04:05"),
+			row.Status,
+			row.UserName,
+			row.UserEmail,
+			row.Region,
+			row.Currency,
+			row.SubtotalPrice.Decimal.String(),
+			row.TaxPrice.Decimal.String(),
+			row.ShippingPrice.Decimal.String(),
+			row.TotalPrice.Decimal.String(),
+			strconv.Itoa(row.ItemID),
+			strconv.Itoa(row.ProductID),
+			row.ProductName,
+			row.SKU,
+			strconv.Itoa(row.Quantity),
+			row.Price.String(),
+			row.TaxRate.String(),
+			row.TaxAmount.String(),
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// writeOrdersXLSX writes the header and the order rows to w as a xlsx workbook with a single sheet,
+// the rows are written with a stream writer so the sheet is not kept in memory
+func writeOrdersXLSX(w io.Writer, rows []repositories.OrderExportRow) error {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	sheet := file.GetSheetName(0)
+	streamWriter, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+
+	header := make([]interface{}, 0, len(orderExportHeader))
+	for _, h := range orderExportHeader {
+		header = append(header, h)
+	}
+	if err := streamWriter.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+
+		if err := streamWriter.SetRow(cell, []interface{}{
+			row.OrderID,
+			row.CreatedAt.Format("2006-01-02 15:04:05"),
+			row.Status,
+			row.UserName,
+			row.UserEmail,
+			row.Region,
+			row.Currency,
+			row.SubtotalPrice.Decimal.InexactFloat64(),
+			row.TaxPrice.Decimal.InexactFloat64(),
+			row.ShippingPrice.Decimal.InexactFloat64(),
+			row.TotalPrice.Decimal.InexactFloat64(),
+			row.ItemID,
+			row.ProductID,
+			row.ProductName,
+			row.SKU,
+			row.Quantity,
+			row.Price.InexactFloat64(),
+			row.TaxRate.InexactFloat64(),
+			row.TaxAmount.InexactFloat64(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := streamWriter.Flush(); err != nil {
+		return err
+	}
+
+	return file.Write(w)
+}
+
+// SendEmailOrders sends an email to a list of users with the exported orders attached in the given format
+func (c *Controller) SendEmailOrders(emailToList []string, reader io.Reader, format string) error {
+	sender := email.NewEmailSender()
+
+	// Write email subject and body
+	m := email.NewMessage("List of orders", fmt.Sprintf("Hi users,\nThis is a list of orders exported to a %s file that is being attached below.\nThanks!", format))
+	m.To = emailToList
+	m.Attachments = reader
+	m.AttachmentName = fmt.Sprintf("export_orders.%s", format)
+
+	return sender.Send(m)
+}
@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+// Test ExportOrders in Controller layer
+func Test_OrderController_ExportOrders(t *testing.T) {
+	createdAt := time.Date(2023, 6, 2, 10, 0, 0, 0, time.UTC)
+	exportRows := []repositories.OrderExportRow{
+		{
+			OrderID:       1,
+			UserName:      "Thuy",
+			UserEmail:     "qthuy@gmail.com",
+			Status:        OrderStatusPaid,
+			Region:        "HCM",
+			SubtotalPrice: decimal.NewNullDecimal(decimal.NewFromInt(300)),
+			TaxPrice:      decimal.NewNullDecimal(decimal.NewFromInt(30)),
+			ShippingPrice: decimal.NewNullDecimal(decimal.NewFromInt(20)),
+			TotalPrice:    decimal.NewNullDecimal(decimal.NewFromInt(350)),
+			Currency:      CurrencyVND,
+			CreatedAt:     createdAt,
+			ItemID:        10,
+			ProductID:     100,
+			ProductName:   "Samsung S23",
+			Quantity:      1,
+			Price:         decimal.NewFromInt(100),
+			TaxRate:       decimal.RequireFromString("0.1"),
+			TaxAmount:     decimal.NewFromInt(10),
+		},
+		{
+			OrderID:       1,
+			UserName:      "Thuy",
+			UserEmail:     "qthuy@gmail.com",
+			Status:        OrderStatusPaid,
+			Region:        "HCM",
+			SubtotalPrice: decimal.NewNullDecimal(decimal.NewFromInt(300)),
+			TaxPrice:      decimal.NewNullDecimal(decimal.NewFromInt(30)),
+			ShippingPrice: decimal.NewNullDecimal(decimal.NewFromInt(20)),
+			TotalPrice:    decimal.NewNullDecimal(decimal.NewFromInt(350)),
+			Currency:      CurrencyVND,
+			CreatedAt:     createdAt,
+			ItemID:        11,
+			ProductID:     101,
+			ProductName:   "Galaxy Watch 5",
+			SKU:           "GW5-44-BLK",
+			Quantity:      2,
+			Price:         decimal.NewFromInt(100),
+			TaxRate:       decimal.RequireFromString("0.1"),
+			TaxAmount:     decimal.NewFromInt(20),
+		},
+	}
+	expRecords := [][]string{
+		orderExportHeader,
+		{"1", "2023-06-02 10:00:00", "PAID", "Thuy", "qthuy@gmail.com", "HCM", "VND", "300", "30", "20", "350", "10", "100", "Samsung S23", "", "1", "100", "0.1", "10"},
+		{"1", "2023-06-02 10:00:00", "PAID", "Thuy", "qthuy@gmail.com", "HCM", "VND", "300", "30", "20", "350", "11", "101", "Galaxy Watch 5", "GW5-44-BLK", "2", "100", "0.1", "20"},
+	}
+
+	type mockOrderRepo struct {
+		expCall bool
+		filter  repositories.OrderFilterRepo
+		output  []repositories.OrderExportRow
+		err     error
+	}
+	tests := map[string]struct {
+		filter        OrderFilterCtrl
+		format        string
+		mockOrderRepo mockOrderRepo
+		expRecords    [][]string
+		expErr        error
+	}{
+		"export to csv": {
+			filter: OrderFilterCtrl{
+				Status:  OrderStatusPaid,
+				Sorting: []Sorting{{ColumnName: "created_at", Desc: true}},
+			},
+			format: ExportFormatCSV,
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				filter: repositories.OrderFilterRepo{
+					Status:  OrderStatusPaid,
+					Sorting: []repositories.Sorting{{ColumnName: "created_at", SortOrder: "desc"}},
+				},
+				output: exportRows,
+			},
+			expRecords: expRecords,
+		},
+		"export to xlsx": {
+			format: ExportFormatXLSX,
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				output:  exportRows,
+			},
+			expRecords: expRecords,
+		},
+		"export without orders": {
+			format: ExportFormatCSV,
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+			},
+			expRecords: [][]string{orderExportHeader},
+		},
+		"invalid format": {
+			format: "pdf",
+			expErr: ErrInvalidExportFormat,
+		},
+		"repository error": {
+			format: ExportFormatCSV,
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				err:     errors.New("something wrong"),
+			},
+			expErr: errors.New("something wrong"),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockOrderRepo.expCall {
+				mockRepo.On("GetOrderExportRows", context.Background(), tc.mockOrderRepo.filter).Return(tc.mockOrderRepo.output, tc.mockOrderRepo.err)
+			}
+
+			reader, err := controller.ExportOrders(context.Background(), tc.filter, tc.format)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			var records [][]string
+			if tc.format == ExportFormatXLSX {
+				file, err := excelize.OpenReader(reader)
+				assert.NoError(t, err)
+				records, err = file.GetRows(file.GetSheetName(0))
+				assert.NoError(t, err)
+			} else {
+				records, err = csv.NewReader(reader).ReadAll()
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expRecords, records)
+		})
+	}
+}
@@ -9,7 +9,11 @@ import (
 type OrderItemInput struct {
 	ID        int
 	ProductID int
-	Quantity  int
+	// VariantID is required if the product has variants
+	VariantID int
+	// WarehouseID is the warehouse the item is fulfilled from, the nearest warehouse which has the quantity if 0
+	WarehouseID int
+	Quantity    int
 }
 
 type OrderItemOutput struct {
@@ -17,6 +21,8 @@ type OrderItemOutput struct {
 	ProductName string
 	Quantity    int
 	Price       decimal.Decimal
+	TaxRate     decimal.Decimal
+	TaxAmount   decimal.Decimal
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
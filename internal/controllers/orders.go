@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
@@ -16,13 +17,39 @@ import (
 	"github.com/qthuy2k1/product-management/internal/repositories"
 	"github.com/shopspring/decimal"
 	"github.com/signintech/gopdf"
+	"github.com/volatiletech/null/v8"
 )
 
+// statuses of an order
+const (
+	OrderStatusNew       = "NEW"
+	OrderStatusPending   = "PENDING"
+	OrderStatusPaid      = "PAID"
+	OrderStatusShipped   = "SHIPPED"
+	OrderStatusDelivered = "DELIVERED"
+	OrderStatusCancelled = "CANCELLED"
+)
+
+// IsValidOrderStatus reports whether the status is one of the statuses of an order
+func IsValidOrderStatus(status string) bool {
+	switch status {
+	case OrderStatusNew, OrderStatusPending, OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered, OrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
 type OrderInput struct {
-	UserID    int
-	Status    string
-	Total     decimal.Decimal
-	OrderItem []OrderItemInput
+	UserID           int
+	Status           string
+	Region           string
+	AddressID        int
+	ShippingMethodID int
+	Currency         string
+	Total            decimal.Decimal
+	OrderItem        []OrderItemInput
+	// ExpectedVersion is the version of the order the update is based on, it is not used on create
+	ExpectedVersion int
 }
 
 // CreateOrder creates an order in db given by order model in parameter
@@ -36,6 +63,46 @@ func (c *Controller) CreateOrder(ctx context.Context, orderInput OrderInput, ord
 		return err
 	}
 
+	// the order is in VND if no currency is given
+	if orderInput.Currency == "" {
+		orderInput.Currency = CurrencyVND
+	}
+	if !IsSupportedCurrency(orderInput.Currency) {
+		return ErrInvalidCurrency
+	}
+
+	orderRepoInput := repositories.Order{
+		UserID:   orderInput.UserID,
+		Status:   orderInput.Status,
+		Region:   orderInput.Region,
+		Currency: orderInput.Currency,
+	}
+
+	// check the shipping address belongs to the user, the tax region follows the address if not given
+	// and the items are fulfilled from the warehouses nearest to it
+	var shippingAddress *AddressOutput
+	if orderInput.AddressID != 0 {
+		address, err := c.getUserAddress(ctx, orderInput.UserID, orderInput.AddressID)
+		if err != nil {
+			return err
+		}
+		shippingAddress = &address
+		orderRepoInput.AddressID = null.IntFrom(address.ID)
+		if orderRepoInput.Region == "" {
+			orderRepoInput.Region = address.Region
+		}
+	}
+
+	// check shipping method exists
+	var shippingMethod models.ShippingMethod
+	if orderInput.ShippingMethodID != 0 {
+		shippingMethod, err = c.getShippingMethod(ctx, orderInput.ShippingMethodID)
+		if err != nil {
+			return err
+		}
+		orderRepoInput.ShippingMethodID = null.IntFrom(shippingMethod.ID)
+	}
+
 	// start a transaction
 	tx, err := c.Repository.BeginTx(ctx)
 	if err != nil {
@@ -43,45 +110,95 @@ func (c *Controller) CreateOrder(ctx context.Context, orderInput OrderInput, ord
 	}
 	defer c.Repository.RollbackTx(tx)
 
-	order, err := c.Repository.CreateOrder(ctx, tx, repositories.Order{
-		UserID: orderInput.UserID,
-		Status: orderInput.Status,
-	})
+	order, err := c.Repository.CreateOrder(ctx, tx, orderRepoInput)
 	if err != nil {
 		return err
 	}
 
-	order.TotalPrice = decimal.NewNullDecimal(decimal.NewFromFloat(0))
+	// the prices of the products are converted to the order currency, the exchange rate is snapshotted on the order
+	converter := &priceConverter{controller: c, currency: order.Currency}
+
+	subtotal := decimal.NewFromFloat(0)
+	taxTotal := decimal.NewFromFloat(0)
+	weight := decimal.NewFromFloat(0)
 	var oiRepoInputList []repositories.OrderItem
 	for _, oi := range orderItemsInput {
-		// check product exists
-		p, err := c.Repository.GetProduct(ctx, oi.ProductID)
+		// check product exists, a variant is sold at its own price if it overrides the product price
+		p, variant, err := c.getOrderItemStock(ctx, oi.ProductID, oi.VariantID)
 		if err != nil {
 			return err
 		}
+		if p.DeletedAt.Valid {
+			return ErrProductArchived
+		}
+		// the price in effect now is used, a scheduled price may not have been set on the product yet
+		if p.Price, p.Currency, err = c.productPriceAt(ctx, p, time.Now()); err != nil {
+			return err
+		}
+		stock, unitPrice := p.Quantity, p.Price
+		if variant != nil {
+			stock, unitPrice = variant.Quantity, variantPrice(p, *variant)
+		}
 
 		// check the quantity of product
-		if p.Quantity < oi.Quantity {
+		if stock < oi.Quantity {
 			return ErrInsufficientQuantity
 		}
 
+		warehouseID, err := c.allocateOrderItem(ctx, p, variant, oi, shippingAddress)
+		if err != nil {
+			return err
+		}
+
+		price, err := converter.convert(ctx, unitPrice, p.Currency)
+		if err != nil {
+			return err
+		}
+
+		// calculate the line tax by the tax rule of the product category
+		taxRate, err := c.getTaxRate(ctx, p.CategoryID, order.Region, time.Now())
+		if err != nil {
+			return err
+		}
+		lineSubtotal := price.Mul(decimal.NewFromInt(int64(oi.Quantity)))
+		lineTax := lineSubtotal.Mul(taxRate).Round(2)
+
 		oiRepoInputList = append(oiRepoInputList, repositories.OrderItem{
-			ProductID: oi.ProductID,
-			Quantity:  oi.Quantity,
-			Price:     p.Price.Mul(decimal.NewFromInt(int64(p.Quantity))),
+			ProductID:   oi.ProductID,
+			VariantID:   oi.VariantID,
+			WarehouseID: warehouseID,
+			Quantity:    oi.Quantity,
+			Price:       price,
+			TaxRate:     taxRate,
+			TaxAmount:   lineTax,
 		})
 
 		// decrease the quantity of product
-		p.Quantity -= oi.Quantity
-		if err = c.Repository.UpdateProduct(ctx, tx, p); err != nil {
+		if err = c.adjustStock(ctx, tx, p, variant, -oi.Quantity, stockChange{Reason: repositories.StockReasonSale, ReferenceID: order.ID, ActorID: order.UserID, WarehouseID: warehouseID}); err != nil {
 			return err
 		}
 
-		// update order price total
-		// TotalPrice + (p.Price * oi.Quantity)
-		order.TotalPrice.Decimal = order.TotalPrice.Decimal.Add(p.Price.Mul(decimal.NewFromInt(int64(oi.Quantity))))
+		subtotal = subtotal.Add(lineSubtotal)
+		taxTotal = taxTotal.Add(lineTax)
+		weight = weight.Add(p.Weight.Mul(decimal.NewFromInt(int64(oi.Quantity))))
 	}
 
+	// update order price total
+	// TotalPrice = Subtotal + Tax + Shipping
+	order.SubtotalPrice = decimal.NewNullDecimal(subtotal)
+	order.TaxPrice = decimal.NewNullDecimal(taxTotal)
+	order.TotalPrice = decimal.NewNullDecimal(subtotal.Add(taxTotal))
+	if order.ShippingMethodID.Valid {
+		// the shipping rates are in VND
+		shippingPrice, err := converter.convert(ctx, calculateShippingPrice(shippingMethod, weight), CurrencyVND)
+		if err != nil {
+			return err
+		}
+		order.ShippingPrice = decimal.NewNullDecimal(shippingPrice)
+		order.TotalPrice.Decimal = order.TotalPrice.Decimal.Add(order.ShippingPrice.Decimal)
+	}
+	order.ExchangeRate = converter.rate
+
 	if err = c.Repository.CreateOrderItem(ctx, tx, oiRepoInputList, order); err != nil {
 		return err
 	}
@@ -95,7 +212,17 @@ func (c *Controller) CreateOrder(ctx context.Context, orderInput OrderInput, ord
 		return err
 	}
 
-	return tx.Commit()
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	var productIDs []int
+	for _, oi := range oiRepoInputList {
+		productIDs = append(productIDs, oi.ProductID)
+	}
+	c.deleteProductCache(ctx, productIDs...)
+
+	return nil
 }
 
 // SendEmailOrder sends an email that contains the order detail to the user
@@ -129,6 +256,10 @@ func (c *Controller) SendEmailOrder(ctx context.Context, emailTo string, order m
 	pdf.Br(20)
 	pdf.Text(fmt.Sprintf("Order Status: %s", order.Status))
 	pdf.Br(20)
+	if order.Region != "" {
+		pdf.Text(fmt.Sprintf("Region: %s", order.Region))
+		pdf.Br(20)
+	}
 
 	user, err := c.Repository.GetUser(ctx, order.UserID)
 	if err != nil {
@@ -150,11 +281,23 @@ func (c *Controller) SendEmailOrder(ctx context.Context, emailTo string, order m
 			return err
 		}
 
-		pdf.Cell(nil, fmt.Sprintf("Product Name: %s, Quantity: %d, Price: %v", p.Name, oi.Quantity, oi.Price.Mul(decimal.NewFromInt(int64(oi.Quantity)))))
+		pdf.Cell(nil, fmt.Sprintf("Product Name: %s, Quantity: %d, Price: %v, Tax (%s%%): %v", p.Name, oi.Quantity, oi.Price.Mul(decimal.NewFromInt(int64(oi.Quantity))), oi.TaxRate.Mul(decimal.NewFromInt(100)).String(), oi.TaxAmount))
 		pdf.Br(20)
 	}
 	pdf.Br(20)
-	pdf.Text(fmt.Sprintf("Total Price: %s", order.TotalPrice.Decimal.String()))
+	pdf.Text(fmt.Sprintf("Subtotal: %s", order.SubtotalPrice.Decimal.String()))
+	pdf.Br(20)
+	pdf.Text(fmt.Sprintf("Tax: %s", order.TaxPrice.Decimal.String()))
+	pdf.Br(20)
+	if order.ShippingPrice.Valid {
+		pdf.Text(fmt.Sprintf("Shipping: %s", order.ShippingPrice.Decimal.String()))
+		pdf.Br(20)
+	}
+	pdf.Text(fmt.Sprintf("Total Price: %s %s", order.TotalPrice.Decimal.String(), order.Currency))
+	if order.ExchangeRate.Valid {
+		pdf.Br(20)
+		pdf.Text(fmt.Sprintf("Exchange Rate: 1 USD = %s VND", order.ExchangeRate.Decimal.String()))
+	}
 
 	pdf.WritePdf("test.pdf")
 
@@ -178,15 +321,26 @@ func (c *Controller) SendEmailOrder(ctx context.Context, emailTo string, order m
 	return nil
 }
 
-// UpdateOrder updates an order in db given by order model in parameter
+// UpdateOrder updates an order in db given by order model in parameter. The update is rejected
+// if the order has been updated since the version expected by the input
 func (c *Controller) UpdateOrder(ctx context.Context, orderID int, orderInput OrderInput) error {
-	order, err := c.Repository.GetOrder(ctx, orderID)
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the order is locked so that it is not updated by anyone else between the check of the version and the update
+	order, err := c.Repository.LockOrder(ctx, tx, orderID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrOrderNotFound) {
 			return ErrOrderNotFound
 		}
 		return err
 	}
+	if order.Version != orderInput.ExpectedVersion {
+		return ErrOrderVersionConflict
+	}
 
 	// check user exists
 	if _, err := c.Repository.GetUser(ctx, orderInput.UserID); err != nil {
@@ -196,80 +350,222 @@ func (c *Controller) UpdateOrder(ctx context.Context, orderID int, orderInput Or
 		return err
 	}
 
-	tx, err := c.Repository.BeginTx(ctx)
-	if err != nil {
-		return err
+	// the prices of an order have been converted with the snapshotted rate, so the currency cannot change
+	if orderInput.Currency != "" && orderInput.Currency != order.Currency {
+		return ErrOrderCurrencyChanged
+	}
+
+	order.UserID = orderInput.UserID
+	order.Status = orderInput.Status
+	order.Region = orderInput.Region
+
+	// change the shipping address if given
+	var shippingAddress *AddressOutput
+	if orderInput.AddressID != 0 {
+		address, err := c.getUserAddress(ctx, orderInput.UserID, orderInput.AddressID)
+		if err != nil {
+			return err
+		}
+		shippingAddress = &address
+		order.AddressID = null.IntFrom(address.ID)
+		if order.Region == "" {
+			order.Region = address.Region
+		}
 	}
-	defer c.Repository.RollbackTx(tx)
 
+	// change the shipping method if given
+	if orderInput.ShippingMethodID != 0 {
+		shippingMethod, err := c.getShippingMethod(ctx, orderInput.ShippingMethodID)
+		if err != nil {
+			return err
+		}
+		order.ShippingMethodID = null.IntFrom(shippingMethod.ID)
+	}
+
+	// the products whose stock is moved by the edited items
+	var productIDs []int
 	if orderInput.OrderItem != nil {
+		// reuse the exchange rate snapshotted on the order if any
+		converter := &priceConverter{controller: c, currency: order.Currency, rate: order.ExchangeRate}
+
 		// init new total price
-		order.TotalPrice = decimal.NewNullDecimal(decimal.NewFromFloat(0))
+		subtotal := decimal.NewFromFloat(0)
+		taxTotal := decimal.NewFromFloat(0)
+		weight := decimal.NewFromFloat(0)
 		for _, oi := range orderInput.OrderItem {
-			// check product exists
-			p, err := c.Repository.GetProduct(ctx, oi.ProductID)
+			// the stored item is the stock already taken by the order
+			oldItem, err := c.Repository.GetOrderItem(ctx, oi.ID)
 			if err != nil {
-				if errors.Is(err, repositories.ErrProductNotFound) {
-					return ErrProductNotFound
+				if errors.Is(err, repositories.ErrOrderItemNotFound) {
+					return ErrOrderItemNotFound
 				}
 				return err
 			}
+			if oldItem.OrderID != order.ID {
+				return ErrOrderItemNotFound
+			}
 
-			// check the product quantity
-			if p.Quantity < oi.Quantity {
-				return ErrInsufficientQuantity
+			// check product exists
+			p, variant, err := c.getOrderItemStock(ctx, oi.ProductID, oi.VariantID)
+			if err != nil {
+				return err
+			}
+			if p.DeletedAt.Valid {
+				return ErrProductArchived
+			}
+			// the price in effect now is used, a scheduled price may not have been set on the product yet
+			if p.Price, p.Currency, err = c.productPriceAt(ctx, p, time.Now()); err != nil {
+				return err
+			}
+			stock, unitPrice := p.Quantity, p.Price
+			if variant != nil {
+				stock, unitPrice = variant.Quantity, variantPrice(p, *variant)
 			}
 
-			if _, err = c.Repository.GetOrderItem(ctx, oi.ID); err != nil {
-				if errors.Is(err, repositories.ErrOrderItemNotFound) {
-					return ErrOrderItemNotFound
-				}
+			warehouseID, err := c.moveOrderItemStock(ctx, tx, order, oldItem, p, variant, oi, stock, shippingAddress)
+			if err != nil {
+				return err
 			}
+			productIDs = append(productIDs, oldItem.ProductID, p.ID)
+
+			price, err := converter.convert(ctx, unitPrice, p.Currency)
+			if err != nil {
+				return err
+			}
+
+			taxRate, err := c.getTaxRate(ctx, p.CategoryID, order.Region, time.Now())
+			if err != nil {
+				return err
+			}
+			lineSubtotal := price.Mul(decimal.NewFromInt(int64(oi.Quantity)))
+			lineTax := lineSubtotal.Mul(taxRate).Round(2)
 
 			if err = c.Repository.UpdateOrderItem(ctx, tx, oi.ID, repositories.OrderItem{
-				OrderID:   order.ID,
-				ProductID: oi.ProductID,
-				Quantity:  oi.Quantity,
-				Price:     p.Price,
+				OrderID:     order.ID,
+				ProductID:   oi.ProductID,
+				VariantID:   oi.VariantID,
+				WarehouseID: warehouseID,
+				Quantity:    oi.Quantity,
+				Price:       price,
+				TaxRate:     taxRate,
+				TaxAmount:   lineTax,
 			}); err != nil {
 				return err
 			}
 
-			// adjust the quantity of product
-			p.Quantity -= oi.Quantity
-			if err = c.Repository.UpdateProduct(ctx, tx, p); err != nil {
+			subtotal = subtotal.Add(lineSubtotal)
+			taxTotal = taxTotal.Add(lineTax)
+			weight = weight.Add(p.Weight.Mul(decimal.NewFromInt(int64(oi.Quantity))))
+		}
+
+		// update order price total
+		order.SubtotalPrice = decimal.NewNullDecimal(subtotal)
+		order.TaxPrice = decimal.NewNullDecimal(taxTotal)
+		order.TotalPrice = decimal.NewNullDecimal(subtotal.Add(taxTotal))
+		if order.ShippingMethodID.Valid {
+			shippingMethod, err := c.getShippingMethod(ctx, order.ShippingMethodID.Int)
+			if err != nil {
 				return err
 			}
-
-			// update order price total
-			order.TotalPrice.Decimal = order.TotalPrice.Decimal.Add(p.Price.Mul(decimal.NewFromInt(int64(oi.Quantity))))
+			shippingPrice, err := converter.convert(ctx, calculateShippingPrice(shippingMethod, weight), CurrencyVND)
+			if err != nil {
+				return err
+			}
+			order.ShippingPrice = decimal.NewNullDecimal(shippingPrice)
+			order.TotalPrice.Decimal = order.TotalPrice.Decimal.Add(order.ShippingPrice.Decimal)
 		}
+		order.ExchangeRate = converter.rate
 	}
 
-	order.UserID = orderInput.UserID
-	order.Status = orderInput.Status
-
 	if err = c.Repository.UpdateOrder(ctx, tx, order); err != nil {
 		return err
 	}
 
-	return tx.Commit()
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	c.deleteProductCache(ctx, productIDs...)
+
+	return nil
+}
+
+// moveOrderItemStock moves the stock of an edited order item and returns the warehouse it is fulfilled from. An item which
+// stays on the same product, variant and warehouse only moves the change of its quantity, otherwise the old item is put
+// back to its stock and the new one is taken from the stock of its product or variant in the allocated warehouse
+func (c *Controller) moveOrderItemStock(ctx context.Context, tx *sql.Tx, order models.Order, oldItem models.OrderItem, product models.Product, variant *models.ProductVariant, oi OrderItemInput, stock int, address *AddressOutput) (int, error) {
+	var variantID null.Int
+	if variant != nil {
+		variantID = null.IntFrom(variant.ID)
+	}
+	sale := stockChange{Reason: repositories.StockReasonSale, ReferenceID: order.ID, ActorID: order.UserID}
+
+	sameWarehouse := oi.WarehouseID == 0 || oi.WarehouseID == oldItem.WarehouseID.Int
+	if oldItem.ProductID == product.ID && oldItem.VariantID == variantID && sameWarehouse {
+		// the stock taken by the item is still available to it
+		if stock+oldItem.Quantity < oi.Quantity {
+			return 0, ErrInsufficientQuantity
+		}
+
+		delta := oldItem.Quantity - oi.Quantity
+		if delta == 0 {
+			return oldItem.WarehouseID.Int, nil
+		}
+		if delta > 0 {
+			// the quantity given back is not sold anymore
+			sale.Reason = repositories.StockReasonCancellation
+		}
+		if err := c.restockOrderItem(ctx, tx, oldItem, delta, sale); err != nil {
+			return 0, err
+		}
+
+		return oldItem.WarehouseID.Int, nil
+	}
+
+	// check the product quantity
+	if stock < oi.Quantity {
+		return 0, ErrInsufficientQuantity
+	}
+
+	warehouseID, err := c.allocateOrderItem(ctx, product, variant, oi, address)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = c.restockOrderItem(ctx, tx, oldItem, oldItem.Quantity, stockChange{Reason: repositories.StockReasonCancellation, ReferenceID: order.ID, ActorID: order.UserID}); err != nil {
+		return 0, err
+	}
+
+	sale.WarehouseID = warehouseID
+	if err = c.adjustStock(ctx, tx, product, variant, -oi.Quantity, sale); err != nil {
+		return 0, err
+	}
+
+	return warehouseID, nil
 }
 
 type OrderOutputGraph struct {
-	ID         int
-	UserName   string
-	UserEmail  string
-	Status     string
-	TotalPrice decimal.Decimal
-	CreatedAt  time.Time
-	Items      []OrderItemOutput
+	ID            int
+	UserName      string
+	UserEmail     string
+	Status        string
+	Region        string
+	SubtotalPrice decimal.Decimal
+	TaxPrice      decimal.Decimal
+	ShippingPrice decimal.Decimal
+	TotalPrice    decimal.Decimal
+	Currency      string
+	ExchangeRate  *decimal.Decimal
+	CreatedAt     time.Time
+	Version       int
+	Items         []OrderItemOutput
 }
 
 type OrderFilterCtrl struct {
 	Sorting    []Sorting
 	Pagination Pagination
 	FilterDate FilterDate
+	Status     string
 }
 
 type Pagination struct {
@@ -289,31 +585,7 @@ type FilterDate struct {
 
 // GetOrders retrieves all the orders in db
 func (c *Controller) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]OrderOutputGraph, int64, error) {
-	filterOrderRepo := repositories.OrderFilterRepo{
-		Pagination: repositories.Pagination{
-			Limit: filter.Pagination.Limit,
-			Page:  filter.Pagination.Page,
-		},
-		FilterDate: repositories.FilterDate{
-			StartDate: filter.FilterDate.StartDate,
-			EndDate:   filter.FilterDate.EndDate,
-		},
-	}
-
-	for _, o := range filter.Sorting {
-		var sortOrder string
-		if o.Desc {
-			sortOrder = "desc"
-		} else {
-			sortOrder = "asc"
-		}
-		filterOrderRepo.Sorting = append(filterOrderRepo.Sorting, repositories.Sorting{
-			ColumnName: o.ColumnName,
-			SortOrder:  sortOrder,
-		})
-	}
-
-	orders, count, err := c.Repository.GetOrders(ctx, filterOrderRepo)
+	orders, count, err := c.Repository.GetOrders(ctx, toOrderFilterRepo(filter))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -321,12 +593,22 @@ func (c *Controller) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]O
 	var ordersOutput []OrderOutputGraph
 	for _, o := range orders {
 		order := OrderOutputGraph{
-			ID:         o.ID,
-			UserName:   o.UserName,
-			UserEmail:  o.UserEmail,
-			Status:     o.Status,
-			TotalPrice: o.TotalPrice,
-			CreatedAt:  o.CreatedAt,
+			ID:            o.ID,
+			UserName:      o.UserName,
+			UserEmail:     o.UserEmail,
+			Status:        o.Status,
+			Region:        o.Region,
+			SubtotalPrice: o.SubtotalPrice.Decimal,
+			TaxPrice:      o.TaxPrice.Decimal,
+			ShippingPrice: o.ShippingPrice.Decimal,
+			TotalPrice:    o.TotalPrice,
+			Currency:      o.Currency,
+			CreatedAt:     o.CreatedAt,
+			Version:       o.Version,
+		}
+		if o.ExchangeRate.Valid {
+			exchangeRate := o.ExchangeRate.Decimal
+			order.ExchangeRate = &exchangeRate
 		}
 
 		// remove the {} and split to a slice
@@ -334,6 +616,8 @@ func (c *Controller) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]O
 		productsName := strings.Split(o.ProductName, ",")
 		itemsQuantity := strings.Split(strings.Trim(o.Quantity, "{}"), ",")
 		itemsPrice := strings.Split(strings.Trim(o.ItemPrice, "{}"), ",")
+		itemsTaxRate := strings.Split(strings.Trim(o.ItemTaxRate, "{}"), ",")
+		itemsTaxAmount := strings.Split(strings.Trim(o.ItemTaxAmount, "{}"), ",")
 
 		for index := range itemsID {
 			// convert string to specific values
@@ -349,12 +633,22 @@ func (c *Controller) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]O
 			if err != nil {
 				return nil, 0, err
 			}
+			taxRate, err := decimal.NewFromString(itemsTaxRate[index])
+			if err != nil {
+				return nil, 0, err
+			}
+			taxAmount, err := decimal.NewFromString(itemsTaxAmount[index])
+			if err != nil {
+				return nil, 0, err
+			}
 
 			order.Items = append(order.Items, OrderItemOutput{
 				ID:          id,
 				ProductName: strings.Trim(productsName[index], `{}"`),
 				Quantity:    quantity,
 				Price:       decimal.NewFromFloat(price),
+				TaxRate:     taxRate,
+				TaxAmount:   taxAmount,
 			})
 		}
 
@@ -363,3 +657,33 @@ func (c *Controller) GetOrders(ctx context.Context, filter OrderFilterCtrl) ([]O
 
 	return ordersOutput, count, nil
 }
+
+// toOrderFilterRepo converts the order filter in controller layer to the one in repository layer
+func toOrderFilterRepo(filter OrderFilterCtrl) repositories.OrderFilterRepo {
+	filterOrderRepo := repositories.OrderFilterRepo{
+		Pagination: repositories.Pagination{
+			Limit: filter.Pagination.Limit,
+			Page:  filter.Pagination.Page,
+		},
+		FilterDate: repositories.FilterDate{
+			StartDate: filter.FilterDate.StartDate,
+			EndDate:   filter.FilterDate.EndDate,
+		},
+		Status: filter.Status,
+	}
+
+	for _, o := range filter.Sorting {
+		var sortOrder string
+		if o.Desc {
+			sortOrder = "desc"
+		} else {
+			sortOrder = "asc"
+		}
+		filterOrderRepo.Sorting = append(filterOrderRepo.Sorting, repositories.Sorting{
+			ColumnName: o.ColumnName,
+			SortOrder:  sortOrder,
+		})
+	}
+
+	return filterOrderRepo
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/qthuy2k1/product-management/internal/repositories"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
 )
 
 func Test_OrderController_CreateOrder(t *testing.T) {
@@ -437,3 +438,144 @@ func Test_OrderController_GetOrders(t *testing.T) {
 		})
 	}
 }
+
+// Test UpdateOrder in Controller layer
+func Test_OrderController_UpdateOrder(t *testing.T) {
+	tests := map[string]struct {
+		givenInput  OrderInput
+		lockedOrder models.Order
+		lockErr     error
+		givenItem   *models.OrderItem
+		expOrder    *models.Order
+		expErr      error
+	}{
+		"update order successfully": {
+			givenInput:  OrderInput{UserID: 1, Status: OrderStatusPaid, ExpectedVersion: 3},
+			lockedOrder: models.Order{ID: 1, UserID: 1, Status: OrderStatusPending, Currency: CurrencyVND, Version: 3},
+			expOrder:    &models.Order{ID: 1, UserID: 1, Status: OrderStatusPaid, Currency: CurrencyVND, Version: 3},
+		},
+		"order changed since it was read": {
+			givenInput:  OrderInput{UserID: 1, Status: OrderStatusPaid, ExpectedVersion: 2},
+			lockedOrder: models.Order{ID: 1, UserID: 1, Status: OrderStatusPending, Currency: CurrencyVND, Version: 3},
+			expErr:      ErrOrderVersionConflict,
+		},
+		"order not found": {
+			givenInput: OrderInput{UserID: 1, Status: OrderStatusPaid, ExpectedVersion: 3},
+			lockErr:    repositories.ErrOrderNotFound,
+			expErr:     ErrOrderNotFound,
+		},
+		"item of another order": {
+			givenInput:  OrderInput{UserID: 1, Status: OrderStatusPaid, ExpectedVersion: 3, OrderItem: []OrderItemInput{{ID: 5, ProductID: 1, Quantity: 2}}},
+			lockedOrder: models.Order{ID: 1, UserID: 1, Status: OrderStatusPending, Currency: CurrencyVND, Version: 3},
+			givenItem:   &models.OrderItem{ID: 5, OrderID: 2, ProductID: 1, Quantity: 2},
+			expErr:      ErrOrderItemNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			tx := sql.Tx{}
+			mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+			mockRepo.On("RollbackTx", &tx).Return(nil)
+			mockRepo.On("LockOrder", context.Background(), &tx, 1).Return(tc.lockedOrder, tc.lockErr)
+			if tc.givenItem != nil {
+				mockRepo.On("GetUser", context.Background(), tc.givenInput.UserID).Return(models.User{ID: tc.givenInput.UserID}, nil)
+				mockRepo.On("GetOrderItem", context.Background(), tc.givenItem.ID).Return(*tc.givenItem, nil)
+			}
+			if tc.expOrder != nil {
+				mockRepo.On("GetUser", context.Background(), tc.givenInput.UserID).Return(models.User{ID: tc.givenInput.UserID}, nil)
+				mockRepo.On("UpdateOrder", context.Background(), &tx, *tc.expOrder).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			err := controller.UpdateOrder(context.Background(), 1, tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test moveOrderItemStock in Controller layer
+func Test_OrderController_moveOrderItemStock(t *testing.T) {
+	order := models.Order{ID: 1, UserID: 2}
+	product := models.Product{ID: 1, Quantity: 10}
+	oldItem := models.OrderItem{ID: 5, OrderID: 1, ProductID: 1, WarehouseID: null.IntFrom(4), Quantity: 2}
+	oldVariantItem := models.OrderItem{ID: 5, OrderID: 1, ProductID: 1, VariantID: null.IntFrom(8), Quantity: 2}
+
+	tests := map[string]struct {
+		oldItem      models.OrderItem
+		variant      *models.ProductVariant
+		givenItem    OrderItemInput
+		stock        int
+		expMovements []repositories.StockMovement
+		expWarehouse int
+		expErr       error
+	}{
+		"quantity unchanged": {
+			oldItem:      oldItem,
+			givenItem:    OrderItemInput{ID: 5, ProductID: 1, Quantity: 2},
+			stock:        10,
+			expWarehouse: 4,
+		},
+		"quantity increased": {
+			oldItem:   oldItem,
+			givenItem: OrderItemInput{ID: 5, ProductID: 1, Quantity: 5},
+			stock:     10,
+			expMovements: []repositories.StockMovement{
+				{ProductID: 1, WarehouseID: null.IntFrom(4), Delta: -3, Reason: repositories.StockReasonSale, ReferenceID: null.IntFrom(1), ActorID: null.IntFrom(2)},
+			},
+			expWarehouse: 4,
+		},
+		"quantity decreased": {
+			oldItem:   oldItem,
+			givenItem: OrderItemInput{ID: 5, ProductID: 1, Quantity: 1},
+			stock:     10,
+			expMovements: []repositories.StockMovement{
+				{ProductID: 1, WarehouseID: null.IntFrom(4), Delta: 1, Reason: repositories.StockReasonCancellation, ReferenceID: null.IntFrom(1), ActorID: null.IntFrom(2)},
+			},
+			expWarehouse: 4,
+		},
+		"quantity increased above the stock": {
+			oldItem:   oldItem,
+			givenItem: OrderItemInput{ID: 5, ProductID: 1, Quantity: 5},
+			stock:     2,
+			expErr:    ErrInsufficientQuantity,
+		},
+		"variant changed": {
+			oldItem:   oldVariantItem,
+			variant:   &models.ProductVariant{ID: 9, ProductID: 1, Quantity: 10},
+			givenItem: OrderItemInput{ID: 5, ProductID: 1, VariantID: 9, Quantity: 3},
+			stock:     10,
+			expMovements: []repositories.StockMovement{
+				{ProductID: 1, VariantID: null.IntFrom(8), Delta: 2, Reason: repositories.StockReasonCancellation, ReferenceID: null.IntFrom(1), ActorID: null.IntFrom(2)},
+				{ProductID: 1, VariantID: null.IntFrom(9), Delta: -3, Reason: repositories.StockReasonSale, ReferenceID: null.IntFrom(1), ActorID: null.IntFrom(2)},
+			},
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+
+			tx := sql.Tx{}
+			for _, sm := range tc.expMovements {
+				mockRepo.On("RecordStockMovement", context.Background(), &tx, sm).Return(models.StockMovement{}, nil).Once()
+			}
+
+			warehouseID, err := controller.moveOrderItemStock(context.Background(), &tx, order, tc.oldItem, product, tc.variant, tc.givenItem, tc.stock, nil)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expWarehouse, warehouseID)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+)
+
+// maxProductTags is the number of tags a product can have at most
+const maxProductTags = 20
+
+type AttributeDefinitionInput struct {
+	CategoryID int
+	Name       string
+	// Type is one of text, number or boolean
+	Type string
+	// Unit is the unit of a number attribute such as GB, it is only shown with the values
+	Unit string
+}
+
+type AttributeDefinitionOutput struct {
+	ID         int
+	CategoryID int
+	Name       string
+	Type       string
+	Unit       string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ProductAttributeOutput is the value of an attribute of a product, a number is written without trailing zeros
+// and a boolean as true or false
+type ProductAttributeOutput struct {
+	AttributeID int
+	Name        string
+	Type        string
+	Unit        string
+	Value       string
+}
+
+// AttributeFilterInput matches the products which have the value of the attribute of the name, or a number value between
+// the min and the max which are both included. The value is compared case-insensitively
+type AttributeFilterInput struct {
+	Name  string
+	Value string
+	Min   *decimal.Decimal
+	Max   *decimal.Decimal
+}
+
+// IsValidAttributeType reports whether the type is a type of the attributes of products
+func IsValidAttributeType(attributeType string) bool {
+	switch attributeType {
+	case repositories.AttributeTypeText, repositories.AttributeTypeNumber, repositories.AttributeTypeBoolean:
+		return true
+	}
+	return false
+}
+
+// CreateAttributeDefinition defines an attribute of the products of a category, the name of an attribute is unique in its category
+func (c *Controller) CreateAttributeDefinition(ctx context.Context, adInput AttributeDefinitionInput) (AttributeDefinitionOutput, error) {
+	if !IsValidAttributeType(adInput.Type) {
+		return AttributeDefinitionOutput{}, ErrInvalidAttributeType
+	}
+
+	definitions, err := c.GetAttributeDefinitions(ctx, adInput.CategoryID)
+	if err != nil {
+		return AttributeDefinitionOutput{}, err
+	}
+	for _, d := range definitions {
+		if strings.EqualFold(d.Name, adInput.Name) {
+			return AttributeDefinitionOutput{}, ErrAttributeExists
+		}
+	}
+
+	definition, err := c.Repository.CreateAttributeDefinition(ctx, repositories.AttributeDefinition{
+		CategoryID: adInput.CategoryID,
+		Name:       adInput.Name,
+		Type:       adInput.Type,
+		Unit:       adInput.Unit,
+	})
+	if err != nil {
+		return AttributeDefinitionOutput{}, err
+	}
+
+	return AttributeDefinitionOutput{
+		ID:         definition.ID,
+		CategoryID: definition.CategoryID,
+		Name:       definition.Name,
+		Type:       definition.Type,
+		Unit:       definition.Unit,
+		CreatedAt:  definition.CreatedAt,
+		UpdatedAt:  definition.UpdatedAt,
+	}, nil
+}
+
+// GetAttributeDefinitions retrieves the attributes defined for the products of a category
+func (c *Controller) GetAttributeDefinitions(ctx context.Context, categoryID int) ([]AttributeDefinitionOutput, error) {
+	if _, err := c.Repository.GetProductCategory(ctx, categoryID); err != nil {
+		if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+			return nil, ErrProductCategoryNotFound
+		}
+		return nil, err
+	}
+
+	definitions, err := c.Repository.GetAttributeDefinitions(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []AttributeDefinitionOutput
+	for _, d := range definitions {
+		output = append(output, AttributeDefinitionOutput{
+			ID:         d.ID,
+			CategoryID: d.CategoryID,
+			Name:       d.Name,
+			Type:       d.Type,
+			Unit:       d.Unit,
+			CreatedAt:  d.CreatedAt,
+			UpdatedAt:  d.UpdatedAt,
+		})
+	}
+
+	return output, nil
+}
+
+// getProductAttributeValues validates the values of the attributes of a product by the name of the attribute against
+// the attributes defined for its category, and converts them to the values in repository layer. nil is returned for nil attributes
+func (c *Controller) getProductAttributeValues(ctx context.Context, categoryID int, attributes map[string]string) ([]repositories.ProductAttributeValue, error) {
+	if attributes == nil {
+		return nil, nil
+	}
+
+	var definitions []models.AttributeDefinition
+	if len(attributes) != 0 {
+		var err error
+		if definitions, err = c.Repository.GetAttributeDefinitions(ctx, categoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	return toProductAttributeValues(definitions, attributes)
+}
+
+// toProductAttributeValues validates the values of the attributes by the name of the attribute against the definitions,
+// and converts them to the values in repository layer sorted by attribute. The names are matched case-insensitively
+func toProductAttributeValues(definitions []models.AttributeDefinition, attributes map[string]string) ([]repositories.ProductAttributeValue, error) {
+	values := []repositories.ProductAttributeValue{}
+	given := make(map[int]bool)
+	for name, value := range attributes {
+		index := -1
+		for i, d := range definitions {
+			if strings.EqualFold(d.Name, strings.TrimSpace(name)) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, ErrUnknownAttribute
+		}
+
+		definition := definitions[index]
+		// the same attribute is given twice in a different case
+		if given[definition.ID] {
+			return nil, ErrInvalidAttributeValue
+		}
+		given[definition.ID] = true
+
+		v, err := parseAttributeValue(definition.Type, value)
+		if err != nil {
+			return nil, err
+		}
+		v.AttributeID = definition.ID
+		values = append(values, v)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].AttributeID < values[j].AttributeID
+	})
+
+	return values, nil
+}
+
+// parseAttributeValue parses the value of an attribute of the type. A number is kept with at most 4 decimal places
+// and less than 10^13, a boolean is one of the values strconv.ParseBool accepts
+func parseAttributeValue(attributeType, value string) (repositories.ProductAttributeValue, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return repositories.ProductAttributeValue{}, ErrInvalidAttributeValue
+	}
+
+	switch attributeType {
+	case repositories.AttributeTypeNumber:
+		d, err := decimal.NewFromString(value)
+		if err != nil || !d.Equal(d.Round(4)) || d.Abs().GreaterThanOrEqual(decimal.New(1, 13)) {
+			return repositories.ProductAttributeValue{}, ErrInvalidAttributeValue
+		}
+		return repositories.ProductAttributeValue{Value: d.String(), ValueNumber: decimal.NewNullDecimal(d)}, nil
+	case repositories.AttributeTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return repositories.ProductAttributeValue{}, ErrInvalidAttributeValue
+		}
+		return repositories.ProductAttributeValue{Value: strconv.FormatBool(b)}, nil
+	}
+
+	if len(value) > 255 {
+		return repositories.ProductAttributeValue{}, ErrInvalidAttributeValue
+	}
+	return repositories.ProductAttributeValue{Value: value}, nil
+}
+
+// normalizeTags trims and lowercases the tags of a product and removes the blank and duplicate ones, the tags are sorted.
+// nil is returned for nil tags
+func normalizeTags(tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	normalized := []string{}
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		// the tags are separated by , in the filters and by ; in the CSV files
+		if len(t) > 64 || strings.ContainsAny(t, ",;") {
+			return nil, ErrInvalidTag
+		}
+		seen[t] = true
+		normalized = append(normalized, t)
+	}
+	if len(normalized) > maxProductTags {
+		return nil, ErrTooManyTags
+	}
+	sort.Strings(normalized)
+
+	return normalized, nil
+}
+
+// toAttributeFilters validates the attribute filters of products and converts them to the ones in repository layer.
+// A value which is a number is also compared with the number values and a boolean is compared as true or false
+func toAttributeFilters(filters []AttributeFilterInput) ([]repositories.AttributeFilter, error) {
+	var repoFilters []repositories.AttributeFilter
+	for _, f := range filters {
+		name := strings.TrimSpace(f.Name)
+		value := strings.TrimSpace(f.Value)
+		if name == "" || (value == "" && f.Min == nil && f.Max == nil) {
+			return nil, ErrInvalidAttributeFilter
+		}
+		if f.Min != nil && f.Max != nil && f.Min.GreaterThan(*f.Max) {
+			return nil, ErrInvalidAttributeFilter
+		}
+
+		repoFilter := repositories.AttributeFilter{Name: name, Value: value}
+		if d, err := decimal.NewFromString(value); err == nil {
+			repoFilter.Value = d.String()
+			repoFilter.ValueNumber = decimal.NewNullDecimal(d)
+		} else if b, err := strconv.ParseBool(value); err == nil {
+			repoFilter.Value = strconv.FormatBool(b)
+		}
+		if f.Min != nil {
+			repoFilter.Min = decimal.NewNullDecimal(*f.Min)
+		}
+		if f.Max != nil {
+			repoFilter.Max = decimal.NewNullDecimal(*f.Max)
+		}
+		repoFilters = append(repoFilters, repoFilter)
+	}
+
+	return repoFilters, nil
+}
+
+// getProductSpecs retrieves the tags and the values of the attributes of the products by product ID
+func (c *Controller) getProductSpecs(ctx context.Context, productIDs ...int) (map[int][]string, map[int][]ProductAttributeOutput, error) {
+	tags, err := c.Repository.GetProductTags(ctx, productIDs...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attributes, err := c.Repository.GetProductAttributes(ctx, productIDs...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	productTags := make(map[int][]string)
+	for _, t := range tags {
+		productTags[t.ProductID] = append(productTags[t.ProductID], t.Tag)
+	}
+
+	productAttributes := make(map[int][]ProductAttributeOutput)
+	for _, a := range attributes {
+		productAttributes[a.ProductID] = append(productAttributes[a.ProductID], ProductAttributeOutput{
+			AttributeID: a.AttributeID,
+			Name:        a.Name,
+			Type:        a.Type,
+			Unit:        a.Unit,
+			Value:       a.Value,
+		})
+	}
+
+	return productTags, productAttributes, nil
+}
+
+// parseCSVAttributes parses the Attributes column of a CSV row written as Name=Value pairs separated by ;
+func parseCSVAttributes(s string) (map[string]string, error) {
+	attributes, err := parseVariantOptions(s)
+	if err != nil {
+		return nil, ErrInvalidAttributes
+	}
+	return attributes, nil
+}
+
+// parseCSVTags parses the Tags column of a CSV row written as tags separated by ;
+func parseCSVTags(s string) ([]string, error) {
+	return normalizeTags(strings.Split(s, ";"))
+}
+
+// formatCSVAttributes writes the values of the attributes of a product the way parseCSVAttributes reads them
+func formatCSVAttributes(attributes []ProductAttributeOutput) string {
+	pairs := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", a.Name, a.Value))
+	}
+	return strings.Join(pairs, ";")
+}
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateAttributeDefinition in Controller layer
+func Test_ProductAttributeController_CreateAttributeDefinition(t *testing.T) {
+	tests := map[string]struct {
+		givenInput   AttributeDefinitionInput
+		categoryErr  error
+		existing     []models.AttributeDefinition
+		expRepoInput *repositories.AttributeDefinition
+		expOutput    AttributeDefinitionOutput
+		expErr       error
+	}{
+		"create attribute definition successfully": {
+			givenInput:   AttributeDefinitionInput{CategoryID: 3, Name: "Storage", Type: "number", Unit: "GB"},
+			existing:     []models.AttributeDefinition{{ID: 1, CategoryID: 3, Name: "RAM", Type: "number", Unit: "GB"}},
+			expRepoInput: &repositories.AttributeDefinition{CategoryID: 3, Name: "Storage", Type: "number", Unit: "GB"},
+			expOutput:    AttributeDefinitionOutput{ID: 2, CategoryID: 3, Name: "Storage", Type: "number", Unit: "GB"},
+		},
+		"name already exists in another case": {
+			givenInput: AttributeDefinitionInput{CategoryID: 3, Name: "ram", Type: "number"},
+			existing:   []models.AttributeDefinition{{ID: 1, CategoryID: 3, Name: "RAM", Type: "number", Unit: "GB"}},
+			expErr:     ErrAttributeExists,
+		},
+		"category not found": {
+			givenInput:  AttributeDefinitionInput{CategoryID: 9, Name: "RAM", Type: "number"},
+			categoryErr: repositories.ErrProductCategoryNotFound,
+			expErr:      ErrProductCategoryNotFound,
+		},
+		"invalid type": {
+			givenInput: AttributeDefinitionInput{CategoryID: 3, Name: "RAM", Type: "date"},
+			expErr:     ErrInvalidAttributeType,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.givenInput.Type != "date" {
+				mockRepo.On("GetProductCategory", context.Background(), tc.givenInput.CategoryID).Return(models.ProductCategory{ID: tc.givenInput.CategoryID}, tc.categoryErr)
+				if tc.categoryErr == nil {
+					mockRepo.On("GetAttributeDefinitions", context.Background(), tc.givenInput.CategoryID).Return(tc.existing, nil)
+				}
+			}
+			if tc.expRepoInput != nil {
+				mockRepo.On("CreateAttributeDefinition", context.Background(), *tc.expRepoInput).Return(models.AttributeDefinition{
+					ID: 2, CategoryID: tc.expRepoInput.CategoryID, Name: tc.expRepoInput.Name, Type: tc.expRepoInput.Type, Unit: tc.expRepoInput.Unit,
+				}, nil)
+			}
+
+			output, err := controller.CreateAttributeDefinition(context.Background(), tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test toProductAttributeValues in Controller layer
+func Test_ProductAttributeController_toProductAttributeValues(t *testing.T) {
+	definitions := []models.AttributeDefinition{
+		{ID: 1, CategoryID: 3, Name: "RAM", Type: repositories.AttributeTypeNumber, Unit: "GB"},
+		{ID: 2, CategoryID: 3, Name: "Color", Type: repositories.AttributeTypeText},
+		{ID: 3, CategoryID: 3, Name: "Dual SIM", Type: repositories.AttributeTypeBoolean},
+	}
+
+	tests := map[string]struct {
+		givenAttributes map[string]string
+		expOutput       []repositories.ProductAttributeValue
+		expErr          error
+	}{
+		"convert attribute values successfully": {
+			givenAttributes: map[string]string{"dual sim": "1", "Color": " Midnight ", "ram": "8.50"},
+			expOutput: []repositories.ProductAttributeValue{
+				{AttributeID: 1, Value: "8.5", ValueNumber: decimal.NewNullDecimal(decimal.RequireFromString("8.50"))},
+				{AttributeID: 2, Value: "Midnight"},
+				{AttributeID: 3, Value: "true"},
+			},
+		},
+		"no attributes": {
+			givenAttributes: map[string]string{},
+			expOutput:       []repositories.ProductAttributeValue{},
+		},
+		"unknown attribute": {
+			givenAttributes: map[string]string{"Weight": "200"},
+			expErr:          ErrUnknownAttribute,
+		},
+		"same attribute given twice": {
+			givenAttributes: map[string]string{"RAM": "8", "ram": "16"},
+			expErr:          ErrInvalidAttributeValue,
+		},
+		"number with too many decimal places": {
+			givenAttributes: map[string]string{"RAM": "8.12345"},
+			expErr:          ErrInvalidAttributeValue,
+		},
+		"invalid boolean": {
+			givenAttributes: map[string]string{"Dual SIM": "maybe"},
+			expErr:          ErrInvalidAttributeValue,
+		},
+		"blank value": {
+			givenAttributes: map[string]string{"Color": " "},
+			expErr:          ErrInvalidAttributeValue,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			output, err := toProductAttributeValues(definitions, tc.givenAttributes)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test normalizeTags in Controller layer
+func Test_ProductAttributeController_normalizeTags(t *testing.T) {
+	tooMany := make([]string, 0, maxProductTags+1)
+	for i := 0; i <= maxProductTags; i++ {
+		tooMany = append(tooMany, string(rune('a'+i)))
+	}
+
+	tests := map[string]struct {
+		givenTags []string
+		expOutput []string
+		expErr    error
+	}{
+		"normalize tags successfully": {
+			givenTags: []string{" Sale ", "5G", "sale", ""},
+			expOutput: []string{"5g", "sale"},
+		},
+		"nil tags": {
+			givenTags: nil,
+			expOutput: nil,
+		},
+		"tag with separator": {
+			givenTags: []string{"new;hot"},
+			expErr:    ErrInvalidTag,
+		},
+		"too many tags": {
+			givenTags: tooMany,
+			expErr:    ErrTooManyTags,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			output, err := normalizeTags(tc.givenTags)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test toAttributeFilters in Controller layer
+func Test_ProductAttributeController_toAttributeFilters(t *testing.T) {
+	min, max := decimal.NewFromInt(8), decimal.NewFromInt(4)
+
+	tests := map[string]struct {
+		givenFilters []AttributeFilterInput
+		expOutput    []repositories.AttributeFilter
+		expErr       error
+	}{
+		"convert filters successfully": {
+			givenFilters: []AttributeFilterInput{
+				{Name: " RAM ", Value: "8.0"},
+				{Name: "Dual SIM", Value: "TRUE"},
+				{Name: "Color", Value: "Midnight"},
+				{Name: "Storage", Min: &min},
+			},
+			expOutput: []repositories.AttributeFilter{
+				{Name: "RAM", Value: "8", ValueNumber: decimal.NewNullDecimal(decimal.RequireFromString("8.0"))},
+				{Name: "Dual SIM", Value: "true"},
+				{Name: "Color", Value: "Midnight"},
+				{Name: "Storage", Min: decimal.NewNullDecimal(min)},
+			},
+		},
+		"min greater than max": {
+			givenFilters: []AttributeFilterInput{{Name: "RAM", Min: &min, Max: &max}},
+			expErr:       ErrInvalidAttributeFilter,
+		},
+		"no value": {
+			givenFilters: []AttributeFilterInput{{Name: "RAM"}},
+			expErr:       ErrInvalidAttributeFilter,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			output, err := toAttributeFilters(tc.givenFilters)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
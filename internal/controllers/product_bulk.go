@@ -0,0 +1,358 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+// operations of the bulk product APIs
+const (
+	BulkOperationPrice    = "price"
+	BulkOperationCategory = "category"
+	BulkOperationStock    = "stock"
+	BulkOperationArchive  = "archive"
+)
+
+const (
+	// maxBulkProducts is the number of products a bulk operation can change at most
+	maxBulkProducts = 1000
+	// bulkChunkSize is the number of products loaded together by a bulk operation which is not transactional
+	bulkChunkSize = 100
+)
+
+// BulkSelection selects the products of a bulk operation by ID or by filter, only one of them is given.
+// The filter selects the active products only
+type BulkSelection struct {
+	IDs    []int
+	Filter *ProductCtrlFilter
+	// Transactional changes all the products in one transaction, none of them is changed if one fails.
+	// Otherwise the products are changed in chunks, each on its own, and the ones which fail are reported
+	Transactional bool
+}
+
+type BulkPriceInput struct {
+	BulkSelection
+	// Amount is added to the price of each product in its currency, or Percent of the price is added to it.
+	// Only one of them is given, the new prices are rounded to 2 decimal places
+	Amount  *decimal.Decimal
+	Percent *decimal.Decimal
+}
+
+type BulkCategoryInput struct {
+	BulkSelection
+	CategoryID int
+}
+
+type BulkStockInput struct {
+	BulkSelection
+	// Quantity is the new quantity of each product, or Delta is added to it. Only one of them is given
+	Quantity *int
+	Delta    *int
+	// ActorID is the user the changes of the stock are recorded for
+	ActorID int
+}
+
+// BulkItemResult is the result of a bulk operation on a product, Err is why the product has not been changed
+type BulkItemResult struct {
+	ProductID int
+	Err       error
+}
+
+// BulkReport is the result of a bulk operation on each of the selected products, in the order they were selected
+type BulkReport struct {
+	Operation     string
+	Transactional bool
+	Succeeded     int
+	Failed        int
+	Items         []BulkItemResult
+}
+
+// bulkApplyFunc changes a product in the transaction, the product is locked until the end of it
+type bulkApplyFunc func(ctx context.Context, tx *sql.Tx, product models.Product) error
+
+// BulkChangePrice adds an amount or a percentage to the prices of the selected products, the new prices are added
+// to their price history
+func (c *Controller) BulkChangePrice(ctx context.Context, input BulkPriceInput) (BulkReport, error) {
+	if (input.Amount == nil) == (input.Percent == nil) ||
+		(input.Amount != nil && input.Amount.IsZero()) ||
+		(input.Percent != nil && (input.Percent.IsZero() || input.Percent.LessThanOrEqual(decimal.NewFromInt(-100)))) {
+		return BulkReport{}, ErrInvalidBulkPriceChange
+	}
+
+	return c.runBulkOperation(ctx, BulkOperationPrice, input.BulkSelection, func(ctx context.Context, tx *sql.Tx, product models.Product) error {
+		var price decimal.Decimal
+		if input.Amount != nil {
+			price = product.Price.Add(*input.Amount)
+		} else {
+			price = product.Price.Mul(decimal.NewFromInt(100).Add(*input.Percent)).Div(decimal.NewFromInt(100))
+		}
+		price = price.Round(2)
+		if !price.IsPositive() || price.GreaterThanOrEqual(decimal.New(1, 15)) {
+			return ErrInvalidPrice
+		}
+		if price.Equal(product.Price) {
+			return nil
+		}
+
+		product.Price = price
+		if err := c.Repository.UpdateProductColumns(ctx, tx, product, []string{models.ProductColumns.Price}); err != nil {
+			return err
+		}
+		return c.recordPriceChange(ctx, tx, product)
+	})
+}
+
+// BulkChangeCategory moves the selected products to a category. The values of the attributes of their previous
+// category are kept but hidden
+func (c *Controller) BulkChangeCategory(ctx context.Context, input BulkCategoryInput) (BulkReport, error) {
+	if _, err := c.Repository.GetProductCategory(ctx, input.CategoryID); err != nil {
+		if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+			return BulkReport{}, ErrProductCategoryNotFound
+		}
+		return BulkReport{}, err
+	}
+
+	return c.runBulkOperation(ctx, BulkOperationCategory, input.BulkSelection, func(ctx context.Context, tx *sql.Tx, product models.Product) error {
+		if product.CategoryID == input.CategoryID {
+			return nil
+		}
+
+		product.CategoryID = input.CategoryID
+		return c.Repository.UpdateProductColumns(ctx, tx, product, []string{models.ProductColumns.CategoryID})
+	})
+}
+
+// BulkUpdateStock sets or adjusts the quantity of the selected products, the changes are recorded on the stock ledger
+// as adjustments by the actor. The products which have variants cannot be changed, the stock is kept by variant
+func (c *Controller) BulkUpdateStock(ctx context.Context, input BulkStockInput) (BulkReport, error) {
+	if (input.Quantity == nil) == (input.Delta == nil) ||
+		(input.Quantity != nil && *input.Quantity < 0) ||
+		(input.Delta != nil && *input.Delta == 0) {
+		return BulkReport{}, ErrInvalidBulkStockChange
+	}
+
+	// check actor exists
+	if _, err := c.Repository.GetUser(ctx, input.ActorID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return BulkReport{}, ErrUserNotFound
+		}
+		return BulkReport{}, err
+	}
+
+	return c.runBulkOperation(ctx, BulkOperationStock, input.BulkSelection, func(ctx context.Context, tx *sql.Tx, product models.Product) error {
+		count, err := c.Repository.CountProductVariants(ctx, product.ID)
+		if err != nil {
+			return err
+		}
+		if count != 0 {
+			return ErrVariantRequired
+		}
+
+		var delta int
+		if input.Delta != nil {
+			delta = *input.Delta
+		} else {
+			delta = *input.Quantity - product.Quantity
+		}
+		if delta == 0 {
+			return nil
+		}
+
+		return c.recordStockMovement(ctx, tx, product.ID, null.Int{}, delta, stockChange{Reason: repositories.StockReasonAdjustment, ActorID: input.ActorID})
+	})
+}
+
+// BulkArchiveProducts archives the selected products, they can be restored one by one
+func (c *Controller) BulkArchiveProducts(ctx context.Context, selection BulkSelection) (BulkReport, error) {
+	return c.runBulkOperation(ctx, BulkOperationArchive, selection, func(ctx context.Context, tx *sql.Tx, product models.Product) error {
+		if err := c.Repository.ArchiveProduct(ctx, tx, product.ID); err != nil {
+			if errors.Is(err, repositories.ErrProductNotFound) {
+				return ErrProductNotFound
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// runBulkOperation applies the change to each of the selected products and reports the result of each of them.
+// The cache of the changed products is removed once the changes are committed so that no stale product is cached
+// in between
+func (c *Controller) runBulkOperation(ctx context.Context, operation string, selection BulkSelection, apply bulkApplyFunc) (BulkReport, error) {
+	ids, err := c.getBulkProductIDs(ctx, selection)
+	if err != nil {
+		return BulkReport{}, err
+	}
+
+	var items []BulkItemResult
+	if selection.Transactional {
+		items, err = c.applyBulkInTx(ctx, ids, apply)
+	} else {
+		items, err = c.applyBulkInChunks(ctx, ids, apply)
+	}
+	if err != nil {
+		return BulkReport{}, err
+	}
+
+	report := BulkReport{Operation: operation, Transactional: selection.Transactional, Items: items}
+	for _, item := range items {
+		if item.Err != nil {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+	}
+
+	return report, nil
+}
+
+// getBulkProductIDs returns the IDs of the products selected by a bulk operation, the duplicate IDs are removed
+func (c *Controller) getBulkProductIDs(ctx context.Context, selection BulkSelection) ([]int, error) {
+	if (len(selection.IDs) == 0) == (selection.Filter == nil) {
+		return nil, ErrInvalidBulkSelection
+	}
+
+	if selection.Filter != nil {
+		filter := *selection.Filter
+		filter.Archived = false
+		repoFilter, err := productFilterToRepo(filter)
+		if err != nil {
+			return nil, err
+		}
+		// one more product is retrieved to know whether there are too many of them
+		repoFilter.Limit = maxBulkProducts + 1
+
+		ids, err := c.Repository.GetProductIDs(ctx, repoFilter)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) > maxBulkProducts {
+			return nil, ErrTooManyBulkProducts
+		}
+		return ids, nil
+	}
+
+	seen := make(map[int]bool)
+	var ids []int
+	for _, id := range selection.IDs {
+		if id <= 0 {
+			return nil, ErrInvalidBulkSelection
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) > maxBulkProducts {
+		return nil, ErrTooManyBulkProducts
+	}
+
+	return ids, nil
+}
+
+// applyBulkItem locks the product of the ID in the transaction and applies the change to it so that the change is made
+// to the current product, an archived product cannot be changed
+func (c *Controller) applyBulkItem(ctx context.Context, tx *sql.Tx, id int, apply bulkApplyFunc) error {
+	product, err := c.Repository.LockProduct(ctx, tx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+	if product.DeletedAt.Valid {
+		return ErrProductNotFound
+	}
+
+	return apply(ctx, tx, product)
+}
+
+// applyBulkInTx applies the change to all the products in one transaction. The transaction stops at the first product
+// which fails, the products before it are rolled back and the ones after it are not changed
+func (c *Controller) applyBulkInTx(ctx context.Context, ids []int, apply bulkApplyFunc) ([]BulkItemResult, error) {
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	items := make([]BulkItemResult, 0, len(ids))
+	failed := false
+	for _, id := range ids {
+		item := BulkItemResult{ProductID: id}
+		if !failed {
+			item.Err = c.applyBulkItem(ctx, tx, id, apply)
+			failed = item.Err != nil
+		}
+		items = append(items, item)
+	}
+
+	if failed {
+		for i := range items {
+			if items[i].Err == nil {
+				items[i].Err = ErrBulkRolledBack
+			}
+		}
+		return items, nil
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return nil, err
+	}
+
+	if err = c.Repository.DeleteProductCache(ctx, ids...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// applyBulkInChunks applies the change to each product in its own transaction, the cache of the changed products is
+// removed by chunk. A product which fails does not stop the others
+func (c *Controller) applyBulkInChunks(ctx context.Context, ids []int, apply bulkApplyFunc) ([]BulkItemResult, error) {
+	items := make([]BulkItemResult, 0, len(ids))
+	for start := 0; start < len(ids); start += bulkChunkSize {
+		end := start + bulkChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+
+		var changed []int
+		for _, id := range chunk {
+			item := BulkItemResult{ProductID: id, Err: c.applyBulkItemInTx(ctx, id, apply)}
+			if item.Err == nil {
+				changed = append(changed, id)
+			}
+			items = append(items, item)
+		}
+
+		if err := c.Repository.DeleteProductCache(ctx, changed...); err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// applyBulkItemInTx applies the change to the product of the ID in its own transaction
+func (c *Controller) applyBulkItemInTx(ctx context.Context, id int, apply bulkApplyFunc) error {
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	if err = c.applyBulkItem(ctx, tx, id, apply); err != nil {
+		return err
+	}
+
+	return c.Repository.CommitTx(tx)
+}
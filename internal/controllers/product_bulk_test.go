@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test BulkChangePrice in Controller layer
+func Test_ProductBulkController_BulkChangePrice(t *testing.T) {
+	percent, amount, zero := decimal.NewFromInt(10), decimal.NewFromInt(-1500), decimal.Zero
+	products := []models.Product{
+		{ID: 1, Name: "iPhone 14", Price: decimal.NewFromInt(1000), Currency: "USD"},
+		{ID: 2, Name: "Macbook", Price: decimal.NewFromInt(2000), Currency: "USD"},
+		{ID: 3, Name: "Old phone", Price: decimal.NewFromInt(100), Currency: "USD", DeletedAt: null.TimeFrom(time.Now())},
+	}
+
+	tests := map[string]struct {
+		givenInput  BulkPriceInput
+		expPrices   map[int]decimal.Decimal
+		expCommits  int
+		expRollback bool
+		expOutput   BulkReport
+		expErr      error
+	}{
+		"change prices by percent in chunks successfully": {
+			givenInput: BulkPriceInput{BulkSelection: BulkSelection{IDs: []int{1, 2, 3, 1}}, Percent: &percent},
+			expPrices:  map[int]decimal.Decimal{1: decimal.NewFromInt(1100), 2: decimal.NewFromInt(2200)},
+			expCommits: 2,
+			expOutput: BulkReport{
+				Operation: BulkOperationPrice,
+				Succeeded: 2,
+				Failed:    1,
+				Items:     []BulkItemResult{{ProductID: 1}, {ProductID: 2}, {ProductID: 3, Err: ErrProductNotFound}},
+			},
+		},
+		"transaction rolled back when a price would not be positive": {
+			givenInput:  BulkPriceInput{BulkSelection: BulkSelection{IDs: []int{2, 1}, Transactional: true}, Amount: &amount},
+			expPrices:   map[int]decimal.Decimal{2: decimal.NewFromInt(500)},
+			expRollback: true,
+			expOutput: BulkReport{
+				Operation:     BulkOperationPrice,
+				Transactional: true,
+				Failed:        2,
+				Items:         []BulkItemResult{{ProductID: 2, Err: ErrBulkRolledBack}, {ProductID: 1, Err: ErrInvalidPrice}},
+			},
+		},
+		"zero percent": {
+			givenInput: BulkPriceInput{BulkSelection: BulkSelection{IDs: []int{1}}, Percent: &zero},
+			expErr:     ErrInvalidBulkPriceChange,
+		},
+		"both amount and percent": {
+			givenInput: BulkPriceInput{BulkSelection: BulkSelection{IDs: []int{1}}, Amount: &amount, Percent: &percent},
+			expErr:     ErrInvalidBulkPriceChange,
+		},
+		"both ids and filter": {
+			givenInput: BulkPriceInput{BulkSelection: BulkSelection{IDs: []int{1}, Filter: &ProductCtrlFilter{CategoryID: 1}}, Percent: &percent},
+			expErr:     ErrInvalidBulkSelection,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.expErr == nil {
+				tx := sql.Tx{}
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				// each product is read again and locked in the transaction it is changed in
+				for _, item := range tc.expOutput.Items {
+					for _, p := range products {
+						if p.ID == item.ProductID {
+							mockRepo.On("LockProduct", context.Background(), &tx, p.ID).Return(p, nil).Once()
+						}
+					}
+				}
+				for id, price := range tc.expPrices {
+					id, price := id, price
+					matchProduct := mock.MatchedBy(func(p models.Product) bool { return p.ID == id && p.Price.Equal(price) })
+					mockRepo.On("UpdateProductColumns", context.Background(), &tx, matchProduct, []string{models.ProductColumns.Price}).Return(nil).Once()
+					mockRepo.On("SetProductPrice", context.Background(), &tx, mock.MatchedBy(func(pp repositories.ProductPrice) bool {
+						return pp.ProductID == id && pp.Price.Equal(price)
+					})).Return(models.ProductPrice{}, nil).Once()
+				}
+				if tc.expCommits > 0 {
+					mockRepo.On("CommitTx", &tx).Return(nil).Times(tc.expCommits)
+					mockRepo.On("DeleteProductCache", context.Background(), 1, 2).Return(nil)
+				}
+			}
+
+			output, err := controller.BulkChangePrice(context.Background(), tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test BulkUpdateStock in Controller layer
+func Test_ProductBulkController_BulkUpdateStock(t *testing.T) {
+	quantity, negative := 10, -1
+
+	tests := map[string]struct {
+		givenInput BulkStockInput
+		variants   int64
+		expDelta   int
+		expOutput  BulkReport
+		expErr     error
+	}{
+		"set stock of products matching the filter successfully": {
+			givenInput: BulkStockInput{BulkSelection: BulkSelection{Filter: &ProductCtrlFilter{CategoryID: 3, Archived: true}, Transactional: true}, Quantity: &quantity, ActorID: 2},
+			expDelta:   6,
+			expOutput: BulkReport{
+				Operation:     BulkOperationStock,
+				Transactional: true,
+				Succeeded:     1,
+				Items:         []BulkItemResult{{ProductID: 1}},
+			},
+		},
+		"product with variants": {
+			givenInput: BulkStockInput{BulkSelection: BulkSelection{Filter: &ProductCtrlFilter{CategoryID: 3}}, Quantity: &quantity, ActorID: 2},
+			variants:   2,
+			expOutput: BulkReport{
+				Operation: BulkOperationStock,
+				Failed:    1,
+				Items:     []BulkItemResult{{ProductID: 1, Err: ErrVariantRequired}},
+			},
+		},
+		"negative quantity": {
+			givenInput: BulkStockInput{BulkSelection: BulkSelection{IDs: []int{1}}, Quantity: &negative, ActorID: 2},
+			expErr:     ErrInvalidBulkStockChange,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.expErr == nil {
+				mockRepo.On("GetUser", context.Background(), 2).Return(models.User{ID: 2}, nil)
+				// the archived products are never selected by a filter
+				mockRepo.On("GetProductIDs", context.Background(), repositories.ProductRepoFilter{CategoryID: 3, Limit: maxBulkProducts + 1}).Return([]int{1}, nil)
+				mockRepo.On("CountProductVariants", context.Background(), 1).Return(tc.variants, nil)
+
+				tx := sql.Tx{}
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				// the quantity is set from the quantity of the locked product
+				mockRepo.On("LockProduct", context.Background(), &tx, 1).Return(models.Product{ID: 1, Quantity: 4}, nil)
+				if tc.expDelta != 0 {
+					mockRepo.On("RecordStockMovement", context.Background(), &tx, repositories.StockMovement{
+						ProductID: 1,
+						Delta:     tc.expDelta,
+						Reason:    repositories.StockReasonAdjustment,
+						ActorID:   null.IntFrom(2),
+					}).Return(models.StockMovement{}, nil)
+					mockRepo.On("CommitTx", &tx).Return(nil)
+					mockRepo.On("DeleteProductCache", context.Background(), 1).Return(nil)
+				} else {
+					mockRepo.On("DeleteProductCache", context.Background()).Return(nil)
+				}
+			}
+
+			output, err := controller.BulkUpdateStock(context.Background(), tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+)
+
+// productSKUPattern is the format of the sku of a product, it is used in the urls and as the key of the CSV files
+var productSKUPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// validateProductSKU checks the format of the sku of a product, an empty sku is generated by db
+func validateProductSKU(sku string) error {
+	if sku == "" {
+		return nil
+	}
+	if len(sku) > maxSKULength {
+		return ErrSKUTooLong
+	}
+	if !productSKUPattern.MatchString(sku) {
+		return ErrInvalidSKU
+	}
+
+	return nil
+}
+
+// GetProductBySKU retrieves a product like GetProduct by its sku
+func (c *Controller) GetProductBySKU(ctx context.Context, sku string, currency string) (ProductOutputGraph, error) {
+	product, err := c.Repository.GetProductBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ProductOutputGraph{}, ErrProductNotFound
+		}
+		return ProductOutputGraph{}, err
+	}
+
+	return c.GetProduct(ctx, product.ID, currency)
+}
+
+// GetProductBySlug retrieves a product like GetProduct by its current slug or by an old slug of it. The current slug
+// is in the output, it differs from the slug given if the product has been renamed since
+func (c *Controller) GetProductBySlug(ctx context.Context, slug string, currency string) (ProductOutputGraph, error) {
+	product, err := c.Repository.GetProductBySlug(ctx, slug)
+	if err == nil {
+		return c.GetProduct(ctx, product.ID, currency)
+	}
+	if !errors.Is(err, repositories.ErrProductNotFound) {
+		return ProductOutputGraph{}, err
+	}
+
+	redirect, err := c.Repository.GetProductSlugRedirect(ctx, slug)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductSlugRedirectNotFound) {
+			return ProductOutputGraph{}, ErrProductNotFound
+		}
+		return ProductOutputGraph{}, err
+	}
+
+	return c.GetProduct(ctx, redirect.ProductID, currency)
+}
@@ -0,0 +1,246 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockGetProductRepo mocks the calls of GetProduct for the product 1 of the author 2 in the category 3
+func mockGetProductRepo(mockRepo *repositories.MockIRepository, product models.Product) {
+	mockRepo.On("GetProduct", context.Background(), 1).Return(product, nil)
+	mockRepo.On("GetUser", context.Background(), 2).Return(models.User{ID: 2, Name: "qthuy"}, nil)
+	mockRepo.On("GetProductCategory", context.Background(), 3).Return(models.ProductCategory{ID: 3, Name: "Smartphone"}, nil)
+	mockRepo.On("GetProductImages", context.Background(), 1).Return([]models.ProductImage{}, nil)
+	mockRepo.On("GetWarehouseStocks", context.Background(), 1).Return([]models.WarehouseStock{}, nil)
+	mockRepo.On("GetProductTags", context.Background(), 1).Return([]models.ProductTag{}, nil)
+	mockRepo.On("GetProductAttributes", context.Background(), 1).Return([]repositories.ProductAttributeOutput{}, nil)
+}
+
+// Test GetProductBySlug in Controller layer
+func Test_ProductController_GetProductBySlug(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	product := models.Product{ID: 1, Sku: "IP14-128", Slug: "iphone-14", Name: "iPhone 14", Price: decimal.NewFromInt(1500), AuthorID: 2, CategoryID: 3, Currency: "USD", Version: 2, CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	tests := map[string]struct {
+		givenSlug   string
+		currentErr  error
+		redirect    models.ProductSlugRedirect
+		redirectErr error
+		expErr      error
+	}{
+		"current slug": {
+			givenSlug: "iphone-14",
+		},
+		"old slug of a renamed product": {
+			givenSlug:  "iphone-14-pro",
+			currentErr: repositories.ErrProductNotFound,
+			redirect:   models.ProductSlugRedirect{ID: 4, ProductID: 1, Slug: "iphone-14-pro"},
+		},
+		"unknown slug": {
+			givenSlug:   "iphone-15",
+			currentErr:  repositories.ErrProductNotFound,
+			redirectErr: repositories.ErrProductSlugRedirectNotFound,
+			expErr:      ErrProductNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProductBySlug", context.Background(), tc.givenSlug).Return(product, tc.currentErr)
+			if tc.currentErr != nil {
+				mockRepo.On("GetProductSlugRedirect", context.Background(), tc.givenSlug).Return(tc.redirect, tc.redirectErr)
+			}
+			if tc.expErr == nil {
+				mockGetProductRepo(mockRepo, product)
+			}
+
+			output, err := controller.GetProductBySlug(context.Background(), tc.givenSlug, "")
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, output.ID)
+				assert.Equal(t, "IP14-128", output.SKU)
+				assert.Equal(t, "iphone-14", output.Slug)
+			}
+		})
+	}
+}
+
+// Test GetProductBySKU in Controller layer
+func Test_ProductController_GetProductBySKU(t *testing.T) {
+	product := models.Product{ID: 1, Sku: "IP14-128", Slug: "iphone-14", Name: "iPhone 14", AuthorID: 2, CategoryID: 3, Currency: "USD"}
+
+	tests := map[string]struct {
+		givenSKU string
+		err      error
+		expErr   error
+	}{
+		"sku of a product": {
+			givenSKU: "IP14-128",
+		},
+		"unknown sku": {
+			givenSKU: "IP15-128",
+			err:      repositories.ErrProductNotFound,
+			expErr:   ErrProductNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProductBySKU", context.Background(), tc.givenSKU).Return(product, tc.err)
+			if tc.expErr == nil {
+				mockGetProductRepo(mockRepo, product)
+			}
+
+			output, err := controller.GetProductBySKU(context.Background(), tc.givenSKU, "")
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "IP14-128", output.SKU)
+			}
+		})
+	}
+}
+
+// Test the sku given to CreateProduct in Controller layer
+func Test_ProductController_CreateProductSKU(t *testing.T) {
+	tests := map[string]struct {
+		givenSKU  string
+		expLookup bool
+		lookupErr error
+		expErr    error
+	}{
+		"sku already exists": {
+			givenSKU:  "IP14-128",
+			expLookup: true,
+			expErr:    ErrSKUExists,
+		},
+		"sku with a space": {
+			givenSKU: "IP14 128",
+			expErr:   ErrInvalidSKU,
+		},
+		"sku too long": {
+			givenSKU: "IP14-128-BLACK-DUAL-SIM-VIETNAM-WARRANTY-EDITION-WITH-CHARGER-AND-CASE",
+			expErr:   ErrSKUTooLong,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.expLookup {
+				mockRepo.On("GetProductBySKU", context.Background(), tc.givenSKU).Return(models.Product{ID: 1, Sku: tc.givenSKU}, tc.lookupErr)
+			}
+
+			err := controller.CreateProduct(context.Background(), ProductInput{SKU: tc.givenSKU, Name: "iPhone 14", AuthorID: 2, CategoryName: "Smartphone"})
+			assert.EqualError(t, err, tc.expErr.Error())
+		})
+	}
+}
+
+// Test the sku validation of validateAndConvertProductCSV in Controller layer, a row with an invalid sku is rejected
+// before it reaches the import query
+func Test_ProductController_validateAndConvertProductCSVSKU(t *testing.T) {
+	tests := map[string]struct {
+		givenSKU string
+		expErr   error
+	}{
+		"sku with a quote": {
+			givenSKU: "IP14'); DROP TABLE products; --",
+			expErr:   ErrInvalidSKU,
+		},
+		"sku too long": {
+			givenSKU: "IP14-128-BLACK-DUAL-SIM-VIETNAM-WARRANTY-EDITION-WITH-CHARGER-AND-CASE",
+			expErr:   ErrSKUTooLong,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+
+			_, err := controller.validateAndConvertProductCSV(context.Background(), ProductCSVInput{SKU: tc.givenSKU, Name: "iPhone 14", Price: "1000", Quantity: "1"}, 2, 3)
+			assert.EqualError(t, err, tc.expErr.Error())
+		})
+	}
+}
+
+// Test checkCSVProductName in Controller layer, a row with a sku cannot take the name of a product of another sku
+func Test_ProductController_checkCSVProductName(t *testing.T) {
+	tests := map[string]struct {
+		givenSKU   string
+		givenSKUs  map[string]string
+		expGetName bool
+		product    models.Product
+		productErr error
+		expErr     error
+	}{
+		"row without sku": {},
+		"name of no product": {
+			givenSKU:   "IP14-128",
+			expGetName: true,
+			productErr: repositories.ErrProductNotFound,
+		},
+		"name of the product of the sku": {
+			givenSKU:   "IP14-128",
+			expGetName: true,
+			product:    models.Product{ID: 1, Name: "iPhone 14", Sku: "IP14-128"},
+		},
+		"name of a product of another sku": {
+			givenSKU:   "IP14-256",
+			expGetName: true,
+			product:    models.Product{ID: 1, Name: "iPhone 14", Sku: "IP14-128"},
+			expErr:     ErrNameExists,
+		},
+		"name of a previous row of another sku": {
+			givenSKU:  "IP14-256",
+			givenSKUs: map[string]string{"iPhone 14": "IP14-128"},
+			expErr:    ErrNameExists,
+		},
+		"product cannot be retrieved": {
+			givenSKU:   "IP14-128",
+			expGetName: true,
+			productErr: errors.New("something went wrong"),
+			expErr:     errors.New("something went wrong"),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+			if tc.expGetName {
+				mockRepo.On("GetProductByName", context.Background(), "iPhone 14").Return(tc.product, tc.productErr)
+			}
+
+			skus := tc.givenSKUs
+			if skus == nil {
+				skus = make(map[string]string)
+			}
+			err := controller.checkCSVProductName(context.Background(), repositories.Product{SKU: tc.givenSKU, Name: "iPhone 14"}, skus)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
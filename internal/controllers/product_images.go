@@ -0,0 +1,371 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	// the gif decoder is registered for image.Decode
+	_ "image/gif"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/imaging"
+)
+
+const (
+	// MaxImageSize is the maximum size in bytes of an uploaded image
+	MaxImageSize = 5 << 20
+	// maxImagePixels limits the dimensions of an image so that decoding it does not exhaust the memory
+	maxImagePixels = 40_000_000
+)
+
+// imageExtensions maps the supported content types of the images to the extension of their files
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type imageThumbnailSize struct {
+	Name string
+	Size int
+}
+
+// imageThumbnailSizes are the sizes of the thumbnails generated for each image, an image is scaled down
+// to fit in a square of the size
+var imageThumbnailSizes = []imageThumbnailSize{
+	{Name: "small", Size: 150},
+	{Name: "medium", Size: 300},
+	{Name: "large", Size: 600},
+}
+
+type ImageThumbnailOutput struct {
+	Size string
+	URL  string
+}
+
+type ProductImageOutput struct {
+	ID          int
+	ProductID   int
+	Position    int
+	URL         string
+	ContentType string
+	Size        int
+	Width       int
+	Height      int
+	Thumbnails  []ImageThumbnailOutput
+	CreatedAt   time.Time
+}
+
+// UploadProductImage validates an image, stores it with its thumbnails and adds it to the end of the gallery of the product
+func (c *Controller) UploadProductImage(ctx context.Context, productID int, file io.Reader, size int64) (ProductImageOutput, error) {
+	// check product exists
+	if _, err := c.Repository.GetProduct(ctx, productID); err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ProductImageOutput{}, ErrProductNotFound
+		}
+		return ProductImageOutput{}, err
+	}
+
+	if size > MaxImageSize {
+		return ProductImageOutput{}, ErrImageTooLarge
+	}
+
+	// the size given by the client is not trusted, at most one byte more than the limit is read
+	data, err := io.ReadAll(io.LimitReader(file, MaxImageSize+1))
+	if err != nil {
+		return ProductImageOutput{}, err
+	}
+	if len(data) > MaxImageSize {
+		return ProductImageOutput{}, ErrImageTooLarge
+	}
+
+	// the content type is detected from the content, not from the file name or the header
+	contentType := http.DetectContentType(data)
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return ProductImageOutput{}, ErrUnsupportedImageType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return ProductImageOutput{}, ErrInvalidImage
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return ProductImageOutput{}, ErrImageDimensionsTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return ProductImageOutput{}, ErrInvalidImage
+	}
+
+	gallery, err := c.Repository.GetProductImages(ctx, productID)
+	if err != nil {
+		return ProductImageOutput{}, err
+	}
+
+	key, err := newImageKey(productID, ext)
+	if err != nil {
+		return ProductImageOutput{}, err
+	}
+
+	// the stored files are removed if the image cannot be saved
+	var savedKeys []string
+	cleanUp := func() {
+		for _, k := range savedKeys {
+			if err := c.Storage.Delete(ctx, k); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+
+	if err := c.Storage.Save(ctx, key, bytes.NewReader(data)); err != nil {
+		return ProductImageOutput{}, err
+	}
+	savedKeys = append(savedKeys, key)
+
+	for _, ts := range imageThumbnailSizes {
+		thumbnail, err := encodeThumbnail(imaging.Fit(img, ts.Size), contentType)
+		if err != nil {
+			cleanUp()
+			return ProductImageOutput{}, err
+		}
+
+		thumbnailKey := imageThumbnailKey(key, contentType, ts.Name)
+		if err := c.Storage.Save(ctx, thumbnailKey, thumbnail); err != nil {
+			cleanUp()
+			return ProductImageOutput{}, err
+		}
+		savedKeys = append(savedKeys, thumbnailKey)
+	}
+
+	productImage, err := c.Repository.CreateProductImage(ctx, repositories.ProductImage{
+		ProductID:   productID,
+		Position:    len(gallery),
+		StorageKey:  key,
+		ContentType: contentType,
+		Size:        len(data),
+		Width:       config.Width,
+		Height:      config.Height,
+	})
+	if err != nil {
+		cleanUp()
+		return ProductImageOutput{}, err
+	}
+
+	return c.toProductImageOutput(productImage), nil
+}
+
+// GetProductImages retrieves the gallery of a product in order
+func (c *Controller) GetProductImages(ctx context.Context, productID int) ([]ProductImageOutput, error) {
+	// check product exists
+	if _, err := c.Repository.GetProduct(ctx, productID); err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	galleries, err := c.getProductGalleries(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return galleries[productID], nil
+}
+
+// ReorderProductImages orders the gallery of a product as the image IDs, which must be all the images of the product
+func (c *Controller) ReorderProductImages(ctx context.Context, productID int, imageIDs []int) error {
+	// check product exists
+	if _, err := c.Repository.GetProduct(ctx, productID); err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	gallery, err := c.Repository.GetProductImages(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	if len(imageIDs) != len(gallery) {
+		return ErrInvalidImageOrder
+	}
+	remaining := make(map[int]bool)
+	for _, i := range gallery {
+		remaining[i.ID] = true
+	}
+	for _, id := range imageIDs {
+		if !remaining[id] {
+			return ErrInvalidImageOrder
+		}
+		// an image cannot be given twice
+		delete(remaining, id)
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	if err = c.Repository.SetProductImagePositions(ctx, tx, productID, imageIDs); err != nil {
+		return err
+	}
+
+	return c.Repository.CommitTx(tx)
+}
+
+// DeleteProductImage removes an image from the gallery of a product and deletes its files
+func (c *Controller) DeleteProductImage(ctx context.Context, productID, imageID int) error {
+	productImage, err := c.Repository.GetProductImage(ctx, imageID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductImageNotFound) {
+			return ErrProductImageNotFound
+		}
+		return err
+	}
+	if productImage.ProductID != productID {
+		return ErrProductImageNotFound
+	}
+
+	gallery, err := c.Repository.GetProductImages(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// the images after the deleted one move up
+	var imageIDs []int
+	for _, i := range gallery {
+		if i.ID != imageID {
+			imageIDs = append(imageIDs, i.ID)
+		}
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	if err = c.Repository.DeleteProductImage(ctx, tx, imageID); err != nil {
+		return err
+	}
+
+	if err = c.Repository.SetProductImagePositions(ctx, tx, productID, imageIDs); err != nil {
+		return err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	c.deleteProductImageFiles(ctx, productImage)
+
+	return nil
+}
+
+// deleteProductImageFiles deletes the files of an image and its thumbnails. The image is already removed from the gallery,
+// a file left behind is only logged
+func (c *Controller) deleteProductImageFiles(ctx context.Context, productImage models.ProductImage) {
+	keys := []string{productImage.StorageKey}
+	for _, ts := range imageThumbnailSizes {
+		keys = append(keys, imageThumbnailKey(productImage.StorageKey, productImage.ContentType, ts.Name))
+	}
+	for _, k := range keys {
+		if err := c.Storage.Delete(ctx, k); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// getProductGalleries retrieves the images of the products, grouped by product in order
+func (c *Controller) getProductGalleries(ctx context.Context, productIDs ...int) (map[int][]ProductImageOutput, error) {
+	images, err := c.Repository.GetProductImages(ctx, productIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	galleries := make(map[int][]ProductImageOutput)
+	for _, i := range images {
+		galleries[i.ProductID] = append(galleries[i.ProductID], c.toProductImageOutput(i))
+	}
+
+	return galleries, nil
+}
+
+// toProductImageOutput converts an image to the output with the URLs of the image and its thumbnails
+func (c *Controller) toProductImageOutput(productImage models.ProductImage) ProductImageOutput {
+	output := ProductImageOutput{
+		ID:          productImage.ID,
+		ProductID:   productImage.ProductID,
+		Position:    productImage.Position,
+		URL:         c.Storage.URL(productImage.StorageKey),
+		ContentType: productImage.ContentType,
+		Size:        productImage.Size,
+		Width:       productImage.Width,
+		Height:      productImage.Height,
+		CreatedAt:   productImage.CreatedAt,
+	}
+	for _, ts := range imageThumbnailSizes {
+		output.Thumbnails = append(output.Thumbnails, ImageThumbnailOutput{
+			Size: ts.Name,
+			URL:  c.Storage.URL(imageThumbnailKey(productImage.StorageKey, productImage.ContentType, ts.Name)),
+		})
+	}
+
+	return output
+}
+
+// newImageKey returns a random storage key of an image of a product, such as products/1/3f2a9c.jpg
+func newImageKey(productID int, ext string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("products/%d/%s%s", productID, hex.EncodeToString(b), ext), nil
+}
+
+// imageThumbnailKey returns the storage key of a thumbnail of an image, such as products/1/3f2a9c_small.jpg.
+// The thumbnails of a jpeg image are jpeg, the others are png
+func imageThumbnailKey(key, contentType, size string) string {
+	ext := ".png"
+	if contentType == "image/jpeg" {
+		ext = ".jpg"
+	}
+
+	return fmt.Sprintf("%s_%s%s", strings.TrimSuffix(key, imageExtensions[contentType]), size, ext)
+}
+
+// encodeThumbnail encodes a thumbnail as jpeg if the image is jpeg, or as png so that the transparency is kept
+func encodeThumbnail(img image.Image, contentType string) (io.Reader, error) {
+	buf := new(bytes.Buffer)
+	if contentType == "image/jpeg" {
+		if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+
+	if err := png.Encode(buf, img); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
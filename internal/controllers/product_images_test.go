@@ -0,0 +1,292 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestImage encodes a width x height image in the format
+func newTestImage(t *testing.T, format string, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	var err error
+	if format == "jpeg" {
+		err = jpeg.Encode(buf, img, nil)
+	} else {
+		err = png.Encode(buf, img)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+// Test UploadProductImage in Controller layer
+func Test_ProductImageController_UploadProductImage(t *testing.T) {
+	jpegImage := newTestImage(t, "jpeg", 800, 400)
+	pngImage := newTestImage(t, "png", 100, 50)
+
+	tests := map[string]struct {
+		data       []byte
+		size       int64
+		productErr error
+		gallery    []models.ProductImage
+		createErr  error
+		expCreate  bool
+		expOutput  repositories.ProductImage
+		expThumbs  []string
+		expErr     error
+	}{
+		"upload jpeg image successfully": {
+			data:      jpegImage,
+			expCreate: true,
+			expOutput: repositories.ProductImage{ProductID: 1, Position: 0, ContentType: "image/jpeg", Size: len(jpegImage), Width: 800, Height: 400},
+			expThumbs: []string{"_small.jpg", "_medium.jpg", "_large.jpg"},
+		},
+		"upload png image to the end of the gallery": {
+			data:      pngImage,
+			gallery:   []models.ProductImage{{ID: 1, ProductID: 1}, {ID: 2, ProductID: 1, Position: 1}},
+			expCreate: true,
+			expOutput: repositories.ProductImage{ProductID: 1, Position: 2, ContentType: "image/png", Size: len(pngImage), Width: 100, Height: 50},
+			expThumbs: []string{"_small.png", "_medium.png", "_large.png"},
+		},
+		"product not found": {
+			data:       pngImage,
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"image too large by the given size": {
+			data:   pngImage,
+			size:   MaxImageSize + 1,
+			expErr: ErrImageTooLarge,
+		},
+		"image too large by the content": {
+			data:   make([]byte, MaxImageSize+1),
+			expErr: ErrImageTooLarge,
+		},
+		"unsupported image type": {
+			data:   []byte("name,price\niphone,100\n"),
+			expErr: ErrUnsupportedImageType,
+		},
+		"corrupted image": {
+			data:   pngImage[:100],
+			expErr: ErrInvalidImage,
+		},
+		"files are removed if the image cannot be saved": {
+			data:      pngImage,
+			expCreate: true,
+			expOutput: repositories.ProductImage{ProductID: 1, Position: 0, ContentType: "image/png", Size: len(pngImage), Width: 100, Height: 50},
+			createErr: errors.New("db error"),
+			expErr:    errors.New("db error"),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			dir := t.TempDir()
+			controller := &Controller{Repository: mockRepo, Storage: storage.NewLocalStorage(dir, "/uploads")}
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(models.Product{ID: 1}, tc.productErr)
+			var savedKey string
+			if tc.expCreate {
+				mockRepo.On("GetProductImages", context.Background(), 1).Return(tc.gallery, nil)
+				mockRepo.On("CreateProductImage", context.Background(), mock.MatchedBy(func(i repositories.ProductImage) bool {
+					savedKey = i.StorageKey
+					i.StorageKey = ""
+					return i == tc.expOutput
+				})).Return(func(_ context.Context, i repositories.ProductImage) models.ProductImage {
+					return models.ProductImage{ID: 3, ProductID: i.ProductID, Position: i.Position, StorageKey: i.StorageKey, ContentType: i.ContentType, Size: i.Size, Width: i.Width, Height: i.Height}
+				}, tc.createErr)
+			}
+
+			size := tc.size
+			if size == 0 {
+				size = int64(len(tc.data))
+			}
+			output, err := controller.UploadProductImage(context.Background(), 1, bytes.NewReader(tc.data), size)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+
+				// nothing is left in the storage
+				files, _ := filepath.Glob(filepath.Join(dir, "products", "1", "*"))
+				assert.Empty(t, files)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(savedKey, "products/1/"))
+			assert.Equal(t, "/uploads/"+savedKey, output.URL)
+			assert.Equal(t, tc.expOutput.Position, output.Position)
+
+			stored, err := os.ReadFile(filepath.Join(dir, savedKey))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.data, stored)
+
+			assert.Len(t, output.Thumbnails, len(tc.expThumbs))
+			for i, ts := range imageThumbnailSizes {
+				thumbnailKey := strings.TrimSuffix(savedKey, filepath.Ext(savedKey)) + tc.expThumbs[i]
+				assert.Equal(t, ImageThumbnailOutput{Size: ts.Name, URL: "/uploads/" + thumbnailKey}, output.Thumbnails[i])
+
+				f, err := os.Open(filepath.Join(dir, thumbnailKey))
+				assert.NoError(t, err)
+				config, _, err := image.DecodeConfig(f)
+				f.Close()
+				assert.NoError(t, err)
+
+				// the thumbnail fits in the size and keeps the aspect ratio, a small image is not scaled up
+				assert.LessOrEqual(t, config.Width, ts.Size)
+				assert.LessOrEqual(t, config.Width, tc.expOutput.Width)
+				assert.Equal(t, config.Width, 2*config.Height)
+			}
+		})
+	}
+}
+
+// Test ReorderProductImages in Controller layer
+func Test_ProductImageController_ReorderProductImages(t *testing.T) {
+	gallery := []models.ProductImage{{ID: 1, ProductID: 1}, {ID: 2, ProductID: 1, Position: 1}, {ID: 3, ProductID: 1, Position: 2}}
+
+	tests := map[string]struct {
+		imageIDs   []int
+		productErr error
+		expSet     bool
+		expErr     error
+	}{
+		"reorder images successfully": {
+			imageIDs: []int{3, 1, 2},
+			expSet:   true,
+		},
+		"product not found": {
+			imageIDs:   []int{3, 1, 2},
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"missing image": {
+			imageIDs: []int{3, 1},
+			expErr:   ErrInvalidImageOrder,
+		},
+		"image of another product": {
+			imageIDs: []int{3, 1, 4},
+			expErr:   ErrInvalidImageOrder,
+		},
+		"duplicate image": {
+			imageIDs: []int{3, 1, 1},
+			expErr:   ErrInvalidImageOrder,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(models.Product{ID: 1}, tc.productErr)
+			if tc.productErr == nil {
+				mockRepo.On("GetProductImages", context.Background(), 1).Return(gallery, nil)
+			}
+			if tc.expSet {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("SetProductImagePositions", context.Background(), &tx, 1, tc.imageIDs).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			err := controller.ReorderProductImages(context.Background(), 1, tc.imageIDs)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test DeleteProductImage in Controller layer
+func Test_ProductImageController_DeleteProductImage(t *testing.T) {
+	tests := map[string]struct {
+		productID int
+		image     models.ProductImage
+		imageErr  error
+		expDelete bool
+		expErr    error
+	}{
+		"delete image successfully": {
+			productID: 1,
+			image:     models.ProductImage{ID: 2, ProductID: 1, Position: 1, StorageKey: "products/1/abc.jpg", ContentType: "image/jpeg"},
+			expDelete: true,
+		},
+		"image not found": {
+			productID: 1,
+			imageErr:  repositories.ErrProductImageNotFound,
+			expErr:    ErrProductImageNotFound,
+		},
+		"image of another product": {
+			productID: 5,
+			image:     models.ProductImage{ID: 2, ProductID: 1, Position: 1, StorageKey: "products/1/abc.jpg", ContentType: "image/jpeg"},
+			expErr:    ErrProductImageNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			dir := t.TempDir()
+			controller := &Controller{Repository: mockRepo, Storage: storage.NewLocalStorage(dir, "/uploads")}
+			tx := sql.Tx{}
+
+			// the files of the image are stored
+			keys := []string{"products/1/abc.jpg", "products/1/abc_small.jpg", "products/1/abc_medium.jpg", "products/1/abc_large.jpg"}
+			for _, k := range keys {
+				assert.NoError(t, controller.Storage.Save(context.Background(), k, strings.NewReader("image")))
+			}
+
+			mockRepo.On("GetProductImage", context.Background(), 2).Return(tc.image, tc.imageErr)
+			if tc.expDelete {
+				mockRepo.On("GetProductImages", context.Background(), 1).Return([]models.ProductImage{{ID: 1, ProductID: 1}, tc.image, {ID: 3, ProductID: 1, Position: 2}}, nil)
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("DeleteProductImage", context.Background(), &tx, 2).Return(nil)
+				// the images after the deleted one move up
+				mockRepo.On("SetProductImagePositions", context.Background(), &tx, 1, []int{1, 3}).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			err := controller.DeleteProductImage(context.Background(), tc.productID, 2)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, k := range keys {
+				_, err := os.Stat(filepath.Join(dir, k))
+				assert.Equal(t, tc.expDelete, os.IsNotExist(err))
+			}
+		})
+	}
+}
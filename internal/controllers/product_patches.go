@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+)
+
+// ProductPatch is a partial update of a product, only the fields which are not nil are changed
+type ProductPatch struct {
+	ID           int
+	Name         *string
+	Description  *string
+	Price        *decimal.Decimal
+	Quantity     *int
+	AuthorID     *int
+	CategoryName *string
+	Weight       *decimal.Decimal
+	Currency     *string
+	// SKU cannot be changed, a patch with another sku is rejected
+	SKU *string
+	// ReorderThreshold of 0 disables the low stock alerts of the product
+	ReorderThreshold *int
+	// Tags replace all the tags of the product if not nil, an empty list removes them
+	Tags []string
+	// Attributes are merged into the values of the attributes of the product by the name of the attribute,
+	// a nil value removes the value of the attribute
+	Attributes map[string]*string
+	// ExpectedVersion is the version of the product the patch is based on
+	ExpectedVersion int
+}
+
+// PatchProduct changes the given fields of a product and keeps the others, the fields are validated one by one.
+// The patch is rejected if the product has been updated since the version expected by it. The new version of the product
+// is returned and the cached product is updated to match
+func (c *Controller) PatchProduct(ctx context.Context, patch ProductPatch) (int, error) {
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the product is locked so that it is not updated by anyone else between the check of the version and the update
+	product, err := c.Repository.LockProduct(ctx, tx, patch.ID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return 0, ErrProductNotFound
+		}
+		return 0, err
+	}
+	// an archived product must be restored before it is updated
+	if product.DeletedAt.Valid {
+		return 0, ErrProductNotFound
+	}
+	if product.Version != patch.ExpectedVersion {
+		return 0, ErrProductVersionConflict
+	}
+
+	currentPrice, currentCurrency := product.Price, product.Currency
+	columns, err := c.applyProductPatch(ctx, &product, patch)
+	if err != nil {
+		return 0, err
+	}
+
+	tags, err := normalizeTags(patch.Tags)
+	if err != nil {
+		return 0, err
+	}
+
+	var attributes []repositories.ProductAttributeValue
+	if patch.Attributes != nil {
+		// the merged values are validated against the attributes of the new category if it is changed
+		merged, err := c.mergeProductAttributes(ctx, product.ID, patch.Attributes)
+		if err != nil {
+			return 0, err
+		}
+		if attributes, err = c.getProductAttributeValues(ctx, product.CategoryID, merged); err != nil {
+			return 0, err
+		}
+	}
+
+	// the product is updated even if only its tags or attributes are changed so that its version is bumped
+	if err = c.Repository.UpdateProductColumns(ctx, tx, product, columns); err != nil {
+		return 0, err
+	}
+
+	// a changed price is added to the price history of the product
+	if !product.Price.Equal(currentPrice) || product.Currency != currentCurrency {
+		if err = c.recordPriceChange(ctx, tx, product); err != nil {
+			return 0, err
+		}
+	}
+
+	// the new quantity is recorded as an adjustment of the stock by the author
+	if patch.Quantity != nil && *patch.Quantity != product.Quantity {
+		if err = c.adjustStock(ctx, tx, product, nil, *patch.Quantity-product.Quantity, stockChange{Reason: repositories.StockReasonAdjustment, ActorID: product.AuthorID}); err != nil {
+			return 0, err
+		}
+	}
+
+	if tags != nil {
+		if err = c.Repository.SetProductTags(ctx, tx, product.ID, tags); err != nil {
+			return 0, err
+		}
+	}
+
+	if attributes != nil {
+		if err = c.Repository.SetProductAttributes(ctx, tx, product.ID, attributes); err != nil {
+			return 0, err
+		}
+	}
+
+	// the product is read again as its quantity and version are changed in db
+	if product, err = c.Repository.LockProduct(ctx, tx, product.ID); err != nil {
+		return 0, err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return 0, err
+	}
+
+	// the cached product is removed rather than replaced as a concurrent update may have been committed since
+	c.deleteProductCache(ctx, product.ID)
+
+	return product.Version, nil
+}
+
+// applyProductPatch sets the fields of the patch which are given on the product, the columns of the changed fields are returned.
+// The format of the fields is validated in the handler layer, the references and the values in db are validated here.
+// The quantity is not set as it only changes by the movements of the stock ledger
+func (c *Controller) applyProductPatch(ctx context.Context, product *models.Product, patch ProductPatch) ([]string, error) {
+	var columns []string
+
+	if patch.SKU != nil && *patch.SKU != product.Sku {
+		return nil, ErrSKUImmutable
+	}
+
+	if patch.Name != nil {
+		product.Name = strings.TrimSpace(*patch.Name)
+		columns = append(columns, models.ProductColumns.Name)
+	}
+
+	if patch.Description != nil {
+		product.Description = strings.TrimSpace(*patch.Description)
+		columns = append(columns, models.ProductColumns.Description)
+	}
+
+	if patch.Price != nil {
+		product.Price = *patch.Price
+		columns = append(columns, models.ProductColumns.Price)
+	}
+
+	if patch.AuthorID != nil {
+		// check user exists
+		if _, err := c.Repository.GetUser(ctx, *patch.AuthorID); err != nil {
+			if errors.Is(err, repositories.ErrUserNotFound) {
+				return nil, ErrUserNotFound
+			}
+			return nil, err
+		}
+		product.AuthorID = *patch.AuthorID
+		columns = append(columns, models.ProductColumns.AuthorID)
+	}
+
+	if patch.CategoryName != nil {
+		// check product category exists
+		pCate, err := c.Repository.GetProductCategoryByName(ctx, strings.TrimSpace(*patch.CategoryName))
+		if err != nil {
+			if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+				return nil, ErrProductCategoryNotFound
+			}
+			return nil, err
+		}
+		product.CategoryID = pCate.ID
+		columns = append(columns, models.ProductColumns.CategoryID)
+	}
+
+	if patch.Weight != nil {
+		product.Weight = *patch.Weight
+		columns = append(columns, models.ProductColumns.Weight)
+	}
+
+	if patch.Currency != nil {
+		if !IsSupportedCurrency(*patch.Currency) {
+			return nil, ErrInvalidCurrency
+		}
+		product.Currency = *patch.Currency
+		columns = append(columns, models.ProductColumns.Currency)
+	}
+
+	if patch.ReorderThreshold != nil {
+		if *patch.ReorderThreshold < 0 {
+			return nil, ErrInvalidReorderThreshold
+		}
+		product.ReorderThreshold = *patch.ReorderThreshold
+		columns = append(columns, models.ProductColumns.ReorderThreshold)
+	}
+
+	return columns, nil
+}
+
+// mergeProductAttributes merges the values of the attributes in the patch into the current values of the attributes
+// of a product by the name of the attribute, case-insensitively. A nil value removes the value of the attribute
+func (c *Controller) mergeProductAttributes(ctx context.Context, productID int, patch map[string]*string) (map[string]string, error) {
+	current, err := c.Repository.GetProductAttributes(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]string)
+	for _, a := range current {
+		merged[a.Name] = a.Value
+	}
+
+	given := make(map[string]bool)
+	for name, value := range patch {
+		// the same attribute is given twice in a different case
+		if given[strings.ToLower(strings.TrimSpace(name))] {
+			return nil, ErrInvalidAttributeValue
+		}
+		given[strings.ToLower(strings.TrimSpace(name))] = true
+
+		for n := range merged {
+			if strings.EqualFold(n, strings.TrimSpace(name)) {
+				delete(merged, n)
+			}
+		}
+		if value != nil {
+			merged[strings.TrimSpace(name)] = *value
+		}
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test PatchProduct in Controller layer
+func Test_ProductController_PatchProduct(t *testing.T) {
+	name, price, quantity, category := " Phone X ", decimal.RequireFromString("120.5"), 7, "Unknown"
+	size := "L"
+	current := models.Product{ID: 1, Name: "Phone", Description: "A phone", Price: decimal.NewFromInt(100), Quantity: 5, AuthorID: 2, CategoryID: 3, Currency: "USD", Version: 3}
+
+	type mockPatchRepo struct {
+		columns      []string
+		product      models.Product
+		expPrice     bool
+		expStock     int
+		expTags      []string
+		expAttrs     []repositories.ProductAttributeValue
+		afterVersion int
+		cacheErr     error
+	}
+	tests := map[string]struct {
+		givenPatch    ProductPatch
+		mockPatchRepo *mockPatchRepo
+		expCategory   bool
+		expVersion    int
+		expErr        error
+	}{
+		"patch name and price": {
+			givenPatch: ProductPatch{ID: 1, Name: &name, Price: &price, ExpectedVersion: 3},
+			mockPatchRepo: &mockPatchRepo{
+				columns:      []string{models.ProductColumns.Name, models.ProductColumns.Price},
+				product:      models.Product{ID: 1, Name: "Phone X", Description: "A phone", Price: price, Quantity: 5, AuthorID: 2, CategoryID: 3, Currency: "USD", Version: 3},
+				expPrice:     true,
+				afterVersion: 4,
+			},
+			expVersion: 4,
+		},
+		"patch quantity, tags and attributes": {
+			givenPatch: ProductPatch{ID: 1, Quantity: &quantity, Tags: []string{" New ", "sale", "new"}, Attributes: map[string]*string{"color": nil, "size": &size}, ExpectedVersion: 3},
+			mockPatchRepo: &mockPatchRepo{
+				product:      current,
+				expStock:     2,
+				expTags:      []string{"new", "sale"},
+				expAttrs:     []repositories.ProductAttributeValue{{AttributeID: 6, Value: "L"}},
+				afterVersion: 4,
+			},
+			expVersion: 4,
+		},
+		"patch applied even if the cache is not removed": {
+			givenPatch: ProductPatch{ID: 1, Name: &name, Price: &price, ExpectedVersion: 3},
+			mockPatchRepo: &mockPatchRepo{
+				columns:      []string{models.ProductColumns.Name, models.ProductColumns.Price},
+				product:      models.Product{ID: 1, Name: "Phone X", Description: "A phone", Price: price, Quantity: 5, AuthorID: 2, CategoryID: 3, Currency: "USD", Version: 3},
+				expPrice:     true,
+				afterVersion: 4,
+				cacheErr:     errors.New("redis: connection refused"),
+			},
+			expVersion: 4,
+		},
+		"category not found": {
+			givenPatch:  ProductPatch{ID: 1, CategoryName: &category, ExpectedVersion: 3},
+			expCategory: true,
+			expErr:      ErrProductCategoryNotFound,
+		},
+		"version conflict": {
+			givenPatch: ProductPatch{ID: 1, Name: &name, ExpectedVersion: 2},
+			expErr:     ErrProductVersionConflict,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			tx := sql.Tx{}
+			mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+			mockRepo.On("RollbackTx", &tx).Return(nil)
+			mockRepo.On("LockProduct", context.Background(), &tx, 1).Return(current, nil).Once()
+			if tc.expCategory {
+				mockRepo.On("GetProductCategoryByName", context.Background(), category).Return(models.ProductCategory{}, repositories.ErrProductCategoryNotFound)
+			}
+
+			if tc.mockPatchRepo != nil {
+				if tc.givenPatch.Attributes != nil {
+					mockRepo.On("GetProductAttributes", context.Background(), 1).Return([]repositories.ProductAttributeOutput{
+						{ProductID: 1, AttributeID: 5, Name: "Color", Type: repositories.AttributeTypeText, Value: "red"},
+						{ProductID: 1, AttributeID: 6, Name: "Size", Type: repositories.AttributeTypeText, Value: "M"},
+					}, nil)
+					mockRepo.On("GetAttributeDefinitions", context.Background(), 3).Return([]models.AttributeDefinition{
+						{ID: 5, CategoryID: 3, Name: "Color", Type: repositories.AttributeTypeText},
+						{ID: 6, CategoryID: 3, Name: "Size", Type: repositories.AttributeTypeText},
+					}, nil)
+					mockRepo.On("SetProductAttributes", context.Background(), &tx, 1, tc.mockPatchRepo.expAttrs).Return(nil)
+				}
+				mockRepo.On("UpdateProductColumns", context.Background(), &tx, tc.mockPatchRepo.product, tc.mockPatchRepo.columns).Return(nil)
+				if tc.mockPatchRepo.expPrice {
+					mockRepo.On("SetProductPrice", context.Background(), &tx, mock.MatchedBy(func(pp repositories.ProductPrice) bool {
+						return pp.ProductID == 1 && pp.Price.Equal(price) && pp.Currency == "USD" && pp.AppliedAt.Valid
+					})).Return(models.ProductPrice{}, nil)
+				}
+				if tc.mockPatchRepo.expStock != 0 {
+					mockRepo.On("RecordStockMovement", context.Background(), &tx, repositories.StockMovement{
+						ProductID: 1,
+						Delta:     tc.mockPatchRepo.expStock,
+						Reason:    repositories.StockReasonAdjustment,
+						ActorID:   null.IntFrom(2),
+					}).Return(models.StockMovement{}, nil)
+				}
+				if tc.mockPatchRepo.expTags != nil {
+					mockRepo.On("SetProductTags", context.Background(), &tx, 1, tc.mockPatchRepo.expTags).Return(nil)
+				}
+
+				after := tc.mockPatchRepo.product
+				after.Version = tc.mockPatchRepo.afterVersion
+				mockRepo.On("LockProduct", context.Background(), &tx, 1).Return(after, nil).Once()
+				mockRepo.On("CommitTx", &tx).Return(nil)
+				mockRepo.On("DeleteProductCache", context.Background(), 1).Return(tc.mockPatchRepo.cacheErr)
+			}
+
+			version, err := controller.PatchProduct(context.Background(), tc.givenPatch)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expVersion, version)
+			}
+		})
+	}
+}
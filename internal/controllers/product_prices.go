@@ -0,0 +1,285 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+type ProductPriceInput struct {
+	ProductID     int
+	Price         decimal.Decimal
+	Currency      string
+	EffectiveFrom time.Time
+}
+
+type ProductPriceOutput struct {
+	ID            int
+	ProductID     int
+	Price         decimal.Decimal
+	Currency      string
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	// Scheduled reports whether the price has not been set on the product yet
+	Scheduled bool
+	CreatedAt time.Time
+}
+
+// SchedulePriceChange schedules a new price of a product from a time in the future, the price is set on the product
+// by the background job once the time has come. The currency of the product is kept if no currency is given
+func (c *Controller) SchedulePriceChange(ctx context.Context, ppInput ProductPriceInput) (ProductPriceOutput, error) {
+	product, err := c.Repository.GetProduct(ctx, ppInput.ProductID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ProductPriceOutput{}, ErrProductNotFound
+		}
+		return ProductPriceOutput{}, err
+	}
+	if product.DeletedAt.Valid {
+		return ProductPriceOutput{}, ErrProductNotFound
+	}
+
+	if !ppInput.Price.IsPositive() {
+		return ProductPriceOutput{}, ErrInvalidPrice
+	}
+	if !ppInput.EffectiveFrom.After(time.Now()) {
+		return ProductPriceOutput{}, ErrPriceChangeNotInFuture
+	}
+
+	currency := product.Currency
+	if ppInput.Currency != "" {
+		if !IsSupportedCurrency(ppInput.Currency) {
+			return ProductPriceOutput{}, ErrInvalidCurrency
+		}
+		currency = ppInput.Currency
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return ProductPriceOutput{}, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	productPrice, err := c.Repository.SetProductPrice(ctx, tx, repositories.ProductPrice{
+		ProductID:     product.ID,
+		Price:         ppInput.Price,
+		Currency:      currency,
+		EffectiveFrom: ppInput.EffectiveFrom,
+	})
+	if err != nil {
+		return ProductPriceOutput{}, err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return ProductPriceOutput{}, err
+	}
+
+	return toProductPriceOutput(productPrice), nil
+}
+
+// GetProductPriceHistory retrieves the prices of a product sorted by effective time, the scheduled prices included
+func (c *Controller) GetProductPriceHistory(ctx context.Context, productID int) ([]ProductPriceOutput, error) {
+	// check product exists
+	if _, err := c.Repository.GetProduct(ctx, productID); err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	productPrices, err := c.Repository.GetProductPrices(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ppOutput []ProductPriceOutput
+	for _, pp := range productPrices {
+		ppOutput = append(ppOutput, toProductPriceOutput(pp))
+	}
+
+	return ppOutput, nil
+}
+
+// GetProductPriceAt retrieves the price of a product which is in effect at the given time
+func (c *Controller) GetProductPriceAt(ctx context.Context, productID int, at time.Time) (ProductPriceOutput, error) {
+	// check product exists
+	if _, err := c.Repository.GetProduct(ctx, productID); err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ProductPriceOutput{}, ErrProductNotFound
+		}
+		return ProductPriceOutput{}, err
+	}
+
+	productPrice, err := c.Repository.GetEffectiveProductPrice(ctx, productID, at)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductPriceNotFound) {
+			return ProductPriceOutput{}, ErrProductPriceNotFound
+		}
+		return ProductPriceOutput{}, err
+	}
+
+	return toProductPriceOutput(productPrice), nil
+}
+
+// CancelScheduledPrice removes a scheduled price of a product which is not in effect yet,
+// the price before it stays in effect until the next price
+func (c *Controller) CancelScheduledPrice(ctx context.Context, productID, priceID int) error {
+	productPrice, err := c.Repository.GetProductPrice(ctx, priceID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductPriceNotFound) {
+			return ErrProductPriceNotFound
+		}
+		return err
+	}
+	if productPrice.ProductID != productID {
+		return ErrProductPriceNotFound
+	}
+	if productPrice.AppliedAt.Valid || !productPrice.EffectiveFrom.After(time.Now()) {
+		return ErrPriceNotScheduled
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	if err = c.Repository.DeleteProductPrice(ctx, tx, priceID); err != nil {
+		if errors.Is(err, repositories.ErrProductPriceNotFound) {
+			return ErrProductPriceNotFound
+		}
+		return err
+	}
+
+	return c.Repository.CommitTx(tx)
+}
+
+// ApplyScheduledPrices sets the scheduled prices which have come into effect on their products, only the latest
+// of them is set on each product and only if no price has been set directly since. It returns the number of products
+// whose price has been changed
+func (c *Controller) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	productPrices, err := c.Repository.GetDueProductPrices(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	// the prices are sorted by product and effective time, the last one of each product is in effect
+	latest := make(map[int]models.ProductPrice)
+	var productIDs []int
+	for _, pp := range productPrices {
+		if _, ok := latest[pp.ProductID]; !ok {
+			productIDs = append(productIDs, pp.ProductID)
+		}
+		latest[pp.ProductID] = pp
+	}
+
+	// a price failing to be set does not stop the others, it is retried at the next run
+	var errs []error
+	applied := 0
+	for _, id := range productIDs {
+		changed, err := c.applyScheduledPrice(ctx, latest[id])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("product #%d: %w", id, err))
+			continue
+		}
+		if changed {
+			applied++
+		}
+	}
+
+	return applied, errors.Join(errs...)
+}
+
+// applyScheduledPrice sets a scheduled price on its product, it reports whether the price of the product has been changed.
+// A price changed directly after the scheduled price came due is in effect instead, the scheduled price is then only marked as applied
+func (c *Controller) applyScheduledPrice(ctx context.Context, productPrice models.ProductPrice) (bool, error) {
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the product is locked so that its price is not changed directly meanwhile
+	if _, err = c.Repository.LockProduct(ctx, tx, productPrice.ProductID); err != nil {
+		return false, err
+	}
+
+	effective, err := c.Repository.GetEffectiveProductPrice(ctx, productPrice.ProductID, time.Now())
+	if err != nil {
+		return false, err
+	}
+	if effective.ID != productPrice.ID {
+		if err = c.Repository.MarkProductPriceApplied(ctx, tx, productPrice); err != nil {
+			return false, err
+		}
+		return false, c.Repository.CommitTx(tx)
+	}
+
+	if err = c.Repository.ApplyProductPrice(ctx, tx, productPrice); err != nil {
+		return false, err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return false, err
+	}
+
+	c.deleteProductCache(ctx, productPrice.ProductID)
+
+	return true, nil
+}
+
+// recordPriceChange adds the price of a product to its price history from now on, it is called when the price
+// of the product is changed directly
+func (c *Controller) recordPriceChange(ctx context.Context, tx *sql.Tx, product models.Product) error {
+	now := time.Now()
+	_, err := c.Repository.SetProductPrice(ctx, tx, repositories.ProductPrice{
+		ProductID:     product.ID,
+		Price:         product.Price,
+		Currency:      product.Currency,
+		EffectiveFrom: now,
+		AppliedAt:     null.TimeFrom(now),
+	})
+	return err
+}
+
+// productPriceAt returns the price and currency of a product in effect at the time. The price of the product is used
+// if its price history does not cover the time
+func (c *Controller) productPriceAt(ctx context.Context, product models.Product, at time.Time) (decimal.Decimal, string, error) {
+	productPrice, err := c.Repository.GetEffectiveProductPrice(ctx, product.ID, at)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductPriceNotFound) {
+			return product.Price, product.Currency, nil
+		}
+		return decimal.Decimal{}, "", err
+	}
+
+	return productPrice.Price, productPrice.Currency, nil
+}
+
+// toProductPriceOutput converts a price of the price history to the output
+func toProductPriceOutput(productPrice models.ProductPrice) ProductPriceOutput {
+	output := ProductPriceOutput{
+		ID:            productPrice.ID,
+		ProductID:     productPrice.ProductID,
+		Price:         productPrice.Price,
+		Currency:      productPrice.Currency,
+		EffectiveFrom: productPrice.EffectiveFrom,
+		Scheduled:     !productPrice.AppliedAt.Valid,
+		CreatedAt:     productPrice.CreatedAt,
+	}
+	if productPrice.EffectiveTo.Valid {
+		effectiveTo := productPrice.EffectiveTo.Time
+		output.EffectiveTo = &effectiveTo
+	}
+
+	return output
+}
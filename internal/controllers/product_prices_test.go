@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test SchedulePriceChange in Controller layer
+func Test_ProductPriceController_SchedulePriceChange(t *testing.T) {
+	effectiveFrom := time.Now().Add(24 * time.Hour)
+
+	tests := map[string]struct {
+		input      ProductPriceInput
+		product    models.Product
+		productErr error
+		expSet     repositories.ProductPrice
+		expErr     error
+	}{
+		"schedule price change successfully": {
+			input:   ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), EffectiveFrom: effectiveFrom},
+			product: models.Product{ID: 1, Price: decimal.NewFromInt(1000), Currency: CurrencyVND},
+			expSet:  repositories.ProductPrice{ProductID: 1, Price: decimal.NewFromInt(900), Currency: CurrencyVND, EffectiveFrom: effectiveFrom},
+		},
+		"schedule price change in another currency": {
+			input:   ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(9), Currency: CurrencyUSD, EffectiveFrom: effectiveFrom},
+			product: models.Product{ID: 1, Price: decimal.NewFromInt(1000), Currency: CurrencyVND},
+			expSet:  repositories.ProductPrice{ProductID: 1, Price: decimal.NewFromInt(9), Currency: CurrencyUSD, EffectiveFrom: effectiveFrom},
+		},
+		"product not found": {
+			input:      ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), EffectiveFrom: effectiveFrom},
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"archived product": {
+			input:   ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), EffectiveFrom: effectiveFrom},
+			product: models.Product{ID: 1, DeletedAt: null.TimeFrom(time.Now())},
+			expErr:  ErrProductNotFound,
+		},
+		"price change in the past": {
+			input:   ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), EffectiveFrom: time.Now().Add(-time.Hour)},
+			product: models.Product{ID: 1, Currency: CurrencyVND},
+			expErr:  ErrPriceChangeNotInFuture,
+		},
+		"invalid price": {
+			input:   ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(-1), EffectiveFrom: effectiveFrom},
+			product: models.Product{ID: 1, Currency: CurrencyVND},
+			expErr:  ErrInvalidPrice,
+		},
+		"invalid currency": {
+			input:   ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), Currency: "EUR", EffectiveFrom: effectiveFrom},
+			product: models.Product{ID: 1, Currency: CurrencyVND},
+			expErr:  ErrInvalidCurrency,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			mockRepo.On("GetProduct", context.Background(), tc.input.ProductID).Return(tc.product, tc.productErr)
+			if tc.expErr == nil {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("SetProductPrice", context.Background(), &tx, tc.expSet).
+					Return(models.ProductPrice{ID: 5, ProductID: 1, Price: tc.expSet.Price, Currency: tc.expSet.Currency, EffectiveFrom: effectiveFrom}, nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			output, err := controller.SchedulePriceChange(context.Background(), tc.input)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, ProductPriceOutput{ID: 5, ProductID: 1, Price: tc.expSet.Price, Currency: tc.expSet.Currency, EffectiveFrom: effectiveFrom, Scheduled: true}, output)
+			}
+		})
+	}
+}
+
+// Test GetProductPriceAt in Controller layer
+func Test_ProductPriceController_GetProductPriceAt(t *testing.T) {
+	at := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	effectiveFrom := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	effectiveTo := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		productErr error
+		price      models.ProductPrice
+		priceErr   error
+		expOutput  ProductPriceOutput
+		expErr     error
+	}{
+		"get price at time successfully": {
+			price: models.ProductPrice{ID: 2, ProductID: 1, Price: decimal.NewFromInt(900), Currency: CurrencyVND, EffectiveFrom: effectiveFrom, EffectiveTo: null.TimeFrom(effectiveTo), AppliedAt: null.TimeFrom(effectiveFrom)},
+			expOutput: ProductPriceOutput{
+				ID: 2, ProductID: 1, Price: decimal.NewFromInt(900), Currency: CurrencyVND, EffectiveFrom: effectiveFrom, EffectiveTo: &effectiveTo,
+			},
+		},
+		"product not found": {
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"no price at time": {
+			priceErr: repositories.ErrProductPriceNotFound,
+			expErr:   ErrProductPriceNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(models.Product{ID: 1}, tc.productErr)
+			if tc.productErr == nil {
+				mockRepo.On("GetEffectiveProductPrice", context.Background(), 1, at).Return(tc.price, tc.priceErr)
+			}
+
+			output, err := controller.GetProductPriceAt(context.Background(), 1, at)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test CancelScheduledPrice in Controller layer
+func Test_ProductPriceController_CancelScheduledPrice(t *testing.T) {
+	tests := map[string]struct {
+		price     models.ProductPrice
+		priceErr  error
+		expDelete bool
+		expErr    error
+	}{
+		"cancel scheduled price successfully": {
+			price:     models.ProductPrice{ID: 2, ProductID: 1, EffectiveFrom: time.Now().Add(time.Hour)},
+			expDelete: true,
+		},
+		"price not found": {
+			priceErr: repositories.ErrProductPriceNotFound,
+			expErr:   ErrProductPriceNotFound,
+		},
+		"price of another product": {
+			price:  models.ProductPrice{ID: 2, ProductID: 5, EffectiveFrom: time.Now().Add(time.Hour)},
+			expErr: ErrProductPriceNotFound,
+		},
+		"price already in effect": {
+			price:  models.ProductPrice{ID: 2, ProductID: 1, EffectiveFrom: time.Now().Add(-time.Hour)},
+			expErr: ErrPriceNotScheduled,
+		},
+		"price already applied": {
+			price:  models.ProductPrice{ID: 2, ProductID: 1, EffectiveFrom: time.Now().Add(time.Hour), AppliedAt: null.TimeFrom(time.Now())},
+			expErr: ErrPriceNotScheduled,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			mockRepo.On("GetProductPrice", context.Background(), 2).Return(tc.price, tc.priceErr)
+			if tc.expDelete {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("DeleteProductPrice", context.Background(), &tx, 2).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			err := controller.CancelScheduledPrice(context.Background(), 1, 2)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test ApplyScheduledPrices in Controller layer
+func Test_ProductPriceController_ApplyScheduledPrices(t *testing.T) {
+	earlier := models.ProductPrice{ID: 1, ProductID: 1, Price: decimal.NewFromInt(900), Currency: CurrencyVND, EffectiveFrom: time.Now().Add(-2 * time.Hour)}
+	later := models.ProductPrice{ID: 2, ProductID: 1, Price: decimal.NewFromInt(800), Currency: CurrencyVND, EffectiveFrom: time.Now().Add(-time.Hour)}
+	other := models.ProductPrice{ID: 3, ProductID: 2, Price: decimal.NewFromInt(50), Currency: CurrencyUSD, EffectiveFrom: time.Now().Add(-time.Hour)}
+
+	direct := models.ProductPrice{ID: 4, ProductID: 1, Price: decimal.NewFromInt(700), Currency: CurrencyVND, EffectiveFrom: time.Now().Add(-time.Minute)}
+
+	tests := map[string]struct {
+		prices     []models.ProductPrice
+		effective  models.ProductPrice
+		applyErr   error
+		expApplied int
+		expErr     error
+	}{
+		"apply the latest due price of each product": {
+			prices:     []models.ProductPrice{earlier, later, other},
+			effective:  later,
+			expApplied: 2,
+		},
+		"no due price": {},
+		"price changed directly since the scheduled price came due": {
+			prices:     []models.ProductPrice{earlier, later, other},
+			effective:  direct,
+			expApplied: 1,
+		},
+		"price cannot be applied": {
+			prices:     []models.ProductPrice{earlier, later, other},
+			effective:  later,
+			applyErr:   errors.New("something went wrong"),
+			expApplied: 1,
+			expErr:     errors.New("product #1: something went wrong"),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+			tx := sql.Tx{}
+
+			mockRepo.On("GetDueProductPrices", context.Background(), mock.AnythingOfType("time.Time")).Return(tc.prices, nil)
+			if len(tc.prices) > 0 {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("LockProduct", context.Background(), &tx, 1).Return(models.Product{ID: 1}, nil)
+				mockRepo.On("LockProduct", context.Background(), &tx, 2).Return(models.Product{ID: 2}, nil)
+				mockRepo.On("GetEffectiveProductPrice", context.Background(), 1, mock.AnythingOfType("time.Time")).Return(tc.effective, nil)
+				mockRepo.On("GetEffectiveProductPrice", context.Background(), 2, mock.AnythingOfType("time.Time")).Return(other, nil)
+				if tc.effective.ID == later.ID {
+					mockRepo.On("ApplyProductPrice", context.Background(), &tx, later).Return(tc.applyErr)
+					if tc.applyErr == nil {
+						mockRepo.On("DeleteProductCache", context.Background(), 1).Return(nil)
+					}
+				} else {
+					// the direct price stays in effect, the scheduled price is only marked as applied
+					mockRepo.On("MarkProductPriceApplied", context.Background(), &tx, later).Return(nil)
+				}
+				mockRepo.On("ApplyProductPrice", context.Background(), &tx, other).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+				mockRepo.On("DeleteProductCache", context.Background(), 2).Return(nil)
+			}
+
+			applied, err := controller.ApplyScheduledPrices(context.Background())
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expApplied, applied)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+)
+
+// number of related products returned by default and at most, the job stores the most related ones of each product
+const (
+	defaultRelatedProductsLimit = 5
+	maxRelatedProductsLimit     = 20
+)
+
+// relatedProductsWindow is how far back the orders are looked at to find the products bought together and the best-sellers
+const relatedProductsWindow = 180 * 24 * time.Hour
+
+// ComputeRelatedProducts finds the products which are most often bought together in the sales orders of the window and stores
+// them as the related products of each other. It returns the number of products having related products
+func (c *Controller) ComputeRelatedProducts(ctx context.Context) (int, error) {
+	coPurchases, err := c.Repository.GetProductCoPurchases(ctx, time.Now().Add(-relatedProductsWindow), salesOrderStatuses, maxRelatedProductsLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	// the co-purchases are sorted by product and then by the number of orders
+	related := make(map[int][]int)
+	for _, cp := range coPurchases {
+		related[cp.ProductID] = append(related[cp.ProductID], cp.RelatedProductID)
+	}
+
+	if err := c.Repository.SetRelatedProducts(ctx, related); err != nil {
+		return 0, err
+	}
+
+	return len(related), nil
+}
+
+// GetRelatedProducts retrieves the products most often bought together with a product, up to the limit which is defaulted if not given.
+// If the product has not been bought with enough others, the best-sellers of its category fill up the rest
+func (c *Controller) GetRelatedProducts(ctx context.Context, productID int, limit int, currency string) ([]ProductOutputGraph, error) {
+	if limit == 0 {
+		limit = defaultRelatedProductsLimit
+	}
+	if limit < 0 || limit > maxRelatedProductsLimit {
+		return nil, ErrInvalidRelatedProductsLimit
+	}
+
+	product, err := c.Repository.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	if product.DeletedAt.Valid {
+		return nil, ErrProductNotFound
+	}
+
+	relatedIDs, err := c.Repository.GetRelatedProductIDs(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the product itself is never related to itself
+	seen := map[int]bool{productID: true}
+	pOutputs, err := c.appendRelatedProducts(ctx, nil, relatedIDs, seen, limit, currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(pOutputs) == limit {
+		return pOutputs, nil
+	}
+
+	now := time.Now()
+	bestSellers, err := c.Repository.GetTopProductsInCategory(ctx, repositories.AnalyticsFilter{
+		StartDate: now.Add(-relatedProductsWindow),
+		EndDate:   now,
+		Statuses:  salesOrderStatuses,
+	}, product.CategoryID, maxRelatedProductsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	bestSellerIDs := make([]int, 0, len(bestSellers))
+	for _, bs := range bestSellers {
+		bestSellerIDs = append(bestSellerIDs, bs.ID)
+	}
+
+	return c.appendRelatedProducts(ctx, pOutputs, bestSellerIDs, seen, limit, currency)
+}
+
+// appendRelatedProducts appends the products of the IDs which have not been seen until there are limit products.
+// A product which has been archived since it was found related is skipped. The products are retrieved at once as
+// the products of a list are, so their availability is not included
+func (c *Controller) appendRelatedProducts(ctx context.Context, pOutputs []ProductOutputGraph, ids []int, seen map[int]bool, limit int, currency string) ([]ProductOutputGraph, error) {
+	var candidateIDs []int
+	for _, id := range ids {
+		if !seen[id] {
+			candidateIDs = append(candidateIDs, id)
+		}
+	}
+	if len(candidateIDs) == 0 || len(pOutputs) == limit {
+		return pOutputs, nil
+	}
+
+	products, err := c.Repository.GetProductsByID(ctx, candidateIDs...)
+	if err != nil {
+		return nil, err
+	}
+	productsByID := make(map[int]models.Product, len(products))
+	for _, p := range products {
+		productsByID[p.ID] = p
+	}
+
+	// the products are kept in the order of the IDs, which are sorted by relevance
+	var related []models.Product
+	for _, id := range candidateIDs {
+		if len(pOutputs)+len(related) == limit {
+			break
+		}
+		seen[id] = true
+
+		p, ok := productsByID[id]
+		if !ok || p.DeletedAt.Valid {
+			continue
+		}
+		related = append(related, p)
+	}
+	if len(related) == 0 {
+		return pOutputs, nil
+	}
+
+	productIDs := make([]int, 0, len(related))
+	for _, p := range related {
+		productIDs = append(productIDs, p.ID)
+	}
+	galleries, err := c.getProductGalleries(ctx, productIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, attributes, err := c.getProductSpecs(ctx, productIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// the authors and categories are shared by the products, each of them is retrieved once
+	authors := make(map[int]models.User)
+	categories := make(map[int]models.ProductCategory)
+	converter := &priceConverter{controller: c, currency: currency}
+	for _, p := range related {
+		author, ok := authors[p.AuthorID]
+		if !ok {
+			if author, err = c.Repository.GetUser(ctx, p.AuthorID); err != nil {
+				if errors.Is(err, repositories.ErrUserNotFound) {
+					return nil, ErrUserNotFound
+				}
+				return nil, err
+			}
+			authors[p.AuthorID] = author
+		}
+
+		category, ok := categories[p.CategoryID]
+		if !ok {
+			if category, err = c.Repository.GetProductCategory(ctx, p.CategoryID); err != nil {
+				if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+					return nil, ErrProductCategoryNotFound
+				}
+				return nil, err
+			}
+			categories[p.CategoryID] = category
+		}
+
+		pOutput := ProductOutputGraph{
+			ID:          p.ID,
+			SKU:         p.Sku,
+			Slug:        p.Slug,
+			Name:        p.Name,
+			Description: p.Description,
+			Price:       p.Price,
+			Quantity:    p.Quantity,
+			Weight:      p.Weight,
+			Currency:    p.Currency,
+			Author: UserOutput{
+				ID:        author.ID,
+				Name:      author.Name,
+				Email:     author.Email,
+				Role:      author.Role,
+				CreatedAt: author.CreatedAt,
+				UpdatedAt: author.UpdatedAt,
+				Status:    author.Status,
+			},
+			Category: PCateOutput{
+				ID:          category.ID,
+				Name:        category.Name,
+				Description: category.Description,
+				CreatedAt:   category.CreatedAt,
+				UpdatedAt:   category.UpdatedAt,
+			},
+			Images:           galleries[p.ID],
+			Tags:             tags[p.ID],
+			Attributes:       attributes[p.ID],
+			CreatedAt:        p.CreatedAt,
+			UpdatedAt:        p.UpdatedAt,
+			ReorderThreshold: p.ReorderThreshold,
+			RatingAverage:    p.RatingAverage,
+			ReviewCount:      p.ReviewCount,
+			Version:          p.Version,
+		}
+
+		if currency != "" {
+			if pOutput.Price, err = converter.convert(ctx, p.Price, p.Currency); err != nil {
+				return nil, err
+			}
+			pOutput.Currency = currency
+		}
+
+		pOutputs = append(pOutputs, pOutput)
+	}
+
+	return pOutputs, nil
+}
@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test ComputeRelatedProducts in Controller layer
+func Test_ProductController_ComputeRelatedProducts(t *testing.T) {
+	tests := map[string]struct {
+		coPurchases []repositories.ProductCoPurchase
+		expRelated  map[int][]int
+	}{
+		"products bought together": {
+			coPurchases: []repositories.ProductCoPurchase{
+				{ProductID: 1, RelatedProductID: 3, OrderCount: 5},
+				{ProductID: 1, RelatedProductID: 2, OrderCount: 2},
+				{ProductID: 2, RelatedProductID: 1, OrderCount: 2},
+				{ProductID: 3, RelatedProductID: 1, OrderCount: 5},
+			},
+			expRelated: map[int][]int{1: {3, 2}, 2: {1}, 3: {1}},
+		},
+		"no orders": {
+			expRelated: map[int][]int{},
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProductCoPurchases", context.Background(), mock.AnythingOfType("time.Time"), salesOrderStatuses, maxRelatedProductsLimit).Return(tc.coPurchases, nil)
+			mockRepo.On("SetRelatedProducts", context.Background(), tc.expRelated).Return(nil)
+
+			computed, err := controller.ComputeRelatedProducts(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.expRelated), computed)
+		})
+	}
+}
+
+// Test GetRelatedProducts in Controller layer
+func Test_ProductController_GetRelatedProducts(t *testing.T) {
+	product := models.Product{ID: 1, Name: "iPhone 14", AuthorID: 2, CategoryID: 3, Currency: "USD"}
+
+	tests := map[string]struct {
+		givenLimit    int
+		product       models.Product
+		productErr    error
+		relatedIDs    []int
+		expBestSeller bool
+		bestSellers   []repositories.TopSeller
+		// the IDs of the products retrieved at once, and of those of them which are returned
+		expRetrieved [][]int
+		expLoaded    [][]int
+		expIDs       []int
+		expErr       error
+	}{
+		"enough products bought together": {
+			givenLimit:   2,
+			product:      product,
+			relatedIDs:   []int{4, 5, 6},
+			expRetrieved: [][]int{{4, 5, 6}},
+			expLoaded:    [][]int{{4, 5}},
+			expIDs:       []int{4, 5},
+		},
+		"filled up with the best-sellers of the category": {
+			givenLimit:    3,
+			product:       product,
+			relatedIDs:    []int{4},
+			expBestSeller: true,
+			bestSellers:   []repositories.TopSeller{{ID: 1}, {ID: 4}, {ID: 7}, {ID: 8}},
+			expRetrieved:  [][]int{{4}, {7, 8}},
+			expLoaded:     [][]int{{4}, {7, 8}},
+			expIDs:        []int{4, 7, 8},
+		},
+		"archived related product skipped": {
+			givenLimit:    2,
+			product:       product,
+			relatedIDs:    []int{4, 9},
+			expBestSeller: true,
+			bestSellers:   []repositories.TopSeller{{ID: 7}},
+			expRetrieved:  [][]int{{4, 9}, {7}},
+			expLoaded:     [][]int{{4}, {7}},
+			expIDs:        []int{4, 7},
+		},
+		"product never bought": {
+			product:       product,
+			expBestSeller: true,
+			bestSellers:   []repositories.TopSeller{{ID: 7}},
+			expRetrieved:  [][]int{{7}},
+			expLoaded:     [][]int{{7}},
+			expIDs:        []int{7},
+		},
+		"archived product": {
+			product: models.Product{ID: 1, DeletedAt: null.TimeFrom(product.CreatedAt)},
+			expErr:  ErrProductNotFound,
+		},
+		"product not found": {
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"limit too large": {
+			givenLimit: 21,
+			expErr:     ErrInvalidRelatedProductsLimit,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.expErr != ErrInvalidRelatedProductsLimit {
+				mockRepo.On("GetProduct", context.Background(), 1).Return(tc.product, tc.productErr)
+			}
+			if tc.expErr == nil {
+				mockRepo.On("GetRelatedProductIDs", context.Background(), 1).Return(tc.relatedIDs, nil)
+			}
+			if tc.expBestSeller {
+				mockRepo.On("GetTopProductsInCategory", context.Background(), mock.AnythingOfType("repositories.AnalyticsFilter"), 3, maxRelatedProductsLimit).Return(tc.bestSellers, nil)
+			}
+			for _, ids := range tc.expRetrieved {
+				var products []models.Product
+				args := []interface{}{context.Background()}
+				for _, id := range ids {
+					p := models.Product{ID: id, AuthorID: 2, CategoryID: 3}
+					if id == 9 {
+						p.DeletedAt = null.TimeFrom(product.CreatedAt)
+					}
+					products = append(products, p)
+					args = append(args, id)
+				}
+				mockRepo.On("GetProductsByID", args...).Return(products, nil)
+			}
+			for _, ids := range tc.expLoaded {
+				args := []interface{}{context.Background()}
+				for _, id := range ids {
+					args = append(args, id)
+				}
+				mockRepo.On("GetProductImages", args...).Return([]models.ProductImage{}, nil)
+				mockRepo.On("GetProductTags", args...).Return([]models.ProductTag{}, nil)
+				mockRepo.On("GetProductAttributes", args...).Return([]repositories.ProductAttributeOutput{}, nil)
+			}
+			if len(tc.expLoaded) > 0 {
+				mockRepo.On("GetUser", context.Background(), 2).Return(models.User{ID: 2}, nil)
+				mockRepo.On("GetProductCategory", context.Background(), 3).Return(models.ProductCategory{ID: 3}, nil)
+			}
+
+			products, err := controller.GetRelatedProducts(context.Background(), 1, tc.givenLimit, "")
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				var ids []int
+				for _, p := range products {
+					ids = append(ids, p.ID)
+				}
+				assert.Equal(t, tc.expIDs, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+)
+
+// statuses of a product review, only the approved reviews are listed and counted in the rating of the product
+const (
+	ReviewStatusPending  = "PENDING"
+	ReviewStatusApproved = "APPROVED"
+	ReviewStatusRejected = "REJECTED"
+)
+
+// reviewStatusTransitions maps the status of a review to the statuses it can move to, a moderator can change their mind
+var reviewStatusTransitions = map[string][]string{
+	ReviewStatusPending:  {ReviewStatusApproved, ReviewStatusRejected},
+	ReviewStatusApproved: {ReviewStatusRejected},
+	ReviewStatusRejected: {ReviewStatusApproved},
+}
+
+// IsValidReviewStatus reports whether the status is one of the statuses of a product review
+func IsValidReviewStatus(status string) bool {
+	switch status {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	}
+	return false
+}
+
+// reviewerOrderStatuses are the statuses of the orders which make the customer a verified buyer of their products
+var reviewerOrderStatuses = []string{OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered}
+
+const (
+	// maxReviewTitleLength and maxReviewBodyLength are the number of characters of a review at most
+	maxReviewTitleLength = 255
+	maxReviewBodyLength  = 5000
+	// defaultReviewsLimit and maxReviewsLimit are the number of reviews in a page by default and at most
+	defaultReviewsLimit = 20
+	maxReviewsLimit     = 100
+)
+
+type ProductReviewInput struct {
+	ProductID int
+	UserID    int
+	// Rating is from 1 to 5
+	Rating int
+	Title  string
+	Body   string
+}
+
+type ProductReviewOutput struct {
+	ID          int
+	ProductID   int
+	UserID      int
+	Rating      int
+	Title       string
+	Body        string
+	Status      string
+	Note        string
+	ModeratedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ProductReviewFilterCtrl struct {
+	ProductID int
+	// Status defaults to APPROVED
+	Status string
+	// Limit defaults to 20
+	Limit  int
+	Offset int
+}
+
+// CreateProductReview creates a review of a product by a customer who bought it, the review is pending until
+// it is moderated. A customer reviews a product once
+func (c *Controller) CreateProductReview(ctx context.Context, input ProductReviewInput) (ProductReviewOutput, error) {
+	if input.Rating < 1 || input.Rating > 5 {
+		return ProductReviewOutput{}, ErrInvalidRating
+	}
+	title, body := strings.TrimSpace(input.Title), strings.TrimSpace(input.Body)
+	if body == "" {
+		return ProductReviewOutput{}, ErrMissingReviewBody
+	}
+	if len([]rune(title)) > maxReviewTitleLength || len([]rune(body)) > maxReviewBodyLength {
+		return ProductReviewOutput{}, ErrReviewTooLong
+	}
+
+	if err := c.checkReviewableProduct(ctx, input.ProductID); err != nil {
+		return ProductReviewOutput{}, err
+	}
+
+	// check user exists
+	if _, err := c.Repository.GetUser(ctx, input.UserID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ProductReviewOutput{}, ErrUserNotFound
+		}
+		return ProductReviewOutput{}, err
+	}
+
+	ordered, err := c.Repository.HasOrderedProduct(ctx, input.UserID, input.ProductID, reviewerOrderStatuses)
+	if err != nil {
+		return ProductReviewOutput{}, err
+	}
+	if !ordered {
+		return ProductReviewOutput{}, ErrReviewerNotVerified
+	}
+
+	if _, err := c.Repository.GetProductReviewByUser(ctx, input.ProductID, input.UserID); err == nil {
+		return ProductReviewOutput{}, ErrProductReviewExists
+	} else if !errors.Is(err, repositories.ErrProductReviewNotFound) {
+		return ProductReviewOutput{}, err
+	}
+
+	review, err := c.Repository.CreateProductReview(ctx, repositories.ProductReview{
+		ProductID: input.ProductID,
+		UserID:    input.UserID,
+		Rating:    input.Rating,
+		Title:     title,
+		Body:      body,
+		Status:    ReviewStatusPending,
+	})
+	if err != nil {
+		return ProductReviewOutput{}, err
+	}
+
+	return toProductReviewOutput(review), nil
+}
+
+// ModerateProductReview moves a review to the status with the note of the moderator, the note is kept if blank.
+// The rating of the product is updated when the review is approved or no longer approved
+func (c *Controller) ModerateProductReview(ctx context.Context, reviewID int, status string, note string) error {
+	review, err := c.Repository.GetProductReview(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductReviewNotFound) {
+			return ErrProductReviewNotFound
+		}
+		return err
+	}
+
+	if !canTransitReview(review.Status, status) {
+		return ErrInvalidReviewStatus
+	}
+
+	if strings.TrimSpace(note) != "" {
+		review.Note = strings.TrimSpace(note)
+	}
+
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	if err = c.Repository.UpdateProductReviewStatus(ctx, tx, review.ID, review.Status, status, review.Note); err != nil {
+		// the review has been moderated concurrently
+		if errors.Is(err, repositories.ErrProductReviewNotFound) {
+			return ErrInvalidReviewStatus
+		}
+		return err
+	}
+
+	var count int
+	switch {
+	case status == ReviewStatusApproved:
+		count = 1
+	case review.Status == ReviewStatusApproved:
+		count = -1
+	}
+	if count != 0 {
+		if err = c.Repository.AddProductRating(ctx, tx, review.ProductID, count, count*review.Rating); err != nil {
+			return err
+		}
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	// the cached product is removed once the rating is committed so that no stale rating is cached in between
+	if count != 0 {
+		return c.Repository.DeleteProductCache(ctx, review.ProductID)
+	}
+
+	return nil
+}
+
+// GetProductReviews retrieves a page of the reviews of a product in the status of the filter, newest first,
+// and the total count of them
+func (c *Controller) GetProductReviews(ctx context.Context, filter ProductReviewFilterCtrl) ([]ProductReviewOutput, int64, error) {
+	status := filter.Status
+	if status == "" {
+		status = ReviewStatusApproved
+	}
+	if !IsValidReviewStatus(status) {
+		return nil, 0, ErrInvalidReviewStatus
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultReviewsLimit
+	}
+	if limit < 0 || limit > maxReviewsLimit {
+		return nil, 0, ErrInvalidReviewLimit
+	}
+	if filter.Offset < 0 {
+		return nil, 0, ErrInvalidOffset
+	}
+
+	if err := c.checkReviewableProduct(ctx, filter.ProductID); err != nil {
+		return nil, 0, err
+	}
+
+	repoFilter := repositories.ProductReviewFilter{
+		ProductID: filter.ProductID,
+		Statuses:  []string{status},
+		Limit:     limit,
+		Offset:    filter.Offset,
+	}
+	reviews, err := c.Repository.GetProductReviews(ctx, repoFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalCount, err := c.Repository.CountProductReviews(ctx, repoFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	output := make([]ProductReviewOutput, 0, len(reviews))
+	for _, pr := range reviews {
+		output = append(output, toProductReviewOutput(pr))
+	}
+
+	return output, totalCount, nil
+}
+
+// checkReviewableProduct checks that the product exists and is not archived
+func (c *Controller) checkReviewableProduct(ctx context.Context, productID int) error {
+	product, err := c.Repository.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+	if product.DeletedAt.Valid {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
+
+// canTransitReview reports whether a review in the status from can move to the status to
+func canTransitReview(from, to string) bool {
+	for _, s := range reviewStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// toProductReviewOutput converts a review in db to the output
+func toProductReviewOutput(review models.ProductReview) ProductReviewOutput {
+	output := ProductReviewOutput{
+		ID:        review.ID,
+		ProductID: review.ProductID,
+		UserID:    review.UserID,
+		Rating:    review.Rating,
+		Title:     review.Title,
+		Body:      review.Body,
+		Status:    review.Status,
+		Note:      review.Note,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+	if review.ModeratedAt.Valid {
+		output.ModeratedAt = &review.ModeratedAt.Time
+	}
+
+	return output
+}
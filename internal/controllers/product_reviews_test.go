@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test CreateProductReview in Controller layer
+func Test_ProductReviewController_CreateProductReview(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockReviewRepo struct {
+		product   models.Product
+		ordered   bool
+		existing  error
+		expCreate bool
+	}
+	tests := map[string]struct {
+		givenInput     ProductReviewInput
+		mockReviewRepo *mockReviewRepo
+		expOutput      ProductReviewOutput
+		expErr         error
+	}{
+		"create review successfully": {
+			givenInput: ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Title: " Good ", Body: " Works well "},
+			mockReviewRepo: &mockReviewRepo{
+				product:   models.Product{ID: 1},
+				ordered:   true,
+				existing:  repositories.ErrProductReviewNotFound,
+				expCreate: true,
+			},
+			expOutput: ProductReviewOutput{ID: 5, ProductID: 1, UserID: 2, Rating: 4, Title: "Good", Body: "Works well", Status: ReviewStatusPending, CreatedAt: createdAt, UpdatedAt: createdAt},
+		},
+		"not a verified buyer": {
+			givenInput: ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Body: "Works well"},
+			mockReviewRepo: &mockReviewRepo{
+				product: models.Product{ID: 1},
+			},
+			expErr: ErrReviewerNotVerified,
+		},
+		"already reviewed": {
+			givenInput: ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Body: "Works well"},
+			mockReviewRepo: &mockReviewRepo{
+				product: models.Product{ID: 1},
+				ordered: true,
+			},
+			expErr: ErrProductReviewExists,
+		},
+		"archived product": {
+			givenInput: ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Body: "Works well"},
+			mockReviewRepo: &mockReviewRepo{
+				product: models.Product{ID: 1, DeletedAt: null.TimeFrom(createdAt)},
+			},
+			expErr: ErrProductNotFound,
+		},
+		"invalid rating": {
+			givenInput: ProductReviewInput{ProductID: 1, UserID: 2, Rating: 6, Body: "Works well"},
+			expErr:     ErrInvalidRating,
+		},
+		"blank text": {
+			givenInput: ProductReviewInput{ProductID: 1, UserID: 2, Rating: 5, Body: "  "},
+			expErr:     ErrMissingReviewBody,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.mockReviewRepo != nil {
+				mockRepo.On("GetProduct", context.Background(), tc.givenInput.ProductID).Return(tc.mockReviewRepo.product, nil)
+				if !tc.mockReviewRepo.product.DeletedAt.Valid {
+					mockRepo.On("GetUser", context.Background(), tc.givenInput.UserID).Return(models.User{ID: tc.givenInput.UserID}, nil)
+					mockRepo.On("HasOrderedProduct", context.Background(), tc.givenInput.UserID, tc.givenInput.ProductID, reviewerOrderStatuses).Return(tc.mockReviewRepo.ordered, nil)
+				}
+				if tc.mockReviewRepo.ordered {
+					mockRepo.On("GetProductReviewByUser", context.Background(), tc.givenInput.ProductID, tc.givenInput.UserID).Return(models.ProductReview{}, tc.mockReviewRepo.existing)
+				}
+				if tc.mockReviewRepo.expCreate {
+					mockRepo.On("CreateProductReview", context.Background(), repositories.ProductReview{
+						ProductID: 1,
+						UserID:    2,
+						Rating:    4,
+						Title:     "Good",
+						Body:      "Works well",
+						Status:    ReviewStatusPending,
+					}).Return(models.ProductReview{ID: 5, ProductID: 1, UserID: 2, Rating: 4, Title: "Good", Body: "Works well", Status: ReviewStatusPending, CreatedAt: createdAt, UpdatedAt: createdAt}, nil)
+				}
+			}
+
+			output, err := controller.CreateProductReview(context.Background(), tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test ModerateProductReview in Controller layer
+func Test_ProductReviewController_ModerateProductReview(t *testing.T) {
+	type mockRatingRepo struct {
+		count int
+		total int
+	}
+	tests := map[string]struct {
+		givenStatus    string
+		givenNote      string
+		review         models.ProductReview
+		updateErr      error
+		mockRatingRepo *mockRatingRepo
+		expNote        string
+		expErr         error
+	}{
+		"approve a pending review": {
+			givenStatus:    ReviewStatusApproved,
+			review:         models.ProductReview{ID: 5, ProductID: 1, Rating: 4, Status: ReviewStatusPending},
+			mockRatingRepo: &mockRatingRepo{count: 1, total: 4},
+		},
+		"reject an approved review": {
+			givenStatus:    ReviewStatusRejected,
+			givenNote:      "spam",
+			review:         models.ProductReview{ID: 5, ProductID: 1, Rating: 4, Status: ReviewStatusApproved, Note: "ok"},
+			mockRatingRepo: &mockRatingRepo{count: -1, total: -4},
+			expNote:        "spam",
+		},
+		"reject a pending review": {
+			givenStatus: ReviewStatusRejected,
+			review:      models.ProductReview{ID: 5, ProductID: 1, Rating: 2, Status: ReviewStatusPending, Note: "first look"},
+			expNote:     "first look",
+		},
+		"review moderated concurrently": {
+			givenStatus: ReviewStatusApproved,
+			review:      models.ProductReview{ID: 5, ProductID: 1, Rating: 4, Status: ReviewStatusPending},
+			updateErr:   repositories.ErrProductReviewNotFound,
+			expErr:      ErrInvalidReviewStatus,
+		},
+		"invalid transition": {
+			givenStatus: ReviewStatusPending,
+			review:      models.ProductReview{ID: 5, ProductID: 1, Rating: 4, Status: ReviewStatusApproved},
+			expErr:      ErrInvalidReviewStatus,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProductReview", context.Background(), tc.review.ID).Return(tc.review, nil)
+			if canTransitReview(tc.review.Status, tc.givenStatus) {
+				tx := sql.Tx{}
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("UpdateProductReviewStatus", context.Background(), &tx, tc.review.ID, tc.review.Status, tc.givenStatus, tc.expNote).Return(tc.updateErr)
+				if tc.updateErr == nil {
+					if tc.mockRatingRepo != nil {
+						mockRepo.On("AddProductRating", context.Background(), &tx, tc.review.ProductID, tc.mockRatingRepo.count, tc.mockRatingRepo.total).Return(nil)
+						mockRepo.On("DeleteProductCache", context.Background(), tc.review.ProductID).Return(nil)
+					}
+					mockRepo.On("CommitTx", &tx).Return(nil)
+				}
+			}
+
+			err := controller.ModerateProductReview(context.Background(), tc.review.ID, tc.givenStatus, tc.givenNote)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test GetProductReviews in Controller layer
+func Test_ProductReviewController_GetProductReviews(t *testing.T) {
+	tests := map[string]struct {
+		givenFilter ProductReviewFilterCtrl
+		expFilter   *repositories.ProductReviewFilter
+		expErr      error
+	}{
+		"approved reviews by default": {
+			givenFilter: ProductReviewFilterCtrl{ProductID: 1, Offset: 20},
+			expFilter:   &repositories.ProductReviewFilter{ProductID: 1, Statuses: []string{ReviewStatusApproved}, Limit: defaultReviewsLimit, Offset: 20},
+		},
+		"pending reviews": {
+			givenFilter: ProductReviewFilterCtrl{ProductID: 1, Status: ReviewStatusPending, Limit: 5},
+			expFilter:   &repositories.ProductReviewFilter{ProductID: 1, Statuses: []string{ReviewStatusPending}, Limit: 5},
+		},
+		"limit too large": {
+			givenFilter: ProductReviewFilterCtrl{ProductID: 1, Limit: 101},
+			expErr:      ErrInvalidReviewLimit,
+		},
+		"invalid status": {
+			givenFilter: ProductReviewFilterCtrl{ProductID: 1, Status: "DELETED"},
+			expErr:      ErrInvalidReviewStatus,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.expFilter != nil {
+				mockRepo.On("GetProduct", context.Background(), 1).Return(models.Product{ID: 1}, nil)
+				mockRepo.On("GetProductReviews", context.Background(), *tc.expFilter).Return([]models.ProductReview{{ID: 5, ProductID: 1, Status: tc.expFilter.Statuses[0]}}, nil)
+				mockRepo.On("CountProductReviews", context.Background(), *tc.expFilter).Return(int64(21), nil)
+			}
+
+			output, totalCount, err := controller.GetProductReviews(context.Background(), tc.givenFilter)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []ProductReviewOutput{{ID: 5, ProductID: 1, Status: tc.expFilter.Statuses[0]}}, output)
+				assert.Equal(t, int64(21), totalCount)
+			}
+		})
+	}
+}
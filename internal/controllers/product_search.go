@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+)
+
+// maxSearchQueryLength is the maximum number of characters of a search query
+const maxSearchQueryLength = 255
+
+type ProductSearchFilter struct {
+	Query    string
+	Currency string
+	// Limit defaults to 20
+	Limit  int
+	Offset int
+}
+
+type ProductSearchOutput struct {
+	ProductOutput
+	Rank float64
+	// NameHighlight and Snippet wrap the words matching the query in <b> tags
+	NameHighlight string
+	Snippet       string
+}
+
+// SearchProducts retrieves a page of the products whose name or description match the query, ranked by relevance,
+// and the total count of them. The prices are converted to the currency of the filter if given
+func (c *Controller) SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]ProductSearchOutput, int64, error) {
+	query := strings.TrimSpace(filter.Query)
+	if query == "" {
+		return nil, 0, ErrMissingSearchQuery
+	}
+	if len([]rune(query)) > maxSearchQueryLength {
+		return nil, 0, ErrSearchQueryTooLong
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultProductsLimit
+	}
+	if limit < 0 || limit > maxProductsLimit {
+		return nil, 0, ErrInvalidProductLimit
+	}
+	if filter.Offset < 0 {
+		return nil, 0, ErrInvalidOffset
+	}
+
+	results, err := c.Repository.SearchProducts(ctx, repositories.ProductSearchFilter{Query: query, Limit: limit, Offset: filter.Offset})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the total count is the same on every row, an empty page has no count so it is left as 0
+	var totalCount int64
+	if len(results) > 0 {
+		totalCount = results[0].TotalCount
+	}
+
+	converter := &priceConverter{controller: c, currency: filter.Currency}
+
+	output := make([]ProductSearchOutput, 0, len(results))
+	for _, r := range results {
+		pOutput := ProductSearchOutput{
+			ProductOutput: ProductOutput{
+				ID:            r.ID,
+				Name:          r.Name,
+				Description:   r.Description,
+				Price:         r.Price,
+				Quantity:      r.Quantity,
+				AuthorID:      r.AuthorID,
+				CategoryName:  r.CategoryName,
+				Currency:      r.Currency,
+				CreatedAt:     r.CreatedAt,
+				UpdatedAt:     r.UpdatedAt,
+				RatingAverage: r.RatingAverage,
+				ReviewCount:   r.ReviewCount,
+			},
+			Rank:          r.Rank,
+			NameHighlight: r.NameHighlight,
+			Snippet:       r.Snippet,
+		}
+
+		if filter.Currency != "" {
+			if pOutput.Price, err = converter.convert(ctx, r.Price, r.Currency); err != nil {
+				return nil, 0, err
+			}
+			pOutput.Currency = filter.Currency
+		}
+
+		output = append(output, pOutput)
+	}
+
+	return output, totalCount, nil
+}
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test SearchProducts in Controller layer
+func Test_ProductController_SearchProducts(t *testing.T) {
+	type mockSearchRepo struct {
+		expCall bool
+		filter  repositories.ProductSearchFilter
+		output  []repositories.ProductSearchResult
+		err     error
+	}
+	tests := map[string]struct {
+		filter         ProductSearchFilter
+		mockSearchRepo mockSearchRepo
+		expOutput      []ProductSearchOutput
+		expTotalCount  int64
+		expErr         error
+	}{
+		"search products successfully": {
+			filter: ProductSearchFilter{Query: "  dien thoai  "},
+			mockSearchRepo: mockSearchRepo{
+				expCall: true,
+				filter:  repositories.ProductSearchFilter{Query: "dien thoai", Limit: defaultProductsLimit},
+				output: []repositories.ProductSearchResult{
+					{
+						ProductOutput: repositories.ProductOutput{ID: 1, Name: "Điện thoại iPhone 14", Price: decimal.NewFromInt(20000000), Currency: CurrencyVND},
+						Rank:          0.9,
+						NameHighlight: "<b>Điện</b> <b>thoại</b> iPhone 14",
+						Snippet:       "<b>Điện</b> <b>thoại</b> Apple",
+						TotalCount:    3,
+					},
+				},
+			},
+			expOutput: []ProductSearchOutput{
+				{
+					ProductOutput: ProductOutput{ID: 1, Name: "Điện thoại iPhone 14", Price: decimal.NewFromInt(20000000), Currency: CurrencyVND},
+					Rank:          0.9,
+					NameHighlight: "<b>Điện</b> <b>thoại</b> iPhone 14",
+					Snippet:       "<b>Điện</b> <b>thoại</b> Apple",
+				},
+			},
+			expTotalCount: 3,
+		},
+		"no product matches": {
+			filter: ProductSearchFilter{Query: "laptop", Limit: 5, Offset: 10},
+			mockSearchRepo: mockSearchRepo{
+				expCall: true,
+				filter:  repositories.ProductSearchFilter{Query: "laptop", Limit: 5, Offset: 10},
+			},
+			expOutput: []ProductSearchOutput{},
+		},
+		"repository error": {
+			filter: ProductSearchFilter{Query: "laptop"},
+			mockSearchRepo: mockSearchRepo{
+				expCall: true,
+				filter:  repositories.ProductSearchFilter{Query: "laptop", Limit: defaultProductsLimit},
+				err:     errors.New("something went wrong"),
+			},
+			expErr: errors.New("something went wrong"),
+		},
+		"missing query": {
+			filter: ProductSearchFilter{Query: "   "},
+			expErr: ErrMissingSearchQuery,
+		},
+		"query too long": {
+			filter: ProductSearchFilter{Query: strings.Repeat("a", maxSearchQueryLength+1)},
+			expErr: ErrSearchQueryTooLong,
+		},
+		"limit too large": {
+			filter: ProductSearchFilter{Query: "laptop", Limit: maxProductsLimit + 1},
+			expErr: ErrInvalidProductLimit,
+		},
+		"negative offset": {
+			filter: ProductSearchFilter{Query: "laptop", Offset: -1},
+			expErr: ErrInvalidOffset,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockSearchRepo.expCall {
+				mockRepo.On("SearchProducts", context.Background(), tc.mockSearchRepo.filter).Return(tc.mockSearchRepo.output, tc.mockSearchRepo.err)
+			}
+
+			output, totalCount, err := controller.SearchProducts(context.Background(), tc.filter)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+				assert.Equal(t, tc.expTotalCount, totalCount)
+			}
+		})
+	}
+}
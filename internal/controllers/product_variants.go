@@ -0,0 +1,523 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+// maxSKULength is the length of the sku column of the product variants
+const maxSKULength = 64
+
+type VariantInput struct {
+	ProductID int
+	SKU       string
+	// Price overrides the price of the product if valid, it is in the currency of the product
+	Price    decimal.NullDecimal
+	Quantity int
+	// Options maps the name of an option type such as Size to the value of the variant such as M
+	Options map[string]string
+}
+
+type VariantOptionOutput struct {
+	Name  string
+	Value string
+}
+
+type VariantOutput struct {
+	ID        int
+	ProductID int
+	SKU       string
+	// Price is the price the variant is sold at, the price of the product if the variant does not override it
+	Price     decimal.Decimal
+	Currency  string
+	Quantity  int
+	Options   []VariantOptionOutput
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CreateVariant creates a variant of a product, the option types of the product are created with its first variant
+// and every later variant must have a value for each of them
+func (c *Controller) CreateVariant(ctx context.Context, vInput VariantInput) error {
+	// check product exists
+	if _, err := c.Repository.GetProduct(ctx, vInput.ProductID); err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	vInput.SKU = strings.TrimSpace(vInput.SKU)
+	if err := validateVariantInput(vInput); err != nil {
+		return err
+	}
+
+	// check the sku is not used by another variant
+	if _, err := c.Repository.GetVariantBySKU(ctx, vInput.SKU); err == nil {
+		return ErrSKUExists
+	} else if !errors.Is(err, repositories.ErrVariantNotFound) {
+		return err
+	}
+
+	optionTypes, err := c.Repository.GetOptionTypes(ctx, vInput.ProductID)
+	if err != nil {
+		return err
+	}
+
+	if len(optionTypes) != 0 {
+		if err := c.checkVariantOptions(ctx, vInput.ProductID, 0, optionTypes, vInput.Options); err != nil {
+			return err
+		}
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the option types are created with the first variant, sorted by name so that their positions are stable
+	if len(optionTypes) == 0 {
+		var names []string
+		for name := range vInput.Options {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for i, name := range names {
+			optionType, err := c.Repository.CreateOptionType(ctx, tx, vInput.ProductID, name, i)
+			if err != nil {
+				return err
+			}
+			optionTypes = append(optionTypes, optionType)
+		}
+	}
+
+	options := make(map[int]string)
+	for _, ot := range optionTypes {
+		options[ot.ID] = vInput.Options[ot.Name]
+	}
+
+	if _, err = c.Repository.CreateVariant(ctx, tx, repositories.ProductVariant{
+		ProductID: vInput.ProductID,
+		SKU:       vInput.SKU,
+		Price:     vInput.Price,
+		Quantity:  vInput.Quantity,
+		Options:   options,
+	}); err != nil {
+		return err
+	}
+
+	return c.Repository.CommitTx(tx)
+}
+
+// validateVariantInput checks the sku, price, quantity and options of a variant
+func validateVariantInput(vInput VariantInput) error {
+	if vInput.SKU == "" {
+		return ErrMissingSKU
+	}
+	if len(vInput.SKU) > maxSKULength {
+		return ErrSKUTooLong
+	}
+	if vInput.Price.Valid && !vInput.Price.Decimal.IsPositive() {
+		return ErrInvalidPrice
+	}
+	if vInput.Quantity < 0 {
+		return ErrInvalidQuantity
+	}
+	if len(vInput.Options) == 0 {
+		return ErrMissingVariantOptions
+	}
+	for name, value := range vInput.Options {
+		if strings.TrimSpace(name) == "" || strings.TrimSpace(value) == "" {
+			return ErrMissingVariantOptions
+		}
+	}
+
+	return nil
+}
+
+// checkVariantOptions checks the options have a value for each option type of the product and no other variant of the
+// product than the one of variantID has the same values
+func (c *Controller) checkVariantOptions(ctx context.Context, productID, variantID int, optionTypes []models.OptionType, options map[string]string) error {
+	if len(options) != len(optionTypes) {
+		return ErrVariantOptionsMismatch
+	}
+	for _, ot := range optionTypes {
+		if _, ok := options[ot.Name]; !ok {
+			return ErrVariantOptionsMismatch
+		}
+	}
+
+	variants, err := c.Repository.GetProductVariants(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range variants {
+		if v.Variant.ID == variantID {
+			continue
+		}
+
+		same := len(v.Options) == len(options)
+		for _, o := range v.Options {
+			if options[o.Name] != o.Value {
+				same = false
+				break
+			}
+		}
+		if same {
+			return ErrDuplicateVariant
+		}
+	}
+
+	return nil
+}
+
+// GetVariants retrieves the variants of a product, the prices are converted to the currency if given
+func (c *Controller) GetVariants(ctx context.Context, productID int, currency string) ([]VariantOutput, error) {
+	product, err := c.Repository.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	variants, err := c.Repository.GetProductVariants(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if currency == "" {
+		currency = product.Currency
+	}
+	converter := &priceConverter{controller: c, currency: currency}
+
+	var vOutput []VariantOutput
+	for _, v := range variants {
+		price, err := converter.convert(ctx, variantPrice(product, v.Variant), product.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		output := VariantOutput{
+			ID:        v.Variant.ID,
+			ProductID: v.Variant.ProductID,
+			SKU:       v.Variant.Sku,
+			Price:     price,
+			Currency:  currency,
+			Quantity:  v.Variant.Quantity,
+			CreatedAt: v.Variant.CreatedAt,
+			UpdatedAt: v.Variant.UpdatedAt,
+		}
+		for _, o := range v.Options {
+			output.Options = append(output.Options, VariantOptionOutput{
+				Name:  o.Name,
+				Value: o.Value,
+			})
+		}
+		vOutput = append(vOutput, output)
+	}
+
+	return vOutput, nil
+}
+
+// UpdateVariant updates the price override and the quantity of a variant of a product
+func (c *Controller) UpdateVariant(ctx context.Context, productID, variantID int, price decimal.NullDecimal, quantity int) error {
+	variant, err := c.getProductVariant(ctx, productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	if price.Valid && !price.Decimal.IsPositive() {
+		return ErrInvalidPrice
+	}
+	if quantity < 0 {
+		return ErrInvalidQuantity
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	variant.Price = price
+	if err = c.Repository.UpdateVariant(ctx, tx, variant); err != nil {
+		return err
+	}
+
+	// the new quantity is recorded as an adjustment of the stock
+	if delta := quantity - variant.Quantity; delta != 0 {
+		if err = c.adjustStock(ctx, tx, models.Product{ID: productID}, &variant, delta, stockChange{Reason: repositories.StockReasonAdjustment}); err != nil {
+			return err
+		}
+	}
+
+	return c.Repository.CommitTx(tx)
+}
+
+// getProductVariant retrieves a variant by ID and checks it belongs to the product
+func (c *Controller) getProductVariant(ctx context.Context, productID, variantID int) (models.ProductVariant, error) {
+	variant, err := c.Repository.GetVariant(ctx, variantID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVariantNotFound) {
+			return models.ProductVariant{}, ErrVariantNotFound
+		}
+		return models.ProductVariant{}, err
+	}
+
+	if variant.ProductID != productID {
+		return models.ProductVariant{}, ErrVariantNotFound
+	}
+
+	return variant, nil
+}
+
+// variantPrice returns the price a variant is sold at, in the currency of the product
+func variantPrice(product models.Product, variant models.ProductVariant) decimal.Decimal {
+	if variant.Price.Valid {
+		return variant.Price.Decimal
+	}
+	return product.Price
+}
+
+// getOrderItemStock retrieves the product of an order item and its variant if given. A product which has variants can
+// only be ordered by one of them
+func (c *Controller) getOrderItemStock(ctx context.Context, productID, variantID int) (models.Product, *models.ProductVariant, error) {
+	product, err := c.Repository.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return models.Product{}, nil, ErrProductNotFound
+		}
+		return models.Product{}, nil, err
+	}
+
+	if variantID == 0 {
+		count, err := c.Repository.CountProductVariants(ctx, productID)
+		if err != nil {
+			return models.Product{}, nil, err
+		}
+		if count != 0 {
+			return models.Product{}, nil, ErrVariantRequired
+		}
+		return product, nil, nil
+	}
+
+	variant, err := c.getProductVariant(ctx, productID, variantID)
+	if err != nil {
+		return models.Product{}, nil, err
+	}
+
+	return product, &variant, nil
+}
+
+// stockChange is why the stock of a product changes: the reason, the order or return request it is for and the user who made it.
+// The stock of a product changes in the warehouse if given, or in the default warehouse
+type stockChange struct {
+	Reason      string
+	ReferenceID int
+	ActorID     int
+	WarehouseID int
+}
+
+// adjustStock adds the delta to the quantity of the variant if given, or of the product
+func (c *Controller) adjustStock(ctx context.Context, tx *sql.Tx, product models.Product, variant *models.ProductVariant, delta int, change stockChange) error {
+	var variantID null.Int
+	if variant != nil {
+		variantID = null.IntFrom(variant.ID)
+	}
+
+	return c.recordStockMovement(ctx, tx, product.ID, variantID, delta, change)
+}
+
+// restockOrderItem puts the quantity of an order item back to the stock of its variant or product, in the warehouse it was fulfilled from
+func (c *Controller) restockOrderItem(ctx context.Context, tx *sql.Tx, orderItem models.OrderItem, quantity int, change stockChange) error {
+	change.WarehouseID = orderItem.WarehouseID.Int
+	return c.recordStockMovement(ctx, tx, orderItem.ProductID, orderItem.VariantID, quantity, change)
+}
+
+// recordStockMovement changes the quantity of the variant if given, or of the product, by recording the movement on the stock ledger
+func (c *Controller) recordStockMovement(ctx context.Context, tx *sql.Tx, productID int, variantID null.Int, delta int, change stockChange) error {
+	smReq := repositories.StockMovement{
+		ProductID: productID,
+		VariantID: variantID,
+		Delta:     delta,
+		Reason:    change.Reason,
+	}
+	if change.ReferenceID != 0 {
+		smReq.ReferenceID = null.IntFrom(change.ReferenceID)
+	}
+	if change.ActorID != 0 {
+		smReq.ActorID = null.IntFrom(change.ActorID)
+	}
+	if change.WarehouseID != 0 && !variantID.Valid {
+		smReq.WarehouseID = null.IntFrom(change.WarehouseID)
+	}
+
+	if _, err := c.Repository.RecordStockMovement(ctx, tx, smReq); err != nil {
+		if errors.Is(err, repositories.ErrInsufficientStock) {
+			return ErrInsufficientQuantity
+		}
+		return err
+	}
+
+	return nil
+}
+
+// deleteProductCache removes the cached products of the IDs once their changes have been committed, so that a product read
+// before the commit is not cached with its old stock or price. The changes are kept even if the cache could not be removed
+func (c *Controller) deleteProductCache(ctx context.Context, ids ...int) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := c.Repository.DeleteProductCache(ctx, ids...); err != nil {
+		log.Printf("could not remove the cached products %v: %v", ids, err)
+	}
+}
+
+type variantCSVInput struct {
+	ProductName string
+	SKU         string
+	Price       string
+	Quantity    string
+	Options     string
+}
+
+// importVariantCSV creates the variant of a CSV row or updates it if the SKU exists. The options of an existing
+// variant are kept if the row has none
+func (c *Controller) importVariantCSV(ctx context.Context, vInput variantCSVInput) error {
+	product, err := c.Repository.GetProductByName(ctx, strings.TrimSpace(vInput.ProductName))
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	var price decimal.NullDecimal
+	if s := strings.TrimSpace(vInput.Price); s != "" {
+		p, err := decimal.NewFromString(s)
+		if err != nil || !p.IsPositive() {
+			return ErrInvalidPrice
+		}
+		price = decimal.NewNullDecimal(p)
+	}
+
+	quantity, err := strconv.Atoi(strings.TrimSpace(vInput.Quantity))
+	if err != nil || quantity < 0 {
+		return ErrInvalidQuantity
+	}
+
+	options, err := parseVariantOptions(vInput.Options)
+	if err != nil {
+		return err
+	}
+
+	sku := strings.TrimSpace(vInput.SKU)
+	variant, err := c.Repository.GetVariantBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVariantNotFound) {
+			return c.CreateVariant(ctx, VariantInput{
+				ProductID: product.ID,
+				SKU:       sku,
+				Price:     price,
+				Quantity:  quantity,
+				Options:   options,
+			})
+		}
+		return err
+	}
+
+	// a sku always belongs to the same product
+	if variant.ProductID != product.ID {
+		return ErrSKUExists
+	}
+
+	optionValues := make(map[int]string)
+	if len(options) != 0 {
+		optionTypes, err := c.Repository.GetOptionTypes(ctx, product.ID)
+		if err != nil {
+			return err
+		}
+		if err := c.checkVariantOptions(ctx, product.ID, variant.ID, optionTypes, options); err != nil {
+			return err
+		}
+		for _, ot := range optionTypes {
+			optionValues[ot.ID] = options[ot.Name]
+		}
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	variant.Price = price
+	if err = c.Repository.UpdateVariant(ctx, tx, variant); err != nil {
+		return err
+	}
+
+	if delta := quantity - variant.Quantity; delta != 0 {
+		if err = c.adjustStock(ctx, tx, product, &variant, delta, stockChange{Reason: repositories.StockReasonImport}); err != nil {
+			return err
+		}
+	}
+
+	if len(optionValues) != 0 {
+		if err = c.Repository.SetVariantOptions(ctx, tx, variant.ID, optionValues); err != nil {
+			return err
+		}
+	}
+
+	return c.Repository.CommitTx(tx)
+}
+
+// parseVariantOptions parses the options of a CSV row written as Name=Value pairs separated by semicolons,
+// such as Size=M;Color=Red
+func parseVariantOptions(s string) (map[string]string, error) {
+	options := make(map[string]string)
+	for _, pair := range strings.Split(s, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(pair, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return nil, ErrInvalidVariantOptions
+		}
+		if _, exists := options[name]; exists {
+			return nil, ErrInvalidVariantOptions
+		}
+		options[name] = value
+	}
+
+	return options, nil
+}
+
+// formatVariantOptions writes the options of a variant the way parseVariantOptions reads them
+func formatVariantOptions(options []repositories.VariantOption) string {
+	pairs := make([]string, 0, len(options))
+	for _, o := range options {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", o.Name, o.Value))
+	}
+	return strings.Join(pairs, ";")
+}
@@ -0,0 +1,364 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test CreateVariant in Controller layer
+func Test_ProductVariantController_CreateVariant(t *testing.T) {
+	type mockVariantRepo struct {
+		skuErr       error
+		optionTypes  []models.OptionType
+		variants     []repositories.ProductVariantOutput
+		expCreate    bool
+		createOutput repositories.ProductVariant
+	}
+	tests := map[string]struct {
+		input           VariantInput
+		productErr      error
+		mockVariantRepo mockVariantRepo
+		expErr          error
+	}{
+		"first variant creates the option types": {
+			input: VariantInput{ProductID: 1, SKU: " TS-M-RED ", Quantity: 5, Options: map[string]string{"Size": "M", "Color": "Red"}},
+			mockVariantRepo: mockVariantRepo{
+				skuErr:       repositories.ErrVariantNotFound,
+				expCreate:    true,
+				createOutput: repositories.ProductVariant{ProductID: 1, SKU: "TS-M-RED", Quantity: 5, Options: map[int]string{10: "Red", 11: "M"}},
+			},
+		},
+		"later variant with a price override": {
+			input: VariantInput{ProductID: 1, SKU: "TS-L-RED", Price: decimal.NewNullDecimal(decimal.NewFromInt(120000)), Quantity: 2, Options: map[string]string{"Size": "L", "Color": "Red"}},
+			mockVariantRepo: mockVariantRepo{
+				skuErr:      repositories.ErrVariantNotFound,
+				optionTypes: []models.OptionType{{ID: 10, ProductID: 1, Name: "Color", Position: 0}, {ID: 11, ProductID: 1, Name: "Size", Position: 1}},
+				variants: []repositories.ProductVariantOutput{
+					{Variant: models.ProductVariant{ID: 1, ProductID: 1}, Options: []repositories.VariantOption{{OptionTypeID: 10, Name: "Color", Value: "Red"}, {OptionTypeID: 11, Name: "Size", Value: "M"}}},
+				},
+				expCreate:    true,
+				createOutput: repositories.ProductVariant{ProductID: 1, SKU: "TS-L-RED", Price: decimal.NewNullDecimal(decimal.NewFromInt(120000)), Quantity: 2, Options: map[int]string{10: "Red", 11: "L"}},
+			},
+		},
+		"product not found": {
+			input:      VariantInput{ProductID: 1, SKU: "TS-M-RED", Options: map[string]string{"Size": "M"}},
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"missing sku": {
+			input:  VariantInput{ProductID: 1, SKU: " ", Options: map[string]string{"Size": "M"}},
+			expErr: ErrMissingSKU,
+		},
+		"invalid price": {
+			input:  VariantInput{ProductID: 1, SKU: "TS-M-RED", Price: decimal.NewNullDecimal(decimal.Zero), Options: map[string]string{"Size": "M"}},
+			expErr: ErrInvalidPrice,
+		},
+		"missing options": {
+			input:  VariantInput{ProductID: 1, SKU: "TS-M-RED"},
+			expErr: ErrMissingVariantOptions,
+		},
+		"sku exists": {
+			input:  VariantInput{ProductID: 1, SKU: "TS-M-RED", Options: map[string]string{"Size": "M"}},
+			expErr: ErrSKUExists,
+		},
+		"options do not match the option types": {
+			input: VariantInput{ProductID: 1, SKU: "TS-M", Options: map[string]string{"Size": "M"}},
+			mockVariantRepo: mockVariantRepo{
+				skuErr:      repositories.ErrVariantNotFound,
+				optionTypes: []models.OptionType{{ID: 10, ProductID: 1, Name: "Color", Position: 0}, {ID: 11, ProductID: 1, Name: "Size", Position: 1}},
+			},
+			expErr: ErrVariantOptionsMismatch,
+		},
+		"duplicate options": {
+			input: VariantInput{ProductID: 1, SKU: "TS-M-RED-2", Options: map[string]string{"Size": "M", "Color": "Red"}},
+			mockVariantRepo: mockVariantRepo{
+				skuErr:      repositories.ErrVariantNotFound,
+				optionTypes: []models.OptionType{{ID: 10, ProductID: 1, Name: "Color", Position: 0}, {ID: 11, ProductID: 1, Name: "Size", Position: 1}},
+				variants: []repositories.ProductVariantOutput{
+					{Variant: models.ProductVariant{ID: 1, ProductID: 1}, Options: []repositories.VariantOption{{OptionTypeID: 10, Name: "Color", Value: "Red"}, {OptionTypeID: 11, Name: "Size", Value: "M"}}},
+				},
+			},
+			expErr: ErrDuplicateVariant,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			mockRepo.On("GetProduct", context.Background(), tc.input.ProductID).Return(models.Product{ID: tc.input.ProductID}, tc.productErr)
+			// the sku is not looked up if the input is invalid
+			mockRepo.On("GetVariantBySKU", context.Background(), strings.TrimSpace(tc.input.SKU)).Return(models.ProductVariant{ID: 1}, tc.mockVariantRepo.skuErr).Maybe()
+			if tc.mockVariantRepo.skuErr != nil {
+				mockRepo.On("GetOptionTypes", context.Background(), tc.input.ProductID).Return(tc.mockVariantRepo.optionTypes, nil)
+				if len(tc.mockVariantRepo.optionTypes) != 0 && tc.expErr != ErrVariantOptionsMismatch {
+					mockRepo.On("GetProductVariants", context.Background(), tc.input.ProductID).Return(tc.mockVariantRepo.variants, nil)
+				}
+			}
+			if tc.mockVariantRepo.expCreate {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				if len(tc.mockVariantRepo.optionTypes) == 0 {
+					// the option types are created in the order of their names
+					mockRepo.On("CreateOptionType", context.Background(), &tx, tc.input.ProductID, "Color", 0).Return(models.OptionType{ID: 10, ProductID: 1, Name: "Color", Position: 0}, nil)
+					mockRepo.On("CreateOptionType", context.Background(), &tx, tc.input.ProductID, "Size", 1).Return(models.OptionType{ID: 11, ProductID: 1, Name: "Size", Position: 1}, nil)
+				}
+				mockRepo.On("CreateVariant", context.Background(), &tx, tc.mockVariantRepo.createOutput).Return(models.ProductVariant{ID: 2}, nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			err := controller.CreateVariant(context.Background(), tc.input)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test GetVariants in Controller layer
+func Test_ProductVariantController_GetVariants(t *testing.T) {
+	product := models.Product{ID: 1, Price: decimal.NewFromInt(100000), Currency: CurrencyVND}
+	variants := []repositories.ProductVariantOutput{
+		{
+			Variant: models.ProductVariant{ID: 1, ProductID: 1, Sku: "TS-L", Price: decimal.NewNullDecimal(decimal.NewFromInt(120000)), Quantity: 2},
+			Options: []repositories.VariantOption{{OptionTypeID: 10, Name: "Size", Value: "L"}},
+		},
+		{
+			Variant: models.ProductVariant{ID: 2, ProductID: 1, Sku: "TS-M", Quantity: 5},
+			Options: []repositories.VariantOption{{OptionTypeID: 10, Name: "Size", Value: "M"}},
+		},
+	}
+
+	tests := map[string]struct {
+		currency   string
+		productErr error
+		rate       decimal.Decimal
+		expOutput  []VariantOutput
+		expErr     error
+	}{
+		"variants in the currency of the product": {
+			expOutput: []VariantOutput{
+				{ID: 1, ProductID: 1, SKU: "TS-L", Price: decimal.NewFromInt(120000), Currency: CurrencyVND, Quantity: 2, Options: []VariantOptionOutput{{Name: "Size", Value: "L"}}},
+				{ID: 2, ProductID: 1, SKU: "TS-M", Price: decimal.NewFromInt(100000), Currency: CurrencyVND, Quantity: 5, Options: []VariantOptionOutput{{Name: "Size", Value: "M"}}},
+			},
+		},
+		"variants converted to USD": {
+			currency: CurrencyUSD,
+			rate:     decimal.NewFromInt(25000),
+			expOutput: []VariantOutput{
+				{ID: 1, ProductID: 1, SKU: "TS-L", Price: decimal.NewFromFloat(4.8), Currency: CurrencyUSD, Quantity: 2, Options: []VariantOptionOutput{{Name: "Size", Value: "L"}}},
+				{ID: 2, ProductID: 1, SKU: "TS-M", Price: decimal.NewFromInt(4), Currency: CurrencyUSD, Quantity: 5, Options: []VariantOptionOutput{{Name: "Size", Value: "M"}}},
+			},
+		},
+		"product not found": {
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(product, tc.productErr)
+			if tc.productErr == nil {
+				mockRepo.On("GetProductVariants", context.Background(), 1).Return(variants, nil)
+			}
+			if tc.currency == CurrencyUSD {
+				mockRepo.On("GetEffectiveExchangeRate", context.Background(), CurrencyUSD, mock.AnythingOfType("time.Time")).Return(models.ExchangeRate{Currency: CurrencyUSD, Rate: tc.rate}, nil)
+			}
+
+			output, err := controller.GetVariants(context.Background(), 1, tc.currency)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.expOutput), len(output))
+			for i := range tc.expOutput {
+				assert.True(t, tc.expOutput[i].Price.Equal(output[i].Price))
+				output[i].Price = tc.expOutput[i].Price
+			}
+			assert.Equal(t, tc.expOutput, output)
+		})
+	}
+}
+
+// Test UpdateVariant in Controller layer
+func Test_ProductVariantController_UpdateVariant(t *testing.T) {
+	tests := map[string]struct {
+		productID  int
+		price      decimal.NullDecimal
+		quantity   int
+		variant    models.ProductVariant
+		variantErr error
+		expUpdate  bool
+		expErr     error
+	}{
+		"success": {
+			productID: 1,
+			price:     decimal.NewNullDecimal(decimal.NewFromInt(90000)),
+			quantity:  3,
+			variant:   models.ProductVariant{ID: 2, ProductID: 1, Sku: "TS-M", Quantity: 5},
+			expUpdate: true,
+		},
+		"variant of another product": {
+			productID: 2,
+			variant:   models.ProductVariant{ID: 2, ProductID: 1, Sku: "TS-M", Quantity: 5},
+			expErr:    ErrVariantNotFound,
+		},
+		"variant not found": {
+			productID:  1,
+			variantErr: repositories.ErrVariantNotFound,
+			expErr:     ErrVariantNotFound,
+		},
+		"invalid quantity": {
+			productID: 1,
+			quantity:  -1,
+			variant:   models.ProductVariant{ID: 2, ProductID: 1, Sku: "TS-M", Quantity: 5},
+			expErr:    ErrInvalidQuantity,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			mockRepo.On("GetVariant", context.Background(), 2).Return(tc.variant, tc.variantErr)
+			if tc.expUpdate {
+				updated := tc.variant
+				updated.Price = tc.price
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("UpdateVariant", context.Background(), &tx, updated).Return(nil)
+				mockRepo.On("RecordStockMovement", context.Background(), &tx, repositories.StockMovement{
+					ProductID: 1,
+					VariantID: null.IntFrom(2),
+					Delta:     tc.quantity - tc.variant.Quantity,
+					Reason:    repositories.StockReasonAdjustment,
+				}).Return(models.StockMovement{}, nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+			}
+
+			err := controller.UpdateVariant(context.Background(), tc.productID, 2, tc.price, tc.quantity)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test the order item stock lookup in Controller layer
+func Test_ProductVariantController_GetOrderItemStock(t *testing.T) {
+	tests := map[string]struct {
+		variantID    int
+		variantCount int64
+		variant      models.ProductVariant
+		expVariant   bool
+		expErr       error
+	}{
+		"product without variants": {},
+		"product with variants requires a variant": {
+			variantCount: 2,
+			expErr:       ErrVariantRequired,
+		},
+		"variant of the product": {
+			variantID:  7,
+			variant:    models.ProductVariant{ID: 7, ProductID: 1, Quantity: 3},
+			expVariant: true,
+		},
+		"variant of another product": {
+			variantID: 7,
+			variant:   models.ProductVariant{ID: 7, ProductID: 2, Quantity: 3},
+			expErr:    ErrVariantNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(models.Product{ID: 1, Quantity: 10}, nil)
+			if tc.variantID == 0 {
+				mockRepo.On("CountProductVariants", context.Background(), 1).Return(tc.variantCount, nil)
+			} else {
+				mockRepo.On("GetVariant", context.Background(), tc.variantID).Return(tc.variant, nil)
+			}
+
+			product, variant, err := controller.getOrderItemStock(context.Background(), 1, tc.variantID)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, product.ID)
+			if tc.expVariant {
+				assert.Equal(t, &tc.variant, variant)
+			} else {
+				assert.Nil(t, variant)
+			}
+		})
+	}
+}
+
+// Test parseVariantOptions in Controller layer
+func Test_ProductVariantController_ParseVariantOptions(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		expOutput map[string]string
+		expErr    error
+	}{
+		"options": {
+			input:     "Size=M; Color = Red",
+			expOutput: map[string]string{"Size": "M", "Color": "Red"},
+		},
+		"no options": {
+			input:     "",
+			expOutput: map[string]string{},
+		},
+		"missing value": {
+			input:  "Size=",
+			expErr: ErrInvalidVariantOptions,
+		},
+		"missing separator": {
+			input:  "Size M",
+			expErr: ErrInvalidVariantOptions,
+		},
+		"repeated option": {
+			input:  "Size=M;Size=L",
+			expErr: ErrInvalidVariantOptions,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			output, err := parseVariantOptions(tc.input)
+			if tc.expErr != nil {
+				assert.True(t, errors.Is(err, tc.expErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expOutput, output)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package controllers
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"io"
 	"log"
@@ -12,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/qthuy2k1/product-management/internal/repositories"
 	"github.com/qthuy2k1/product-management/internal/utils/email"
 	"github.com/shopspring/decimal"
@@ -25,10 +28,36 @@ type ProductInput struct {
 	Quantity     int
 	AuthorID     int
 	CategoryName string
+	Weight       decimal.Decimal
+	Currency     string
+	// SKU is generated from the id of the product on create if empty. It cannot be changed, an update with another sku is rejected
+	SKU string
+	// ReorderThreshold is the quantity below which the product is low on stock, 0 disables the alerts.
+	// The threshold of the product is kept on update if not given
+	ReorderThreshold *int
+	// Tags are free-form, they are kept lowercase. The tags of the product are kept on update if nil
+	Tags []string
+	// Attributes are the values of the attributes defined for the category of the product by the name of the attribute,
+	// they replace all the values of the product. The values of the product are kept on update if nil
+	Attributes map[string]string
+	// ExpectedVersion is the version of the product the update is based on, it is not used on create
+	ExpectedVersion int
 }
 
 // CreateProduct creates a product in db given by product model in parameter
 func (c *Controller) CreateProduct(ctx context.Context, pInput ProductInput) error {
+	if err := validateProductSKU(pInput.SKU); err != nil {
+		return err
+	}
+	// check the sku is not used by another product
+	if pInput.SKU != "" {
+		if _, err := c.Repository.GetProductBySKU(ctx, pInput.SKU); err == nil {
+			return ErrSKUExists
+		} else if !errors.Is(err, repositories.ErrProductNotFound) {
+			return err
+		}
+	}
+
 	// check user exists
 	if _, err := c.Repository.GetUser(ctx, pInput.AuthorID); err != nil {
 		return err
@@ -40,28 +69,75 @@ func (c *Controller) CreateProduct(ctx context.Context, pInput ProductInput) err
 		return err
 	}
 
+	// the price is in VND by the column default if no currency is given
+	if pInput.Currency != "" && !IsSupportedCurrency(pInput.Currency) {
+		return ErrInvalidCurrency
+	}
+
+	var reorderThreshold int
+	if pInput.ReorderThreshold != nil {
+		if *pInput.ReorderThreshold < 0 {
+			return ErrInvalidReorderThreshold
+		}
+		reorderThreshold = *pInput.ReorderThreshold
+	}
+
+	tags, err := normalizeTags(pInput.Tags)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := c.getProductAttributeValues(ctx, pCate.ID, pInput.Attributes)
+	if err != nil {
+		return err
+	}
+
 	product := repositories.Product{
-		Name:        pInput.Name,
-		Description: pInput.Description,
-		Price:       pInput.Price,
-		Quantity:    pInput.Quantity,
-		AuthorID:    pInput.AuthorID,
-		CategoryID:  pCate.ID,
+		SKU:              pInput.SKU,
+		Name:             pInput.Name,
+		Description:      pInput.Description,
+		Price:            pInput.Price,
+		Quantity:         pInput.Quantity,
+		AuthorID:         pInput.AuthorID,
+		CategoryID:       pCate.ID,
+		Weight:           pInput.Weight,
+		Currency:         pInput.Currency,
+		ReorderThreshold: reorderThreshold,
+		Tags:             tags,
+		Attributes:       attributes,
 	}
 
 	return c.Repository.CreateProduct(ctx, product)
 }
 
-// UpdateProduct updates a product in db given by product model in parameter
+// UpdateProduct updates a product in db given by product model in parameter. The update is rejected
+// if the product has been updated since the version expected by the input
 func (c *Controller) UpdateProduct(ctx context.Context, pInput ProductInput) error {
-	// check product exists
-	product, err := c.Repository.GetProduct(ctx, pInput.ID)
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the product is locked so that it is not updated by anyone else between the check of the version and the update
+	product, err := c.Repository.LockProduct(ctx, tx, pInput.ID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrProductNotFound) {
 			return ErrProductNotFound
 		}
 		return err
 	}
+	// an archived product must be restored before it is updated
+	if product.DeletedAt.Valid {
+		return ErrProductNotFound
+	}
+	if product.Version != pInput.ExpectedVersion {
+		return ErrProductVersionConflict
+	}
+	if pInput.SKU != "" && pInput.SKU != product.Sku {
+		return ErrSKUImmutable
+	}
 
 	// check user exists
 	if _, err := c.Repository.GetUser(ctx, pInput.AuthorID); err != nil {
@@ -74,17 +150,83 @@ func (c *Controller) UpdateProduct(ctx context.Context, pInput ProductInput) err
 		return err
 	}
 
+	currentPrice, currentCurrency := product.Price, product.Currency
+
 	product.Name = pInput.Name
 	product.Description = pInput.Description
 	product.Price = pInput.Price
-	product.Quantity = pInput.Quantity
 	product.AuthorID = pInput.AuthorID
 	product.CategoryID = pCate.ID
+	product.Weight = pInput.Weight
+	// keep the current currency if no currency is given
+	if pInput.Currency != "" {
+		if !IsSupportedCurrency(pInput.Currency) {
+			return ErrInvalidCurrency
+		}
+		product.Currency = pInput.Currency
+	}
+	if pInput.ReorderThreshold != nil {
+		if *pInput.ReorderThreshold < 0 {
+			return ErrInvalidReorderThreshold
+		}
+		product.ReorderThreshold = *pInput.ReorderThreshold
+	}
+
+	tags, err := normalizeTags(pInput.Tags)
+	if err != nil {
+		return err
+	}
+
+	// the values are validated against the attributes of the new category if it is changed
+	attributes, err := c.getProductAttributeValues(ctx, pCate.ID, pInput.Attributes)
+	if err != nil {
+		return err
+	}
+
+	priceChanged := !product.Price.Equal(currentPrice) || product.Currency != currentCurrency
+	delta := pInput.Quantity - product.Quantity
 
-	return c.Repository.UpdateProduct(ctx, nil, product)
+	if err = c.Repository.UpdateProduct(ctx, tx, product); err != nil {
+		return err
+	}
+
+	// a changed price is added to the price history of the product
+	if priceChanged {
+		if err = c.recordPriceChange(ctx, tx, product); err != nil {
+			return err
+		}
+	}
+
+	// the new quantity is recorded as an adjustment of the stock by the author
+	if delta != 0 {
+		if err = c.adjustStock(ctx, tx, product, nil, delta, stockChange{Reason: repositories.StockReasonAdjustment, ActorID: pInput.AuthorID}); err != nil {
+			return err
+		}
+	}
+
+	if tags != nil {
+		if err = c.Repository.SetProductTags(ctx, tx, product.ID, tags); err != nil {
+			return err
+		}
+	}
+
+	if attributes != nil {
+		if err = c.Repository.SetProductAttributes(ctx, tx, product.ID, attributes); err != nil {
+			return err
+		}
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	c.deleteProductCache(ctx, product.ID)
+
+	return nil
 }
 
-// DeleteProduct deletes a product in db by ID
+// DeleteProduct archives a product by ID. The archived product is hidden from the listings but still resolved from the orders,
+// it can be restored
 func (c *Controller) DeleteProduct(ctx context.Context, id int) error {
 	if err := c.Repository.DeleteProduct(ctx, id); err != nil {
 		if errors.Is(err, repositories.ErrProductNotFound) {
@@ -95,55 +237,369 @@ func (c *Controller) DeleteProduct(ctx context.Context, id int) error {
 	return nil
 }
 
+// RestoreProduct restores an archived product by ID
+func (c *Controller) RestoreProduct(ctx context.Context, id int) error {
+	product, err := c.Repository.GetProduct(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+	if !product.DeletedAt.Valid {
+		return ErrProductNotArchived
+	}
+
+	if err := c.Repository.RestoreProduct(ctx, id); err != nil {
+		// the product has been restored concurrently
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotArchived
+		}
+		return err
+	}
+	return nil
+}
+
+// PurgeArchivedProducts deletes the products archived before the time with their variants and images, and returns the number
+// of deleted products. The products which have been ordered are kept so that the orders can still be resolved
+func (c *Controller) PurgeArchivedProducts(ctx context.Context, archivedBefore time.Time) (int, error) {
+	if archivedBefore.IsZero() {
+		return 0, ErrMissingArchivedBefore
+	}
+
+	ids, err := c.Repository.GetPurgeableProducts(ctx, archivedBefore)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// the images are retrieved before their rows are deleted with the products
+	images, err := c.Repository.GetProductImages(ctx, ids...)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := c.Repository.PurgeProducts(ctx, archivedBefore, ids...)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := make(map[int]bool)
+	for _, id := range deleted {
+		purged[id] = true
+	}
+	for _, i := range images {
+		if purged[i.ProductID] {
+			c.deleteProductImageFiles(ctx, i)
+		}
+	}
+
+	return len(deleted), nil
+}
+
+// columns the products can be sorted by
+const (
+	ProductSortName      = "name"
+	ProductSortPrice     = "price"
+	ProductSortQuantity  = "quantity"
+	ProductSortCreatedAt = "created_at"
+)
+
+// number of products in a page by default and at most
+const (
+	defaultProductsLimit = 20
+	maxProductsLimit     = 100
+)
+
+// IsValidProductSort reports whether the products can be sorted by the column
+func IsValidProductSort(sortBy string) bool {
+	switch sortBy {
+	case ProductSortName, ProductSortPrice, ProductSortQuantity, ProductSortCreatedAt:
+		return true
+	}
+	return false
+}
+
 type ProductCtrlFilter struct {
-	Name  string
-	Date  string
-	Email []string
+	Name     string
+	Date     string
+	Email    []string
+	Currency string
+	// MinPrice and MaxPrice are compared with the prices in the currency of each product
+	MinPrice    *decimal.Decimal
+	MaxPrice    *decimal.Decimal
+	CategoryID  int
+	AuthorID    int
+	InStock     bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Tags lists the products which have all the tags
+	Tags []string
+	// Attributes lists the products matching all the attribute filters
+	Attributes []AttributeFilterInput
+	// Archived lists the archived products instead of the active ones
+	Archived bool
+	// SortBy is one of name, price, quantity or created_at, the products are sorted by id if empty
+	SortBy   string
+	SortDesc bool
+	// Limit defaults to 20, the Cursor of the next page cannot be used with an Offset
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+type ProductPageInfo struct {
+	// TotalCount is the number of products matching the filter in all pages
+	TotalCount int64
+	// NextCursor is the cursor of the next page, it is empty on the last page
+	NextCursor string
+}
+
+// productCursor is the content of the opaque cursor of the next page, the sort is kept to check that the cursor is used with the same sort
+type productCursor struct {
+	SortBy   string `json:"s,omitempty"`
+	SortDesc bool   `json:"d,omitempty"`
+	Value    string `json:"v,omitempty"`
+	ID       int    `json:"id"`
+}
+
+// toProductRepoFilter validates the filter and the page of products and converts them to the filter in repository layer
+func toProductRepoFilter(filter ProductCtrlFilter) (repositories.ProductRepoFilter, error) {
+	if filter.SortBy != "" && !IsValidProductSort(filter.SortBy) {
+		return repositories.ProductRepoFilter{}, ErrInvalidProductSort
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && filter.MinPrice.GreaterThan(*filter.MaxPrice) {
+		return repositories.ProductRepoFilter{}, ErrInvalidPriceRange
+	}
+
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedFrom.After(filter.CreatedTo) {
+		return repositories.ProductRepoFilter{}, ErrStartDateAfterEndDate
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultProductsLimit
+	}
+	if limit < 0 || limit > maxProductsLimit {
+		return repositories.ProductRepoFilter{}, ErrInvalidProductLimit
+	}
+
+	if filter.Offset < 0 {
+		return repositories.ProductRepoFilter{}, ErrInvalidOffset
+	}
+
+	repoFilter, err := productFilterToRepo(filter)
+	if err != nil {
+		return repositories.ProductRepoFilter{}, err
+	}
+	repoFilter.SortBy = filter.SortBy
+	repoFilter.SortDesc = filter.SortDesc
+	repoFilter.Limit = limit
+	repoFilter.Offset = filter.Offset
+
+	if filter.Cursor != "" {
+		if filter.Offset != 0 {
+			return repositories.ProductRepoFilter{}, ErrCursorWithOffset
+		}
+
+		cursor, err := decodeProductCursor(filter.Cursor)
+		if err != nil || cursor.SortBy != filter.SortBy || cursor.SortDesc != filter.SortDesc {
+			return repositories.ProductRepoFilter{}, ErrInvalidCursor
+		}
+		repoFilter.After = &repositories.ProductCursor{Value: cursor.Value, ID: cursor.ID}
+	}
+
+	return repoFilter, nil
+}
+
+// productFilterToRepo validates the filters of products and converts them to the ones in repository layer, without sorting and pagination
+func productFilterToRepo(filter ProductCtrlFilter) (repositories.ProductRepoFilter, error) {
+	repoFilter := repositories.ProductRepoFilter{
+		Name:        filter.Name,
+		Date:        filter.Date,
+		CategoryID:  filter.CategoryID,
+		AuthorID:    filter.AuthorID,
+		InStock:     filter.InStock,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		Archived:    filter.Archived,
+	}
+	if filter.MinPrice != nil {
+		repoFilter.MinPrice = decimal.NewNullDecimal(*filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		repoFilter.MaxPrice = decimal.NewNullDecimal(*filter.MaxPrice)
+	}
+
+	tags, err := normalizeTags(filter.Tags)
+	if err != nil {
+		return repositories.ProductRepoFilter{}, err
+	}
+	repoFilter.Tags = tags
+
+	if repoFilter.Attributes, err = toAttributeFilters(filter.Attributes); err != nil {
+		return repositories.ProductRepoFilter{}, err
+	}
+
+	return repoFilter, nil
+}
+
+// nextProductCursor returns the cursor of the page after the given last product, it is empty if the page is not full
+func nextProductCursor(filter repositories.ProductRepoFilter, pageSize int, last repositories.Product) string {
+	if filter.Limit == 0 || pageSize < filter.Limit {
+		return ""
+	}
+
+	cursor := productCursor{SortBy: filter.SortBy, SortDesc: filter.SortDesc, ID: last.ID}
+	switch filter.SortBy {
+	case ProductSortName:
+		cursor.Value = last.Name
+	case ProductSortPrice:
+		cursor.Value = last.Price.String()
+	case ProductSortQuantity:
+		cursor.Value = strconv.Itoa(last.Quantity)
+	case ProductSortCreatedAt:
+		cursor.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeProductCursor decodes the opaque cursor of a page of products
+func decodeProductCursor(cursor string) (productCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return productCursor{}, err
+	}
+
+	var pCursor productCursor
+	if err := json.Unmarshal(data, &pCursor); err != nil {
+		return productCursor{}, err
+	}
+	if pCursor.ID <= 0 {
+		return productCursor{}, ErrInvalidCursor
+	}
+
+	// the value is compared with the sorted column so it must be of the type of the column
+	switch pCursor.SortBy {
+	case ProductSortPrice:
+		_, err = decimal.NewFromString(pCursor.Value)
+	case ProductSortQuantity:
+		_, err = strconv.Atoi(pCursor.Value)
+	case ProductSortCreatedAt:
+		_, err = time.Parse(time.RFC3339Nano, pCursor.Value)
+	}
+	if err != nil {
+		return productCursor{}, err
+	}
+
+	return pCursor, nil
 }
 
 type ProductOutput struct {
 	ID           int
+	SKU          string
+	Slug         string
 	Name         string
 	Description  string
 	Price        decimal.Decimal
 	Quantity     int
 	AuthorID     int
 	CategoryName string
+	Currency     string
+	Images       []ProductImageOutput
+	Tags         []string
+	Attributes   []ProductAttributeOutput
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	// ArchivedAt is the time the product was archived, nil if it is active
+	ArchivedAt *time.Time
+	// RatingAverage is the average rating of the approved reviews of the product, 0 if it has none
+	RatingAverage decimal.Decimal
+	ReviewCount   int
 }
 
-// GetProducts retrieves all the products in db
-func (c *Controller) GetProducts(ctx context.Context, filter ProductCtrlFilter) ([]ProductOutput, error) {
-	pRepoFilter := repositories.ProductRepoFilter{
-		Name: filter.Name,
-		Date: filter.Date,
+// GetProducts retrieves a page of the products in db matching the filter and the total count of them,
+// the prices are converted to the currency of the filter if given
+func (c *Controller) GetProducts(ctx context.Context, filter ProductCtrlFilter) ([]ProductOutput, ProductPageInfo, error) {
+	pRepoFilter, err := toProductRepoFilter(filter)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
 	}
 
 	products, err := c.Repository.GetProducts(ctx, pRepoFilter)
 	if err != nil {
-		return nil, err
+		return nil, ProductPageInfo{}, err
+	}
+
+	totalCount, err := c.Repository.CountProducts(ctx, pRepoFilter)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
 	}
 
+	pageInfo := ProductPageInfo{TotalCount: totalCount}
 	if len(products) == 0 {
-		return nil, nil
+		return nil, pageInfo, nil
 	}
 
+	last := products[len(products)-1]
+	pageInfo.NextCursor = nextProductCursor(pRepoFilter, len(products), repositories.Product{ID: last.ID, Name: last.Name, Price: last.Price, Quantity: last.Quantity, CreatedAt: last.CreatedAt})
+
+	productIDs := make([]int, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+	galleries, err := c.getProductGalleries(ctx, productIDs...)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
+	}
+
+	tags, attributes, err := c.getProductSpecs(ctx, productIDs...)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
+	}
+
+	converter := &priceConverter{controller: c, currency: filter.Currency}
+
 	var pListResp []ProductOutput
 	for _, product := range products {
-		pListResp = append(pListResp, ProductOutput{
-			ID:           product.ID,
-			Name:         product.Name,
-			Description:  product.Description,
-			Price:        product.Price,
-			Quantity:     product.Quantity,
-			AuthorID:     product.AuthorID,
-			CategoryName: product.CategoryName,
-			CreatedAt:    product.CreatedAt,
-			UpdatedAt:    product.UpdatedAt,
-		})
-	}
-	return pListResp, nil
+		pOutput := ProductOutput{
+			ID:            product.ID,
+			SKU:           product.SKU,
+			Slug:          product.Slug,
+			Name:          product.Name,
+			Description:   product.Description,
+			Price:         product.Price,
+			Quantity:      product.Quantity,
+			AuthorID:      product.AuthorID,
+			CategoryName:  product.CategoryName,
+			Currency:      product.Currency,
+			Images:        galleries[product.ID],
+			Tags:          tags[product.ID],
+			Attributes:    attributes[product.ID],
+			CreatedAt:     product.CreatedAt,
+			UpdatedAt:     product.UpdatedAt,
+			RatingAverage: product.RatingAverage,
+			ReviewCount:   product.ReviewCount,
+		}
+		if product.DeletedAt.Valid {
+			pOutput.ArchivedAt = &product.DeletedAt.Time
+		}
+
+		if filter.Currency != "" {
+			if pOutput.Price, err = converter.convert(ctx, product.Price, product.Currency); err != nil {
+				return nil, ProductPageInfo{}, err
+			}
+			pOutput.Currency = filter.Currency
+		}
+
+		pListResp = append(pListResp, pOutput)
+	}
+	return pListResp, pageInfo, nil
 }
 
 type ProductIndexHeader struct {
@@ -155,7 +611,15 @@ type ProductIndexHeader struct {
 	Category    int
 }
 
+// variantCSVHeader is the index of the optional variant columns, -1 if the file does not have them.
+// A row with a SKU is a variant of the product of the same name, its Price overrides the price of the product if not blank
+type variantCSVHeader struct {
+	SKU     int
+	Options int
+}
+
 type ProductCSVInput struct {
+	SKU          string
 	Name         string
 	Description  string
 	Price        string
@@ -164,18 +628,24 @@ type ProductCSVInput struct {
 	CategoryName string
 }
 
-// ImportProductsFromCSV imports list of products data from a CSV file
+// ImportProductsFromCSV imports list of products data from a CSV file. The quantity of a product is the quantity in
+// the warehouse of the optional Warehouse column, or in the default warehouse. The optional Tags column is a list of tags
+// separated by ; and the optional Attributes column a list of Name=Value pairs separated by ;, they replace the tags
+// and the attributes of an existing product. A product with the optional ProductSKU column is matched by its sku and
+// renamed if its name is changed, a product without it is matched by its name
 func (c *Controller) ImportProductsFromCSV(ctx context.Context, file multipart.File) error {
 	records, err := csv.NewReader(file).ReadAll()
 	if err != nil {
 		return ErrCSVFileFormat
 	}
 	// check if the file doesn't have enough columns
-	if (reflect.TypeOf(ProductInput{}).NumField()-1 > len(records[0])) {
+	if reflect.TypeOf(ProductIndexHeader{}).NumField() > len(records[0]) {
 		return ErrNotEnoughColumns
 	}
 
 	var pHeader ProductIndexHeader
+	vHeader := variantCSVHeader{SKU: -1, Options: -1}
+	skuIndex, warehouseIndex, tagsIndex, attributesIndex := -1, -1, -1, -1
 	for i, r := range records[0] {
 		switch r {
 		case "Name":
@@ -190,6 +660,18 @@ func (c *Controller) ImportProductsFromCSV(ctx context.Context, file multipart.F
 			pHeader.AuthorID = i
 		case "Category":
 			pHeader.Category = i
+		case "ProductSKU":
+			skuIndex = i
+		case "SKU":
+			vHeader.SKU = i
+		case "Options":
+			vHeader.Options = i
+		case "Warehouse":
+			warehouseIndex = i
+		case "Tags":
+			tagsIndex = i
+		case "Attributes":
+			attributesIndex = i
 		}
 	}
 
@@ -213,6 +695,13 @@ func (c *Controller) ImportProductsFromCSV(ctx context.Context, file multipart.F
 	numChunks := (len(records) + chunkSize - 1) / chunkSize // round up division
 
 	productsInput := []repositories.Product{}
+	var variantRecords [][]string
+	// the IDs of the warehouses of the rows by name
+	warehouseIDs := make(map[string]int)
+	// the skus of the rows with a sku by product name
+	skus := make(map[string]string)
+	// the attribute definitions of the categories of the rows by category ID
+	attributeDefinitions := make(map[int][]models.AttributeDefinition)
 	for i := 0; i < numChunks; i++ {
 		start := i * chunkSize
 		end := (i + 1) * chunkSize
@@ -222,34 +711,159 @@ func (c *Controller) ImportProductsFromCSV(ctx context.Context, file multipart.F
 
 		// iterate the records and append it to product controller input
 		for _, record := range records[start:end] {
-			product, err := c.validateAndConvertProductCSV(ctx, ProductCSVInput{
+			// the variants are imported after their products
+			if vHeader.SKU >= 0 && strings.TrimSpace(record[vHeader.SKU]) != "" {
+				variantRecords = append(variantRecords, record)
+				continue
+			}
+
+			pCSVInput := ProductCSVInput{
 				Name:         record[pHeader.Name],
 				Description:  record[pHeader.Description],
 				Price:        record[pHeader.Price],
 				Quantity:     record[pHeader.Quantity],
 				AuthorID:     record[pHeader.AuthorID],
 				CategoryName: record[pHeader.Category],
-			}, userDefault.ID, pCateDefault.ID)
+			}
+			if skuIndex >= 0 {
+				pCSVInput.SKU = record[skuIndex]
+			}
+
+			product, err := c.validateAndConvertProductCSV(ctx, pCSVInput, userDefault.ID, pCateDefault.ID)
 			if err != nil {
 				log.Println(err, "at product:", record[pHeader.Name])
 				continue
 			}
 
+			if err = c.checkCSVProductName(ctx, product, skus); err != nil {
+				log.Println(err, "at product:", record[pHeader.Name])
+				continue
+			}
+
+			if warehouseIndex >= 0 {
+				if product.WarehouseID, err = c.getCSVWarehouseID(ctx, record[warehouseIndex], warehouseIDs); err != nil {
+					log.Println(err, "at product:", record[pHeader.Name])
+					continue
+				}
+			}
+
+			if tagsIndex >= 0 {
+				if product.Tags, err = parseCSVTags(record[tagsIndex]); err != nil {
+					log.Println(err, "at product:", record[pHeader.Name])
+					continue
+				}
+			}
+
+			if attributesIndex >= 0 {
+				if product.Attributes, err = c.getCSVAttributeValues(ctx, product.CategoryID, record[attributesIndex], attributeDefinitions); err != nil {
+					log.Println(err, "at product:", record[pHeader.Name])
+					continue
+				}
+			}
+
 			productsInput = append(productsInput, product)
 		}
 	}
 
-	if err := c.Repository.UpsertProducts(ctx, productsInput); err != nil {
+	if len(productsInput) != 0 || len(variantRecords) == 0 {
+		if err := c.Repository.UpsertProducts(ctx, productsInput); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range variantRecords {
+		vInput := variantCSVInput{
+			ProductName: record[pHeader.Name],
+			SKU:         record[vHeader.SKU],
+			Price:       record[pHeader.Price],
+			Quantity:    record[pHeader.Quantity],
+		}
+		if vHeader.Options >= 0 {
+			vInput.Options = record[vHeader.Options]
+		}
+
+		if err := c.importVariantCSV(ctx, vInput); err != nil {
+			log.Println(err, "at variant:", record[vHeader.SKU])
+		}
+	}
+
+	return nil
+}
+
+// getCSVWarehouseID retrieves the ID of the warehouse of the name in a CSV row, 0 for the default warehouse if the name is blank.
+// The IDs are kept in the map by name so that each warehouse is retrieved once per import
+func (c *Controller) getCSVWarehouseID(ctx context.Context, name string, warehouseIDs map[string]int) (int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, nil
+	}
+	if id, ok := warehouseIDs[name]; ok {
+		return id, nil
+	}
+
+	warehouse, err := c.Repository.GetWarehouseByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWarehouseNotFound) {
+			return 0, ErrWarehouseNotFound
+		}
+		return 0, err
+	}
+	warehouseIDs[name] = warehouse.ID
+
+	return warehouse.ID, nil
+}
+
+// checkCSVProductName checks that the name of a CSV row with a sku does not belong to a product of another sku, as the row
+// is matched by its sku the name would be taken twice. The skus of the rows are kept in the map by name so that two rows
+// of different skus cannot take the same name either
+func (c *Controller) checkCSVProductName(ctx context.Context, product repositories.Product, skus map[string]string) error {
+	if product.SKU == "" {
+		return nil
+	}
+	if sku, ok := skus[product.Name]; ok {
+		if sku != product.SKU {
+			return ErrNameExists
+		}
+		return nil
+	}
+
+	existing, err := c.Repository.GetProductByName(ctx, product.Name)
+	if err == nil && existing.Sku != product.SKU {
+		return ErrNameExists
+	} else if err != nil && !errors.Is(err, repositories.ErrProductNotFound) {
 		return err
 	}
+	skus[product.Name] = product.SKU
 
 	return nil
 }
 
+// getCSVAttributeValues parses the values of the attributes in a CSV row of a product of the category. The attribute
+// definitions are kept in the map by category ID so that the ones of each category are retrieved once per import
+func (c *Controller) getCSVAttributeValues(ctx context.Context, categoryID int, s string, definitions map[int][]models.AttributeDefinition) ([]repositories.ProductAttributeValue, error) {
+	attributes, err := parseCSVAttributes(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := definitions[categoryID]; !ok && len(attributes) != 0 {
+		if definitions[categoryID], err = c.Repository.GetAttributeDefinitions(ctx, categoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	return toProductAttributeValues(definitions[categoryID], attributes)
+}
+
 func (c *Controller) validateAndConvertProductCSV(ctx context.Context, product ProductCSVInput, userIDDefault int, pCateIDDefault int) (repositories.Product, error) {
+	product.SKU = strings.TrimSpace(product.SKU)
 	product.Name = strings.TrimSpace(product.Name)
 	product.Description = strings.TrimSpace(product.Description)
 
+	if err := validateProductSKU(product.SKU); err != nil {
+		return repositories.Product{}, err
+	}
+
 	if len(product.Name) == 0 {
 		return repositories.Product{}, ErrMissingName
 	}
@@ -327,6 +941,7 @@ func (c *Controller) validateAndConvertProductCSV(ctx context.Context, product P
 	}
 
 	return repositories.Product{
+		SKU:         product.SKU,
 		Name:        product.Name,
 		Description: product.Description,
 		Price:       price,
@@ -337,27 +952,73 @@ func (c *Controller) validateAndConvertProductCSV(ctx context.Context, product P
 }
 
 type ProductOutputGraph struct {
-	ID          int
-	Name        string
-	Description string
-	Price       decimal.Decimal
-	Quantity    int
-	Author      UserOutput
-	Category    PCateOutput
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID               int
+	SKU              string
+	Slug             string
+	Name             string
+	Description      string
+	Price            decimal.Decimal
+	Quantity         int
+	Weight           decimal.Decimal
+	Currency         string
+	Author           UserOutput
+	Category         PCateOutput
+	Images           []ProductImageOutput
+	Tags             []string
+	Attributes       []ProductAttributeOutput
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	ReorderThreshold int
+	// RatingAverage is the average rating of the approved reviews of the product, 0 if it has none
+	RatingAverage decimal.Decimal
+	ReviewCount   int
+	// Version is bumped on every update of the product, an update is rejected if the version it expects is out of date
+	Version int
+	// Availability is the quantity available in each warehouse, the quantity is the total of them.
+	// It is only retrieved for a single product
+	Availability []WarehouseStockOutput
 }
 
-// GetProductsGraph retrieves all the products and  author, category
-func (c *Controller) GetProductsGraph(ctx context.Context, pFilter ProductCtrlFilter) ([]ProductOutputGraph, error) {
-	products, err := c.Repository.GetProductsGraph(ctx, repositories.ProductRepoFilter{
-		Name: pFilter.Name,
-		Date: pFilter.Date,
-	})
+// GetProductsGraph retrieves a page of the products and their author, category, and the total count of the products matching the filter.
+// The prices are converted to the currency of the filter if given
+func (c *Controller) GetProductsGraph(ctx context.Context, pFilter ProductCtrlFilter) ([]ProductOutputGraph, ProductPageInfo, error) {
+	pRepoFilter, err := toProductRepoFilter(pFilter)
 	if err != nil {
-		return nil, err
+		return nil, ProductPageInfo{}, err
+	}
+
+	products, err := c.Repository.GetProductsGraph(ctx, pRepoFilter)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
+	}
+
+	totalCount, err := c.Repository.CountProducts(ctx, pRepoFilter)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
+	}
+
+	pageInfo := ProductPageInfo{TotalCount: totalCount}
+	if len(products) > 0 {
+		last := products[len(products)-1].Product
+		pageInfo.NextCursor = nextProductCursor(pRepoFilter, len(products), repositories.Product{ID: last.ID, Name: last.Name, Price: last.Price, Quantity: last.Quantity, CreatedAt: last.CreatedAt})
+	}
+
+	productIDs := make([]int, 0, len(products))
+	for _, p := range products {
+		productIDs = append(productIDs, p.Product.ID)
+	}
+	galleries, err := c.getProductGalleries(ctx, productIDs...)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
+	}
+
+	tags, attributes, err := c.getProductSpecs(ctx, productIDs...)
+	if err != nil {
+		return nil, ProductPageInfo{}, err
 	}
 
+	converter := &priceConverter{controller: c, currency: pFilter.Currency}
+
 	var pResp []ProductOutputGraph
 	for _, p := range products {
 		userResp := UserOutput{
@@ -378,31 +1039,166 @@ func (c *Controller) GetProductsGraph(ctx context.Context, pFilter ProductCtrlFi
 			UpdatedAt:   p.PCate.UpdatedAt,
 		}
 
-		pResp = append(pResp, ProductOutputGraph{
-			ID:          p.Product.ID,
-			Name:        p.Product.Name,
-			Description: p.Product.Description,
-			Price:       p.Product.Price,
-			Quantity:    p.Product.Quantity,
-			Category:    pCateResp,
-			Author:      userResp,
-			CreatedAt:   p.Product.CreatedAt,
-			UpdatedAt:   p.Product.UpdatedAt,
-		})
+		pOutput := ProductOutputGraph{
+			ID:               p.Product.ID,
+			SKU:              p.Product.Sku,
+			Slug:             p.Product.Slug,
+			Name:             p.Product.Name,
+			Description:      p.Product.Description,
+			Price:            p.Product.Price,
+			Quantity:         p.Product.Quantity,
+			Weight:           p.Product.Weight,
+			Currency:         p.Product.Currency,
+			Category:         pCateResp,
+			Author:           userResp,
+			Images:           galleries[p.Product.ID],
+			Tags:             tags[p.Product.ID],
+			Attributes:       attributes[p.Product.ID],
+			CreatedAt:        p.Product.CreatedAt,
+			UpdatedAt:        p.Product.UpdatedAt,
+			ReorderThreshold: p.Product.ReorderThreshold,
+			RatingAverage:    p.Product.RatingAverage,
+			ReviewCount:      p.Product.ReviewCount,
+			Version:          p.Product.Version,
+		}
+
+		if pFilter.Currency != "" {
+			if pOutput.Price, err = converter.convert(ctx, p.Product.Price, p.Product.Currency); err != nil {
+				return nil, ProductPageInfo{}, err
+			}
+			pOutput.Currency = pFilter.Currency
+		}
+
+		pResp = append(pResp, pOutput)
+	}
+
+	return pResp, pageInfo, nil
+}
+
+// GetProduct retrieves a product with its author and category by ID, the price is converted to the currency if given
+func (c *Controller) GetProduct(ctx context.Context, id int, currency string) (ProductOutputGraph, error) {
+	product, err := c.Repository.GetProduct(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ProductOutputGraph{}, ErrProductNotFound
+		}
+		return ProductOutputGraph{}, err
+	}
+	// an archived product is only resolved from the orders
+	if product.DeletedAt.Valid {
+		return ProductOutputGraph{}, ErrProductNotFound
+	}
+
+	author, err := c.Repository.GetUser(ctx, product.AuthorID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ProductOutputGraph{}, ErrUserNotFound
+		}
+		return ProductOutputGraph{}, err
+	}
+
+	category, err := c.Repository.GetProductCategory(ctx, product.CategoryID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+			return ProductOutputGraph{}, ErrProductCategoryNotFound
+		}
+		return ProductOutputGraph{}, err
+	}
+
+	galleries, err := c.getProductGalleries(ctx, product.ID)
+	if err != nil {
+		return ProductOutputGraph{}, err
+	}
+
+	availability, err := c.getProductAvailability(ctx, product.ID)
+	if err != nil {
+		return ProductOutputGraph{}, err
+	}
+
+	tags, attributes, err := c.getProductSpecs(ctx, product.ID)
+	if err != nil {
+		return ProductOutputGraph{}, err
+	}
+
+	pOutput := ProductOutputGraph{
+		ID:          product.ID,
+		SKU:         product.Sku,
+		Slug:        product.Slug,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Quantity:    product.Quantity,
+		Weight:      product.Weight,
+		Currency:    product.Currency,
+		Author: UserOutput{
+			ID:        author.ID,
+			Name:      author.Name,
+			Email:     author.Email,
+			Role:      author.Role,
+			CreatedAt: author.CreatedAt,
+			UpdatedAt: author.UpdatedAt,
+			Status:    author.Status,
+		},
+		Category: PCateOutput{
+			ID:          category.ID,
+			Name:        category.Name,
+			Description: category.Description,
+			CreatedAt:   category.CreatedAt,
+			UpdatedAt:   category.UpdatedAt,
+		},
+		Images:           galleries[product.ID],
+		Tags:             tags[product.ID],
+		Attributes:       attributes[product.ID],
+		CreatedAt:        product.CreatedAt,
+		UpdatedAt:        product.UpdatedAt,
+		ReorderThreshold: product.ReorderThreshold,
+		RatingAverage:    product.RatingAverage,
+		ReviewCount:      product.ReviewCount,
+		Version:          product.Version,
+		Availability:     availability,
+	}
+
+	if currency != "" {
+		converter := &priceConverter{controller: c, currency: currency}
+		if pOutput.Price, err = converter.convert(ctx, product.Price, product.Currency); err != nil {
+			return ProductOutputGraph{}, err
+		}
+		pOutput.Currency = currency
 	}
 
-	return pResp, nil
+	return pOutput, nil
 }
 
-// ExportProductsToCSV exports all the products in db to a csv file
+// ExportProductsToCSV exports all the products in db to a csv file, the sku of the product is in the ProductSKU column
+// of its row and of the rows of its variants so that the file can be imported again
 func (c *Controller) ExportProductsToCSV(ctx context.Context, filter ProductCtrlFilter) (io.Reader, error) {
 	pipeReader, pipeWriter := io.Pipe()
 
-	// get all products
-	products, err := c.Repository.GetProducts(ctx, repositories.ProductRepoFilter{
-		Name: filter.Name,
-		Date: filter.Date,
-	})
+	// get all products matching the filter, without pagination
+	repoFilter, err := productFilterToRepo(filter)
+	if err != nil {
+		return nil, err
+	}
+	products, err := c.Repository.GetProducts(ctx, repoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	// the variants of a product are written in the rows following it
+	productIDs := make([]int, 0, len(products))
+	for _, p := range products {
+		productIDs = append(productIDs, p.ID)
+	}
+	variants, err := c.Repository.GetProductVariants(ctx, productIDs...)
+	if err != nil {
+		return nil, err
+	}
+	productVariants := make(map[int][]repositories.ProductVariantOutput)
+	for _, v := range variants {
+		productVariants[v.Variant.ProductID] = append(productVariants[v.Variant.ProductID], v)
+	}
+
+	tags, attributes, err := c.getProductSpecs(ctx, productIDs...)
 	if err != nil {
 		return nil, err
 	}
@@ -412,7 +1208,7 @@ func (c *Controller) ExportProductsToCSV(ctx context.Context, filter ProductCtrl
 		csvWriter := csv.NewWriter(pipeWriter)
 
 		// Write CSV header
-		csvWriter.Write([]string{"ID", "Name", "Description", "Price", "Quantity", "AuthorID", "Category", "CreatedAt", "UpdatedAt"})
+		csvWriter.Write([]string{"ID", "Name", "Description", "Price", "Quantity", "AuthorID", "Category", "CreatedAt", "UpdatedAt", "SKU", "Options", "Tags", "Attributes", "ProductSKU"})
 
 		for _, p := range products {
 			record := []string{
@@ -425,8 +1221,37 @@ func (c *Controller) ExportProductsToCSV(ctx context.Context, filter ProductCtrl
 				p.CategoryName,
 				p.CreatedAt.Format(time.RFC3339),
 				p.UpdatedAt.Format(time.RFC3339),
+				"",
+				"",
+				strings.Join(tags[p.ID], ";"),
+				formatCSVAttributes(attributes[p.ID]),
+				p.SKU,
 			}
 			csvWriter.Write(record)
+
+			// a variant row has a blank price if it is sold at the price of the product
+			for _, v := range productVariants[p.ID] {
+				var price string
+				if v.Variant.Price.Valid {
+					price = v.Variant.Price.Decimal.String()
+				}
+				csvWriter.Write([]string{
+					strconv.Itoa(p.ID),
+					p.Name,
+					"",
+					price,
+					strconv.Itoa(v.Variant.Quantity),
+					strconv.Itoa(p.AuthorID),
+					p.CategoryName,
+					v.Variant.CreatedAt.Format(time.RFC3339),
+					v.Variant.UpdatedAt.Format(time.RFC3339),
+					v.Variant.Sku,
+					formatVariantOptions(v.Options),
+					"",
+					"",
+					p.SKU,
+				})
+			}
 		}
 		csvWriter.Flush()
 		pipeWriter.Close()
@@ -2,16 +2,20 @@ package controllers
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/storage"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
 )
 
 func Test_ProductControler_CreateProduct(t *testing.T) {
@@ -178,7 +182,7 @@ func Test_ProductControler_CreateProduct(t *testing.T) {
 	}
 }
 func Test_ProductControler_UpdateProduct(t *testing.T) {
-	type mockGetProductRepo struct {
+	type mockLockProductRepo struct {
 		productID int
 		output    models.Product
 		err       error
@@ -204,7 +208,7 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 	testCases := map[string]struct {
 		expCall               bool
 		productInput          ProductInput
-		mockGetProductRepo    mockGetProductRepo
+		mockLockProductRepo   mockLockProductRepo
 		mockUpdateProductRepo mockUpdateProductRepo
 		mockUserRepo          mockUserRepo
 		mockPCateRepo         mockPCateRepo
@@ -213,13 +217,14 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 		"success": {
 			expCall: true,
 			productInput: ProductInput{
-				ID:           1,
-				Name:         "iPhone 14",
-				Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-				Price:        decimal.New(1500, 20),
-				Quantity:     20,
-				CategoryName: "Smartphone",
-				AuthorID:     1,
+				ID:              1,
+				Name:            "iPhone 14",
+				Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+				Price:           decimal.New(1500, 20),
+				Quantity:        20,
+				CategoryName:    "Smartphone",
+				AuthorID:        1,
+				ExpectedVersion: 3,
 			},
 			mockUpdateProductRepo: mockUpdateProductRepo{
 				productInput: models.Product{
@@ -230,9 +235,10 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 			},
-			mockGetProductRepo: mockGetProductRepo{
+			mockLockProductRepo: mockLockProductRepo{
 				productID: 1,
 				output: models.Product{
 					ID:          1,
@@ -242,6 +248,7 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 			},
 			mockUserRepo: mockUserRepo{
@@ -265,16 +272,17 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 		"user not found": {
 			expCall: true,
 			productInput: ProductInput{
-				ID:           1,
-				Name:         "iPhone 14",
-				Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-				Price:        decimal.New(1500, 20),
-				Quantity:     20,
-				CategoryName: "Smartphone",
-				AuthorID:     100,
+				ID:              1,
+				Name:            "iPhone 14",
+				Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+				Price:           decimal.New(1500, 20),
+				Quantity:        20,
+				CategoryName:    "Smartphone",
+				AuthorID:        100,
+				ExpectedVersion: 3,
 			},
 			mockUpdateProductRepo: mockUpdateProductRepo{},
-			mockGetProductRepo: mockGetProductRepo{
+			mockLockProductRepo: mockLockProductRepo{
 				productID: 1,
 				output: models.Product{
 					ID:          1,
@@ -284,6 +292,7 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 			},
 			mockUserRepo: mockUserRepo{
@@ -295,16 +304,17 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 		"product category not found": {
 			expCall: true,
 			productInput: ProductInput{
-				ID:           1,
-				Name:         "iPhone 14",
-				Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-				Price:        decimal.New(1500, 20),
-				Quantity:     20,
-				CategoryName: "smartwatchhhh",
-				AuthorID:     1,
+				ID:              1,
+				Name:            "iPhone 14",
+				Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+				Price:           decimal.New(1500, 20),
+				Quantity:        20,
+				CategoryName:    "smartwatchhhh",
+				AuthorID:        1,
+				ExpectedVersion: 3,
 			},
 			mockUpdateProductRepo: mockUpdateProductRepo{},
-			mockGetProductRepo: mockGetProductRepo{
+			mockLockProductRepo: mockLockProductRepo{
 				productID: 1,
 				output: models.Product{
 					ID:          1,
@@ -314,6 +324,7 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 			},
 			mockUserRepo: mockUserRepo{
@@ -334,13 +345,14 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 		"create product with name longer than 255 characters": {
 			expCall: true,
 			productInput: ProductInput{
-				ID:           1,
-				Name:         "iPhone 14 Pro Maxxxx" + strings.Repeat("a", 251),
-				Description:  "An Apple cellphone with A16 Bionic chip, 6GB RAM and 128GB storage",
-				Price:        decimal.New(1500, 20),
-				Quantity:     20,
-				CategoryName: "Smartphone",
-				AuthorID:     1,
+				ID:              1,
+				Name:            "iPhone 14 Pro Maxxxx" + strings.Repeat("a", 251),
+				Description:     "An Apple cellphone with A16 Bionic chip, 6GB RAM and 128GB storage",
+				Price:           decimal.New(1500, 20),
+				Quantity:        20,
+				CategoryName:    "Smartphone",
+				AuthorID:        1,
+				ExpectedVersion: 3,
 			},
 			mockUpdateProductRepo: mockUpdateProductRepo{
 				productInput: models.Product{
@@ -351,10 +363,11 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 				err: errors.New("models: unable to insert into products: pq: value too long for type character varying(255)"),
 			},
-			mockGetProductRepo: mockGetProductRepo{
+			mockLockProductRepo: mockLockProductRepo{
 				productID: 1,
 				output: models.Product{
 					ID:          1,
@@ -364,6 +377,7 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 			},
 			mockUserRepo: mockUserRepo{
@@ -388,13 +402,14 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 		"product not found": {
 			expCall: true,
 			productInput: ProductInput{
-				ID:           1000,
-				Name:         "iPhone 14",
-				Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-				Price:        decimal.New(1500, 20),
-				Quantity:     20,
-				CategoryName: "Smartphone",
-				AuthorID:     1,
+				ID:              1000,
+				Name:            "iPhone 14",
+				Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+				Price:           decimal.New(1500, 20),
+				Quantity:        20,
+				CategoryName:    "Smartphone",
+				AuthorID:        1,
+				ExpectedVersion: 3,
 			},
 			mockUpdateProductRepo: mockUpdateProductRepo{
 				productInput: models.Product{
@@ -405,13 +420,38 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 					Quantity:    20,
 					CategoryID:  1,
 					AuthorID:    1,
+					Version:     3,
 				},
 			},
-			mockGetProductRepo: mockGetProductRepo{
+			mockLockProductRepo: mockLockProductRepo{
 				productID: 1000,
-				err:       errors.New("product not found"),
+				err:       repositories.ErrProductNotFound,
 			},
-			expErr: errors.New("product not found"),
+			expErr: ErrProductNotFound,
+		},
+		"product changed since it was read": {
+			expCall: true,
+			productInput: ProductInput{
+				ID:              1,
+				Name:            "iPhone 14",
+				Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+				Price:           decimal.New(1500, 20),
+				Quantity:        20,
+				CategoryName:    "Smartphone",
+				AuthorID:        1,
+				ExpectedVersion: 2,
+			},
+			mockLockProductRepo: mockLockProductRepo{
+				productID: 1,
+				output: models.Product{
+					ID:       1,
+					Name:     "iPhone 14",
+					Price:    decimal.New(1500, 20),
+					Quantity: 20,
+					Version:  3,
+				},
+			},
+			expErr: ErrProductVersionConflict,
 		},
 	}
 
@@ -420,14 +460,22 @@ func Test_ProductControler_UpdateProduct(t *testing.T) {
 			mockRepo := &repositories.MockIRepository{}
 			controller := NewController(mockRepo)
 			if tc.expCall {
-				mockRepo.On("GetProduct", context.Background(), tc.mockGetProductRepo.productID).Return(tc.mockGetProductRepo.output, tc.mockGetProductRepo.err)
+				tx := sql.Tx{}
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("LockProduct", context.Background(), &tx, tc.mockLockProductRepo.productID).Return(tc.mockLockProductRepo.output, tc.mockLockProductRepo.err)
 				mockRepo.On("GetUser", context.Background(), tc.productInput.AuthorID).Return(tc.mockUserRepo.output, tc.mockUserRepo.err)
 				mockRepo.On("GetProductCategoryByName", context.Background(), tc.productInput.CategoryName).Return(tc.mockPCateRepo.output, tc.mockPCateRepo.err)
-				mockRepo.On("UpdateProduct", context.Background(), tc.mockUpdateProductRepo.productInput).Return(tc.expErr)
+				mockRepo.On("UpdateProduct", context.Background(), &tx, tc.mockUpdateProductRepo.productInput).Return(tc.mockUpdateProductRepo.err)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+				mockRepo.On("DeleteProductCache", context.Background(), tc.productInput.ID).Return(nil)
 			}
 
-			if err := controller.UpdateProduct(context.Background(), tc.productInput); tc.expErr != nil {
+			err := controller.UpdateProduct(context.Background(), tc.productInput)
+			if tc.expErr != nil {
 				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
 			}
 		})
 	}
@@ -485,19 +533,26 @@ func Test_ProductController_GetProducts(t *testing.T) {
 	type mockProductRepo struct {
 		input  repositories.ProductRepoFilter
 		output []repositories.ProductOutput
+		count  int64
 		err    error
 	}
 
+	minPrice, maxPrice := decimal.NewFromInt(100), decimal.NewFromInt(10)
+	nextCursor := nextProductCursor(repositories.ProductRepoFilter{SortBy: ProductSortPrice, Limit: 1}, 1, repositories.Product{ID: 194, Price: decimal.New(1500, 20)})
+
 	tests := map[string]struct {
 		expCall         bool
 		mockProductRepo mockProductRepo
 		input           ProductCtrlFilter
 		output          []ProductOutput
+		pageInfo        ProductPageInfo
 		err             error
 	}{
 		"get products successfully": {
 			expCall: true,
 			mockProductRepo: mockProductRepo{
+				input: repositories.ProductRepoFilter{Limit: defaultProductsLimit},
+				count: 2,
 				output: []repositories.ProductOutput{
 					{
 						ID:           194,
@@ -547,13 +602,16 @@ func Test_ProductController_GetProducts(t *testing.T) {
 					UpdatedAt:    myUpdatedTime,
 				},
 			},
+			pageInfo: ProductPageInfo{TotalCount: 2},
 		},
 		"get products successfully with filter": {
 			expCall: true,
 			mockProductRepo: mockProductRepo{
 				input: repositories.ProductRepoFilter{
-					Name: "iPhone",
+					Name:  "iPhone",
+					Limit: defaultProductsLimit,
 				},
+				count: 1,
 				output: []repositories.ProductOutput{
 					{
 						ID:           194,
@@ -584,18 +642,88 @@ func Test_ProductController_GetProducts(t *testing.T) {
 					UpdatedAt:    myUpdatedTime,
 				},
 			},
+			pageInfo: ProductPageInfo{TotalCount: 1},
 		},
 		"products not found with filter": {
 			expCall: true,
 			mockProductRepo: mockProductRepo{
 				input: repositories.ProductRepoFilter{
-					Name: "imac",
+					Name:  "imac",
+					Limit: defaultProductsLimit,
 				},
 			},
 			input: ProductCtrlFilter{
 				Name: "imac",
 			},
 		},
+		"get first page sorted by price": {
+			expCall: true,
+			mockProductRepo: mockProductRepo{
+				input: repositories.ProductRepoFilter{SortBy: ProductSortPrice, Limit: 1},
+				count: 2,
+				output: []repositories.ProductOutput{
+					{
+						ID:        194,
+						Name:      "iPhone 14",
+						Price:     decimal.New(1500, 20),
+						CreatedAt: myCreatedTime,
+						UpdatedAt: myUpdatedTime,
+					},
+				},
+			},
+			input: ProductCtrlFilter{SortBy: ProductSortPrice, Limit: 1},
+			output: []ProductOutput{
+				{
+					ID:        194,
+					Name:      "iPhone 14",
+					Price:     decimal.New(1500, 20),
+					CreatedAt: myCreatedTime,
+					UpdatedAt: myUpdatedTime,
+				},
+			},
+			pageInfo: ProductPageInfo{TotalCount: 2, NextCursor: nextCursor},
+		},
+		"get next page by cursor": {
+			expCall: true,
+			mockProductRepo: mockProductRepo{
+				input: repositories.ProductRepoFilter{
+					SortBy: ProductSortPrice,
+					Limit:  1,
+					After:  &repositories.ProductCursor{Value: decimal.New(1500, 20).String(), ID: 194},
+				},
+				count: 2,
+			},
+			input:    ProductCtrlFilter{SortBy: ProductSortPrice, Limit: 1, Cursor: nextCursor},
+			pageInfo: ProductPageInfo{TotalCount: 2},
+		},
+		"cursor of another sort": {
+			input: ProductCtrlFilter{SortBy: ProductSortName, Limit: 1, Cursor: nextCursor},
+			err:   ErrInvalidCursor,
+		},
+		"malformed cursor": {
+			input: ProductCtrlFilter{Cursor: "not-a-cursor"},
+			err:   ErrInvalidCursor,
+		},
+		"cursor with offset": {
+			input: ProductCtrlFilter{SortBy: ProductSortPrice, Limit: 1, Offset: 1, Cursor: nextCursor},
+			err:   ErrCursorWithOffset,
+		},
+		"invalid sort": {
+			input: ProductCtrlFilter{SortBy: "author_id"},
+			err:   ErrInvalidProductSort,
+		},
+		"limit too large": {
+			input: ProductCtrlFilter{Limit: maxProductsLimit + 1},
+			err:   ErrInvalidProductLimit,
+		},
+		"min price greater than max price": {
+			input: ProductCtrlFilter{MinPrice: &minPrice, MaxPrice: &maxPrice},
+			err:   ErrInvalidPriceRange,
+		},
+		"created from after created to": {
+			input: ProductCtrlFilter{CreatedFrom: myCreatedTime, CreatedTo: myCreatedTime.AddDate(0, 0, -1)},
+			err:   ErrStartDateAfterEndDate,
+		},
 	}
 
 	for desc, tc := range tests {
@@ -604,12 +732,22 @@ func Test_ProductController_GetProducts(t *testing.T) {
 			controller := NewController(&mockRepo)
 			if tc.expCall {
 				mockRepo.On("GetProducts", context.Background(), tc.mockProductRepo.input).Return(tc.mockProductRepo.output, tc.mockProductRepo.err)
+				mockRepo.On("CountProducts", context.Background(), tc.mockProductRepo.input).Return(tc.mockProductRepo.count, nil)
+
+				imageArgs := []interface{}{context.Background()}
+				for _, p := range tc.mockProductRepo.output {
+					imageArgs = append(imageArgs, p.ID)
+				}
+				mockRepo.On("GetProductImages", imageArgs...).Return(nil, nil)
+				mockRepo.On("GetProductTags", imageArgs...).Return(nil, nil)
+				mockRepo.On("GetProductAttributes", imageArgs...).Return(nil, nil)
 			}
-			products, err := controller.GetProducts(context.Background(), tc.input)
+			products, pageInfo, err := controller.GetProducts(context.Background(), tc.input)
 			if tc.err != nil {
 				assert.EqualError(t, err, tc.err.Error())
 			} else {
 				assert.Equal(t, products, tc.output)
+				assert.Equal(t, tc.pageInfo, pageInfo)
 			}
 		})
 	}
@@ -1194,6 +1332,7 @@ func Test_ProductController_GetProductsGraph(t *testing.T) {
 		"get products successfully": {
 			expCall: true,
 			mockProductRepo: mockProductRepo{
+				input: repositories.ProductRepoFilter{Limit: defaultProductsLimit},
 				output: []repositories.ProductOutput{
 					{
 						ID:           194,
@@ -1294,7 +1433,8 @@ func Test_ProductController_GetProductsGraph(t *testing.T) {
 			expCall: true,
 			mockProductRepo: mockProductRepo{
 				input: repositories.ProductRepoFilter{
-					Name: "iPhone",
+					Name:  "iPhone",
+					Limit: defaultProductsLimit,
 				},
 				output: []repositories.ProductOutput{
 					{
@@ -1355,7 +1495,8 @@ func Test_ProductController_GetProductsGraph(t *testing.T) {
 			expCall: true,
 			mockProductRepo: mockProductRepo{
 				input: repositories.ProductRepoFilter{
-					Name: "imac",
+					Name:  "imac",
+					Limit: defaultProductsLimit,
 				},
 			},
 			input: ProductCtrlFilter{
@@ -1370,12 +1511,21 @@ func Test_ProductController_GetProductsGraph(t *testing.T) {
 			controller := NewController(&mockRepo)
 			if tc.expCall {
 				mockRepo.On("GetProducts", context.Background(), tc.mockProductRepo.input).Return(tc.mockProductRepo.output, tc.mockProductRepo.err)
+				mockRepo.On("CountProducts", context.Background(), tc.mockProductRepo.input).Return(int64(len(tc.mockProductRepo.output)), nil)
+
+				imageArgs := []interface{}{context.Background()}
+				for _, p := range tc.mockProductRepo.output {
+					imageArgs = append(imageArgs, p.ID)
+				}
+				mockRepo.On("GetProductImages", imageArgs...).Return(nil, nil)
+				mockRepo.On("GetProductTags", imageArgs...).Return(nil, nil)
+				mockRepo.On("GetProductAttributes", imageArgs...).Return(nil, nil)
 				for i := range tc.mockProductRepo.output {
 					mockRepo.On("GetUser", context.Background(), tc.mockProductRepo.output[i].AuthorID).Return(tc.mockRepo[i].mockUserRepo.output, tc.mockRepo[i].mockUserRepo.err)
 					mockRepo.On("GetProductCategoryByName", context.Background(), tc.mockProductRepo.output[i].CategoryName).Return(tc.mockRepo[i].mockPCateRepo.output, tc.mockRepo[i].mockPCateRepo.err)
 				}
 			}
-			products, err := controller.GetProducts(context.Background(), tc.input)
+			products, _, err := controller.GetProducts(context.Background(), tc.input)
 			if tc.err != nil {
 				assert.EqualError(t, err, tc.err.Error())
 			} else {
@@ -1490,6 +1640,14 @@ func Test_ProductController_ExportProductsToCSV(t *testing.T) {
 
 			if tc.expCall {
 				mockRepo.On("GetProducts", context.Background(), tc.mockProductRepo.input).Return(tc.mockProductRepo.output, tc.mockProductRepo.err)
+
+				variantArgs := []interface{}{context.Background()}
+				for _, p := range tc.mockProductRepo.output {
+					variantArgs = append(variantArgs, p.ID)
+				}
+				mockRepo.On("GetProductVariants", variantArgs...).Return(nil, nil)
+				mockRepo.On("GetProductTags", variantArgs...).Return(nil, nil)
+				mockRepo.On("GetProductAttributes", variantArgs...).Return(nil, nil)
 			}
 
 			if _, err := controller.ExportProductsToCSV(context.Background(), tc.input); err != nil {
@@ -1500,3 +1658,243 @@ func Test_ProductController_ExportProductsToCSV(t *testing.T) {
 		})
 	}
 }
+
+func Test_ProductController_GetProduct(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	product := models.Product{ID: 1, Name: "iPhone 14", Description: "Apple phone", Price: decimal.NewFromInt(20000000), Quantity: 5, AuthorID: 2, CategoryID: 3, Weight: decimal.NewFromInt(1), Currency: CurrencyVND, CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	type mockProductRepo struct {
+		output models.Product
+		err    error
+	}
+	tests := map[string]struct {
+		mockProductRepo mockProductRepo
+		userErr         error
+		expOutput       ProductOutputGraph
+		expErr          error
+	}{
+		"get product successfully": {
+			mockProductRepo: mockProductRepo{output: product},
+			expOutput: ProductOutputGraph{
+				ID:          1,
+				Name:        "iPhone 14",
+				Description: "Apple phone",
+				Price:       decimal.NewFromInt(20000000),
+				Quantity:    5,
+				Weight:      decimal.NewFromInt(1),
+				Currency:    CurrencyVND,
+				Author:      UserOutput{ID: 2, Name: "qthuy", Email: "qthuy@gmail.com", CreatedAt: createdAt, UpdatedAt: createdAt},
+				Category:    PCateOutput{ID: 3, Name: "Smartphone", CreatedAt: createdAt, UpdatedAt: createdAt},
+				Images: []ProductImageOutput{
+					{
+						ID:          4,
+						ProductID:   1,
+						URL:         "/uploads/products/1/abc.jpg",
+						ContentType: "image/jpeg",
+						Size:        2048,
+						Width:       800,
+						Height:      600,
+						Thumbnails: []ImageThumbnailOutput{
+							{Size: "small", URL: "/uploads/products/1/abc_small.jpg"},
+							{Size: "medium", URL: "/uploads/products/1/abc_medium.jpg"},
+							{Size: "large", URL: "/uploads/products/1/abc_large.jpg"},
+						},
+						CreatedAt: createdAt,
+					},
+				},
+				Tags: []string{"5g", "dual-sim"},
+				Attributes: []ProductAttributeOutput{
+					{AttributeID: 1, Name: "RAM", Type: repositories.AttributeTypeNumber, Unit: "GB", Value: "8"},
+					{AttributeID: 2, Name: "Storage", Type: repositories.AttributeTypeNumber, Unit: "GB", Value: "128"},
+				},
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+				Availability: []WarehouseStockOutput{
+					{WarehouseID: 1, WarehouseName: "Main warehouse", City: "Ho Chi Minh", Country: "Vietnam", Quantity: 3},
+					{WarehouseID: 2, WarehouseName: "Ha Noi", City: "Ha Noi", Country: "Vietnam", Quantity: 2},
+				},
+			},
+		},
+		"product not found": {
+			mockProductRepo: mockProductRepo{err: repositories.ErrProductNotFound},
+			expErr:          ErrProductNotFound,
+		},
+		"archived product": {
+			mockProductRepo: mockProductRepo{output: models.Product{ID: 1, AuthorID: 2, CategoryID: 3, DeletedAt: null.TimeFrom(createdAt)}},
+			expErr:          ErrProductNotFound,
+		},
+		"author not found": {
+			mockProductRepo: mockProductRepo{output: product},
+			userErr:         repositories.ErrUserNotFound,
+			expErr:          ErrUserNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo, Storage: storage.NewLocalStorage(t.TempDir(), "/uploads")}
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(tc.mockProductRepo.output, tc.mockProductRepo.err)
+			if tc.mockProductRepo.err == nil && !tc.mockProductRepo.output.DeletedAt.Valid {
+				mockRepo.On("GetUser", context.Background(), 2).Return(models.User{ID: 2, Name: "qthuy", Email: "qthuy@gmail.com", CreatedAt: createdAt, UpdatedAt: createdAt}, tc.userErr)
+				if tc.userErr == nil {
+					mockRepo.On("GetProductCategory", context.Background(), 3).Return(models.ProductCategory{ID: 3, Name: "Smartphone", CreatedAt: createdAt, UpdatedAt: createdAt}, nil)
+					mockRepo.On("GetProductImages", context.Background(), 1).Return([]models.ProductImage{
+						{ID: 4, ProductID: 1, StorageKey: "products/1/abc.jpg", ContentType: "image/jpeg", Size: 2048, Width: 800, Height: 600, CreatedAt: createdAt},
+					}, nil)
+					// the warehouses which are out of the product are left out
+					mockRepo.On("GetWarehouseStocks", context.Background(), 1).Return([]models.WarehouseStock{
+						warehouseStockOf(models.Warehouse{ID: 1, Name: "Main warehouse", City: "Ho Chi Minh", Country: "Vietnam", IsDefault: true}, 3),
+						warehouseStockOf(models.Warehouse{ID: 2, Name: "Ha Noi", City: "Ha Noi", Country: "Vietnam"}, 2),
+						warehouseStockOf(models.Warehouse{ID: 3, Name: "Da Nang", City: "Da Nang", Country: "Vietnam"}, 0),
+					}, nil)
+					mockRepo.On("GetProductTags", context.Background(), 1).Return([]models.ProductTag{
+						{ProductID: 1, Tag: "5g"},
+						{ProductID: 1, Tag: "dual-sim"},
+					}, nil)
+					mockRepo.On("GetProductAttributes", context.Background(), 1).Return([]repositories.ProductAttributeOutput{
+						{ProductID: 1, AttributeID: 1, Name: "RAM", Type: repositories.AttributeTypeNumber, Unit: "GB", Value: "8"},
+						{ProductID: 1, AttributeID: 2, Name: "Storage", Type: repositories.AttributeTypeNumber, Unit: "GB", Value: "128"},
+					}, nil)
+				}
+			}
+
+			output, err := controller.GetProduct(context.Background(), 1, "")
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+func Test_ProductController_RestoreProduct(t *testing.T) {
+	archivedAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		product    models.Product
+		productErr error
+		expRestore bool
+		restoreErr error
+		expErr     error
+	}{
+		"restore product successfully": {
+			product:    models.Product{ID: 1, DeletedAt: null.TimeFrom(archivedAt)},
+			expRestore: true,
+		},
+		"product not found": {
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"product not archived": {
+			product: models.Product{ID: 1},
+			expErr:  ErrProductNotArchived,
+		},
+		"product restored concurrently": {
+			product:    models.Product{ID: 1, DeletedAt: null.TimeFrom(archivedAt)},
+			expRestore: true,
+			restoreErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotArchived,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(tc.product, tc.productErr)
+			if tc.expRestore {
+				mockRepo.On("RestoreProduct", context.Background(), 1).Return(tc.restoreErr)
+			}
+
+			err := controller.RestoreProduct(context.Background(), 1)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ProductController_PurgeArchivedProducts(t *testing.T) {
+	archivedBefore := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		archivedBefore time.Time
+		purgeable      []int
+		images         []models.ProductImage
+		deleted        []int
+		expPurged      int
+		expFilesLeft   []string
+		expErr         error
+	}{
+		"purge archived products successfully": {
+			archivedBefore: archivedBefore,
+			purgeable:      []int{1, 2},
+			images: []models.ProductImage{
+				{ID: 1, ProductID: 1, StorageKey: "products/1/abc.png", ContentType: "image/png"},
+				{ID: 2, ProductID: 2, StorageKey: "products/2/def.png", ContentType: "image/png"},
+			},
+			// product 2 has been ordered or restored since it was listed
+			deleted:      []int{1},
+			expPurged:    1,
+			expFilesLeft: []string{"products/2/def.png"},
+		},
+		"no archived product to purge": {
+			archivedBefore: archivedBefore,
+		},
+		"missing archived before": {
+			expErr: ErrMissingArchivedBefore,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			dir := t.TempDir()
+			controller := &Controller{Repository: mockRepo, Storage: storage.NewLocalStorage(dir, "/uploads")}
+
+			for _, i := range tc.images {
+				assert.NoError(t, controller.Storage.Save(context.Background(), i.StorageKey, strings.NewReader("image")))
+			}
+
+			if !tc.archivedBefore.IsZero() {
+				mockRepo.On("GetPurgeableProducts", context.Background(), tc.archivedBefore).Return(tc.purgeable, nil)
+			}
+			if len(tc.purgeable) != 0 {
+				args := []interface{}{context.Background()}
+				purgeArgs := []interface{}{context.Background(), tc.archivedBefore}
+				for _, id := range tc.purgeable {
+					args = append(args, id)
+					purgeArgs = append(purgeArgs, id)
+				}
+				mockRepo.On("GetProductImages", args...).Return(tc.images, nil)
+				mockRepo.On("PurgeProducts", purgeArgs...).Return(tc.deleted, nil)
+			}
+
+			purged, err := controller.PurgeArchivedProducts(context.Background(), tc.archivedBefore)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expPurged, purged)
+
+			// only the images of the deleted products are removed
+			for _, i := range tc.images {
+				_, err := os.Stat(filepath.Join(dir, i.StorageKey))
+				left := false
+				for _, k := range tc.expFilesLeft {
+					left = left || k == i.StorageKey
+				}
+				assert.Equal(t, left, err == nil)
+			}
+		})
+	}
+}
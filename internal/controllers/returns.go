@@ -0,0 +1,476 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/email"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+// statuses of a return request
+const (
+	ReturnStatusRequested = "REQUESTED"
+	ReturnStatusApproved  = "APPROVED"
+	ReturnStatusRejected  = "REJECTED"
+	ReturnStatusReceived  = "RECEIVED"
+	ReturnStatusRefunded  = "REFUNDED"
+	ReturnStatusReplaced  = "REPLACED"
+)
+
+// returnStatusTransitions maps the status of a return request to the statuses it can move to
+var returnStatusTransitions = map[string][]string{
+	ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected},
+	ReturnStatusApproved:  {ReturnStatusReceived},
+	ReturnStatusReceived:  {ReturnStatusRefunded, ReturnStatusReplaced},
+}
+
+// IsValidReturnStatus reports whether the status is one of the statuses of a return request
+func IsValidReturnStatus(status string) bool {
+	switch status {
+	case ReturnStatusRequested, ReturnStatusApproved, ReturnStatusRejected, ReturnStatusReceived, ReturnStatusRefunded, ReturnStatusReplaced:
+		return true
+	}
+	return false
+}
+
+// pendingReturnStatuses are the statuses of the return requests which still need an action of an admin
+var pendingReturnStatuses = []string{ReturnStatusRequested, ReturnStatusApproved, ReturnStatusReceived}
+
+type ReturnRequestInput struct {
+	OrderID int
+	Reason  string
+	Items   []ReturnItemInput
+}
+
+type ReturnItemInput struct {
+	OrderItemID int
+	Quantity    int
+}
+
+// CreateReturnRequest requests a return of items of a delivered order, the customer gets an email with the return number
+func (c *Controller) CreateReturnRequest(ctx context.Context, rInput ReturnRequestInput) error {
+	if strings.TrimSpace(rInput.Reason) == "" {
+		return ErrMissingReturnReason
+	}
+	if len(rInput.Items) == 0 {
+		return ErrMissingReturnItems
+	}
+
+	order, err := c.Repository.GetOrder(ctx, rInput.OrderID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrOrderNotFound) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	// only a delivered order can be returned
+	if order.Status != OrderStatusDelivered {
+		return ErrOrderNotReturnable
+	}
+
+	user, err := c.Repository.GetUser(ctx, order.UserID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	// the items of the rejected requests can be requested again
+	returned, err := c.Repository.GetReturnedQuantities(ctx, order.ID, []string{ReturnStatusRejected})
+	if err != nil {
+		return err
+	}
+
+	var riRepoInputList []repositories.ReturnItem
+	for _, ri := range rInput.Items {
+		if ri.Quantity <= 0 {
+			return ErrInvalidReturnQuantity
+		}
+
+		orderItem, err := c.Repository.GetOrderItem(ctx, ri.OrderItemID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrOrderItemNotFound) {
+				return ErrOrderItemNotFound
+			}
+			return err
+		}
+		if orderItem.OrderID != order.ID {
+			return ErrOrderItemNotFound
+		}
+
+		// an item cannot be returned more than it was ordered
+		returned[orderItem.ID] += ri.Quantity
+		if returned[orderItem.ID] > orderItem.Quantity {
+			return ErrInvalidReturnQuantity
+		}
+
+		riRepoInputList = append(riRepoInputList, repositories.ReturnItem{
+			OrderItemID: orderItem.ID,
+			Quantity:    ri.Quantity,
+		})
+	}
+
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	returnRequest, err := c.Repository.CreateReturnRequest(ctx, tx, repositories.ReturnRequest{
+		OrderID: order.ID,
+		Status:  ReturnStatusRequested,
+		Reason:  strings.TrimSpace(rInput.Reason),
+	}, riRepoInputList)
+	if err != nil {
+		return err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	// the request is created even if the customer could not be emailed
+	if err = c.SendEmailReturn(user.Email, order, returnRequest); err != nil {
+		log.Printf("could not send the return request %d email: %v", returnRequest.ID, err)
+	}
+
+	return nil
+}
+
+// UpdateReturnStatus moves a return request to the next status of the workflow and emails the customer.
+// The items go back to stock when they are received, a received return is then either refunded or
+// replaced by a new paid order of the same items
+func (c *Controller) UpdateReturnStatus(ctx context.Context, returnRequestID int, status string, note string) error {
+	returnRequest, err := c.Repository.GetReturnRequest(ctx, returnRequestID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrReturnRequestNotFound) {
+			return ErrReturnRequestNotFound
+		}
+		return err
+	}
+
+	if !canTransitReturn(returnRequest.Status, status) {
+		return ErrInvalidReturnStatus
+	}
+
+	order, err := c.Repository.GetOrder(ctx, returnRequest.OrderID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrOrderNotFound) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	user, err := c.Repository.GetUser(ctx, order.UserID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	returnItems, err := c.Repository.GetReturnItems(ctx, returnRequest.ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the products whose stock is moved by the new status
+	var productIDs []int
+	switch status {
+	case ReturnStatusReceived:
+		// the returned items go back to stock
+		for _, ri := range returnItems {
+			orderItem, err := c.Repository.GetOrderItem(ctx, ri.OrderItemID)
+			if err != nil {
+				return err
+			}
+			productIDs = append(productIDs, orderItem.ProductID)
+
+			if err = c.restockOrderItem(ctx, tx, orderItem, ri.Quantity, stockChange{Reason: repositories.StockReasonReturn, ReferenceID: returnRequest.ID}); err != nil {
+				return err
+			}
+		}
+		returnRequest.ReceivedAt = null.TimeFrom(time.Now())
+
+	case ReturnStatusRefunded:
+		refundAmount := decimal.Zero
+		for _, ri := range returnItems {
+			orderItem, err := c.Repository.GetOrderItem(ctx, ri.OrderItemID)
+			if err != nil {
+				return err
+			}
+			refundAmount = refundAmount.Add(calculateRefundAmount(orderItem, ri.Quantity))
+		}
+		returnRequest.RefundAmount = decimal.NewNullDecimal(refundAmount)
+		returnRequest.ResolvedAt = null.TimeFrom(time.Now())
+
+	case ReturnStatusReplaced:
+		replacementOrder, replacedIDs, err := c.createReplacementOrder(ctx, tx, order, returnItems)
+		if err != nil {
+			return err
+		}
+		productIDs = replacedIDs
+		returnRequest.ReplacementOrderID = null.IntFrom(replacementOrder.ID)
+		returnRequest.ResolvedAt = null.TimeFrom(time.Now())
+	}
+
+	from := returnRequest.Status
+	returnRequest.Status = status
+	if strings.TrimSpace(note) != "" {
+		returnRequest.Note = strings.TrimSpace(note)
+	}
+
+	if err = c.Repository.UpdateReturnRequest(ctx, tx, returnRequest, from); err != nil {
+		// the request has been moved concurrently, its restock or replacement is rolled back
+		if errors.Is(err, repositories.ErrReturnRequestNotFound) {
+			return ErrInvalidReturnStatus
+		}
+		return err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	c.deleteProductCache(ctx, productIDs...)
+
+	// the status is moved even if the customer could not be emailed
+	if err = c.SendEmailReturn(user.Email, order, returnRequest); err != nil {
+		log.Printf("could not send the return request %d email: %v", returnRequest.ID, err)
+	}
+
+	return nil
+}
+
+// canTransitReturn reports whether a return request in the status from can move to the status to
+func canTransitReturn(from, to string) bool {
+	for _, s := range returnStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// calculateRefundAmount calculates the amount refunded for a quantity of an order item,
+// the tax of the item is refunded in proportion to the quantity
+func calculateRefundAmount(orderItem models.OrderItem, quantity int) decimal.Decimal {
+	amount := orderItem.Price.Mul(decimal.NewFromInt(int64(quantity)))
+	if orderItem.Quantity > 0 {
+		amount = amount.Add(orderItem.TaxAmount.Mul(decimal.NewFromInt(int64(quantity))).Div(decimal.NewFromInt(int64(orderItem.Quantity))))
+	}
+
+	return amount.Round(2)
+}
+
+// createReplacementOrder creates a paid order free of charge with the returned items, which is shipped to the customer
+// like any other paid order. The stock of the products is decreased, the IDs of the products are returned with the order
+func (c *Controller) createReplacementOrder(ctx context.Context, tx *sql.Tx, order models.Order, returnItems []models.ReturnItem) (models.Order, []int, error) {
+	replacementOrder, err := c.Repository.CreateOrder(ctx, tx, repositories.Order{
+		UserID:           order.UserID,
+		Status:           OrderStatusPaid,
+		Region:           order.Region,
+		SubtotalPrice:    decimal.NewNullDecimal(decimal.Zero),
+		TaxPrice:         decimal.NewNullDecimal(decimal.Zero),
+		AddressID:        order.AddressID,
+		ShippingMethodID: order.ShippingMethodID,
+		TotalPrice:       decimal.NewNullDecimal(decimal.Zero),
+		Currency:         order.Currency,
+		ExchangeRate:     order.ExchangeRate,
+	})
+	if err != nil {
+		return models.Order{}, nil, err
+	}
+
+	// the replacement is fulfilled from the warehouses nearest to the shipping address of the order
+	var shippingAddress *AddressOutput
+	if order.AddressID.Valid {
+		address, err := c.getUserAddress(ctx, order.UserID, order.AddressID.Int)
+		if err != nil {
+			return models.Order{}, nil, err
+		}
+		shippingAddress = &address
+	}
+
+	var oiRepoInputList []repositories.OrderItem
+	var productIDs []int
+	for _, ri := range returnItems {
+		orderItem, err := c.Repository.GetOrderItem(ctx, ri.OrderItemID)
+		if err != nil {
+			return models.Order{}, nil, err
+		}
+
+		// the replacement is the same variant of the product
+		product, variant, err := c.getOrderItemStock(ctx, orderItem.ProductID, orderItem.VariantID.Int)
+		if err != nil {
+			return models.Order{}, nil, err
+		}
+		stock := product.Quantity
+		if variant != nil {
+			stock = variant.Quantity
+		}
+
+		// check the quantity of product
+		if stock < ri.Quantity {
+			return models.Order{}, nil, ErrInsufficientQuantity
+		}
+
+		warehouseID, err := c.allocateOrderItem(ctx, product, variant, OrderItemInput{Quantity: ri.Quantity}, shippingAddress)
+		if err != nil {
+			return models.Order{}, nil, err
+		}
+
+		// decrease the quantity of product
+		if err = c.adjustStock(ctx, tx, product, variant, -ri.Quantity, stockChange{Reason: repositories.StockReasonSale, ReferenceID: replacementOrder.ID, ActorID: order.UserID, WarehouseID: warehouseID}); err != nil {
+			return models.Order{}, nil, err
+		}
+
+		productIDs = append(productIDs, orderItem.ProductID)
+		oiRepoInputList = append(oiRepoInputList, repositories.OrderItem{
+			ProductID:   orderItem.ProductID,
+			VariantID:   orderItem.VariantID.Int,
+			WarehouseID: warehouseID,
+			Quantity:    ri.Quantity,
+			Price:       decimal.Zero,
+			TaxRate:     decimal.Zero,
+			TaxAmount:   decimal.Zero,
+		})
+	}
+
+	if err = c.Repository.CreateOrderItem(ctx, tx, oiRepoInputList, replacementOrder); err != nil {
+		return models.Order{}, nil, err
+	}
+
+	return replacementOrder, productIDs, nil
+}
+
+// SendEmailReturn sends an email about the current status of a return request to the user
+func (c *Controller) SendEmailReturn(emailTo string, order models.Order, returnRequest models.ReturnRequest) error {
+	body := []string{"Hi users,"}
+
+	switch returnRequest.Status {
+	case ReturnStatusRequested:
+		body = append(body, fmt.Sprintf("We have received your return request #%d for the order #%d.", returnRequest.ID, order.ID), "We will review it shortly.")
+	case ReturnStatusApproved:
+		body = append(body, fmt.Sprintf("Your return request #%d for the order #%d has been approved.", returnRequest.ID, order.ID), "Please send the items back to us.")
+	case ReturnStatusRejected:
+		body = append(body, fmt.Sprintf("Your return request #%d for the order #%d has been rejected.", returnRequest.ID, order.ID))
+	case ReturnStatusReceived:
+		body = append(body, fmt.Sprintf("We have received the returned items of the return request #%d.", returnRequest.ID))
+	case ReturnStatusRefunded:
+		body = append(body, fmt.Sprintf("The return request #%d has been refunded: %s %s.", returnRequest.ID, returnRequest.RefundAmount.Decimal.StringFixed(2), order.Currency))
+	case ReturnStatusReplaced:
+		body = append(body, fmt.Sprintf("The items of the return request #%d will be replaced by the order #%d.", returnRequest.ID, returnRequest.ReplacementOrderID.Int))
+	}
+	if returnRequest.Note != "" {
+		body = append(body, fmt.Sprintf("Note: %s", returnRequest.Note))
+	}
+	body = append(body, "Thanks for choosing us!")
+
+	sender := email.NewEmailSender()
+
+	m := email.NewMessage(fmt.Sprintf("Return Request #%d", returnRequest.ID), strings.Join(body, "\n"))
+	m.To = []string{emailTo}
+
+	return sender.Send(m)
+}
+
+type ReturnRequestOutput struct {
+	ID                 int
+	OrderID            int
+	Status             string
+	Reason             string
+	Note               string
+	RefundAmount       *decimal.Decimal
+	ReplacementOrderID *int
+	ReceivedAt         *time.Time
+	ResolvedAt         *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Items              []ReturnItemOutput
+}
+
+type ReturnItemOutput struct {
+	ID          int
+	OrderItemID int
+	Quantity    int
+}
+
+type ReturnRequestFilterCtrl struct {
+	Status  string
+	OrderID int
+}
+
+// GetReturnRequests retrieves the return requests, oldest first. Without a status or an order in the filter,
+// it returns the queue of the pending requests which still need an action of an admin
+func (c *Controller) GetReturnRequests(ctx context.Context, filter ReturnRequestFilterCtrl) ([]ReturnRequestOutput, error) {
+	var repoFilter repositories.ReturnRequestFilter
+	switch {
+	case filter.Status != "":
+		repoFilter.Statuses = []string{filter.Status}
+	case filter.OrderID == 0:
+		repoFilter.Statuses = pendingReturnStatuses
+	}
+	repoFilter.OrderID = filter.OrderID
+
+	returnRequests, err := c.Repository.GetReturnRequests(ctx, repoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	var rOutput []ReturnRequestOutput
+	for _, rr := range returnRequests {
+		returnRequest := ReturnRequestOutput{
+			ID:        rr.ReturnRequest.ID,
+			OrderID:   rr.ReturnRequest.OrderID,
+			Status:    rr.ReturnRequest.Status,
+			Reason:    rr.ReturnRequest.Reason,
+			Note:      rr.ReturnRequest.Note,
+			CreatedAt: rr.ReturnRequest.CreatedAt,
+			UpdatedAt: rr.ReturnRequest.UpdatedAt,
+		}
+		if rr.ReturnRequest.RefundAmount.Valid {
+			refundAmount := rr.ReturnRequest.RefundAmount.Decimal
+			returnRequest.RefundAmount = &refundAmount
+		}
+		if rr.ReturnRequest.ReplacementOrderID.Valid {
+			replacementOrderID := rr.ReturnRequest.ReplacementOrderID.Int
+			returnRequest.ReplacementOrderID = &replacementOrderID
+		}
+		if rr.ReturnRequest.ReceivedAt.Valid {
+			receivedAt := rr.ReturnRequest.ReceivedAt.Time
+			returnRequest.ReceivedAt = &receivedAt
+		}
+		if rr.ReturnRequest.ResolvedAt.Valid {
+			resolvedAt := rr.ReturnRequest.ResolvedAt.Time
+			returnRequest.ResolvedAt = &resolvedAt
+		}
+		for _, ri := range rr.Items {
+			returnRequest.Items = append(returnRequest.Items, ReturnItemOutput{
+				ID:          ri.ID,
+				OrderItemID: ri.OrderItemID,
+				Quantity:    ri.Quantity,
+			})
+		}
+		rOutput = append(rOutput, returnRequest)
+	}
+
+	return rOutput, nil
+}
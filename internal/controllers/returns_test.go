@@ -0,0 +1,318 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test CreateReturnRequest in Controller layer
+func Test_ReturnController_CreateReturnRequest(t *testing.T) {
+	deliveredOrder := models.Order{ID: 1, UserID: 1, Status: OrderStatusDelivered}
+	orderItem := models.OrderItem{ID: 10, OrderID: 1, ProductID: 100, Quantity: 2}
+
+	type mockOrderRepo struct {
+		expCall bool
+		output  models.Order
+		err     error
+	}
+	type mockReturnRepo struct {
+		expCall  bool
+		returned map[int]int
+	}
+	type mockOrderItemRepo struct {
+		expCall bool
+		output  models.OrderItem
+		err     error
+	}
+	tests := map[string]struct {
+		rInput            ReturnRequestInput
+		mockOrderRepo     mockOrderRepo
+		mockReturnRepo    mockReturnRepo
+		mockOrderItemRepo mockOrderItemRepo
+		expErr            error
+	}{
+		"missing reason": {
+			rInput: ReturnRequestInput{OrderID: 1, Items: []ReturnItemInput{{OrderItemID: 10, Quantity: 1}}},
+			expErr: ErrMissingReturnReason,
+		},
+		"missing items": {
+			rInput: ReturnRequestInput{OrderID: 1, Reason: "broken"},
+			expErr: ErrMissingReturnItems,
+		},
+		"order not found": {
+			rInput: ReturnRequestInput{OrderID: 1, Reason: "broken", Items: []ReturnItemInput{{OrderItemID: 10, Quantity: 1}}},
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				err:     repositories.ErrOrderNotFound,
+			},
+			expErr: ErrOrderNotFound,
+		},
+		"order not delivered": {
+			rInput: ReturnRequestInput{OrderID: 1, Reason: "broken", Items: []ReturnItemInput{{OrderItemID: 10, Quantity: 1}}},
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				output:  models.Order{ID: 1, UserID: 1, Status: OrderStatusShipped},
+			},
+			expErr: ErrOrderNotReturnable,
+		},
+		"item of another order": {
+			rInput: ReturnRequestInput{OrderID: 1, Reason: "broken", Items: []ReturnItemInput{{OrderItemID: 20, Quantity: 1}}},
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				output:  deliveredOrder,
+			},
+			mockReturnRepo: mockReturnRepo{
+				expCall:  true,
+				returned: map[int]int{},
+			},
+			mockOrderItemRepo: mockOrderItemRepo{
+				expCall: true,
+				output:  models.OrderItem{ID: 20, OrderID: 2, ProductID: 100, Quantity: 1},
+			},
+			expErr: ErrOrderItemNotFound,
+		},
+		"quantity more than ordered": {
+			rInput: ReturnRequestInput{OrderID: 1, Reason: "broken", Items: []ReturnItemInput{{OrderItemID: 10, Quantity: 3}}},
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				output:  deliveredOrder,
+			},
+			mockReturnRepo: mockReturnRepo{
+				expCall:  true,
+				returned: map[int]int{},
+			},
+			mockOrderItemRepo: mockOrderItemRepo{
+				expCall: true,
+				output:  orderItem,
+			},
+			expErr: ErrInvalidReturnQuantity,
+		},
+		"item already returned": {
+			rInput: ReturnRequestInput{OrderID: 1, Reason: "broken", Items: []ReturnItemInput{{OrderItemID: 10, Quantity: 1}}},
+			mockOrderRepo: mockOrderRepo{
+				expCall: true,
+				output:  deliveredOrder,
+			},
+			mockReturnRepo: mockReturnRepo{
+				expCall:  true,
+				returned: map[int]int{10: 2},
+			},
+			mockOrderItemRepo: mockOrderItemRepo{
+				expCall: true,
+				output:  orderItem,
+			},
+			expErr: ErrInvalidReturnQuantity,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockOrderRepo.expCall {
+				mockRepo.On("GetOrder", context.Background(), tc.rInput.OrderID).Return(tc.mockOrderRepo.output, tc.mockOrderRepo.err)
+				if tc.mockOrderRepo.err == nil && tc.mockOrderRepo.output.Status == OrderStatusDelivered {
+					mockRepo.On("GetUser", context.Background(), tc.mockOrderRepo.output.UserID).Return(models.User{ID: 1, Email: "qthuy@gmail.com"}, nil)
+				}
+			}
+			if tc.mockReturnRepo.expCall {
+				mockRepo.On("GetReturnedQuantities", context.Background(), tc.rInput.OrderID, []string{ReturnStatusRejected}).Return(tc.mockReturnRepo.returned, nil)
+			}
+			if tc.mockOrderItemRepo.expCall {
+				mockRepo.On("GetOrderItem", context.Background(), tc.rInput.Items[0].OrderItemID).Return(tc.mockOrderItemRepo.output, tc.mockOrderItemRepo.err)
+			}
+
+			err := controller.CreateReturnRequest(context.Background(), tc.rInput)
+			assert.EqualError(t, err, tc.expErr.Error())
+		})
+	}
+}
+
+// Test UpdateReturnStatus in Controller layer
+func Test_ReturnController_UpdateReturnStatus(t *testing.T) {
+	type mockReturnRepo struct {
+		output models.ReturnRequest
+		err    error
+	}
+	tests := map[string]struct {
+		returnRequestID int
+		status          string
+		mockReturnRepo  mockReturnRepo
+		expUpdateErr    error
+		expErr          error
+	}{
+		"return request not found": {
+			returnRequestID: 1,
+			status:          ReturnStatusApproved,
+			mockReturnRepo: mockReturnRepo{
+				err: repositories.ErrReturnRequestNotFound,
+			},
+			expErr: ErrReturnRequestNotFound,
+		},
+		"receive a request which is not approved": {
+			returnRequestID: 1,
+			status:          ReturnStatusReceived,
+			mockReturnRepo: mockReturnRepo{
+				output: models.ReturnRequest{ID: 1, OrderID: 1, Status: ReturnStatusRequested},
+			},
+			expErr: ErrInvalidReturnStatus,
+		},
+		"refund a request which is not received": {
+			returnRequestID: 1,
+			status:          ReturnStatusRefunded,
+			mockReturnRepo: mockReturnRepo{
+				output: models.ReturnRequest{ID: 1, OrderID: 1, Status: ReturnStatusApproved},
+			},
+			expErr: ErrInvalidReturnStatus,
+		},
+		"reopen a rejected request": {
+			returnRequestID: 1,
+			status:          ReturnStatusApproved,
+			mockReturnRepo: mockReturnRepo{
+				output: models.ReturnRequest{ID: 1, OrderID: 1, Status: ReturnStatusRejected},
+			},
+			expErr: ErrInvalidReturnStatus,
+		},
+		"approve a request moved concurrently": {
+			returnRequestID: 1,
+			status:          ReturnStatusApproved,
+			mockReturnRepo: mockReturnRepo{
+				output: models.ReturnRequest{ID: 1, OrderID: 1, Status: ReturnStatusRequested},
+			},
+			expUpdateErr: repositories.ErrReturnRequestNotFound,
+			expErr:       ErrInvalidReturnStatus,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			mockRepo.On("GetReturnRequest", context.Background(), tc.returnRequestID).Return(tc.mockReturnRepo.output, tc.mockReturnRepo.err)
+			if tc.expUpdateErr != nil {
+				tx := sql.Tx{}
+				mockRepo.On("GetOrder", context.Background(), 1).Return(models.Order{ID: 1, UserID: 2}, nil)
+				mockRepo.On("GetUser", context.Background(), 2).Return(models.User{ID: 2, Email: "qthuy@gmail.com"}, nil)
+				mockRepo.On("GetReturnItems", context.Background(), tc.returnRequestID).Return([]models.ReturnItem{}, nil)
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("UpdateReturnRequest", context.Background(), &tx, mock.Anything, tc.mockReturnRepo.output.Status).Return(tc.expUpdateErr)
+			}
+
+			err := controller.UpdateReturnStatus(context.Background(), tc.returnRequestID, tc.status, "")
+			assert.EqualError(t, err, tc.expErr.Error())
+		})
+	}
+}
+
+// Test calculateRefundAmount in Controller layer
+func Test_ReturnController_calculateRefundAmount(t *testing.T) {
+	tests := map[string]struct {
+		orderItem models.OrderItem
+		quantity  int
+		expAmount decimal.Decimal
+	}{
+		"whole item": {
+			orderItem: models.OrderItem{Quantity: 2, Price: decimal.NewFromInt(100), TaxAmount: decimal.NewFromInt(20)},
+			quantity:  2,
+			expAmount: decimal.NewFromInt(220),
+		},
+		"part of the item": {
+			orderItem: models.OrderItem{Quantity: 3, Price: decimal.NewFromInt(100), TaxAmount: decimal.NewFromInt(25)},
+			quantity:  1,
+			expAmount: decimal.RequireFromString("108.33"),
+		},
+		"item without tax": {
+			orderItem: models.OrderItem{Quantity: 1, Price: decimal.RequireFromString("9.99")},
+			quantity:  1,
+			expAmount: decimal.RequireFromString("9.99"),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			amount := calculateRefundAmount(tc.orderItem, tc.quantity)
+			assert.True(t, tc.expAmount.Equal(amount), "expected %s, got %s", tc.expAmount, amount)
+		})
+	}
+}
+
+// Test GetReturnRequests in Controller layer
+func Test_ReturnController_GetReturnRequests(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	refundAmount := decimal.NewFromInt(220)
+
+	tests := map[string]struct {
+		filter     ReturnRequestFilterCtrl
+		expFilter  repositories.ReturnRequestFilter
+		repoOutput []repositories.ReturnRequestOutput
+		expOutput  []ReturnRequestOutput
+	}{
+		"pending queue": {
+			expFilter: repositories.ReturnRequestFilter{Statuses: []string{ReturnStatusRequested, ReturnStatusApproved, ReturnStatusReceived}},
+			repoOutput: []repositories.ReturnRequestOutput{
+				{
+					ReturnRequest: models.ReturnRequest{ID: 1, OrderID: 1, Status: ReturnStatusRequested, Reason: "broken", CreatedAt: createdAt, UpdatedAt: createdAt},
+					Items:         []models.ReturnItem{{ID: 1, ReturnRequestID: 1, OrderItemID: 10, Quantity: 1}},
+				},
+			},
+			expOutput: []ReturnRequestOutput{
+				{
+					ID:        1,
+					OrderID:   1,
+					Status:    ReturnStatusRequested,
+					Reason:    "broken",
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					Items:     []ReturnItemOutput{{ID: 1, OrderItemID: 10, Quantity: 1}},
+				},
+			},
+		},
+		"by status": {
+			filter:    ReturnRequestFilterCtrl{Status: ReturnStatusRefunded},
+			expFilter: repositories.ReturnRequestFilter{Statuses: []string{ReturnStatusRefunded}},
+			repoOutput: []repositories.ReturnRequestOutput{
+				{
+					ReturnRequest: models.ReturnRequest{ID: 2, OrderID: 1, Status: ReturnStatusRefunded, Reason: "broken", RefundAmount: decimal.NewNullDecimal(refundAmount), ResolvedAt: null.TimeFrom(createdAt), CreatedAt: createdAt, UpdatedAt: createdAt},
+				},
+			},
+			expOutput: []ReturnRequestOutput{
+				{
+					ID:           2,
+					OrderID:      1,
+					Status:       ReturnStatusRefunded,
+					Reason:       "broken",
+					RefundAmount: &refundAmount,
+					ResolvedAt:   &createdAt,
+					CreatedAt:    createdAt,
+					UpdatedAt:    createdAt,
+				},
+			},
+		},
+		"all the requests of an order": {
+			filter:    ReturnRequestFilterCtrl{OrderID: 1},
+			expFilter: repositories.ReturnRequestFilter{OrderID: 1},
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			mockRepo.On("GetReturnRequests", context.Background(), tc.expFilter).Return(tc.repoOutput, nil)
+
+			output, err := controller.GetReturnRequests(context.Background(), tc.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expOutput, output)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+)
+
+// names of the background jobs
+const (
+	JobCancelStaleOrders      = "cancel-stale-orders"
+	JobApplyScheduledPrices   = "apply-scheduled-prices"
+	JobSendLowStockDigest     = "send-low-stock-digest"
+	JobComputeRelatedProducts = "compute-related-products"
+	JobSendBackInStock        = "send-back-in-stock-notifications"
+)
+
+type SchedulerConfig struct {
+	// PendingOrderTimeout is how long an order can stay PENDING before it is cancelled
+	PendingOrderTimeout time.Duration
+	// CancelStaleOrdersInterval is how often the PENDING orders are checked
+	CancelStaleOrdersInterval time.Duration
+	// ApplyScheduledPricesInterval is how often the scheduled prices are checked
+	ApplyScheduledPricesInterval time.Duration
+	// LowStockDigestInterval is how often the low stock digest is sent to the catalog managers
+	LowStockDigestInterval time.Duration
+	// RelatedProductsInterval is how often the products bought together are computed from the orders
+	RelatedProductsInterval time.Duration
+	// BackInStockInterval is how often the users subscribed to the restocked products are emailed
+	BackInStockInterval time.Duration
+}
+
+// Job is a background job run by the scheduler at every interval, it returns a summary of what has been done
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) (string, error)
+}
+
+// Scheduler runs the background jobs in process. Every instance of the application runs a scheduler,
+// a redis lock makes sure that a job is run by only one of them at a time
+type Scheduler struct {
+	repository repositories.IRepository
+	jobs       []Job
+}
+
+// NewScheduler returns a scheduler of the background jobs of the application
+func NewScheduler(repository repositories.IRepository, config SchedulerConfig) *Scheduler {
+	c := &Controller{Repository: repository}
+
+	return &Scheduler{
+		repository: repository,
+		jobs: []Job{
+			{
+				Name:     JobCancelStaleOrders,
+				Interval: config.CancelStaleOrdersInterval,
+				Run: func(ctx context.Context) (string, error) {
+					cancelled, err := c.CancelStaleOrders(ctx, config.PendingOrderTimeout)
+					return fmt.Sprintf("%d orders cancelled", cancelled), err
+				},
+			},
+			{
+				Name:     JobApplyScheduledPrices,
+				Interval: config.ApplyScheduledPricesInterval,
+				Run: func(ctx context.Context) (string, error) {
+					applied, err := c.ApplyScheduledPrices(ctx)
+					return fmt.Sprintf("%d product prices changed", applied), err
+				},
+			},
+			{
+				Name:     JobSendLowStockDigest,
+				Interval: config.LowStockDigestInterval,
+				Run: func(ctx context.Context) (string, error) {
+					sent, err := c.SendLowStockDigest(ctx)
+					return fmt.Sprintf("%d low stock alerts sent", sent), err
+				},
+			},
+			{
+				Name:     JobComputeRelatedProducts,
+				Interval: config.RelatedProductsInterval,
+				Run: func(ctx context.Context) (string, error) {
+					computed, err := c.ComputeRelatedProducts(ctx)
+					return fmt.Sprintf("related products of %d products computed", computed), err
+				},
+			},
+			{
+				Name:     JobSendBackInStock,
+				Interval: config.BackInStockInterval,
+				Run: func(ctx context.Context) (string, error) {
+					notified, err := c.SendBackInStockNotifications(ctx)
+					return fmt.Sprintf("%d back in stock notifications sent", notified), err
+				},
+			},
+		},
+	}
+}
+
+// Start runs each job right away and then at every interval until the context is done
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go func(job Job) {
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+
+			for {
+				s.runJob(ctx, job)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(job)
+	}
+}
+
+// jobLockMargin is taken off the interval for the lock of a job so that it has expired by the next tick of the instance
+// which ran the job
+const jobLockMargin = time.Second
+
+// runJob runs a job if its lock is free and stores the status of the run. The lock of a successful run is kept until it
+// expires so that the other instances skip the job for the rest of the interval, it is released if the run failed
+// so that the job is retried by the next instance whose ticker fires
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	// the lock expires after an interval in case the instance holding it dies
+	lockName := fmt.Sprintf("job:%s", job.Name)
+	lockTTL := job.Interval
+	if lockTTL > 2*jobLockMargin {
+		lockTTL -= jobLockMargin
+	}
+	token, err := s.repository.AcquireLock(ctx, lockName, lockTTL)
+	if err != nil {
+		log.Printf("could not acquire the lock of job %s: %v", job.Name, err)
+		return
+	}
+	if token == "" {
+		// another instance has run the job in this interval
+		return
+	}
+
+	jobRun := repositories.JobRun{
+		Name:      job.Name,
+		StartedAt: time.Now(),
+	}
+	result, err := job.Run(ctx)
+	jobRun.FinishedAt = time.Now()
+	jobRun.Result = result
+	jobRun.Success = err == nil
+	if err != nil {
+		log.Printf("job %s failed: %v", job.Name, err)
+		jobRun.Error = err.Error()
+
+		if err := s.repository.ReleaseLock(ctx, lockName, token); err != nil {
+			log.Printf("could not release the lock of job %s: %v", job.Name, err)
+		}
+	}
+
+	if err := s.repository.SaveJobRun(ctx, jobRun); err != nil {
+		log.Printf("could not save the run of job %s: %v", job.Name, err)
+	}
+}
+
+type JobRunOutput struct {
+	Name       string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Success    bool
+	Result     string
+	Error      string
+}
+
+// GetJobRuns retrieves the last run of each background job
+func (c *Controller) GetJobRuns(ctx context.Context) ([]JobRunOutput, error) {
+	jobRuns, err := c.Repository.GetJobRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var jrOutput []JobRunOutput
+	for _, jr := range jobRuns {
+		jrOutput = append(jrOutput, JobRunOutput{
+			Name:       jr.Name,
+			StartedAt:  jr.StartedAt,
+			FinishedAt: jr.FinishedAt,
+			Success:    jr.Success,
+			Result:     jr.Result,
+			Error:      jr.Error,
+		})
+	}
+
+	return jrOutput, nil
+}
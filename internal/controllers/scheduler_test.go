@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test runJob in Controller layer
+func Test_Scheduler_runJob(t *testing.T) {
+	tests := map[string]struct {
+		token      string
+		jobErr     error
+		expRun     bool
+		expSuccess bool
+		expError   string
+	}{
+		"run job successfully": {
+			token:      "token",
+			expRun:     true,
+			expSuccess: true,
+		},
+		"job failed": {
+			token:    "token",
+			jobErr:   errors.New("something went wrong"),
+			expRun:   true,
+			expError: "something went wrong",
+		},
+		"job run by another instance": {},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			ran := false
+			job := Job{
+				Name:     "test-job",
+				Interval: time.Minute,
+				Run: func(ctx context.Context) (string, error) {
+					ran = true
+					return "done", tc.jobErr
+				},
+			}
+			scheduler := &Scheduler{repository: mockRepo, jobs: []Job{job}}
+
+			// the lock of a successful run is kept until it expires
+			mockRepo.On("AcquireLock", context.Background(), "job:test-job", time.Minute-jobLockMargin).Return(tc.token, nil)
+			if tc.jobErr != nil {
+				mockRepo.On("ReleaseLock", context.Background(), "job:test-job", tc.token).Return(nil)
+			}
+			if tc.expRun {
+				mockRepo.On("SaveJobRun", context.Background(), mock.MatchedBy(func(jr repositories.JobRun) bool {
+					return jr.Name == job.Name && jr.Result == "done" && jr.Success == tc.expSuccess && jr.Error == tc.expError
+				})).Return(nil)
+			}
+
+			scheduler.runJob(context.Background(), job)
+			assert.Equal(t, tc.expRun, ran)
+		})
+	}
+}
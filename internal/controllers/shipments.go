@@ -0,0 +1,236 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/email"
+	"github.com/volatiletech/null/v8"
+)
+
+// statuses of a shipment
+const (
+	ShipmentStatusShipped   = "SHIPPED"
+	ShipmentStatusInTransit = "IN_TRANSIT"
+	ShipmentStatusDelivered = "DELIVERED"
+)
+
+type ShipmentInput struct {
+	Carrier        string
+	TrackingNumber string
+}
+
+// CreateShipment dispatches a paid order, the order is marked as shipped and the customer gets an email with the tracking number
+func (c *Controller) CreateShipment(ctx context.Context, orderID int, sInput ShipmentInput) error {
+	order, err := c.Repository.GetOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrOrderNotFound) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	// only a paid order can be shipped
+	if order.Status != OrderStatusPaid {
+		return ErrOrderNotShippable
+	}
+
+	user, err := c.Repository.GetUser(ctx, order.UserID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	// use the carrier of the shipping method if not given
+	carrier := sInput.Carrier
+	if carrier == "" && order.ShippingMethodID.Valid {
+		shippingMethod, err := c.getShippingMethod(ctx, order.ShippingMethodID.Int)
+		if err != nil {
+			return err
+		}
+		carrier = shippingMethod.Carrier
+	}
+	if carrier == "" {
+		return ErrMissingCarrier
+	}
+
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	// the order is read again and locked so that a stale cached order is not written back, nor shipped twice
+	if order, err = c.Repository.LockOrder(ctx, tx, orderID); err != nil {
+		if errors.Is(err, repositories.ErrOrderNotFound) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+	if order.Status != OrderStatusPaid {
+		return ErrOrderNotShippable
+	}
+
+	shipment, err := c.Repository.CreateShipment(ctx, tx, repositories.Shipment{
+		OrderID:        order.ID,
+		Carrier:        carrier,
+		TrackingNumber: sInput.TrackingNumber,
+		Status:         ShipmentStatusShipped,
+		ShippedAt:      null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return err
+	}
+
+	order.Status = OrderStatusShipped
+	if err = c.Repository.UpdateOrder(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return err
+	}
+
+	// the order is shipped even if the customer could not be emailed
+	if err = c.SendEmailShipment(ctx, user.Email, order, shipment); err != nil {
+		log.Printf("could not send the shipment email of order %d: %v", order.ID, err)
+	}
+
+	return nil
+}
+
+// SendEmailShipment sends an email that contains the carrier and tracking number of the shipment to the user
+func (c *Controller) SendEmailShipment(ctx context.Context, emailTo string, order models.Order, shipment models.Shipment) error {
+	body := []string{
+		"Hi users,",
+		fmt.Sprintf("Your order #%d has been shipped.", order.ID),
+		fmt.Sprintf("Carrier: %s", shipment.Carrier),
+		fmt.Sprintf("Tracking number: %s", shipment.TrackingNumber),
+	}
+
+	if order.AddressID.Valid {
+		address, err := c.Repository.GetAddress(ctx, order.AddressID.Int)
+		if err != nil {
+			return err
+		}
+		body = append(body, fmt.Sprintf("Shipping to: %s, %s, %s, %s", address.RecipientName, address.Street, address.City, address.Country))
+	}
+	body = append(body, "Thanks for choosing us!")
+
+	sender := email.NewEmailSender()
+
+	m := email.NewMessage("Order Shipped", strings.Join(body, "\n"))
+	m.To = []string{emailTo}
+
+	return sender.Send(m)
+}
+
+// UpdateShipmentStatus updates the status of a shipment, the order is marked as delivered when the shipment is delivered
+func (c *Controller) UpdateShipmentStatus(ctx context.Context, shipmentID int, status string) error {
+	if status != ShipmentStatusInTransit && status != ShipmentStatusDelivered {
+		return ErrInvalidShipmentStatus
+	}
+
+	shipment, err := c.Repository.GetShipment(ctx, shipmentID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrShipmentNotFound) {
+			return ErrShipmentNotFound
+		}
+		return err
+	}
+
+	// a delivered shipment cannot go back
+	if shipment.Status == ShipmentStatusDelivered {
+		return ErrInvalidShipmentStatus
+	}
+
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	shipment.Status = status
+	if status == ShipmentStatusDelivered {
+		shipment.DeliveredAt = null.TimeFrom(time.Now())
+
+		// the order is locked so that a stale cached order is not written back
+		order, err := c.Repository.LockOrder(ctx, tx, shipment.OrderID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrOrderNotFound) {
+				return ErrOrderNotFound
+			}
+			return err
+		}
+
+		order.Status = OrderStatusDelivered
+		if err = c.Repository.UpdateOrder(ctx, tx, order); err != nil {
+			return err
+		}
+	}
+
+	if err = c.Repository.UpdateShipment(ctx, tx, shipment); err != nil {
+		return err
+	}
+
+	return c.Repository.CommitTx(tx)
+}
+
+type ShipmentOutput struct {
+	ID             int
+	OrderID        int
+	Carrier        string
+	TrackingNumber string
+	Status         string
+	ShippedAt      *time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// GetShipments retrieves all the shipments of an order
+func (c *Controller) GetShipments(ctx context.Context, orderID int) ([]ShipmentOutput, error) {
+	if _, err := c.Repository.GetOrder(ctx, orderID); err != nil {
+		if errors.Is(err, repositories.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	shipments, err := c.Repository.GetShipments(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sOutput []ShipmentOutput
+	for _, s := range shipments {
+		shipment := ShipmentOutput{
+			ID:             s.ID,
+			OrderID:        s.OrderID,
+			Carrier:        s.Carrier,
+			TrackingNumber: s.TrackingNumber,
+			Status:         s.Status,
+			CreatedAt:      s.CreatedAt,
+			UpdatedAt:      s.UpdatedAt,
+		}
+		if s.ShippedAt.Valid {
+			shippedAt := s.ShippedAt.Time
+			shipment.ShippedAt = &shippedAt
+		}
+		if s.DeliveredAt.Valid {
+			deliveredAt := s.DeliveredAt.Time
+			shipment.DeliveredAt = &deliveredAt
+		}
+		sOutput = append(sOutput, shipment)
+	}
+
+	return sOutput, nil
+}
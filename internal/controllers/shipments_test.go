@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test CreateShipment in Controller layer
+func Test_ShipmentController_CreateShipment(t *testing.T) {
+	type mockOrderRepo struct {
+		output models.Order
+		err    error
+	}
+	tests := map[string]struct {
+		orderID       int
+		sInput        ShipmentInput
+		mockOrderRepo mockOrderRepo
+		lockedOrder   *models.Order
+		expErr        error
+	}{
+		"order not found": {
+			orderID: 1,
+			sInput:  ShipmentInput{Carrier: "GHN", TrackingNumber: "GHN123"},
+			mockOrderRepo: mockOrderRepo{
+				err: repositories.ErrOrderNotFound,
+			},
+			expErr: ErrOrderNotFound,
+		},
+		"order is not paid": {
+			orderID: 1,
+			sInput:  ShipmentInput{Carrier: "GHN", TrackingNumber: "GHN123"},
+			mockOrderRepo: mockOrderRepo{
+				output: models.Order{ID: 1, UserID: 1, Status: "PENDING"},
+			},
+			expErr: ErrOrderNotShippable,
+		},
+		"order shipped concurrently": {
+			orderID: 1,
+			sInput:  ShipmentInput{Carrier: "GHN", TrackingNumber: "GHN123"},
+			mockOrderRepo: mockOrderRepo{
+				output: models.Order{ID: 1, UserID: 1, Status: OrderStatusPaid},
+			},
+			lockedOrder: &models.Order{ID: 1, UserID: 1, Status: OrderStatusShipped},
+			expErr:      ErrOrderNotShippable,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			mockRepo.On("GetOrder", context.Background(), tc.orderID).Return(tc.mockOrderRepo.output, tc.mockOrderRepo.err)
+			if tc.lockedOrder != nil {
+				tx := sql.Tx{}
+				mockRepo.On("GetUser", context.Background(), 1).Return(models.User{ID: 1, Email: "qthuy@gmail.com"}, nil)
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("LockOrder", context.Background(), &tx, tc.orderID).Return(*tc.lockedOrder, nil)
+			}
+
+			err := controller.CreateShipment(context.Background(), tc.orderID, tc.sInput)
+			assert.EqualError(t, err, tc.expErr.Error())
+		})
+	}
+}
+
+// Test UpdateShipmentStatus in Controller layer
+func Test_ShipmentController_UpdateShipmentStatus(t *testing.T) {
+	type mockShipmentRepo struct {
+		expCall bool
+		output  models.Shipment
+		err     error
+	}
+	tests := map[string]struct {
+		shipmentID       int
+		status           string
+		mockShipmentRepo mockShipmentRepo
+		expDelivered     bool
+		expErr           error
+	}{
+		"mark as in transit": {
+			shipmentID: 1,
+			status:     ShipmentStatusInTransit,
+			mockShipmentRepo: mockShipmentRepo{
+				expCall: true,
+				output:  models.Shipment{ID: 1, OrderID: 1, Status: ShipmentStatusShipped},
+			},
+		},
+		"mark as delivered": {
+			shipmentID: 1,
+			status:     ShipmentStatusDelivered,
+			mockShipmentRepo: mockShipmentRepo{
+				expCall: true,
+				output:  models.Shipment{ID: 1, OrderID: 1, Status: ShipmentStatusInTransit},
+			},
+			expDelivered: true,
+		},
+		"invalid status": {
+			shipmentID: 1,
+			status:     ShipmentStatusShipped,
+			expErr:     ErrInvalidShipmentStatus,
+		},
+		"shipment already delivered": {
+			shipmentID: 1,
+			status:     ShipmentStatusInTransit,
+			mockShipmentRepo: mockShipmentRepo{
+				expCall: true,
+				output:  models.Shipment{ID: 1, OrderID: 1, Status: ShipmentStatusDelivered},
+			},
+			expErr: ErrInvalidShipmentStatus,
+		},
+		"shipment not found": {
+			shipmentID: 1,
+			status:     ShipmentStatusDelivered,
+			mockShipmentRepo: mockShipmentRepo{
+				expCall: true,
+				err:     repositories.ErrShipmentNotFound,
+			},
+			expErr: ErrShipmentNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			if tc.mockShipmentRepo.expCall {
+				mockRepo.On("GetShipment", context.Background(), tc.shipmentID).Return(tc.mockShipmentRepo.output, tc.mockShipmentRepo.err)
+			}
+			if tc.expErr == nil {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("CommitTx", &tx).Return(nil)
+
+				if tc.expDelivered {
+					order := models.Order{ID: 1, UserID: 1, Status: OrderStatusShipped}
+					mockRepo.On("LockOrder", context.Background(), &tx, 1).Return(order, nil)
+
+					order.Status = OrderStatusDelivered
+					mockRepo.On("UpdateOrder", context.Background(), &tx, order).Return(nil)
+				}
+
+				mockRepo.On("UpdateShipment", context.Background(), &tx, mock.MatchedBy(func(s models.Shipment) bool {
+					return s.Status == tc.status && s.DeliveredAt.Valid == tc.expDelivered
+				})).Return(nil)
+			}
+
+			err := controller.UpdateShipmentStatus(context.Background(), tc.shipmentID, tc.status)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+)
+
+// rate types of a shipping method
+const (
+	// ShippingRateFlat charges the base rate for every order
+	ShippingRateFlat = "FLAT"
+	// ShippingRateWeight charges the base rate plus the rate per kg of the order weight
+	ShippingRateWeight = "WEIGHT"
+)
+
+type ShippingMethodInput struct {
+	Name      string
+	Carrier   string
+	RateType  string
+	BaseRate  decimal.Decimal
+	RatePerKG decimal.Decimal
+}
+
+// CreateShippingMethod creates a shipping method in db given by shipping method model in parameter
+func (c *Controller) CreateShippingMethod(ctx context.Context, smInput ShippingMethodInput) error {
+	if smInput.RateType != ShippingRateFlat && smInput.RateType != ShippingRateWeight {
+		return ErrInvalidShippingRateType
+	}
+
+	return c.Repository.CreateShippingMethod(ctx, repositories.ShippingMethod{
+		Name:      smInput.Name,
+		Carrier:   smInput.Carrier,
+		RateType:  smInput.RateType,
+		BaseRate:  smInput.BaseRate,
+		RatePerKG: smInput.RatePerKG,
+	})
+}
+
+type ShippingMethodOutput struct {
+	ID        int
+	Name      string
+	Carrier   string
+	RateType  string
+	BaseRate  decimal.Decimal
+	RatePerKG decimal.Decimal
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GetShippingMethods retrieves all the shipping methods in db
+func (c *Controller) GetShippingMethods(ctx context.Context) ([]ShippingMethodOutput, error) {
+	shippingMethods, err := c.Repository.GetShippingMethods(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var smOutput []ShippingMethodOutput
+	for _, sm := range shippingMethods {
+		smOutput = append(smOutput, ShippingMethodOutput{
+			ID:        sm.ID,
+			Name:      sm.Name,
+			Carrier:   sm.Carrier,
+			RateType:  sm.RateType,
+			BaseRate:  sm.BaseRate,
+			RatePerKG: sm.RatePerKG,
+			CreatedAt: sm.CreatedAt,
+			UpdatedAt: sm.UpdatedAt,
+		})
+	}
+
+	return smOutput, nil
+}
+
+// getShippingMethod retrieves a shipping method in db by ID
+func (c *Controller) getShippingMethod(ctx context.Context, id int) (models.ShippingMethod, error) {
+	shippingMethod, err := c.Repository.GetShippingMethod(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrShippingMethodNotFound) {
+			return models.ShippingMethod{}, ErrShippingMethodNotFound
+		}
+		return models.ShippingMethod{}, err
+	}
+
+	return shippingMethod, nil
+}
+
+// calculateShippingPrice returns the shipping cost of an order by the rate of the shipping method and the order weight in kg
+func calculateShippingPrice(shippingMethod models.ShippingMethod, weight decimal.Decimal) decimal.Decimal {
+	if shippingMethod.RateType == ShippingRateWeight {
+		return shippingMethod.BaseRate.Add(shippingMethod.RatePerKG.Mul(weight)).Round(2)
+	}
+
+	return shippingMethod.BaseRate
+}
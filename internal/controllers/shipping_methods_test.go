@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateShippingMethod in Controller layer
+func Test_ShippingMethodController_CreateShippingMethod(t *testing.T) {
+	type mockShippingMethodRepo struct {
+		expCall bool
+		input   repositories.ShippingMethod
+		err     error
+	}
+	tests := map[string]struct {
+		smInput                ShippingMethodInput
+		mockShippingMethodRepo mockShippingMethodRepo
+		expErr                 error
+	}{
+		"success": {
+			smInput: ShippingMethodInput{
+				Name:      "Express",
+				Carrier:   "GHN",
+				RateType:  ShippingRateWeight,
+				BaseRate:  decimal.New(20000, 0),
+				RatePerKG: decimal.New(5000, 0),
+			},
+			mockShippingMethodRepo: mockShippingMethodRepo{
+				expCall: true,
+				input: repositories.ShippingMethod{
+					Name:      "Express",
+					Carrier:   "GHN",
+					RateType:  ShippingRateWeight,
+					BaseRate:  decimal.New(20000, 0),
+					RatePerKG: decimal.New(5000, 0),
+				},
+			},
+		},
+		"invalid rate type": {
+			smInput: ShippingMethodInput{
+				Name:     "Express",
+				Carrier:  "GHN",
+				RateType: "DISTANCE",
+				BaseRate: decimal.New(20000, 0),
+			},
+			expErr: ErrInvalidShippingRateType,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockShippingMethodRepo.expCall {
+				mockRepo.On("CreateShippingMethod", context.Background(), tc.mockShippingMethodRepo.input).Return(tc.mockShippingMethodRepo.err)
+			}
+
+			err := controller.CreateShippingMethod(context.Background(), tc.smInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test calculateShippingPrice in Controller layer
+func Test_ShippingMethodController_calculateShippingPrice(t *testing.T) {
+	tests := map[string]struct {
+		shippingMethod models.ShippingMethod
+		weight         decimal.Decimal
+		expPrice       decimal.Decimal
+	}{
+		"flat rate": {
+			shippingMethod: models.ShippingMethod{
+				RateType:  ShippingRateFlat,
+				BaseRate:  decimal.New(30000, 0),
+				RatePerKG: decimal.New(5000, 0),
+			},
+			weight:   decimal.NewFromFloat(2.5),
+			expPrice: decimal.New(30000, 0),
+		},
+		"weight-based rate": {
+			shippingMethod: models.ShippingMethod{
+				RateType:  ShippingRateWeight,
+				BaseRate:  decimal.New(20000, 0),
+				RatePerKG: decimal.New(5000, 0),
+			},
+			weight:   decimal.NewFromFloat(2.5),
+			expPrice: decimal.New(32500, 0),
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			price := calculateShippingPrice(tc.shippingMethod, tc.weight)
+			assert.True(t, tc.expPrice.Equal(price), "expected %s, got %s", tc.expPrice, price)
+		})
+	}
+}
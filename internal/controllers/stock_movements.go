@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/volatiletech/null/v8"
+)
+
+type StockAdjustmentInput struct {
+	ProductID int
+	// VariantID is the variant whose stock is adjusted, it must be given if the product has variants
+	VariantID int
+	// WarehouseID is the warehouse the stock of the product is adjusted in, the default warehouse if 0
+	WarehouseID int
+	Delta       int
+	ActorID     int
+	Note        string
+}
+
+type StockMovementOutput struct {
+	ID            int
+	ProductID     int
+	VariantID     *int
+	WarehouseID   *int
+	Delta         int
+	Reason        string
+	ReferenceID   *int
+	ActorID       *int
+	Note          string
+	QuantityAfter int
+	CreatedAt     time.Time
+}
+
+// StockReportOutput shows how the current stock of a product or variant is derived from its movements
+type StockReportOutput struct {
+	ProductID int
+	VariantID *int
+	// Quantity is the current quantity of the product or variant
+	Quantity int
+	// LedgerQuantity is the sum of the deltas of the movements
+	LedgerQuantity int
+	// Consistent reports whether the current quantity is the quantity derived from the movements
+	Consistent bool
+	// Totals are the sums of the deltas by reason
+	Totals    map[string]int
+	Movements []StockMovementOutput
+}
+
+// AdjustStock manually adds the delta to the stock of a product or of one of its variants, the adjustment is recorded
+// on the stock ledger with the user who made it and the note
+func (c *Controller) AdjustStock(ctx context.Context, saInput StockAdjustmentInput) (StockMovementOutput, error) {
+	if saInput.Delta == 0 {
+		return StockMovementOutput{}, ErrInvalidStockDelta
+	}
+
+	product, variant, err := c.getOrderItemStock(ctx, saInput.ProductID, saInput.VariantID)
+	if err != nil {
+		return StockMovementOutput{}, err
+	}
+	if product.DeletedAt.Valid {
+		return StockMovementOutput{}, ErrProductNotFound
+	}
+
+	if saInput.WarehouseID != 0 {
+		if variant != nil {
+			return StockMovementOutput{}, ErrVariantWarehouse
+		}
+		if _, err := c.getWarehouse(ctx, saInput.WarehouseID); err != nil {
+			return StockMovementOutput{}, err
+		}
+	}
+
+	// check actor exists
+	if _, err := c.Repository.GetUser(ctx, saInput.ActorID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return StockMovementOutput{}, ErrUserNotFound
+		}
+		return StockMovementOutput{}, err
+	}
+
+	smReq := repositories.StockMovement{
+		ProductID: product.ID,
+		Delta:     saInput.Delta,
+		Reason:    repositories.StockReasonAdjustment,
+		ActorID:   null.IntFrom(saInput.ActorID),
+		Note:      saInput.Note,
+	}
+	if variant != nil {
+		smReq.VariantID = null.IntFrom(variant.ID)
+	}
+	if saInput.WarehouseID != 0 {
+		smReq.WarehouseID = null.IntFrom(saInput.WarehouseID)
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return StockMovementOutput{}, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	stockMovement, err := c.Repository.RecordStockMovement(ctx, tx, smReq)
+	if err != nil {
+		if errors.Is(err, repositories.ErrInsufficientStock) {
+			return StockMovementOutput{}, ErrInsufficientQuantity
+		}
+		return StockMovementOutput{}, err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return StockMovementOutput{}, err
+	}
+
+	if variant == nil {
+		c.deleteProductCache(ctx, product.ID)
+	}
+
+	return toStockMovementOutput(stockMovement), nil
+}
+
+// GetStockReport retrieves the current stock of a product or of one of its variants with the movements it is derived from
+func (c *Controller) GetStockReport(ctx context.Context, productID, variantID int) (StockReportOutput, error) {
+	product, variant, err := c.getOrderItemStock(ctx, productID, variantID)
+	if err != nil {
+		return StockReportOutput{}, err
+	}
+
+	report := StockReportOutput{
+		ProductID: product.ID,
+		Quantity:  product.Quantity,
+		Totals:    make(map[string]int),
+	}
+	var vID null.Int
+	if variant != nil {
+		vID = null.IntFrom(variant.ID)
+		report.VariantID = &variant.ID
+		report.Quantity = variant.Quantity
+	}
+
+	stockMovements, err := c.Repository.GetStockMovements(ctx, product.ID, vID)
+	if err != nil {
+		return StockReportOutput{}, err
+	}
+
+	for _, sm := range stockMovements {
+		report.LedgerQuantity += sm.Delta
+		report.Totals[sm.Reason] += sm.Delta
+		report.Movements = append(report.Movements, toStockMovementOutput(sm))
+	}
+	report.Consistent = report.LedgerQuantity == report.Quantity
+
+	return report, nil
+}
+
+// toStockMovementOutput converts a movement of the stock ledger to the output
+func toStockMovementOutput(stockMovement models.StockMovement) StockMovementOutput {
+	return StockMovementOutput{
+		ID:            stockMovement.ID,
+		ProductID:     stockMovement.ProductID,
+		VariantID:     stockMovement.VariantID.Ptr(),
+		WarehouseID:   stockMovement.WarehouseID.Ptr(),
+		Delta:         stockMovement.Delta,
+		Reason:        stockMovement.Reason,
+		ReferenceID:   stockMovement.ReferenceID.Ptr(),
+		ActorID:       stockMovement.ActorID.Ptr(),
+		Note:          stockMovement.Note,
+		QuantityAfter: stockMovement.QuantityAfter,
+		CreatedAt:     stockMovement.CreatedAt,
+	}
+}
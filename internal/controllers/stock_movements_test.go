@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test AdjustStock in Controller layer
+func Test_StockController_AdjustStock(t *testing.T) {
+	tests := map[string]struct {
+		input        StockAdjustmentInput
+		product      models.Product
+		productErr   error
+		variantCount int64
+		userErr      error
+		expRecord    *repositories.StockMovement
+		recordErr    error
+		expErr       error
+	}{
+		"adjust stock of product successfully": {
+			input:     StockAdjustmentInput{ProductID: 1, Delta: -2, ActorID: 3, Note: "damaged"},
+			product:   models.Product{ID: 1, Quantity: 5},
+			expRecord: &repositories.StockMovement{ProductID: 1, Delta: -2, Reason: repositories.StockReasonAdjustment, ActorID: null.IntFrom(3), Note: "damaged"},
+		},
+		"zero delta": {
+			input:  StockAdjustmentInput{ProductID: 1, ActorID: 3},
+			expErr: ErrInvalidStockDelta,
+		},
+		"product not found": {
+			input:      StockAdjustmentInput{ProductID: 1, Delta: 2, ActorID: 3},
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"archived product": {
+			input:   StockAdjustmentInput{ProductID: 1, Delta: 2, ActorID: 3},
+			product: models.Product{ID: 1, DeletedAt: null.TimeFrom(time.Now())},
+			expErr:  ErrProductNotFound,
+		},
+		"product with variants requires a variant": {
+			input:        StockAdjustmentInput{ProductID: 1, Delta: 2, ActorID: 3},
+			product:      models.Product{ID: 1},
+			variantCount: 2,
+			expErr:       ErrVariantRequired,
+		},
+		"actor not found": {
+			input:   StockAdjustmentInput{ProductID: 1, Delta: 2, ActorID: 3},
+			product: models.Product{ID: 1},
+			userErr: repositories.ErrUserNotFound,
+			expErr:  ErrUserNotFound,
+		},
+		"stock would be negative": {
+			input:     StockAdjustmentInput{ProductID: 1, Delta: -10, ActorID: 3},
+			product:   models.Product{ID: 1, Quantity: 5},
+			expRecord: &repositories.StockMovement{ProductID: 1, Delta: -10, Reason: repositories.StockReasonAdjustment, ActorID: null.IntFrom(3)},
+			recordErr: repositories.ErrInsufficientStock,
+			expErr:    ErrInsufficientQuantity,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			tx := sql.Tx{}
+
+			if tc.input.Delta != 0 {
+				mockRepo.On("GetProduct", context.Background(), 1).Return(tc.product, tc.productErr)
+				if tc.productErr == nil {
+					mockRepo.On("CountProductVariants", context.Background(), 1).Return(tc.variantCount, nil)
+				}
+				if tc.productErr == nil && tc.variantCount == 0 && !tc.product.DeletedAt.Valid {
+					mockRepo.On("GetUser", context.Background(), 3).Return(models.User{ID: 3}, tc.userErr)
+				}
+			}
+			if tc.expRecord != nil {
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+				mockRepo.On("RecordStockMovement", context.Background(), &tx, *tc.expRecord).
+					Return(models.StockMovement{ID: 9, ProductID: 1, Delta: tc.expRecord.Delta, Reason: tc.expRecord.Reason, ActorID: tc.expRecord.ActorID, Note: tc.expRecord.Note, QuantityAfter: 3}, tc.recordErr)
+				if tc.recordErr == nil {
+					mockRepo.On("CommitTx", &tx).Return(nil)
+					if !tc.expRecord.VariantID.Valid {
+						mockRepo.On("DeleteProductCache", context.Background(), 1).Return(nil)
+					}
+				}
+			}
+
+			output, err := controller.AdjustStock(context.Background(), tc.input)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				actorID := 3
+				assert.Equal(t, StockMovementOutput{ID: 9, ProductID: 1, Delta: -2, Reason: repositories.StockReasonAdjustment, ActorID: &actorID, Note: "damaged", QuantityAfter: 3}, output)
+			}
+		})
+	}
+}
+
+// Test GetStockReport in Controller layer
+func Test_StockController_GetStockReport(t *testing.T) {
+	movements := []models.StockMovement{
+		{ID: 1, ProductID: 1, Delta: 10, Reason: repositories.StockReasonAdjustment, QuantityAfter: 10},
+		{ID: 2, ProductID: 1, Delta: -3, Reason: repositories.StockReasonSale, ReferenceID: null.IntFrom(4), QuantityAfter: 7},
+		{ID: 3, ProductID: 1, Delta: 1, Reason: repositories.StockReasonReturn, ReferenceID: null.IntFrom(2), QuantityAfter: 8},
+	}
+
+	tests := map[string]struct {
+		product       models.Product
+		productErr    error
+		expConsistent bool
+		expErr        error
+	}{
+		"stock derived from the ledger": {
+			product:       models.Product{ID: 1, Quantity: 8},
+			expConsistent: true,
+		},
+		"stock not matching the ledger": {
+			product: models.Product{ID: 1, Quantity: 6},
+		},
+		"product not found": {
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetProduct", context.Background(), 1).Return(tc.product, tc.productErr)
+			if tc.productErr == nil {
+				mockRepo.On("CountProductVariants", context.Background(), 1).Return(int64(0), nil)
+				mockRepo.On("GetStockMovements", context.Background(), 1, null.Int{}).Return(movements, nil)
+			}
+
+			report, err := controller.GetStockReport(context.Background(), 1, 0)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.product.Quantity, report.Quantity)
+				assert.Equal(t, 8, report.LedgerQuantity)
+				assert.Equal(t, tc.expConsistent, report.Consistent)
+				assert.Equal(t, map[string]int{repositories.StockReasonAdjustment: 10, repositories.StockReasonSale: -3, repositories.StockReasonReturn: 1}, report.Totals)
+				assert.Len(t, report.Movements, 3)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+)
+
+type TaxRuleInput struct {
+	CategoryName  string
+	Region        string
+	Rate          decimal.Decimal
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
+}
+
+// CreateTaxRule creates a tax rule of a product category
+func (c *Controller) CreateTaxRule(ctx context.Context, trInput TaxRuleInput) error {
+	// check product category exists
+	pCate, err := c.Repository.GetProductCategoryByName(ctx, trInput.CategoryName)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+			return ErrProductCategoryNotFound
+		}
+		return err
+	}
+
+	if !trInput.EffectiveTo.IsZero() && !trInput.EffectiveTo.After(trInput.EffectiveFrom) {
+		return ErrInvalidEffectiveDate
+	}
+
+	taxRule := repositories.TaxRule{
+		CategoryID:    pCate.ID,
+		Region:        trInput.Region,
+		Rate:          trInput.Rate,
+		EffectiveFrom: trInput.EffectiveFrom,
+	}
+	if !trInput.EffectiveTo.IsZero() {
+		taxRule.EffectiveTo = null.TimeFrom(trInput.EffectiveTo)
+	}
+
+	return c.Repository.CreateTaxRule(ctx, taxRule)
+}
+
+type TaxRuleOutput struct {
+	ID            int
+	CategoryID    int
+	Region        string
+	Rate          decimal.Decimal
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// GetTaxRules retrieves the tax rules, filtered by the product category name and region if given
+func (c *Controller) GetTaxRules(ctx context.Context, categoryName, region string) ([]TaxRuleOutput, error) {
+	var filter repositories.TaxRuleFilter
+	if categoryName != "" {
+		pCate, err := c.Repository.GetProductCategoryByName(ctx, categoryName)
+		if err != nil {
+			if errors.Is(err, repositories.ErrProductCategoryNotFound) {
+				return nil, ErrProductCategoryNotFound
+			}
+			return nil, err
+		}
+		filter.CategoryID = pCate.ID
+	}
+	filter.Region = region
+
+	taxRules, err := c.Repository.GetTaxRules(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var trOutput []TaxRuleOutput
+	for _, tr := range taxRules {
+		taxRule := TaxRuleOutput{
+			ID:            tr.ID,
+			CategoryID:    tr.CategoryID,
+			Region:        tr.Region,
+			Rate:          tr.Rate,
+			EffectiveFrom: tr.EffectiveFrom,
+			CreatedAt:     tr.CreatedAt,
+			UpdatedAt:     tr.UpdatedAt,
+		}
+		if tr.EffectiveTo.Valid {
+			effectiveTo := tr.EffectiveTo.Time
+			taxRule.EffectiveTo = &effectiveTo
+		}
+		trOutput = append(trOutput, taxRule)
+	}
+
+	return trOutput, nil
+}
+
+// getTaxRate returns the tax rate of a product category that is in effect at the given time for the region.
+// The rate is zero if the category has no tax rule
+func (c *Controller) getTaxRate(ctx context.Context, categoryID int, region string, at time.Time) (decimal.Decimal, error) {
+	taxRule, err := c.Repository.GetEffectiveTaxRule(ctx, categoryID, region, at)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTaxRuleNotFound) {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, err
+	}
+
+	return taxRule.Rate, nil
+}
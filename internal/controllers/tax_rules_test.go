@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test CreateTaxRule in Controller layer
+func Test_TaxRuleController_CreateTaxRule(t *testing.T) {
+	effectiveFrom := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	effectiveTo := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	type mockPCateRepo struct {
+		expCall bool
+		output  models.ProductCategory
+		err     error
+	}
+	type mockTaxRuleRepo struct {
+		expCall bool
+		input   repositories.TaxRule
+		err     error
+	}
+	tests := map[string]struct {
+		trInput         TaxRuleInput
+		mockPCateRepo   mockPCateRepo
+		mockTaxRuleRepo mockTaxRuleRepo
+		expErr          error
+	}{
+		"success": {
+			trInput: TaxRuleInput{
+				CategoryName:  "Food",
+				Region:        "HCM",
+				Rate:          decimal.NewFromFloat(0.05),
+				EffectiveFrom: effectiveFrom,
+				EffectiveTo:   effectiveTo,
+			},
+			mockPCateRepo: mockPCateRepo{
+				expCall: true,
+				output:  models.ProductCategory{ID: 1, Name: "Food"},
+			},
+			mockTaxRuleRepo: mockTaxRuleRepo{
+				expCall: true,
+				input: repositories.TaxRule{
+					CategoryID:    1,
+					Region:        "HCM",
+					Rate:          decimal.NewFromFloat(0.05),
+					EffectiveFrom: effectiveFrom,
+					EffectiveTo:   null.TimeFrom(effectiveTo),
+				},
+			},
+		},
+		"success without effective end date": {
+			trInput: TaxRuleInput{
+				CategoryName:  "Food",
+				Rate:          decimal.NewFromFloat(0.05),
+				EffectiveFrom: effectiveFrom,
+			},
+			mockPCateRepo: mockPCateRepo{
+				expCall: true,
+				output:  models.ProductCategory{ID: 1, Name: "Food"},
+			},
+			mockTaxRuleRepo: mockTaxRuleRepo{
+				expCall: true,
+				input: repositories.TaxRule{
+					CategoryID:    1,
+					Rate:          decimal.NewFromFloat(0.05),
+					EffectiveFrom: effectiveFrom,
+				},
+			},
+		},
+		"product category not found": {
+			trInput: TaxRuleInput{
+				CategoryName:  "Food",
+				Rate:          decimal.NewFromFloat(0.05),
+				EffectiveFrom: effectiveFrom,
+			},
+			mockPCateRepo: mockPCateRepo{
+				expCall: true,
+				err:     repositories.ErrProductCategoryNotFound,
+			},
+			expErr: ErrProductCategoryNotFound,
+		},
+		"effective end date before start date": {
+			trInput: TaxRuleInput{
+				CategoryName:  "Food",
+				Rate:          decimal.NewFromFloat(0.05),
+				EffectiveFrom: effectiveTo,
+				EffectiveTo:   effectiveFrom,
+			},
+			mockPCateRepo: mockPCateRepo{
+				expCall: true,
+				output:  models.ProductCategory{ID: 1, Name: "Food"},
+			},
+			expErr: ErrInvalidEffectiveDate,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+			if tc.mockPCateRepo.expCall {
+				mockRepo.On("GetProductCategoryByName", context.Background(), tc.trInput.CategoryName).Return(tc.mockPCateRepo.output, tc.mockPCateRepo.err)
+			}
+			if tc.mockTaxRuleRepo.expCall {
+				mockRepo.On("CreateTaxRule", context.Background(), tc.mockTaxRuleRepo.input).Return(tc.mockTaxRuleRepo.err)
+			}
+
+			err := controller.CreateTaxRule(context.Background(), tc.trInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test getTaxRate in Controller layer
+func Test_TaxRuleController_getTaxRate(t *testing.T) {
+	at := time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	type mockTaxRuleRepo struct {
+		output models.TaxRule
+		err    error
+	}
+	tests := map[string]struct {
+		mockTaxRuleRepo mockTaxRuleRepo
+		expRate         decimal.Decimal
+		expErr          error
+	}{
+		"tax rule in effect": {
+			mockTaxRuleRepo: mockTaxRuleRepo{
+				output: models.TaxRule{ID: 1, CategoryID: 1, Rate: decimal.NewFromFloat(0.1)},
+			},
+			expRate: decimal.NewFromFloat(0.1),
+		},
+		"no tax rule": {
+			mockTaxRuleRepo: mockTaxRuleRepo{
+				err: repositories.ErrTaxRuleNotFound,
+			},
+			expRate: decimal.Zero,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+			mockRepo.On("GetEffectiveTaxRule", context.Background(), 1, "HCM", at).Return(tc.mockTaxRuleRepo.output, tc.mockTaxRuleRepo.err)
+
+			rate, err := controller.getTaxRate(context.Background(), 1, "HCM", at)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, tc.expRate.Equal(rate))
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/volatiletech/null/v8"
+)
+
+type WarehouseInput struct {
+	Name    string
+	City    string
+	Region  string
+	Country string
+	// IsDefault makes the warehouse keep the stock which is not given a warehouse, instead of the current default
+	IsDefault bool
+}
+
+type WarehouseOutput struct {
+	ID        int
+	Name      string
+	City      string
+	Region    string
+	Country   string
+	IsDefault bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WarehouseStockOutput is the quantity of a product available in a warehouse
+type WarehouseStockOutput struct {
+	WarehouseID   int
+	WarehouseName string
+	City          string
+	Region        string
+	Country       string
+	Quantity      int
+}
+
+type StockTransferInput struct {
+	ProductID       int
+	FromWarehouseID int
+	ToWarehouseID   int
+	Quantity        int
+	ActorID         int
+	Note            string
+}
+
+// CreateWarehouse creates a warehouse, the name of a warehouse is unique
+func (c *Controller) CreateWarehouse(ctx context.Context, wInput WarehouseInput) (WarehouseOutput, error) {
+	if _, err := c.Repository.GetWarehouseByName(ctx, wInput.Name); err == nil {
+		return WarehouseOutput{}, ErrWarehouseExists
+	} else if !errors.Is(err, repositories.ErrWarehouseNotFound) {
+		return WarehouseOutput{}, err
+	}
+
+	warehouse, err := c.Repository.CreateWarehouse(ctx, repositories.Warehouse{
+		Name:      wInput.Name,
+		City:      wInput.City,
+		Region:    wInput.Region,
+		Country:   wInput.Country,
+		IsDefault: wInput.IsDefault,
+	})
+	if err != nil {
+		return WarehouseOutput{}, err
+	}
+
+	return toWarehouseOutput(warehouse), nil
+}
+
+// GetWarehouses retrieves all the warehouses
+func (c *Controller) GetWarehouses(ctx context.Context) ([]WarehouseOutput, error) {
+	warehouses, err := c.Repository.GetWarehouses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []WarehouseOutput
+	for _, w := range warehouses {
+		output = append(output, toWarehouseOutput(w))
+	}
+
+	return output, nil
+}
+
+// TransferStock moves a quantity of a product from a warehouse to another, the transfer is recorded on the stock ledger
+// of both warehouses with the user who made it
+func (c *Controller) TransferStock(ctx context.Context, stInput StockTransferInput) ([]StockMovementOutput, error) {
+	if stInput.Quantity <= 0 {
+		return nil, ErrInvalidTransferQuantity
+	}
+	if stInput.FromWarehouseID == stInput.ToWarehouseID {
+		return nil, ErrSameWarehouseTransfer
+	}
+
+	product, err := c.Repository.GetProduct(ctx, stInput.ProductID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	if product.DeletedAt.Valid {
+		return nil, ErrProductNotFound
+	}
+
+	for _, id := range []int{stInput.FromWarehouseID, stInput.ToWarehouseID} {
+		if _, err := c.getWarehouse(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	// check actor exists
+	if _, err := c.Repository.GetUser(ctx, stInput.ActorID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	// start a transaction
+	tx, err := c.Repository.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Repository.RollbackTx(tx)
+
+	stockMovements, err := c.Repository.TransferStock(ctx, tx, repositories.StockTransfer{
+		ProductID:       product.ID,
+		FromWarehouseID: stInput.FromWarehouseID,
+		ToWarehouseID:   stInput.ToWarehouseID,
+		Quantity:        stInput.Quantity,
+		ActorID:         null.IntFrom(stInput.ActorID),
+		Note:            stInput.Note,
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrInsufficientStock) {
+			return nil, ErrInsufficientQuantity
+		}
+		return nil, err
+	}
+
+	if err = c.Repository.CommitTx(tx); err != nil {
+		return nil, err
+	}
+
+	var output []StockMovementOutput
+	for _, sm := range stockMovements {
+		output = append(output, toStockMovementOutput(sm))
+	}
+
+	return output, nil
+}
+
+// getWarehouse retrieves a warehouse by ID
+func (c *Controller) getWarehouse(ctx context.Context, id int) (models.Warehouse, error) {
+	warehouse, err := c.Repository.GetWarehouse(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWarehouseNotFound) {
+			return models.Warehouse{}, ErrWarehouseNotFound
+		}
+		return models.Warehouse{}, err
+	}
+
+	return warehouse, nil
+}
+
+// getProductAvailability retrieves the quantity of a product available in each warehouse, the warehouses which are out of
+// the product are left out
+func (c *Controller) getProductAvailability(ctx context.Context, productID int) ([]WarehouseStockOutput, error) {
+	stocks, err := c.Repository.GetWarehouseStocks(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []WarehouseStockOutput
+	for _, s := range stocks {
+		if s.Quantity == 0 {
+			continue
+		}
+		stockOutput := WarehouseStockOutput{WarehouseID: s.WarehouseID, Quantity: s.Quantity}
+		if w := s.R.GetWarehouse(); w != nil {
+			stockOutput.WarehouseName, stockOutput.City, stockOutput.Region, stockOutput.Country = w.Name, w.City, w.Region, w.Country
+		}
+		output = append(output, stockOutput)
+	}
+
+	return output, nil
+}
+
+// allocateOrderItem picks the warehouse an order item is fulfilled from, 0 for a variant as the stock of a variant is not kept by warehouse
+func (c *Controller) allocateOrderItem(ctx context.Context, product models.Product, variant *models.ProductVariant, oi OrderItemInput, address *AddressOutput) (int, error) {
+	if variant != nil {
+		if oi.WarehouseID != 0 {
+			return 0, ErrVariantWarehouse
+		}
+		return 0, nil
+	}
+
+	return c.allocateWarehouse(ctx, product.ID, oi.Quantity, oi.WarehouseID, address)
+}
+
+// allocateWarehouse picks the warehouse an order item of a product is fulfilled from. The chosen warehouse is used if given,
+// otherwise the nearest warehouse to the shipping address which has the whole quantity: in the same city, then region,
+// then country, the default warehouse and the warehouse with the most stock first for the same distance.
+// ErrInsufficientQuantity is returned if no warehouse has the quantity
+func (c *Controller) allocateWarehouse(ctx context.Context, productID, quantity, chosenWarehouseID int, address *AddressOutput) (int, error) {
+	if chosenWarehouseID != 0 {
+		if _, err := c.getWarehouse(ctx, chosenWarehouseID); err != nil {
+			return 0, err
+		}
+	}
+
+	stocks, err := c.Repository.GetWarehouseStocks(ctx, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	var candidates []models.WarehouseStock
+	for _, s := range stocks {
+		if s.Quantity < quantity || s.R.GetWarehouse() == nil {
+			continue
+		}
+		if chosenWarehouseID != 0 && s.WarehouseID != chosenWarehouseID {
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+	if len(candidates) == 0 {
+		return 0, ErrInsufficientQuantity
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		wi, wj := candidates[i].R.Warehouse, candidates[j].R.Warehouse
+		if di, dj := warehouseDistance(*wi, address), warehouseDistance(*wj, address); di != dj {
+			return di < dj
+		}
+		if wi.IsDefault != wj.IsDefault {
+			return wi.IsDefault
+		}
+		return candidates[i].Quantity > candidates[j].Quantity
+	})
+
+	return candidates[0].WarehouseID, nil
+}
+
+// warehouseDistance ranks how far a warehouse is from a shipping address: 0 in the same city, 1 in the same region,
+// 2 in the same country and 3 otherwise or if there is no address
+func warehouseDistance(warehouse models.Warehouse, address *AddressOutput) int {
+	if address == nil {
+		return 3
+	}
+
+	sameLocation := func(a, b string) bool {
+		return a != "" && strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+	}
+	sameCountry := address.Country == "" || warehouse.Country == "" || sameLocation(warehouse.Country, address.Country)
+
+	switch {
+	case sameCountry && sameLocation(warehouse.City, address.City):
+		return 0
+	case sameCountry && sameLocation(warehouse.Region, address.Region):
+		return 1
+	case sameLocation(warehouse.Country, address.Country):
+		return 2
+	}
+	return 3
+}
+
+// toWarehouseOutput converts a warehouse to the output
+func toWarehouseOutput(warehouse models.Warehouse) WarehouseOutput {
+	return WarehouseOutput{
+		ID:        warehouse.ID,
+		Name:      warehouse.Name,
+		City:      warehouse.City,
+		Region:    warehouse.Region,
+		Country:   warehouse.Country,
+		IsDefault: warehouse.IsDefault,
+		CreatedAt: warehouse.CreatedAt,
+		UpdatedAt: warehouse.UpdatedAt,
+	}
+}
@@ -0,0 +1,229 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+// warehouseStockOf returns the stock of product 1 in the warehouse with the warehouse loaded
+func warehouseStockOf(warehouse models.Warehouse, quantity int) models.WarehouseStock {
+	stock := models.WarehouseStock{WarehouseID: warehouse.ID, ProductID: 1, Quantity: quantity}
+	stock.R = stock.R.NewStruct()
+	stock.R.Warehouse = &warehouse
+	return stock
+}
+
+// Test CreateWarehouse in Controller layer
+func Test_WarehouseController_CreateWarehouse(t *testing.T) {
+	tests := map[string]struct {
+		givenInput   WarehouseInput
+		existing     bool
+		expRepoInput *repositories.Warehouse
+		expOutput    WarehouseOutput
+		expErr       error
+	}{
+		"create warehouse successfully": {
+			givenInput:   WarehouseInput{Name: "Ha Noi", City: "Ha Noi", Country: "Vietnam"},
+			expRepoInput: &repositories.Warehouse{Name: "Ha Noi", City: "Ha Noi", Country: "Vietnam"},
+			expOutput:    WarehouseOutput{ID: 2, Name: "Ha Noi", City: "Ha Noi", Country: "Vietnam"},
+		},
+		"name already exists": {
+			givenInput: WarehouseInput{Name: "Main warehouse"},
+			existing:   true,
+			expErr:     ErrWarehouseExists,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.existing {
+				mockRepo.On("GetWarehouseByName", context.Background(), tc.givenInput.Name).Return(models.Warehouse{ID: 1, Name: tc.givenInput.Name}, nil)
+			} else {
+				mockRepo.On("GetWarehouseByName", context.Background(), tc.givenInput.Name).Return(models.Warehouse{}, repositories.ErrWarehouseNotFound)
+			}
+			if tc.expRepoInput != nil {
+				mockRepo.On("CreateWarehouse", context.Background(), *tc.expRepoInput).Return(models.Warehouse{
+					ID: 2, Name: tc.expRepoInput.Name, City: tc.expRepoInput.City, Country: tc.expRepoInput.Country,
+				}, nil)
+			}
+
+			output, err := controller.CreateWarehouse(context.Background(), tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test TransferStock in Controller layer
+func Test_WarehouseController_TransferStock(t *testing.T) {
+	fromID, toID, actorID := 1, 2, 3
+
+	tests := map[string]struct {
+		givenInput  StockTransferInput
+		expTransfer bool
+		transferErr error
+		expOutput   []StockMovementOutput
+		expErr      error
+	}{
+		"transfer stock successfully": {
+			givenInput:  StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 2, Quantity: 2, ActorID: 3, Note: "rebalance"},
+			expTransfer: true,
+			expOutput: []StockMovementOutput{
+				{ID: 7, ProductID: 1, WarehouseID: &fromID, Delta: -2, Reason: repositories.StockReasonTransfer, ActorID: &actorID, Note: "rebalance", QuantityAfter: 5},
+				{ID: 8, ProductID: 1, WarehouseID: &toID, Delta: 2, Reason: repositories.StockReasonTransfer, ActorID: &actorID, Note: "rebalance", QuantityAfter: 5},
+			},
+		},
+		"source warehouse does not have the quantity": {
+			givenInput:  StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 2, Quantity: 20, ActorID: 3},
+			expTransfer: true,
+			transferErr: repositories.ErrInsufficientStock,
+			expErr:      ErrInsufficientQuantity,
+		},
+		"same warehouse": {
+			givenInput: StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 1, Quantity: 2, ActorID: 3},
+			expErr:     ErrSameWarehouseTransfer,
+		},
+		"zero quantity": {
+			givenInput: StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 2, ActorID: 3},
+			expErr:     ErrInvalidTransferQuantity,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			if tc.expTransfer {
+				mockRepo.On("GetProduct", context.Background(), 1).Return(models.Product{ID: 1, Quantity: 5}, nil)
+				mockRepo.On("GetWarehouse", context.Background(), 1).Return(models.Warehouse{ID: 1}, nil)
+				mockRepo.On("GetWarehouse", context.Background(), 2).Return(models.Warehouse{ID: 2}, nil)
+				mockRepo.On("GetUser", context.Background(), 3).Return(models.User{ID: 3}, nil)
+
+				tx := sql.Tx{}
+				mockRepo.On("BeginTx", context.Background()).Return(&tx, nil)
+				mockRepo.On("RollbackTx", &tx).Return(nil)
+
+				var stockMovements []models.StockMovement
+				if tc.transferErr == nil {
+					stockMovements = []models.StockMovement{
+						{ID: 7, ProductID: 1, WarehouseID: null.IntFrom(1), Delta: -2, Reason: repositories.StockReasonTransfer, ActorID: null.IntFrom(3), Note: "rebalance", QuantityAfter: 5},
+						{ID: 8, ProductID: 1, WarehouseID: null.IntFrom(2), Delta: 2, Reason: repositories.StockReasonTransfer, ActorID: null.IntFrom(3), Note: "rebalance", QuantityAfter: 5},
+					}
+					mockRepo.On("CommitTx", &tx).Return(nil)
+				}
+				mockRepo.On("TransferStock", context.Background(), &tx, repositories.StockTransfer{
+					ProductID:       1,
+					FromWarehouseID: tc.givenInput.FromWarehouseID,
+					ToWarehouseID:   tc.givenInput.ToWarehouseID,
+					Quantity:        tc.givenInput.Quantity,
+					ActorID:         null.IntFrom(3),
+					Note:            tc.givenInput.Note,
+				}).Return(stockMovements, tc.transferErr)
+			}
+
+			output, err := controller.TransferStock(context.Background(), tc.givenInput)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, output)
+			}
+		})
+	}
+}
+
+// Test allocateWarehouse in Controller layer
+func Test_WarehouseController_allocateWarehouse(t *testing.T) {
+	stocks := []models.WarehouseStock{
+		warehouseStockOf(models.Warehouse{ID: 1, Name: "Main warehouse", City: "Ho Chi Minh", Region: "South", Country: "Vietnam", IsDefault: true}, 10),
+		warehouseStockOf(models.Warehouse{ID: 2, Name: "Ha Noi", City: "Ha Noi", Region: "North", Country: "Vietnam"}, 3),
+		warehouseStockOf(models.Warehouse{ID: 3, Name: "Hai Phong", City: "Hai Phong", Region: "North", Country: "Vietnam"}, 8),
+	}
+
+	tests := map[string]struct {
+		quantity     int
+		chosenID     int
+		chosenErr    error
+		address      *AddressOutput
+		expWarehouse int
+		expErr       error
+	}{
+		"same city": {
+			quantity:     2,
+			address:      &AddressOutput{City: "Ha Noi", Region: "North", Country: "Vietnam"},
+			expWarehouse: 2,
+		},
+		"same city out of the quantity falls back to the same region": {
+			quantity:     5,
+			address:      &AddressOutput{City: "Ha Noi", Region: "North", Country: "Vietnam"},
+			expWarehouse: 3,
+		},
+		"same country prefers the default warehouse": {
+			quantity:     2,
+			address:      &AddressOutput{City: "Da Nang", Region: "Central", Country: "Vietnam"},
+			expWarehouse: 1,
+		},
+		"no address prefers the default warehouse": {
+			quantity:     2,
+			expWarehouse: 1,
+		},
+		"chosen warehouse": {
+			quantity:     2,
+			chosenID:     3,
+			address:      &AddressOutput{City: "Ha Noi", Region: "North", Country: "Vietnam"},
+			expWarehouse: 3,
+		},
+		"chosen warehouse out of the quantity": {
+			quantity: 5,
+			chosenID: 2,
+			expErr:   ErrInsufficientQuantity,
+		},
+		"chosen warehouse not found": {
+			quantity:  2,
+			chosenID:  9,
+			chosenErr: repositories.ErrWarehouseNotFound,
+			expErr:    ErrWarehouseNotFound,
+		},
+		"no warehouse has the quantity": {
+			quantity: 11,
+			expErr:   ErrInsufficientQuantity,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := &Controller{Repository: mockRepo}
+
+			if tc.chosenID != 0 {
+				mockRepo.On("GetWarehouse", context.Background(), tc.chosenID).Return(models.Warehouse{ID: tc.chosenID}, tc.chosenErr)
+			}
+			if !errors.Is(tc.chosenErr, repositories.ErrWarehouseNotFound) {
+				mockRepo.On("GetWarehouseStocks", context.Background(), 1).Return(stocks, nil)
+			}
+
+			warehouseID, err := controller.allocateWarehouse(context.Background(), 1, tc.quantity, tc.chosenID, tc.address)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expWarehouse, warehouseID)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/qthuy2k1/product-management/internal/utils/email"
+	"github.com/shopspring/decimal"
+)
+
+// AddToWishlist saves a product to the wishlist of a user, a product is saved once
+func (c *Controller) AddToWishlist(ctx context.Context, userID, productID int) error {
+	// check user exists
+	if _, err := c.Repository.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	// an archived product cannot be saved
+	product, err := c.Repository.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+	if product.DeletedAt.Valid {
+		return ErrProductNotFound
+	}
+
+	// check the product is not in the wishlist yet
+	if _, err := c.Repository.GetWishlistItem(ctx, userID, productID); err == nil {
+		return ErrWishlistItemExists
+	} else if !errors.Is(err, repositories.ErrWishlistItemNotFound) {
+		return err
+	}
+
+	_, err = c.Repository.AddWishlistItem(ctx, userID, productID)
+	return err
+}
+
+type WishlistItemOutput struct {
+	ID          int
+	UserID      int
+	ProductID   int
+	ProductName string
+	ProductSlug string
+	Price       decimal.Decimal
+	Currency    string
+	Quantity    int
+	// NotifyBackInStock reports whether the user is emailed once the product is back in stock
+	NotifyBackInStock bool
+	CreatedAt         time.Time
+}
+
+// GetWishlist retrieves the wishlist of a user with the current price and stock of the products, the last saved first.
+// The archived products are left out
+func (c *Controller) GetWishlist(ctx context.Context, userID int) ([]WishlistItemOutput, error) {
+	// check user exists
+	if _, err := c.Repository.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	items, err := c.Repository.GetWishlistItems(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var wOutput []WishlistItemOutput
+	for _, i := range items {
+		p := i.R.GetProduct()
+		if p == nil || p.DeletedAt.Valid {
+			continue
+		}
+		wOutput = append(wOutput, WishlistItemOutput{
+			ID:                i.ID,
+			UserID:            i.UserID,
+			ProductID:         p.ID,
+			ProductName:       p.Name,
+			ProductSlug:       p.Slug,
+			Price:             p.Price,
+			Currency:          p.Currency,
+			Quantity:          p.Quantity,
+			NotifyBackInStock: i.NotifyBackInStock,
+			CreatedAt:         i.CreatedAt,
+		})
+	}
+
+	return wOutput, nil
+}
+
+// RemoveFromWishlist removes a product from the wishlist of a user, its back in stock subscription is removed with it
+func (c *Controller) RemoveFromWishlist(ctx context.Context, userID, productID int) error {
+	if err := c.Repository.DeleteWishlistItem(ctx, userID, productID); err != nil {
+		if errors.Is(err, repositories.ErrWishlistItemNotFound) {
+			return ErrWishlistItemNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SubscribeBackInStock subscribes a user to the back in stock email of a product in their wishlist which is out of stock.
+// The user is emailed once, after the product is restocked by an adjustment, an import or a cancellation
+func (c *Controller) SubscribeBackInStock(ctx context.Context, userID, productID int) error {
+	item, err := c.Repository.GetWishlistItem(ctx, userID, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWishlistItemNotFound) {
+			return ErrWishlistItemNotFound
+		}
+		return err
+	}
+	if item.NotifyBackInStock {
+		return nil
+	}
+
+	product, err := c.Repository.GetProduct(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProductNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+	if product.DeletedAt.Valid {
+		return ErrProductNotFound
+	}
+	if product.Quantity > 0 {
+		return ErrProductInStock
+	}
+
+	return c.Repository.SetWishlistItemNotification(ctx, userID, productID, true)
+}
+
+// UnsubscribeBackInStock unsubscribes a user from the back in stock email of a product in their wishlist
+func (c *Controller) UnsubscribeBackInStock(ctx context.Context, userID, productID int) error {
+	if err := c.Repository.SetWishlistItemNotification(ctx, userID, productID, false); err != nil {
+		if errors.Is(err, repositories.ErrWishlistItemNotFound) {
+			return ErrWishlistItemNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SendBackInStockNotifications emails the users subscribed to the products which have been restocked, a user gets one email
+// with all their restocked products. A product which is out of stock again or archived is kept for its next restock.
+// It returns the number of wishlist items notified, the users whose email failed are notified by the next run
+func (c *Controller) SendBackInStockNotifications(ctx context.Context) (int, error) {
+	items, err := c.Repository.GetRestockedWishlistItems(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	var outOfStockIDs []int
+	var userIDs []int
+	users := make(map[int]models.User)
+	itemIDs := make(map[int][]int)
+	products := make(map[int][]models.Product)
+	for _, i := range items {
+		p, u := i.R.GetProduct(), i.R.GetUser()
+		if p == nil || u == nil || p.DeletedAt.Valid || p.Quantity <= 0 {
+			outOfStockIDs = append(outOfStockIDs, i.ID)
+			continue
+		}
+		if _, ok := users[u.ID]; !ok {
+			userIDs = append(userIDs, u.ID)
+			users[u.ID] = *u
+		}
+		itemIDs[u.ID] = append(itemIDs[u.ID], i.ID)
+		products[u.ID] = append(products[u.ID], *p)
+	}
+
+	if len(outOfStockIDs) > 0 {
+		if err = c.Repository.ClearWishlistItemsRestocked(ctx, outOfStockIDs); err != nil {
+			return 0, err
+		}
+	}
+
+	var notifiedIDs []int
+	var sendErr error
+	for _, userID := range userIDs {
+		if err := c.SendEmailBackInStock(users[userID], products[userID]); err != nil {
+			log.Printf("could not send the back in stock email to user %d: %v", userID, err)
+			if sendErr == nil {
+				sendErr = err
+			}
+			continue
+		}
+		notifiedIDs = append(notifiedIDs, itemIDs[userID]...)
+	}
+
+	if len(notifiedIDs) > 0 {
+		if err = c.Repository.MarkWishlistItemsNotified(ctx, notifiedIDs, time.Now()); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(notifiedIDs), sendErr
+}
+
+// SendEmailBackInStock sends an email to a user with the products of their wishlist which are back in stock
+func (c *Controller) SendEmailBackInStock(user models.User, products []models.Product) error {
+	body := []string{
+		fmt.Sprintf("Hi %s,", user.Name),
+		"Good news, the following products of your wishlist are back in stock:",
+	}
+	for _, p := range products {
+		body = append(body, fmt.Sprintf("- %s: %s %s", p.Name, p.Price.String(), p.Currency))
+	}
+	body = append(body, "Get them before they are gone!", "Thanks!")
+
+	sender := email.NewEmailSender()
+
+	subject := fmt.Sprintf("%s is back in stock", products[0].Name)
+	if len(products) > 1 {
+		subject = fmt.Sprintf("%d products of your wishlist are back in stock", len(products))
+	}
+	m := email.NewMessage(subject, strings.Join(body, "\n"))
+	m.To = []string{user.Email}
+
+	return sender.Send(m)
+}
@@ -0,0 +1,166 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/qthuy2k1/product-management/internal/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+// Test AddToWishlist in Controller layer
+func Test_WishlistController_AddToWishlist(t *testing.T) {
+	tests := map[string]struct {
+		userErr    error
+		product    models.Product
+		productErr error
+		itemErr    error
+		expAdd     bool
+		expErr     error
+	}{
+		"success": {
+			product: models.Product{ID: 1, Name: "iPhone 14"},
+			itemErr: repositories.ErrWishlistItemNotFound,
+			expAdd:  true,
+		},
+		"product already in the wishlist": {
+			product: models.Product{ID: 1, Name: "iPhone 14"},
+			expErr:  ErrWishlistItemExists,
+		},
+		"archived product": {
+			product: models.Product{ID: 1, DeletedAt: null.TimeFrom(time.Now())},
+			expErr:  ErrProductNotFound,
+		},
+		"product not found": {
+			productErr: repositories.ErrProductNotFound,
+			expErr:     ErrProductNotFound,
+		},
+		"user not found": {
+			userErr: repositories.ErrUserNotFound,
+			expErr:  ErrUserNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetUser", context.Background(), 2).Return(models.User{ID: 2}, tc.userErr)
+			if tc.userErr == nil {
+				mockRepo.On("GetProduct", context.Background(), 1).Return(tc.product, tc.productErr)
+			}
+			if tc.productErr == nil && tc.userErr == nil && !tc.product.DeletedAt.Valid {
+				mockRepo.On("GetWishlistItem", context.Background(), 2, 1).Return(models.WishlistItem{ID: 3, UserID: 2, ProductID: 1}, tc.itemErr)
+			}
+			if tc.expAdd {
+				mockRepo.On("AddWishlistItem", context.Background(), 2, 1).Return(models.WishlistItem{ID: 3, UserID: 2, ProductID: 1}, nil)
+			}
+
+			err := controller.AddToWishlist(context.Background(), 2, 1)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test SubscribeBackInStock in Controller layer
+func Test_WishlistController_SubscribeBackInStock(t *testing.T) {
+	tests := map[string]struct {
+		item       models.WishlistItem
+		itemErr    error
+		expProduct bool
+		product    models.Product
+		expSet     bool
+		expErr     error
+	}{
+		"out of stock product": {
+			item:       models.WishlistItem{ID: 3, UserID: 2, ProductID: 1},
+			expProduct: true,
+			product:    models.Product{ID: 1, Quantity: 0},
+			expSet:     true,
+		},
+		"already subscribed": {
+			item: models.WishlistItem{ID: 3, UserID: 2, ProductID: 1, NotifyBackInStock: true},
+		},
+		"product in stock": {
+			item:       models.WishlistItem{ID: 3, UserID: 2, ProductID: 1},
+			expProduct: true,
+			product:    models.Product{ID: 1, Quantity: 5},
+			expErr:     ErrProductInStock,
+		},
+		"product not in the wishlist": {
+			itemErr: repositories.ErrWishlistItemNotFound,
+			expErr:  ErrWishlistItemNotFound,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetWishlistItem", context.Background(), 2, 1).Return(tc.item, tc.itemErr)
+			if tc.expProduct {
+				mockRepo.On("GetProduct", context.Background(), 1).Return(tc.product, nil)
+			}
+			if tc.expSet {
+				mockRepo.On("SetWishlistItemNotification", context.Background(), 2, 1, true).Return(nil)
+			}
+
+			err := controller.SubscribeBackInStock(context.Background(), 2, 1)
+			if tc.expErr != nil {
+				assert.EqualError(t, err, tc.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// Test SendBackInStockNotifications in Controller layer, the cases which do not send an email
+func Test_WishlistController_SendBackInStockNotifications(t *testing.T) {
+	user := &models.User{ID: 2, Name: "qthuy", Email: "qthuy@gmail.com"}
+	outOfStock := models.WishlistItem{ID: 3, UserID: 2, ProductID: 1, NotifyBackInStock: true}
+	outOfStock.R = outOfStock.R.NewStruct()
+	outOfStock.R.Product = &models.Product{ID: 1, Name: "iPhone 14", Quantity: 0}
+	outOfStock.R.User = user
+
+	archived := models.WishlistItem{ID: 4, UserID: 2, ProductID: 5, NotifyBackInStock: true}
+	archived.R = archived.R.NewStruct()
+	archived.R.Product = &models.Product{ID: 5, Name: "iPhone 13", Quantity: 3, DeletedAt: null.TimeFrom(time.Now())}
+	archived.R.User = user
+
+	tests := map[string]struct {
+		items    []models.WishlistItem
+		expClear []int
+	}{
+		"no restocked products": {},
+		"products out of stock again or archived": {
+			items:    []models.WishlistItem{outOfStock, archived},
+			expClear: []int{3, 4},
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			mockRepo := repositories.NewMockIRepository(t)
+			controller := NewController(mockRepo)
+
+			mockRepo.On("GetRestockedWishlistItems", context.Background()).Return(tc.items, nil)
+			if tc.expClear != nil {
+				mockRepo.On("ClearWishlistItemsRestocked", context.Background(), tc.expClear).Return(nil)
+			}
+
+			notified, err := controller.SendBackInStockNotifications(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 0, notified)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// GetRevenue is the resolver for the getRevenue field.
+func (r *queryResolver) GetRevenue(ctx context.Context, filter model.FilterDate, period model.AnalyticsPeriod) ([]*model.RevenuePoint, error) {
+	if !period.IsValid() {
+		return nil, ErrInvalidAnalyticsPeriod
+	}
+
+	analyticsFilter, err := validateAndConvertAnalyticsFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, err := r.Controller.GetRevenueByPeriod(ctx, analyticsFilter, strings.ToLower(period.String()))
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	revenueResp := make([]*model.RevenuePoint, 0, len(revenue))
+	for _, rv := range revenue {
+		revenueResp = append(revenueResp, &model.RevenuePoint{
+			Period:     rv.Period.Format("02-01-2006"),
+			Revenue:    rv.Revenue.InexactFloat64(),
+			OrderCount: rv.OrderCount,
+		})
+	}
+
+	return revenueResp, nil
+}
+
+// GetTopProducts is the resolver for the getTopProducts field.
+func (r *queryResolver) GetTopProducts(ctx context.Context, filter model.FilterDate, sortBy model.AnalyticsSort, limit *int) ([]*model.TopProduct, error) {
+	topProducts, err := r.getTopSellers(ctx, filter, sortBy, limit, r.Controller.GetTopProducts)
+	if err != nil {
+		return nil, err
+	}
+
+	topProductsResp := make([]*model.TopProduct, 0, len(topProducts))
+	for _, tp := range topProducts {
+		topProductsResp = append(topProductsResp, &model.TopProduct{
+			ID:      tp.ID,
+			Name:    tp.Name,
+			Units:   tp.Units,
+			Revenue: tp.Revenue.InexactFloat64(),
+		})
+	}
+
+	return topProductsResp, nil
+}
+
+// GetTopCategories is the resolver for the getTopCategories field.
+func (r *queryResolver) GetTopCategories(ctx context.Context, filter model.FilterDate, sortBy model.AnalyticsSort, limit *int) ([]*model.TopCategory, error) {
+	topCategories, err := r.getTopSellers(ctx, filter, sortBy, limit, r.Controller.GetTopCategories)
+	if err != nil {
+		return nil, err
+	}
+
+	topCategoriesResp := make([]*model.TopCategory, 0, len(topCategories))
+	for _, tc := range topCategories {
+		topCategoriesResp = append(topCategoriesResp, &model.TopCategory{
+			ID:      tc.ID,
+			Name:    tc.Name,
+			Units:   tc.Units,
+			Revenue: tc.Revenue.InexactFloat64(),
+		})
+	}
+
+	return topCategoriesResp, nil
+}
+
+// GetAverageOrderValue is the resolver for the getAverageOrderValue field.
+func (r *queryResolver) GetAverageOrderValue(ctx context.Context, filter model.FilterDate) (*model.OrderValueSummary, error) {
+	analyticsFilter, err := validateAndConvertAnalyticsFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := r.Controller.GetAverageOrderValue(ctx, analyticsFilter)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return &model.OrderValueSummary{
+		OrderCount:        summary.OrderCount,
+		Revenue:           summary.Revenue.InexactFloat64(),
+		AverageOrderValue: summary.AverageOrderValue.InexactFloat64(),
+	}, nil
+}
+
+// getTopSellers validates the arguments of the top sellers and calls to the given controller
+func (r *queryResolver) getTopSellers(ctx context.Context, filter model.FilterDate, sortBy model.AnalyticsSort, limit *int, getTopSellers func(ctx context.Context, filter controllers.AnalyticsFilterCtrl, sortBy string, limit int) ([]controllers.TopSellerOutput, error)) ([]controllers.TopSellerOutput, error) {
+	if !sortBy.IsValid() {
+		return nil, ErrInvalidAnalyticsSort
+	}
+
+	var limitInput int
+	if limit != nil {
+		if *limit <= 0 {
+			return nil, ErrInvalidAnalyticsLimit
+		}
+		limitInput = *limit
+	}
+
+	analyticsFilter, err := validateAndConvertAnalyticsFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	topSellers, err := getTopSellers(ctx, analyticsFilter, strings.ToLower(sortBy.String()), limitInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return topSellers, nil
+}
+
+// validateAndConvertAnalyticsFilter parses the dates of the filter as dd-mm-yyyy and returns the filter in controller layer
+func validateAndConvertAnalyticsFilter(filter model.FilterDate) (controllers.AnalyticsFilterCtrl, error) {
+	startDateTrimmed := strings.TrimSpace(filter.StartDate)
+	endDateTrimmed := strings.TrimSpace(filter.EndDate)
+	if startDateTrimmed == "" || endDateTrimmed == "" {
+		return controllers.AnalyticsFilterCtrl{}, ErrMissingDateRange
+	}
+
+	startDateParsed, err := time.Parse("02-01-2006", startDateTrimmed)
+	if err != nil {
+		return controllers.AnalyticsFilterCtrl{}, ErrDateBadRequest
+	}
+
+	endDateParsed, err := time.Parse("02-01-2006", endDateTrimmed)
+	if err != nil {
+		return controllers.AnalyticsFilterCtrl{}, ErrDateBadRequest
+	}
+
+	if startDateParsed.After(endDateParsed) {
+		return controllers.AnalyticsFilterCtrl{}, ErrStartDateAfterEndDate
+	}
+
+	return controllers.AnalyticsFilterCtrl{
+		StartDate: startDateParsed,
+		EndDate:   endDateParsed,
+	}, nil
+}
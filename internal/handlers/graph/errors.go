@@ -11,6 +11,7 @@ var (
 	ErrInvalidQuantity                 = errors.New("quantity must be non-negative")
 	ErrInvalidUserID                   = errors.New("invalid user id")
 	ErrMissingOrderStatus              = errors.New("missing order status")
+	ErrInvalidOrderStatus              = errors.New("invalid order status")
 	ErrInvalidOrderTotal               = errors.New("invalid order total price")
 	ErrInsufficientQuantity            = errors.New("insufficient quantity")
 	ErrProductNotFound                 = errors.New("product not found")
@@ -35,6 +36,102 @@ var (
 	ErrOrderItemNotFound               = errors.New("order item not found")
 	ErrStartDateAfterEndDate           = errors.New("start date must not be after end date")
 	ErrDateAfterCurrentDate            = errors.New("date must not be after the current date")
+	ErrInvalidTaxRate                  = errors.New("tax rate must be between 0 and 1")
+	ErrInvalidEffectiveDate            = errors.New("effective end date must be after the effective start date")
+	ErrInvalidWeight                   = errors.New("weight must be non-negative")
+	ErrInvalidAddressID                = errors.New("invalid address id")
+	ErrInvalidShippingMethodID         = errors.New("invalid shipping method id")
+	ErrInvalidShipmentID               = errors.New("invalid shipment id")
+	ErrMissingPhone                    = errors.New("phone cannot be blank")
+	ErrMissingAddress                  = errors.New("street, city and country cannot be blank")
+	ErrMissingCarrier                  = errors.New("carrier cannot be blank")
+	ErrMissingTrackingNumber           = errors.New("tracking number cannot be blank")
+	ErrInvalidShippingRate             = errors.New("shipping rate must be non-negative")
+	ErrInvalidShippingRateType         = errors.New("invalid shipping rate type, rate type must be FLAT or WEIGHT")
+	ErrAddressNotFound                 = errors.New("address not found")
+	ErrShippingMethodNotFound          = errors.New("shipping method not found")
+	ErrShipmentNotFound                = errors.New("shipment not found")
+	ErrOrderNotShippable               = errors.New("only a paid order can be shipped")
+	ErrInvalidShipmentStatus           = errors.New("invalid shipment status")
+	ErrInvalidCurrency                 = errors.New("invalid currency, currency must be VND or USD")
+	ErrInvalidExchangeRate             = errors.New("exchange rate must be greater than 0")
+	ErrExchangeRateNotFound            = errors.New("exchange rate not found")
+	ErrOrderCurrencyChanged            = errors.New("the currency of an order cannot be changed")
+	ErrInvalidReturnRequestID          = errors.New("invalid return request id")
+	ErrReturnRequestNotFound           = errors.New("return request not found")
+	ErrOrderNotReturnable              = errors.New("only a delivered order can be returned")
+	ErrMissingReturnReason             = errors.New("return reason cannot be blank")
+	ErrMissingReturnItems              = errors.New("a return must have at least one item")
+	ErrInvalidReturnQuantity           = errors.New("return quantity must be greater than 0 and not more than the quantity ordered")
+	ErrInvalidReturnStatus             = errors.New("invalid return status")
+	ErrMissingDateRange                = errors.New("start date and end date cannot be blank")
+	ErrInvalidAnalyticsPeriod          = errors.New("invalid period, period must be DAY, WEEK or MONTH")
+	ErrInvalidAnalyticsSort            = errors.New("invalid sort, sort must be REVENUE or UNITS")
+	ErrInvalidAnalyticsLimit           = errors.New("limit must be between 1 and 100")
+	ErrInvalidProductSort              = errors.New("invalid sort, sort field must be NAME, PRICE, QUANTITY or CREATED_AT")
+	ErrInvalidProductLimit             = errors.New("limit must be between 1 and 100")
+	ErrInvalidOffset                   = errors.New("offset must be non-negative")
+	ErrInvalidCursor                   = errors.New("invalid cursor")
+	ErrCursorWithOffset                = errors.New("cursor cannot be used with offset")
+	ErrInvalidPriceRange               = errors.New("min price must not be greater than max price")
+	ErrInvalidPriceFilter              = errors.New("minPrice and maxPrice must be non-negative")
+	ErrInvalidCategoryID               = errors.New("invalid category id")
+	ErrMissingSearchQuery              = errors.New("search query cannot be blank")
+	ErrSearchQueryTooLong              = errors.New("search query too long")
+	ErrInvalidVariantID                = errors.New("invalid variant id")
+	ErrMissingSKU                      = errors.New("sku cannot be blank")
+	ErrSKUTooLong                      = errors.New("sku must not be longer than 64 characters")
+	ErrMissingVariantOptions           = errors.New("a variant must have at least one option with a name and a value")
+	ErrVariantOptionsMismatch          = errors.New("the options of a variant must match the option types of the product")
+	ErrVariantRequired                 = errors.New("the product has variants, a variant must be given")
+	ErrVariantNotFound                 = errors.New("variant not found")
+	ErrSKUExists                       = errors.New("sku already exists")
+	ErrDuplicateVariant                = errors.New("a variant with the same options already exists")
+	ErrProductArchived                 = errors.New("product is archived and cannot be ordered")
+	ErrInvalidPriceID                  = errors.New("invalid price id")
+	ErrTimeBadRequest                  = errors.New("invalid time format, times must follow the format dd-mm-yyyy hh:mm:ss")
+	ErrProductPriceNotFound            = errors.New("product price not found")
+	ErrPriceChangeNotInFuture          = errors.New("a price change must be scheduled in the future")
+	ErrPriceNotScheduled               = errors.New("only a scheduled price which is not in effect yet can be cancelled")
+	ErrInvalidReorderThreshold         = errors.New("reorder threshold must not be negative")
+	ErrInvalidWarehouseID              = errors.New("invalid warehouse id")
+	ErrMissingWarehouseName            = errors.New("warehouse name cannot be blank")
+	ErrWarehouseNotFound               = errors.New("warehouse not found")
+	ErrWarehouseExists                 = errors.New("a warehouse with the same name already exists")
+	ErrVariantWarehouse                = errors.New("the stock of a variant is not kept by warehouse")
+	ErrMissingAttributeName            = errors.New("attribute name cannot be blank")
+	ErrAttributeUnitTooLong            = errors.New("attribute unit must not be longer than 32 characters")
+	ErrInvalidAttributeType            = errors.New("invalid attribute type, type must be text, number or boolean")
+	ErrAttributeExists                 = errors.New("an attribute with the same name already exists in the category")
+	ErrUnknownAttribute                = errors.New("the attribute is not defined for the category of the product")
+	ErrInvalidAttributeValue           = errors.New("invalid attribute value, the value must be of the type of the attribute")
+	ErrInvalidAttributeFilter          = errors.New("invalid attribute filter, the min and max must be numbers and the min must not be greater than the max")
+	ErrInvalidTag                      = errors.New("invalid tag, a tag must not be longer than 64 characters or contain , or ;")
+	ErrTooManyTags                     = errors.New("a product must not have more than 20 tags")
+	ErrInvalidBulkSelection            = errors.New("the products must be selected by either a list of positive IDs or a filter")
+	ErrTooManyBulkProducts             = errors.New("a bulk operation must not change more than 1000 products")
+	ErrInvalidBulkPriceChange          = errors.New("either a non-zero amount or a non-zero percent greater than -100 must be given")
+	ErrInvalidBulkStockChange          = errors.New("either a quantity of at least 0 or a non-zero delta must be given")
+	ErrBulkRolledBack                  = errors.New("not changed, the bulk operation failed on another product")
+	ErrInvalidReviewID                 = errors.New("invalid review id")
+	ErrProductReviewNotFound           = errors.New("product review not found")
+	ErrInvalidRating                   = errors.New("rating must be between 1 and 5")
+	ErrMissingReviewBody               = errors.New("review text cannot be blank")
+	ErrReviewTooLong                   = errors.New("review title must not be longer than 255 characters and text not longer than 5000 characters")
+	ErrReviewerNotVerified             = errors.New("only a customer who bought the product can review it")
+	ErrProductReviewExists             = errors.New("the customer has already reviewed the product")
+	ErrInvalidReviewStatus             = errors.New("invalid review status")
+	ErrInvalidReviewLimit              = errors.New("limit must be between 1 and 100")
+	ErrInvalidVersion                  = errors.New("expected version must be greater than 0")
+	ErrProductVersionConflict          = errors.New("the product has been changed since it was read, reload it and try again")
+	ErrOrderVersionConflict            = errors.New("the order has been changed since it was read, reload it and try again")
+	ErrInvalidSKU                      = errors.New("sku must only contain letters, digits, ., _ and -")
+	ErrSKUImmutable                    = errors.New("the sku of a product cannot be changed")
+	ErrMissingSlug                     = errors.New("slug cannot be blank")
+	ErrWishlistItemNotFound            = errors.New("the product is not in the wishlist")
+	ErrWishlistItemExists              = errors.New("the product is already in the wishlist")
+	ErrProductInStock                  = errors.New("the product is in stock, only an out of stock product can be subscribed to")
+	ErrInvalidRelatedProductsLimit     = errors.New("limit must be between 1 and 20")
 )
 
 // convertCtrlError compares the error return with the error in controller and returns the corresponding ErrorResponse
@@ -68,6 +165,154 @@ func convertCtrlError(err error) error {
 		return ErrOrderItemNotFound
 	case controllers.ErrInsufficientQuantity:
 		return ErrInsufficientQuantity
+	case controllers.ErrInvalidEffectiveDate:
+		return ErrInvalidEffectiveDate
+	case controllers.ErrAddressNotFound:
+		return ErrAddressNotFound
+	case controllers.ErrShippingMethodNotFound:
+		return ErrShippingMethodNotFound
+	case controllers.ErrInvalidShippingRateType:
+		return ErrInvalidShippingRateType
+	case controllers.ErrShipmentNotFound:
+		return ErrShipmentNotFound
+	case controllers.ErrOrderNotShippable:
+		return ErrOrderNotShippable
+	case controllers.ErrMissingCarrier:
+		return ErrMissingCarrier
+	case controllers.ErrInvalidShipmentStatus:
+		return ErrInvalidShipmentStatus
+	case controllers.ErrInvalidCurrency:
+		return ErrInvalidCurrency
+	case controllers.ErrInvalidExchangeRate:
+		return ErrInvalidExchangeRate
+	case controllers.ErrExchangeRateNotFound:
+		return ErrExchangeRateNotFound
+	case controllers.ErrOrderCurrencyChanged:
+		return ErrOrderCurrencyChanged
+	case controllers.ErrReturnRequestNotFound:
+		return ErrReturnRequestNotFound
+	case controllers.ErrOrderNotReturnable:
+		return ErrOrderNotReturnable
+	case controllers.ErrMissingReturnReason:
+		return ErrMissingReturnReason
+	case controllers.ErrMissingReturnItems:
+		return ErrMissingReturnItems
+	case controllers.ErrInvalidReturnQuantity:
+		return ErrInvalidReturnQuantity
+	case controllers.ErrInvalidReturnStatus:
+		return ErrInvalidReturnStatus
+	case controllers.ErrMissingDateRange:
+		return ErrMissingDateRange
+	case controllers.ErrStartDateAfterEndDate:
+		return ErrStartDateAfterEndDate
+	case controllers.ErrInvalidAnalyticsPeriod:
+		return ErrInvalidAnalyticsPeriod
+	case controllers.ErrInvalidAnalyticsSort:
+		return ErrInvalidAnalyticsSort
+	case controllers.ErrInvalidAnalyticsLimit:
+		return ErrInvalidAnalyticsLimit
+	case controllers.ErrInvalidProductSort:
+		return ErrInvalidProductSort
+	case controllers.ErrInvalidProductLimit:
+		return ErrInvalidProductLimit
+	case controllers.ErrInvalidOffset:
+		return ErrInvalidOffset
+	case controllers.ErrInvalidCursor:
+		return ErrInvalidCursor
+	case controllers.ErrCursorWithOffset:
+		return ErrCursorWithOffset
+	case controllers.ErrInvalidPriceRange:
+		return ErrInvalidPriceRange
+	case controllers.ErrMissingSearchQuery:
+		return ErrMissingSearchQuery
+	case controllers.ErrSearchQueryTooLong:
+		return ErrSearchQueryTooLong
+	case controllers.ErrMissingSKU:
+		return ErrMissingSKU
+	case controllers.ErrSKUTooLong:
+		return ErrSKUTooLong
+	case controllers.ErrMissingVariantOptions:
+		return ErrMissingVariantOptions
+	case controllers.ErrVariantOptionsMismatch:
+		return ErrVariantOptionsMismatch
+	case controllers.ErrVariantRequired:
+		return ErrVariantRequired
+	case controllers.ErrVariantNotFound:
+		return ErrVariantNotFound
+	case controllers.ErrSKUExists:
+		return ErrSKUExists
+	case controllers.ErrInvalidSKU:
+		return ErrInvalidSKU
+	case controllers.ErrSKUImmutable:
+		return ErrSKUImmutable
+	case controllers.ErrInvalidRelatedProductsLimit:
+		return ErrInvalidRelatedProductsLimit
+	case controllers.ErrWishlistItemNotFound:
+		return ErrWishlistItemNotFound
+	case controllers.ErrWishlistItemExists:
+		return ErrWishlistItemExists
+	case controllers.ErrProductInStock:
+		return ErrProductInStock
+	case controllers.ErrDuplicateVariant:
+		return ErrDuplicateVariant
+	case controllers.ErrProductArchived:
+		return ErrProductArchived
+	case controllers.ErrProductPriceNotFound:
+		return ErrProductPriceNotFound
+	case controllers.ErrPriceChangeNotInFuture:
+		return ErrPriceChangeNotInFuture
+	case controllers.ErrPriceNotScheduled:
+		return ErrPriceNotScheduled
+	case controllers.ErrInvalidReorderThreshold:
+		return ErrInvalidReorderThreshold
+	case controllers.ErrWarehouseNotFound:
+		return ErrWarehouseNotFound
+	case controllers.ErrWarehouseExists:
+		return ErrWarehouseExists
+	case controllers.ErrVariantWarehouse:
+		return ErrVariantWarehouse
+	case controllers.ErrInvalidAttributeType:
+		return ErrInvalidAttributeType
+	case controllers.ErrAttributeExists:
+		return ErrAttributeExists
+	case controllers.ErrUnknownAttribute:
+		return ErrUnknownAttribute
+	case controllers.ErrInvalidAttributeValue:
+		return ErrInvalidAttributeValue
+	case controllers.ErrInvalidAttributeFilter:
+		return ErrInvalidAttributeFilter
+	case controllers.ErrInvalidTag:
+		return ErrInvalidTag
+	case controllers.ErrTooManyTags:
+		return ErrTooManyTags
+	case controllers.ErrInvalidBulkSelection:
+		return ErrInvalidBulkSelection
+	case controllers.ErrTooManyBulkProducts:
+		return ErrTooManyBulkProducts
+	case controllers.ErrInvalidBulkPriceChange:
+		return ErrInvalidBulkPriceChange
+	case controllers.ErrInvalidBulkStockChange:
+		return ErrInvalidBulkStockChange
+	case controllers.ErrProductReviewNotFound:
+		return ErrProductReviewNotFound
+	case controllers.ErrInvalidRating:
+		return ErrInvalidRating
+	case controllers.ErrMissingReviewBody:
+		return ErrMissingReviewBody
+	case controllers.ErrReviewTooLong:
+		return ErrReviewTooLong
+	case controllers.ErrReviewerNotVerified:
+		return ErrReviewerNotVerified
+	case controllers.ErrProductReviewExists:
+		return ErrProductReviewExists
+	case controllers.ErrInvalidReviewStatus:
+		return ErrInvalidReviewStatus
+	case controllers.ErrInvalidReviewLimit:
+		return ErrInvalidReviewLimit
+	case controllers.ErrProductVersionConflict:
+		return ErrProductVersionConflict
+	case controllers.ErrOrderVersionConflict:
+		return ErrOrderVersionConflict
 	default:
 		return ErrInternalServer
 	}
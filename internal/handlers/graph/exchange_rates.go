@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// CreateExchangeRate is the resolver for the createExchangeRate field.
+func (r *mutationResolver) CreateExchangeRate(ctx context.Context, input model.ExchangeRateRequest) (bool, error) {
+	exchangeRate, err := validateAndConvertExchangeRate(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.Controller.CreateExchangeRate(ctx, exchangeRate); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetExchangeRates is the resolver for the getExchangeRates field.
+func (r *queryResolver) GetExchangeRates(ctx context.Context, currency *model.Currency) ([]*model.ExchangeRate, error) {
+	var currencyInput string
+	if currency != nil {
+		currencyInput = currency.String()
+	}
+
+	exchangeRates, err := r.Controller.GetExchangeRates(ctx, currencyInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	exchangeRatesResp := make([]*model.ExchangeRate, 0, len(exchangeRates))
+	for _, er := range exchangeRates {
+		exchangeRatesResp = append(exchangeRatesResp, &model.ExchangeRate{
+			ID:            er.ID,
+			Currency:      model.Currency(er.Currency),
+			Rate:          er.Rate.InexactFloat64(),
+			EffectiveFrom: er.EffectiveFrom.Format("02-01-2006"),
+			CreatedAt:     er.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt:     er.UpdatedAt.Format("02-01-2006 15:04:05"),
+		})
+	}
+
+	return exchangeRatesResp, nil
+}
+
+// validateAndConvertExchangeRate validates the exchange rate from request and returns exchange rate struct in controller layer
+func validateAndConvertExchangeRate(erReq model.ExchangeRateRequest) (controllers.ExchangeRateInput, error) {
+	// the rate of the base currency is always 1
+	if erReq.Currency.String() == controllers.CurrencyVND {
+		return controllers.ExchangeRateInput{}, ErrInvalidCurrency
+	}
+
+	rate := decimal.NewFromFloat(erReq.Rate)
+	if !rate.IsPositive() {
+		return controllers.ExchangeRateInput{}, ErrInvalidExchangeRate
+	}
+
+	exchangeRate := controllers.ExchangeRateInput{
+		Currency: erReq.Currency.String(),
+		Rate:     rate,
+	}
+
+	if erReq.EffectiveFrom != nil && strings.TrimSpace(*erReq.EffectiveFrom) != "" {
+		effectiveFrom, err := time.Parse("02-01-2006", strings.TrimSpace(*erReq.EffectiveFrom))
+		if err != nil {
+			return controllers.ExchangeRateInput{}, ErrDateBadRequest
+		}
+		exchangeRate.EffectiveFrom = effectiveFrom
+	}
+
+	return exchangeRate, nil
+}
@@ -0,0 +1,30 @@
+package graph
+
+import (
+	"context"
+	"log"
+
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// GetLowStockProducts is the resolver for the getLowStockProducts field.
+func (r *queryResolver) GetLowStockProducts(ctx context.Context) ([]*model.LowStockProduct, error) {
+	products, err := r.Controller.GetLowStockProducts(ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	resp := make([]*model.LowStockProduct, 0, len(products))
+	for _, p := range products {
+		resp = append(resp, &model.LowStockProduct{
+			ID:               p.ID,
+			Name:             p.Name,
+			Quantity:         p.Quantity,
+			ReorderThreshold: p.ReorderThreshold,
+			Shortage:         p.Shortage,
+		})
+	}
+
+	return resp, nil
+}
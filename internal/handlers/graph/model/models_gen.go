@@ -8,19 +8,125 @@ import (
 	"strconv"
 )
 
+type Address struct {
+	ID            int    `json:"id"`
+	UserID        int    `json:"userID"`
+	RecipientName string `json:"recipientName"`
+	Phone         string `json:"phone"`
+	Street        string `json:"street"`
+	City          string `json:"city"`
+	Region        string `json:"region"`
+	PostalCode    string `json:"postalCode"`
+	Country       string `json:"country"`
+	IsDefault     bool   `json:"isDefault"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+type AddressRequest struct {
+	UserID        int     `json:"userID"`
+	RecipientName string  `json:"recipientName"`
+	Phone         string  `json:"phone"`
+	Street        string  `json:"street"`
+	City          string  `json:"city"`
+	Region        *string `json:"region,omitempty"`
+	PostalCode    *string `json:"postalCode,omitempty"`
+	Country       string  `json:"country"`
+	IsDefault     *bool   `json:"isDefault,omitempty"`
+}
+
+type AttributeDefinition struct {
+	ID         int           `json:"id"`
+	CategoryID int           `json:"categoryID"`
+	Name       string        `json:"name"`
+	Type       AttributeType `json:"type"`
+	Unit       string        `json:"unit"`
+	CreatedAt  string        `json:"createdAt"`
+	UpdatedAt  string        `json:"updatedAt"`
+}
+
+type AttributeDefinitionRequest struct {
+	CategoryID int           `json:"categoryID"`
+	Name       string        `json:"name"`
+	Type       AttributeType `json:"type"`
+	Unit       *string       `json:"unit,omitempty"`
+}
+
+type AttributeFilterInput struct {
+	Name  string   `json:"name"`
+	Value *string  `json:"value,omitempty"`
+	Min   *float64 `json:"min,omitempty"`
+	Max   *float64 `json:"max,omitempty"`
+}
+
+type BulkItemResult struct {
+	ProductID int     `json:"productID"`
+	Success   bool    `json:"success"`
+	Error     *string `json:"error,omitempty"`
+}
+
+type BulkReport struct {
+	Operation     string            `json:"operation"`
+	Transactional bool              `json:"transactional"`
+	Succeeded     int               `json:"succeeded"`
+	Failed        int               `json:"failed"`
+	Items         []*BulkItemResult `json:"items"`
+}
+
+type BulkSelectionInput struct {
+	Ids           []int               `json:"ids,omitempty"`
+	Filter        *ProductFilterInput `json:"filter,omitempty"`
+	Transactional *bool               `json:"transactional,omitempty"`
+}
+
+type ExchangeRate struct {
+	ID            int      `json:"id"`
+	Currency      Currency `json:"currency"`
+	Rate          float64  `json:"rate"`
+	EffectiveFrom string   `json:"effectiveFrom"`
+	CreatedAt     string   `json:"createdAt"`
+	UpdatedAt     string   `json:"updatedAt"`
+}
+
+type ExchangeRateRequest struct {
+	Currency      Currency `json:"currency"`
+	Rate          float64  `json:"rate"`
+	EffectiveFrom *string  `json:"effectiveFrom,omitempty"`
+}
+
 type FilterDate struct {
 	StartDate string `json:"startDate"`
 	EndDate   string `json:"endDate"`
 }
 
+type ImageThumbnail struct {
+	Size string `json:"size"`
+	URL  string `json:"url"`
+}
+
+type LowStockProduct struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Quantity         int    `json:"quantity"`
+	ReorderThreshold int    `json:"reorderThreshold"`
+	Shortage         int    `json:"shortage"`
+}
+
 type Order struct {
-	ID        int          `json:"id"`
-	User      *User        `json:"user"`
-	Status    Status       `json:"status"`
-	CreatedAt *string      `json:"createdAt,omitempty"`
-	UpdatedAt *string      `json:"updatedAt,omitempty"`
-	Total     *float64     `json:"total,omitempty"`
-	Items     []*OrderItem `json:"items"`
+	ID           int          `json:"id"`
+	User         *User        `json:"user"`
+	Status       Status       `json:"status"`
+	Region       *string      `json:"region,omitempty"`
+	CreatedAt    *string      `json:"createdAt,omitempty"`
+	UpdatedAt    *string      `json:"updatedAt,omitempty"`
+	Subtotal     *float64     `json:"subtotal,omitempty"`
+	Tax          *float64     `json:"tax,omitempty"`
+	Shipping     *float64     `json:"shipping,omitempty"`
+	Total        *float64     `json:"total,omitempty"`
+	Currency     Currency     `json:"currency"`
+	ExchangeRate *float64     `json:"exchangeRate,omitempty"`
+	Version      int          `json:"version"`
+	Items        []*OrderItem `json:"items"`
 }
 
 type OrderItem struct {
@@ -29,20 +135,28 @@ type OrderItem struct {
 	Product   *Product `json:"product"`
 	Price     float64  `json:"price"`
 	Quantity  int      `json:"quantity"`
+	TaxRate   float64  `json:"taxRate"`
+	TaxAmount float64  `json:"taxAmount"`
 	CreatedAt string   `json:"createdAt"`
 	UpdatedAt string   `json:"updatedAt"`
 }
 
 type OrderItemRequest struct {
-	ID        *int `json:"id,omitempty"`
-	ProductID int  `json:"productID"`
-	Quantity  int  `json:"quantity"`
+	ID          *int `json:"id,omitempty"`
+	ProductID   int  `json:"productID"`
+	VariantID   *int `json:"variantID,omitempty"`
+	WarehouseID *int `json:"warehouseID,omitempty"`
+	Quantity    int  `json:"quantity"`
 }
 
 type OrderRequest struct {
-	UserID int                 `json:"userID"`
-	Status Status              `json:"status"`
-	Items  []*OrderItemRequest `json:"items"`
+	UserID           int                 `json:"userID"`
+	Status           Status              `json:"status"`
+	Region           *string             `json:"region,omitempty"`
+	AddressID        *int                `json:"addressID,omitempty"`
+	ShippingMethodID *int                `json:"shippingMethodID,omitempty"`
+	Currency         *Currency           `json:"currency,omitempty"`
+	Items            []*OrderItemRequest `json:"items"`
 }
 
 type OrderResponse struct {
@@ -50,6 +164,12 @@ type OrderResponse struct {
 	TotalCount int      `json:"totalCount"`
 }
 
+type OrderValueSummary struct {
+	OrderCount        int     `json:"orderCount"`
+	Revenue           float64 `json:"revenue"`
+	AverageOrderValue float64 `json:"averageOrderValue"`
+}
+
 type PaginationInput struct {
 	Limit int `json:"limit"`
 	Page  int `json:"page"`
@@ -75,15 +195,45 @@ type PaymentDetail struct {
 }
 
 type Product struct {
-	ID          int              `json:"id"`
-	Name        string           `json:"name"`
-	Description string           `json:"description"`
-	Price       float64          `json:"price"`
-	Quantity    int              `json:"quantity"`
-	Category    *ProductCategory `json:"category"`
-	Author      *User            `json:"author"`
-	CreatedAt   string           `json:"createdAt"`
-	UpdatedAt   string           `json:"updatedAt"`
+	ID               int                 `json:"id"`
+	Sku              string              `json:"sku"`
+	Slug             string              `json:"slug"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description"`
+	Price            float64             `json:"price"`
+	Quantity         int                 `json:"quantity"`
+	Weight           float64             `json:"weight"`
+	Currency         Currency            `json:"currency"`
+	Category         *ProductCategory    `json:"category"`
+	Author           *User               `json:"author"`
+	Images           []*ProductImage     `json:"images"`
+	Tags             []string            `json:"tags"`
+	Attributes       []*ProductAttribute `json:"attributes"`
+	CreatedAt        string              `json:"createdAt"`
+	UpdatedAt        string              `json:"updatedAt"`
+	ReorderThreshold int                 `json:"reorderThreshold"`
+	RatingAverage    float64             `json:"ratingAverage"`
+	ReviewCount      int                 `json:"reviewCount"`
+	Version          int                 `json:"version"`
+	Availability     []*WarehouseStock   `json:"availability"`
+	RelatedProducts  []*Product          `json:"relatedProducts"`
+}
+
+type ProductAttribute struct {
+	Name  string        `json:"name"`
+	Type  AttributeType `json:"type"`
+	Unit  string        `json:"unit"`
+	Value string        `json:"value"`
+}
+
+type ProductAttributeInput struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ProductAttributePatch struct {
+	Name  string  `json:"name"`
+	Value *string `json:"value,omitempty"`
 }
 
 type ProductCategory struct {
@@ -94,13 +244,231 @@ type ProductCategory struct {
 	UpdatedAt   string `json:"updatedAt"`
 }
 
+type ProductFilterInput struct {
+	MinPrice    *float64                `json:"minPrice,omitempty"`
+	MaxPrice    *float64                `json:"maxPrice,omitempty"`
+	CategoryID  *int                    `json:"categoryID,omitempty"`
+	AuthorID    *int                    `json:"authorID,omitempty"`
+	InStock     *bool                   `json:"inStock,omitempty"`
+	CreatedFrom *string                 `json:"createdFrom,omitempty"`
+	CreatedTo   *string                 `json:"createdTo,omitempty"`
+	Tags        []string                `json:"tags,omitempty"`
+	Attributes  []*AttributeFilterInput `json:"attributes,omitempty"`
+}
+
+type ProductImage struct {
+	ID          int               `json:"id"`
+	Position    int               `json:"position"`
+	URL         string            `json:"url"`
+	ContentType string            `json:"contentType"`
+	Size        int               `json:"size"`
+	Width       int               `json:"width"`
+	Height      int               `json:"height"`
+	Thumbnails  []*ImageThumbnail `json:"thumbnails"`
+}
+
+type ProductPaginationInput struct {
+	Limit  *int    `json:"limit,omitempty"`
+	Offset *int    `json:"offset,omitempty"`
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+type ProductPatchRequest struct {
+	Name             *string                  `json:"name,omitempty"`
+	Description      *string                  `json:"description,omitempty"`
+	Price            *float64                 `json:"price,omitempty"`
+	Quantity         *int                     `json:"quantity,omitempty"`
+	CategoryName     *string                  `json:"categoryName,omitempty"`
+	AuthorID         *int                     `json:"authorID,omitempty"`
+	Weight           *float64                 `json:"weight,omitempty"`
+	Currency         *Currency                `json:"currency,omitempty"`
+	ReorderThreshold *int                     `json:"reorderThreshold,omitempty"`
+	Tags             []string                 `json:"tags,omitempty"`
+	Attributes       []*ProductAttributePatch `json:"attributes,omitempty"`
+}
+
+type ProductPrice struct {
+	ID            int      `json:"id"`
+	ProductID     int      `json:"productID"`
+	Price         float64  `json:"price"`
+	Currency      Currency `json:"currency"`
+	EffectiveFrom string   `json:"effectiveFrom"`
+	EffectiveTo   *string  `json:"effectiveTo,omitempty"`
+	Scheduled     bool     `json:"scheduled"`
+	CreatedAt     string   `json:"createdAt"`
+}
+
+type ProductPriceRequest struct {
+	ProductID     int       `json:"productID"`
+	Price         float64   `json:"price"`
+	Currency      *Currency `json:"currency,omitempty"`
+	EffectiveFrom string    `json:"effectiveFrom"`
+}
+
 type ProductRequest struct {
-	Name         string  `json:"name"`
-	Description  string  `json:"description"`
-	Price        float64 `json:"price"`
-	Quantity     int     `json:"quantity"`
-	CategoryName string  `json:"categoryName"`
-	AuthorID     int     `json:"authorID"`
+	Sku              *string                  `json:"sku,omitempty"`
+	Name             string                   `json:"name"`
+	Description      string                   `json:"description"`
+	Price            float64                  `json:"price"`
+	Quantity         int                      `json:"quantity"`
+	CategoryName     string                   `json:"categoryName"`
+	AuthorID         int                      `json:"authorID"`
+	Weight           *float64                 `json:"weight,omitempty"`
+	Currency         *Currency                `json:"currency,omitempty"`
+	ReorderThreshold *int                     `json:"reorderThreshold,omitempty"`
+	Tags             []string                 `json:"tags,omitempty"`
+	Attributes       []*ProductAttributeInput `json:"attributes,omitempty"`
+}
+
+type ProductResponse struct {
+	Products   []*Product `json:"products"`
+	TotalCount int        `json:"totalCount"`
+	NextCursor *string    `json:"nextCursor,omitempty"`
+}
+
+type ProductReview struct {
+	ID          int          `json:"id"`
+	ProductID   int          `json:"productID"`
+	UserID      int          `json:"userID"`
+	Rating      int          `json:"rating"`
+	Title       string       `json:"title"`
+	Body        string       `json:"body"`
+	Status      ReviewStatus `json:"status"`
+	Note        string       `json:"note"`
+	ModeratedAt *string      `json:"moderatedAt,omitempty"`
+	CreatedAt   string       `json:"createdAt"`
+	UpdatedAt   string       `json:"updatedAt"`
+}
+
+type ProductReviewList struct {
+	Reviews    []*ProductReview `json:"reviews"`
+	TotalCount int              `json:"totalCount"`
+}
+
+type ProductReviewRequest struct {
+	ProductID int     `json:"productID"`
+	UserID    int     `json:"userID"`
+	Rating    int     `json:"rating"`
+	Title     *string `json:"title,omitempty"`
+	Body      string  `json:"body"`
+}
+
+type ProductSearchResponse struct {
+	Results    []*ProductSearchResult `json:"results"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+type ProductSearchResult struct {
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Price         float64  `json:"price"`
+	Quantity      int      `json:"quantity"`
+	Currency      Currency `json:"currency"`
+	Category      string   `json:"category"`
+	AuthorID      int      `json:"authorID"`
+	Rank          float64  `json:"rank"`
+	NameHighlight string   `json:"nameHighlight"`
+	Snippet       string   `json:"snippet"`
+}
+
+type ProductSortingInput struct {
+	Field ProductSortField `json:"field"`
+	Desc  bool             `json:"desc"`
+}
+
+type ProductVariant struct {
+	ID        int              `json:"id"`
+	ProductID int              `json:"productID"`
+	Sku       string           `json:"sku"`
+	Price     float64          `json:"price"`
+	Currency  Currency         `json:"currency"`
+	Quantity  int              `json:"quantity"`
+	Options   []*VariantOption `json:"options"`
+	CreatedAt string           `json:"createdAt"`
+	UpdatedAt string           `json:"updatedAt"`
+}
+
+type ProductVariantRequest struct {
+	ProductID int                   `json:"productID"`
+	Sku       string                `json:"sku"`
+	Price     *float64              `json:"price,omitempty"`
+	Quantity  int                   `json:"quantity"`
+	Options   []*VariantOptionInput `json:"options"`
+}
+
+type ReturnItem struct {
+	ID          int `json:"id"`
+	OrderItemID int `json:"orderItemID"`
+	Quantity    int `json:"quantity"`
+}
+
+type ReturnItemRequest struct {
+	OrderItemID int `json:"orderItemID"`
+	Quantity    int `json:"quantity"`
+}
+
+type ReturnRequest struct {
+	ID                 int           `json:"id"`
+	OrderID            int           `json:"orderID"`
+	Status             ReturnStatus  `json:"status"`
+	Reason             string        `json:"reason"`
+	Note               string        `json:"note"`
+	RefundAmount       *float64      `json:"refundAmount,omitempty"`
+	ReplacementOrderID *int          `json:"replacementOrderID,omitempty"`
+	ReceivedAt         *string       `json:"receivedAt,omitempty"`
+	ResolvedAt         *string       `json:"resolvedAt,omitempty"`
+	CreatedAt          string        `json:"createdAt"`
+	UpdatedAt          string        `json:"updatedAt"`
+	Items              []*ReturnItem `json:"items"`
+}
+
+type ReturnRequestInput struct {
+	OrderID int                  `json:"orderID"`
+	Reason  string               `json:"reason"`
+	Items   []*ReturnItemRequest `json:"items"`
+}
+
+type RevenuePoint struct {
+	Period     string  `json:"period"`
+	Revenue    float64 `json:"revenue"`
+	OrderCount int     `json:"orderCount"`
+}
+
+type Shipment struct {
+	ID             int            `json:"id"`
+	OrderID        int            `json:"orderID"`
+	Carrier        string         `json:"carrier"`
+	TrackingNumber string         `json:"trackingNumber"`
+	Status         ShipmentStatus `json:"status"`
+	ShippedAt      *string        `json:"shippedAt,omitempty"`
+	DeliveredAt    *string        `json:"deliveredAt,omitempty"`
+	CreatedAt      string         `json:"createdAt"`
+	UpdatedAt      string         `json:"updatedAt"`
+}
+
+type ShipmentRequest struct {
+	Carrier        *string `json:"carrier,omitempty"`
+	TrackingNumber string  `json:"trackingNumber"`
+}
+
+type ShippingMethod struct {
+	ID        int              `json:"id"`
+	Name      string           `json:"name"`
+	Carrier   string           `json:"carrier"`
+	RateType  ShippingRateType `json:"rateType"`
+	BaseRate  float64          `json:"baseRate"`
+	RatePerKg float64          `json:"ratePerKg"`
+	CreatedAt string           `json:"createdAt"`
+	UpdatedAt string           `json:"updatedAt"`
+}
+
+type ShippingMethodRequest struct {
+	Name      string           `json:"name"`
+	Carrier   string           `json:"carrier"`
+	RateType  ShippingRateType `json:"rateType"`
+	BaseRate  float64          `json:"baseRate"`
+	RatePerKg *float64         `json:"ratePerKg,omitempty"`
 }
 
 type Sorting struct {
@@ -112,6 +480,46 @@ type SortingInput struct {
 	Column []*Sorting `json:"column,omitempty"`
 }
 
+type TaxRule struct {
+	ID            int     `json:"id"`
+	CategoryID    int     `json:"categoryID"`
+	Region        string  `json:"region"`
+	Rate          float64 `json:"rate"`
+	EffectiveFrom string  `json:"effectiveFrom"`
+	EffectiveTo   *string `json:"effectiveTo,omitempty"`
+	CreatedAt     string  `json:"createdAt"`
+	UpdatedAt     string  `json:"updatedAt"`
+}
+
+type TaxRuleRequest struct {
+	CategoryName  string  `json:"categoryName"`
+	Region        *string `json:"region,omitempty"`
+	Rate          float64 `json:"rate"`
+	EffectiveFrom string  `json:"effectiveFrom"`
+	EffectiveTo   *string `json:"effectiveTo,omitempty"`
+}
+
+type TopCategory struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Units   int     `json:"units"`
+	Revenue float64 `json:"revenue"`
+}
+
+type TopProduct struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Units   int     `json:"units"`
+	Revenue float64 `json:"revenue"`
+}
+
+type UpdateProductVariantRequest struct {
+	ProductID int      `json:"productID"`
+	VariantID int      `json:"variantID"`
+	Price     *float64 `json:"price,omitempty"`
+	Quantity  int      `json:"quantity"`
+}
+
 type User struct {
 	ID        int      `json:"id"`
 	Name      string   `json:"name"`
@@ -123,12 +531,453 @@ type User struct {
 	Orders    []*Order `json:"orders"`
 }
 
+type VariantOption struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type VariantOptionInput struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Warehouse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	City      string `json:"city"`
+	Region    string `json:"region"`
+	Country   string `json:"country"`
+	IsDefault bool   `json:"isDefault"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type WarehouseRequest struct {
+	Name      string  `json:"name"`
+	City      *string `json:"city,omitempty"`
+	Region    *string `json:"region,omitempty"`
+	Country   *string `json:"country,omitempty"`
+	IsDefault *bool   `json:"isDefault,omitempty"`
+}
+
+type WarehouseStock struct {
+	WarehouseID   int    `json:"warehouseID"`
+	WarehouseName string `json:"warehouseName"`
+	City          string `json:"city"`
+	Region        string `json:"region"`
+	Country       string `json:"country"`
+	Quantity      int    `json:"quantity"`
+}
+
+type WishlistItem struct {
+	ID                int      `json:"id"`
+	ProductID         int      `json:"productID"`
+	ProductName       string   `json:"productName"`
+	ProductSlug       string   `json:"productSlug"`
+	Price             float64  `json:"price"`
+	Currency          Currency `json:"currency"`
+	InStock           bool     `json:"inStock"`
+	NotifyBackInStock bool     `json:"notifyBackInStock"`
+	CreatedAt         string   `json:"createdAt"`
+}
+
+type AnalyticsPeriod string
+
+const (
+	AnalyticsPeriodDay   AnalyticsPeriod = "DAY"
+	AnalyticsPeriodWeek  AnalyticsPeriod = "WEEK"
+	AnalyticsPeriodMonth AnalyticsPeriod = "MONTH"
+)
+
+var AllAnalyticsPeriod = []AnalyticsPeriod{
+	AnalyticsPeriodDay,
+	AnalyticsPeriodWeek,
+	AnalyticsPeriodMonth,
+}
+
+func (e AnalyticsPeriod) IsValid() bool {
+	switch e {
+	case AnalyticsPeriodDay, AnalyticsPeriodWeek, AnalyticsPeriodMonth:
+		return true
+	}
+	return false
+}
+
+func (e AnalyticsPeriod) String() string {
+	return string(e)
+}
+
+func (e *AnalyticsPeriod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnalyticsPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnalyticsPeriod", str)
+	}
+	return nil
+}
+
+func (e AnalyticsPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AnalyticsSort string
+
+const (
+	AnalyticsSortRevenue AnalyticsSort = "REVENUE"
+	AnalyticsSortUnits   AnalyticsSort = "UNITS"
+)
+
+var AllAnalyticsSort = []AnalyticsSort{
+	AnalyticsSortRevenue,
+	AnalyticsSortUnits,
+}
+
+func (e AnalyticsSort) IsValid() bool {
+	switch e {
+	case AnalyticsSortRevenue, AnalyticsSortUnits:
+		return true
+	}
+	return false
+}
+
+func (e AnalyticsSort) String() string {
+	return string(e)
+}
+
+func (e *AnalyticsSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnalyticsSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnalyticsSort", str)
+	}
+	return nil
+}
+
+func (e AnalyticsSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AttributeType string
+
+const (
+	AttributeTypeText    AttributeType = "TEXT"
+	AttributeTypeNumber  AttributeType = "NUMBER"
+	AttributeTypeBoolean AttributeType = "BOOLEAN"
+)
+
+var AllAttributeType = []AttributeType{
+	AttributeTypeText,
+	AttributeTypeNumber,
+	AttributeTypeBoolean,
+}
+
+func (e AttributeType) IsValid() bool {
+	switch e {
+	case AttributeTypeText, AttributeTypeNumber, AttributeTypeBoolean:
+		return true
+	}
+	return false
+}
+
+func (e AttributeType) String() string {
+	return string(e)
+}
+
+func (e *AttributeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AttributeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AttributeType", str)
+	}
+	return nil
+}
+
+func (e AttributeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Currency string
+
+const (
+	CurrencyVnd Currency = "VND"
+	CurrencyUsd Currency = "USD"
+)
+
+var AllCurrency = []Currency{
+	CurrencyVnd,
+	CurrencyUsd,
+}
+
+func (e Currency) IsValid() bool {
+	switch e {
+	case CurrencyVnd, CurrencyUsd:
+		return true
+	}
+	return false
+}
+
+func (e Currency) String() string {
+	return string(e)
+}
+
+func (e *Currency) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Currency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Currency", str)
+	}
+	return nil
+}
+
+func (e Currency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ProductSortField string
+
+const (
+	ProductSortFieldName      ProductSortField = "NAME"
+	ProductSortFieldPrice     ProductSortField = "PRICE"
+	ProductSortFieldQuantity  ProductSortField = "QUANTITY"
+	ProductSortFieldCreatedAt ProductSortField = "CREATED_AT"
+)
+
+var AllProductSortField = []ProductSortField{
+	ProductSortFieldName,
+	ProductSortFieldPrice,
+	ProductSortFieldQuantity,
+	ProductSortFieldCreatedAt,
+}
+
+func (e ProductSortField) IsValid() bool {
+	switch e {
+	case ProductSortFieldName, ProductSortFieldPrice, ProductSortFieldQuantity, ProductSortFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e ProductSortField) String() string {
+	return string(e)
+}
+
+func (e *ProductSortField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProductSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProductSortField", str)
+	}
+	return nil
+}
+
+func (e ProductSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "REQUESTED"
+	ReturnStatusApproved  ReturnStatus = "APPROVED"
+	ReturnStatusRejected  ReturnStatus = "REJECTED"
+	ReturnStatusReceived  ReturnStatus = "RECEIVED"
+	ReturnStatusRefunded  ReturnStatus = "REFUNDED"
+	ReturnStatusReplaced  ReturnStatus = "REPLACED"
+)
+
+var AllReturnStatus = []ReturnStatus{
+	ReturnStatusRequested,
+	ReturnStatusApproved,
+	ReturnStatusRejected,
+	ReturnStatusReceived,
+	ReturnStatusRefunded,
+	ReturnStatusReplaced,
+}
+
+func (e ReturnStatus) IsValid() bool {
+	switch e {
+	case ReturnStatusRequested, ReturnStatusApproved, ReturnStatusRejected, ReturnStatusReceived, ReturnStatusRefunded, ReturnStatusReplaced:
+		return true
+	}
+	return false
+}
+
+func (e ReturnStatus) String() string {
+	return string(e)
+}
+
+func (e *ReturnStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReturnStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReturnStatus", str)
+	}
+	return nil
+}
+
+func (e ReturnStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "PENDING"
+	ReviewStatusApproved ReviewStatus = "APPROVED"
+	ReviewStatusRejected ReviewStatus = "REJECTED"
+)
+
+var AllReviewStatus = []ReviewStatus{
+	ReviewStatusPending,
+	ReviewStatusApproved,
+	ReviewStatusRejected,
+}
+
+func (e ReviewStatus) IsValid() bool {
+	switch e {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e ReviewStatus) String() string {
+	return string(e)
+}
+
+func (e *ReviewStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReviewStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReviewStatus", str)
+	}
+	return nil
+}
+
+func (e ReviewStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusShipped   ShipmentStatus = "SHIPPED"
+	ShipmentStatusInTransit ShipmentStatus = "IN_TRANSIT"
+	ShipmentStatusDelivered ShipmentStatus = "DELIVERED"
+)
+
+var AllShipmentStatus = []ShipmentStatus{
+	ShipmentStatusShipped,
+	ShipmentStatusInTransit,
+	ShipmentStatusDelivered,
+}
+
+func (e ShipmentStatus) IsValid() bool {
+	switch e {
+	case ShipmentStatusShipped, ShipmentStatusInTransit, ShipmentStatusDelivered:
+		return true
+	}
+	return false
+}
+
+func (e ShipmentStatus) String() string {
+	return string(e)
+}
+
+func (e *ShipmentStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShipmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShipmentStatus", str)
+	}
+	return nil
+}
+
+func (e ShipmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ShippingRateType string
+
+const (
+	ShippingRateTypeFlat   ShippingRateType = "FLAT"
+	ShippingRateTypeWeight ShippingRateType = "WEIGHT"
+)
+
+var AllShippingRateType = []ShippingRateType{
+	ShippingRateTypeFlat,
+	ShippingRateTypeWeight,
+}
+
+func (e ShippingRateType) IsValid() bool {
+	switch e {
+	case ShippingRateTypeFlat, ShippingRateTypeWeight:
+		return true
+	}
+	return false
+}
+
+func (e ShippingRateType) String() string {
+	return string(e)
+}
+
+func (e *ShippingRateType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShippingRateType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShippingRateType", str)
+	}
+	return nil
+}
+
+func (e ShippingRateType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Status string
 
 const (
 	StatusNew       Status = "NEW"
 	StatusPending   Status = "PENDING"
 	StatusPaid      Status = "PAID"
+	StatusShipped   Status = "SHIPPED"
+	StatusDelivered Status = "DELIVERED"
 	StatusCancelled Status = "CANCELLED"
 )
 
@@ -136,12 +985,14 @@ var AllStatus = []Status{
 	StatusNew,
 	StatusPending,
 	StatusPaid,
+	StatusShipped,
+	StatusDelivered,
 	StatusCancelled,
 }
 
 func (e Status) IsValid() bool {
 	switch e {
-	case StatusNew, StatusPending, StatusPaid, StatusCancelled:
+	case StatusNew, StatusPending, StatusPaid, StatusShipped, StatusDelivered, StatusCancelled:
 		return true
 	}
 	return false
@@ -20,6 +20,20 @@ func validateAndConvertOrderItem(oiReq model.OrderItemRequest) (controllers.Orde
 		Quantity:  oiReq.Quantity,
 	}
 
+	if oiReq.VariantID != nil {
+		if *oiReq.VariantID <= 0 {
+			return controllers.OrderItemInput{}, ErrInvalidVariantID
+		}
+		oiInput.VariantID = *oiReq.VariantID
+	}
+
+	if oiReq.WarehouseID != nil {
+		if *oiReq.WarehouseID <= 0 {
+			return controllers.OrderItemInput{}, ErrInvalidWarehouseID
+		}
+		oiInput.WarehouseID = *oiReq.WarehouseID
+	}
+
 	if oiReq.ID != nil {
 		if *oiReq.ID <= 0 {
 			return controllers.OrderItemInput{}, ErrInvalidOrderID
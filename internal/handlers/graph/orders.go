@@ -35,10 +35,13 @@ func (r *mutationResolver) CreateOrder(ctx context.Context, input model.OrderReq
 }
 
 // UpdateOrder is the resolver for the updateOrder field.
-func (r *mutationResolver) UpdateOrder(ctx context.Context, orderID int, input model.OrderRequest) (bool, error) {
+func (r *mutationResolver) UpdateOrder(ctx context.Context, orderID int, expectedVersion int, input model.OrderRequest) (bool, error) {
 	if orderID <= 0 {
 		return false, ErrInvalidOrderID
 	}
+	if expectedVersion <= 0 {
+		return false, ErrInvalidVersion
+	}
 
 	order, errResp := validateAndConvertOrder(input)
 	if errResp != nil {
@@ -52,6 +55,7 @@ func (r *mutationResolver) UpdateOrder(ctx context.Context, orderID int, input m
 		}
 		order.OrderItem = append(order.OrderItem, orderItem)
 	}
+	order.ExpectedVersion = expectedVersion
 
 	if err := r.Controller.UpdateOrder(ctx, orderID, order); err != nil {
 		log.Println(err)
@@ -69,17 +73,43 @@ func validateAndConvertOrder(orderReq model.OrderRequest) (controllers.OrderInpu
 		return controllers.OrderInput{}, ErrMissingOrderStatus
 	}
 
-	return controllers.OrderInput{
+	order := controllers.OrderInput{
 		UserID: orderReq.UserID,
 		Status: orderReq.Status.String(),
-	}, nil
+	}
+	if orderReq.Region != nil {
+		order.Region = strings.TrimSpace(*orderReq.Region)
+	}
+	if orderReq.AddressID != nil {
+		if *orderReq.AddressID <= 0 {
+			return controllers.OrderInput{}, ErrInvalidAddressID
+		}
+		order.AddressID = *orderReq.AddressID
+	}
+	if orderReq.ShippingMethodID != nil {
+		if *orderReq.ShippingMethodID <= 0 {
+			return controllers.OrderInput{}, ErrInvalidShippingMethodID
+		}
+		order.ShippingMethodID = *orderReq.ShippingMethodID
+	}
+	if orderReq.Currency != nil {
+		order.Currency = orderReq.Currency.String()
+	}
+
+	return order, nil
 }
 
-func (r *queryResolver) GetOrders(ctx context.Context, filter *model.FilterDate, sorting *model.SortingInput, pagination model.PaginationInput) (*model.OrderResponse, error) {
+func (r *queryResolver) GetOrders(ctx context.Context, filter *model.FilterDate, status *model.Status, sorting *model.SortingInput, pagination model.PaginationInput) (*model.OrderResponse, error) {
 	orderFilter, err := validateAndConvertOrderFilter(filter, sorting, pagination)
 	if err != nil {
 		return nil, err
 	}
+	if status != nil {
+		if !status.IsValid() {
+			return nil, ErrInvalidOrderStatus
+		}
+		orderFilter.Status = status.String()
+	}
 
 	orders, count, err := r.Controller.GetOrders(ctx, orderFilter)
 	if err != nil {
@@ -89,6 +119,10 @@ func (r *queryResolver) GetOrders(ctx context.Context, filter *model.FilterDate,
 	orderResp := make([]*model.Order, 0, len(orders))
 
 	for _, o := range orders {
+		region := o.Region
+		subtotal := o.SubtotalPrice.InexactFloat64()
+		tax := o.TaxPrice.InexactFloat64()
+		shipping := o.ShippingPrice.InexactFloat64()
 		total := o.TotalPrice.InexactFloat64()
 		createdAt := o.CreatedAt.Format("02-01-2006 15:04:05")
 		order := &model.Order{
@@ -98,8 +132,18 @@ func (r *queryResolver) GetOrders(ctx context.Context, filter *model.FilterDate,
 				Email: o.UserEmail,
 			},
 			Status:    model.Status(o.Status),
+			Region:    &region,
+			Subtotal:  &subtotal,
+			Tax:       &tax,
+			Shipping:  &shipping,
 			Total:     &total,
+			Currency:  model.Currency(o.Currency),
 			CreatedAt: &createdAt,
+			Version:   o.Version,
+		}
+		if o.ExchangeRate != nil {
+			exchangeRate := o.ExchangeRate.InexactFloat64()
+			order.ExchangeRate = &exchangeRate
 		}
 
 		for index := range o.Items {
@@ -108,8 +152,10 @@ func (r *queryResolver) GetOrders(ctx context.Context, filter *model.FilterDate,
 				Product: &model.Product{
 					Name: o.Items[index].ProductName,
 				},
-				Quantity: o.Items[index].Quantity,
-				Price:    o.Items[index].Price.InexactFloat64(),
+				Quantity:  o.Items[index].Quantity,
+				Price:     o.Items[index].Price.InexactFloat64(),
+				TaxRate:   o.Items[index].TaxRate.InexactFloat64(),
+				TaxAmount: o.Items[index].TaxAmount.InexactFloat64(),
 			})
 		}
 
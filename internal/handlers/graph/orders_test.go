@@ -162,14 +162,16 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		givenOrderIDInput int                // payload provided from end-user
-		givenOrderInput   model.OrderRequest // payload provided from end-user
-		mockOrderCtrl     mockOrderCtrl
-		expResp           bool
-		expErr            error
+		givenOrderIDInput    int                // payload provided from end-user
+		givenExpVersionInput int                // payload provided from end-user
+		givenOrderInput      model.OrderRequest // payload provided from end-user
+		mockOrderCtrl        mockOrderCtrl
+		expResp              bool
+		expErr               error
 	}{
 		"update order successfully": {
-			givenOrderIDInput: 1,
+			givenOrderIDInput:    1,
+			givenExpVersionInput: 3,
 			givenOrderInput: model.OrderRequest{
 				UserID: 1,
 				Status: "Updated",
@@ -200,11 +202,63 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 							Quantity:  1,
 						},
 					},
+					ExpectedVersion: 3,
 				},
 			},
 			expResp: true,
 		},
+		"order changed since it was read": {
+			givenOrderIDInput:    1,
+			givenExpVersionInput: 2,
+			givenOrderInput: model.OrderRequest{
+				UserID: 1,
+				Status: "Updated",
+				Items: []*model.OrderItemRequest{
+					{
+						ProductID: 1,
+						Quantity:  1,
+					},
+				},
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				expCall: true,
+				orderID: 1,
+				orderInput: controllers.OrderInput{
+					UserID: 1,
+					Status: "Updated",
+					OrderItem: []controllers.OrderItemInput{
+						{
+							ProductID: 1,
+							Quantity:  1,
+						},
+					},
+					ExpectedVersion: 2,
+				},
+				err: controllers.ErrOrderVersionConflict,
+			},
+			expResp: false,
+			expErr:  ErrOrderVersionConflict,
+		},
+		"invalid expected version": {
+			givenOrderIDInput: 1,
+			givenOrderInput: model.OrderRequest{
+				UserID: 1,
+				Status: "Updated",
+				Items: []*model.OrderItemRequest{
+					{
+						ProductID: 1,
+						Quantity:  1,
+					},
+				},
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				expCall: false,
+			},
+			expResp: false,
+			expErr:  ErrInvalidVersion,
+		},
 		"invalid order id": {
+			givenExpVersionInput: 3,
 			givenOrderInput: model.OrderRequest{
 				UserID: 1,
 				Status: "Updated",
@@ -222,7 +276,8 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 			expErr:  ErrInvalidOrderID,
 		},
 		"invalid product id": {
-			givenOrderIDInput: 1,
+			givenOrderIDInput:    1,
+			givenExpVersionInput: 3,
 			givenOrderInput: model.OrderRequest{
 				UserID: 1,
 				Status: "Updated",
@@ -240,7 +295,8 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 			expErr:  ErrInvalidProductID,
 		},
 		"invalid quantity": {
-			givenOrderIDInput: 1,
+			givenOrderIDInput:    1,
+			givenExpVersionInput: 3,
 			givenOrderInput: model.OrderRequest{
 				UserID: 1,
 				Status: "Created",
@@ -258,7 +314,8 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 			expErr:  ErrInvalidQuantity,
 		},
 		"invalid user id": {
-			givenOrderIDInput: 1,
+			givenOrderIDInput:    1,
+			givenExpVersionInput: 3,
 			givenOrderInput: model.OrderRequest{
 				UserID: 0,
 				Status: "Created",
@@ -276,7 +333,8 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 			expErr:  ErrInvalidUserID,
 		},
 		"missing order status": {
-			givenOrderIDInput: 1,
+			givenOrderIDInput:    1,
+			givenExpVersionInput: 3,
 			givenOrderInput: model.OrderRequest{
 				UserID: 1,
 				Status: "  ",
@@ -304,7 +362,7 @@ func Test_OrderHandler_UpdateOrder(t *testing.T) {
 				mockController.On("UpdateOrder", context.Background(), tc.mockOrderCtrl.orderID, tc.mockOrderCtrl.orderInput).Return(tc.mockOrderCtrl.err)
 			}
 
-			result, err := resolver.Mutation().UpdateOrder(context.Background(), tc.givenOrderIDInput, tc.givenOrderInput)
+			result, err := resolver.Mutation().UpdateOrder(context.Background(), tc.givenOrderIDInput, tc.givenExpVersionInput, tc.givenOrderInput)
 
 			if err != nil {
 				assert.EqualError(t, err, tc.expErr.Error())
@@ -637,7 +695,7 @@ func Test_OrderHandler_GetOrders(t *testing.T) {
 				mockController.On("GetOrders", context.Background(), tc.mockOrderCtrl.filter).Return(tc.mockOrderCtrl.output, tc.mockOrderCtrl.err)
 			}
 
-			result, err := resolver.Query().GetOrders(context.Background(), nil, nil, model.PaginationInput{})
+			result, err := resolver.Query().GetOrders(context.Background(), nil, nil, nil, model.PaginationInput{})
 
 			if err != nil {
 				assert.EqualError(t, err, tc.expErr.Error())
@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// CreateAttributeDefinition is the resolver for the createAttributeDefinition field.
+func (r *mutationResolver) CreateAttributeDefinition(ctx context.Context, input model.AttributeDefinitionRequest) (*model.AttributeDefinition, error) {
+	if input.CategoryID <= 0 {
+		return nil, ErrInvalidCategoryID
+	}
+
+	adInput := controllers.AttributeDefinitionInput{
+		CategoryID: input.CategoryID,
+		Name:       strings.TrimSpace(input.Name),
+		Type:       strings.ToLower(input.Type.String()),
+	}
+	if adInput.Name == "" {
+		return nil, ErrMissingAttributeName
+	}
+	if len(adInput.Name) > 255 {
+		return nil, ErrNameTooLong
+	}
+	if !controllers.IsValidAttributeType(adInput.Type) {
+		return nil, ErrInvalidAttributeType
+	}
+	if input.Unit != nil {
+		adInput.Unit = strings.TrimSpace(*input.Unit)
+		if len(adInput.Unit) > 32 {
+			return nil, ErrAttributeUnitTooLong
+		}
+	}
+
+	definition, err := r.Controller.CreateAttributeDefinition(ctx, adInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toAttributeDefinitionModel(definition), nil
+}
+
+// GetAttributeDefinitions is the resolver for the getAttributeDefinitions field.
+func (r *queryResolver) GetAttributeDefinitions(ctx context.Context, categoryID int) ([]*model.AttributeDefinition, error) {
+	if categoryID <= 0 {
+		return nil, ErrInvalidCategoryID
+	}
+
+	definitions, err := r.Controller.GetAttributeDefinitions(ctx, categoryID)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	resp := make([]*model.AttributeDefinition, 0, len(definitions))
+	for _, d := range definitions {
+		resp = append(resp, toAttributeDefinitionModel(d))
+	}
+
+	return resp, nil
+}
+
+// toAttributeDefinitionModel converts an attribute definition in controller layer to the graph model
+func toAttributeDefinitionModel(d controllers.AttributeDefinitionOutput) *model.AttributeDefinition {
+	return &model.AttributeDefinition{
+		ID:         d.ID,
+		CategoryID: d.CategoryID,
+		Name:       d.Name,
+		Type:       model.AttributeType(strings.ToUpper(d.Type)),
+		Unit:       d.Unit,
+		CreatedAt:  d.CreatedAt.String(),
+		UpdatedAt:  d.UpdatedAt.String(),
+	}
+}
+
+// toProductAttributeModels converts the values of the attributes of a product in controller layer to the graph model
+func toProductAttributeModels(attributes []controllers.ProductAttributeOutput) []*model.ProductAttribute {
+	resp := []*model.ProductAttribute{}
+	for _, a := range attributes {
+		resp = append(resp, &model.ProductAttribute{
+			Name:  a.Name,
+			Type:  model.AttributeType(strings.ToUpper(a.Type)),
+			Unit:  a.Unit,
+			Value: a.Value,
+		})
+	}
+
+	return resp
+}
+
+// toProductTagModels returns the tags of a product, an empty list if it has no tags
+func toProductTagModels(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"context"
+	"log"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// BulkChangePrice is the resolver for the bulkChangePrice field.
+func (r *mutationResolver) BulkChangePrice(ctx context.Context, selection model.BulkSelectionInput, amount *float64, percent *float64) (*model.BulkReport, error) {
+	bSelection, err := validateAndConvertBulkSelection(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	if (amount == nil) == (percent == nil) {
+		return nil, ErrInvalidBulkPriceChange
+	}
+	bpInput := controllers.BulkPriceInput{BulkSelection: bSelection}
+	if amount != nil {
+		d := decimal.NewFromFloat(*amount)
+		bpInput.Amount = &d
+	}
+	if percent != nil {
+		d := decimal.NewFromFloat(*percent)
+		bpInput.Percent = &d
+	}
+
+	report, err := r.Controller.BulkChangePrice(ctx, bpInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toBulkReportModel(report), nil
+}
+
+// BulkChangeCategory is the resolver for the bulkChangeCategory field.
+func (r *mutationResolver) BulkChangeCategory(ctx context.Context, selection model.BulkSelectionInput, categoryID int) (*model.BulkReport, error) {
+	bSelection, err := validateAndConvertBulkSelection(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	if categoryID <= 0 {
+		return nil, ErrInvalidCategoryID
+	}
+
+	report, err := r.Controller.BulkChangeCategory(ctx, controllers.BulkCategoryInput{BulkSelection: bSelection, CategoryID: categoryID})
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toBulkReportModel(report), nil
+}
+
+// BulkUpdateStock is the resolver for the bulkUpdateStock field.
+func (r *mutationResolver) BulkUpdateStock(ctx context.Context, selection model.BulkSelectionInput, quantity *int, delta *int, actorID int) (*model.BulkReport, error) {
+	bSelection, err := validateAndConvertBulkSelection(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	if (quantity == nil) == (delta == nil) {
+		return nil, ErrInvalidBulkStockChange
+	}
+	if actorID <= 0 {
+		return nil, ErrInvalidUserID
+	}
+
+	report, err := r.Controller.BulkUpdateStock(ctx, controllers.BulkStockInput{
+		BulkSelection: bSelection,
+		Quantity:      quantity,
+		Delta:         delta,
+		ActorID:       actorID,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toBulkReportModel(report), nil
+}
+
+// BulkArchiveProducts is the resolver for the bulkArchiveProducts field.
+func (r *mutationResolver) BulkArchiveProducts(ctx context.Context, selection model.BulkSelectionInput) (*model.BulkReport, error) {
+	bSelection, err := validateAndConvertBulkSelection(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := r.Controller.BulkArchiveProducts(ctx, bSelection)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toBulkReportModel(report), nil
+}
+
+// validateAndConvertBulkSelection selects the products of a bulk operation by either the IDs or the filter
+func validateAndConvertBulkSelection(selection model.BulkSelectionInput) (controllers.BulkSelection, error) {
+	if (len(selection.Ids) == 0) == (selection.Filter == nil) {
+		return controllers.BulkSelection{}, ErrInvalidBulkSelection
+	}
+
+	var bSelection controllers.BulkSelection
+	if selection.Transactional != nil {
+		bSelection.Transactional = *selection.Transactional
+	}
+
+	if selection.Filter != nil {
+		var filter controllers.ProductCtrlFilter
+		if err := validateAndConvertProductPage(selection.Filter, nil, nil, &filter); err != nil {
+			return controllers.BulkSelection{}, err
+		}
+		bSelection.Filter = &filter
+		return bSelection, nil
+	}
+
+	for _, id := range selection.Ids {
+		if id <= 0 {
+			return controllers.BulkSelection{}, ErrInvalidBulkSelection
+		}
+	}
+	bSelection.IDs = selection.Ids
+
+	return bSelection, nil
+}
+
+// toBulkReportModel converts the report of a bulk operation in controller layer to the graph model
+func toBulkReportModel(report controllers.BulkReport) *model.BulkReport {
+	resp := &model.BulkReport{
+		Operation:     report.Operation,
+		Transactional: report.Transactional,
+		Succeeded:     report.Succeeded,
+		Failed:        report.Failed,
+		Items:         []*model.BulkItemResult{},
+	}
+	for _, item := range report.Items {
+		itemResp := &model.BulkItemResult{ProductID: item.ProductID, Success: item.Err == nil}
+		if item.Err != nil {
+			msg := convertBulkItemError(item.Err).Error()
+			itemResp.Error = &msg
+		}
+		resp.Items = append(resp.Items, itemResp)
+	}
+
+	return resp
+}
+
+// convertBulkItemError returns the error of a product in a bulk operation
+func convertBulkItemError(err error) error {
+	if err == controllers.ErrBulkRolledBack {
+		return ErrBulkRolledBack
+	}
+	return convertCtrlError(err)
+}
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// GetProductBySku is the resolver for the getProductBySKU field.
+func (r *queryResolver) GetProductBySku(ctx context.Context, sku string, currency *model.Currency) (*model.Product, error) {
+	sku = strings.TrimSpace(sku)
+	if sku == "" {
+		return nil, ErrMissingSKU
+	}
+
+	var currencyInput string
+	if currency != nil {
+		if !currency.IsValid() {
+			return nil, ErrInvalidCurrency
+		}
+		currencyInput = currency.String()
+	}
+
+	product, err := r.Controller.GetProductBySKU(ctx, sku, currencyInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toProductModel(product), nil
+}
+
+// GetProductBySlug is the resolver for the getProductBySlug field. An old slug of a renamed product resolves
+// the product, its current slug is in the result
+func (r *queryResolver) GetProductBySlug(ctx context.Context, slug string, currency *model.Currency) (*model.Product, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		return nil, ErrMissingSlug
+	}
+
+	var currencyInput string
+	if currency != nil {
+		if !currency.IsValid() {
+			return nil, ErrInvalidCurrency
+		}
+		currencyInput = currency.String()
+	}
+
+	product, err := r.Controller.GetProductBySlug(ctx, slug, currencyInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toProductModel(product), nil
+}
@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// UpdateProduct is the resolver for the updateProduct field.
+func (r *mutationResolver) UpdateProduct(ctx context.Context, productID int, expectedVersion int, input model.ProductPatchRequest) (int, error) {
+	if productID <= 0 {
+		return 0, ErrInvalidProductID
+	}
+	if expectedVersion <= 0 {
+		return 0, ErrInvalidVersion
+	}
+
+	patch, err := validateAndConvertProductPatch(input)
+	if err != nil {
+		return 0, err
+	}
+	patch.ID = productID
+	patch.ExpectedVersion = expectedVersion
+
+	version, err := r.Controller.PatchProduct(ctx, patch)
+	if err != nil {
+		log.Println(err)
+		return 0, convertCtrlError(err)
+	}
+
+	return version, nil
+}
+
+// validateAndConvertProductPatch validates the fields given in the patch of a product one by one and returns the patch in
+// controller layer, the fields which are not given are kept
+func validateAndConvertProductPatch(pReq model.ProductPatchRequest) (controllers.ProductPatch, error) {
+	patch := controllers.ProductPatch{}
+
+	if pReq.Name != nil {
+		name := strings.TrimSpace(*pReq.Name)
+		if name == "" {
+			return controllers.ProductPatch{}, ErrMissingName
+		}
+		if len(name) > 255 {
+			return controllers.ProductPatch{}, ErrNameTooLong
+		}
+		patch.Name = &name
+	}
+
+	if pReq.Description != nil {
+		description := strings.TrimSpace(*pReq.Description)
+		if description == "" {
+			return controllers.ProductPatch{}, ErrMissingDesc
+		}
+		patch.Description = &description
+	}
+
+	if pReq.Price != nil {
+		if *pReq.Price <= 0 {
+			return controllers.ProductPatch{}, ErrInvalidPrice
+		}
+		price := decimal.NewFromFloat(*pReq.Price)
+		patch.Price = &price
+	}
+
+	if pReq.Quantity != nil {
+		if *pReq.Quantity < 0 {
+			return controllers.ProductPatch{}, ErrInvalidQuantity
+		}
+		patch.Quantity = pReq.Quantity
+	}
+
+	if pReq.AuthorID != nil {
+		if *pReq.AuthorID <= 0 {
+			return controllers.ProductPatch{}, ErrInvalidAuthorID
+		}
+		patch.AuthorID = pReq.AuthorID
+	}
+
+	if pReq.CategoryName != nil {
+		categoryName := strings.TrimSpace(*pReq.CategoryName)
+		if categoryName == "" {
+			return controllers.ProductPatch{}, ErrMissingCategoryName
+		}
+		patch.CategoryName = &categoryName
+	}
+
+	if pReq.Weight != nil {
+		if *pReq.Weight < 0 {
+			return controllers.ProductPatch{}, ErrInvalidWeight
+		}
+		weight := decimal.NewFromFloat(*pReq.Weight)
+		patch.Weight = &weight
+	}
+
+	if pReq.Currency != nil {
+		currency := pReq.Currency.String()
+		patch.Currency = &currency
+	}
+
+	if pReq.ReorderThreshold != nil {
+		if *pReq.ReorderThreshold < 0 {
+			return controllers.ProductPatch{}, ErrInvalidReorderThreshold
+		}
+		patch.ReorderThreshold = pReq.ReorderThreshold
+	}
+
+	// the tags are replaced as a whole, an empty list removes them
+	if pReq.Tags != nil {
+		patch.Tags = append([]string{}, pReq.Tags...)
+	}
+
+	// the attributes are merged by name, an attribute without a value is removed
+	if pReq.Attributes != nil {
+		patch.Attributes = make(map[string]*string)
+		for _, a := range pReq.Attributes {
+			name := strings.TrimSpace(a.Name)
+			if name == "" {
+				return controllers.ProductPatch{}, ErrMissingAttributeName
+			}
+			if _, exists := patch.Attributes[name]; exists {
+				return controllers.ProductPatch{}, ErrInvalidAttributeValue
+			}
+			patch.Attributes[name] = a.Value
+		}
+	}
+
+	return patch, nil
+}
@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// priceTimeLayout is the layout of the times of the price history, as the other timestamps of the schema
+const priceTimeLayout = "02-01-2006 15:04:05"
+
+// SchedulePriceChange is the resolver for the schedulePriceChange field.
+func (r *mutationResolver) SchedulePriceChange(ctx context.Context, input model.ProductPriceRequest) (*model.ProductPrice, error) {
+	if input.ProductID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	price := decimal.NewFromFloat(input.Price)
+	if !price.IsPositive() || len(price.String()) > 15 {
+		return nil, ErrInvalidPrice
+	}
+
+	var currency string
+	if input.Currency != nil {
+		if !input.Currency.IsValid() {
+			return nil, ErrInvalidCurrency
+		}
+		currency = input.Currency.String()
+	}
+
+	effectiveFrom, err := time.Parse(priceTimeLayout, strings.TrimSpace(input.EffectiveFrom))
+	if err != nil {
+		return nil, ErrTimeBadRequest
+	}
+
+	productPrice, err := r.Controller.SchedulePriceChange(ctx, controllers.ProductPriceInput{
+		ProductID:     input.ProductID,
+		Price:         price,
+		Currency:      currency,
+		EffectiveFrom: effectiveFrom,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toProductPriceModel(productPrice), nil
+}
+
+// CancelScheduledPrice is the resolver for the cancelScheduledPrice field.
+func (r *mutationResolver) CancelScheduledPrice(ctx context.Context, productID int, priceID int) (bool, error) {
+	if productID <= 0 {
+		return false, ErrInvalidProductID
+	}
+	if priceID <= 0 {
+		return false, ErrInvalidPriceID
+	}
+
+	if err := r.Controller.CancelScheduledPrice(ctx, productID, priceID); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetProductPriceHistory is the resolver for the getProductPriceHistory field.
+func (r *queryResolver) GetProductPriceHistory(ctx context.Context, productID int) ([]*model.ProductPrice, error) {
+	if productID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	productPrices, err := r.Controller.GetProductPriceHistory(ctx, productID)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	ppResp := make([]*model.ProductPrice, 0, len(productPrices))
+	for _, pp := range productPrices {
+		ppResp = append(ppResp, toProductPriceModel(pp))
+	}
+
+	return ppResp, nil
+}
+
+// GetProductPriceAt is the resolver for the getProductPriceAt field.
+func (r *queryResolver) GetProductPriceAt(ctx context.Context, productID int, at *string) (*model.ProductPrice, error) {
+	if productID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	// the current price is retrieved if no time is given
+	atTime := time.Now()
+	if at != nil {
+		var err error
+		atTime, err = time.Parse(priceTimeLayout, strings.TrimSpace(*at))
+		if err != nil {
+			return nil, ErrTimeBadRequest
+		}
+	}
+
+	productPrice, err := r.Controller.GetProductPriceAt(ctx, productID, atTime)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toProductPriceModel(productPrice), nil
+}
+
+// toProductPriceModel converts a price of the price history to the GraphQL model
+func toProductPriceModel(pp controllers.ProductPriceOutput) *model.ProductPrice {
+	productPrice := &model.ProductPrice{
+		ID:            pp.ID,
+		ProductID:     pp.ProductID,
+		Price:         pp.Price.InexactFloat64(),
+		Currency:      model.Currency(pp.Currency),
+		EffectiveFrom: pp.EffectiveFrom.Format(priceTimeLayout),
+		Scheduled:     pp.Scheduled,
+		CreatedAt:     pp.CreatedAt.Format(priceTimeLayout),
+	}
+	if pp.EffectiveTo != nil {
+		effectiveTo := pp.EffectiveTo.Format(priceTimeLayout)
+		productPrice.EffectiveTo = &effectiveTo
+	}
+
+	return productPrice
+}
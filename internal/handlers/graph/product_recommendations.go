@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"context"
+	"log"
+
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// RelatedProducts is the resolver for the relatedProducts field. The related products are in the currency of the product
+func (r *productResolver) RelatedProducts(ctx context.Context, obj *model.Product, limit *int) ([]*model.Product, error) {
+	var limitInput int
+	if limit != nil {
+		if *limit <= 0 {
+			return nil, ErrInvalidRelatedProductsLimit
+		}
+		limitInput = *limit
+	}
+
+	products, err := r.Controller.GetRelatedProducts(ctx, obj.ID, limitInput, obj.Currency.String())
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	pModels := make([]*model.Product, 0, len(products))
+	for _, p := range products {
+		pModels = append(pModels, toProductModel(p))
+	}
+
+	return pModels, nil
+}
+
+// Product returns ProductResolver implementation.
+func (r *Resolver) Product() ProductResolver { return &productResolver{r} }
+
+type productResolver struct{ *Resolver }
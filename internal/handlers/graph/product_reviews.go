@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// CreateProductReview is the resolver for the createProductReview field.
+func (r *mutationResolver) CreateProductReview(ctx context.Context, input model.ProductReviewRequest) (*model.ProductReview, error) {
+	if input.ProductID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+	if input.UserID <= 0 {
+		return nil, ErrInvalidUserID
+	}
+	if input.Rating < 1 || input.Rating > 5 {
+		return nil, ErrInvalidRating
+	}
+
+	prInput := controllers.ProductReviewInput{
+		ProductID: input.ProductID,
+		UserID:    input.UserID,
+		Rating:    input.Rating,
+		Body:      strings.TrimSpace(input.Body),
+	}
+	if prInput.Body == "" {
+		return nil, ErrMissingReviewBody
+	}
+	if input.Title != nil {
+		prInput.Title = strings.TrimSpace(*input.Title)
+	}
+
+	review, err := r.Controller.CreateProductReview(ctx, prInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toProductReviewModel(review), nil
+}
+
+// ModerateProductReview is the resolver for the moderateProductReview field.
+func (r *mutationResolver) ModerateProductReview(ctx context.Context, reviewID int, status model.ReviewStatus, note *string) (bool, error) {
+	if reviewID <= 0 {
+		return false, ErrInvalidReviewID
+	}
+	if !status.IsValid() {
+		return false, ErrInvalidReviewStatus
+	}
+
+	var noteInput string
+	if note != nil {
+		noteInput = strings.TrimSpace(*note)
+	}
+
+	if err := r.Controller.ModerateProductReview(ctx, reviewID, status.String(), noteInput); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetProductReviews is the resolver for the getProductReviews field.
+func (r *queryResolver) GetProductReviews(ctx context.Context, productID int, status *model.ReviewStatus, limit *int, offset *int) (*model.ProductReviewList, error) {
+	if productID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	filter := controllers.ProductReviewFilterCtrl{ProductID: productID}
+	if status != nil {
+		if !status.IsValid() {
+			return nil, ErrInvalidReviewStatus
+		}
+		filter.Status = status.String()
+	}
+
+	if limit != nil {
+		if *limit <= 0 {
+			return nil, ErrInvalidReviewLimit
+		}
+		filter.Limit = *limit
+	}
+
+	if offset != nil {
+		if *offset < 0 {
+			return nil, ErrInvalidOffset
+		}
+		filter.Offset = *offset
+	}
+
+	reviews, totalCount, err := r.Controller.GetProductReviews(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	resp := &model.ProductReviewList{
+		Reviews:    make([]*model.ProductReview, 0, len(reviews)),
+		TotalCount: int(totalCount),
+	}
+	for _, pr := range reviews {
+		resp.Reviews = append(resp.Reviews, toProductReviewModel(pr))
+	}
+
+	return resp, nil
+}
+
+// toProductReviewModel converts a review of a product in controller layer to the graph model
+func toProductReviewModel(pr controllers.ProductReviewOutput) *model.ProductReview {
+	review := &model.ProductReview{
+		ID:        pr.ID,
+		ProductID: pr.ProductID,
+		UserID:    pr.UserID,
+		Rating:    pr.Rating,
+		Title:     pr.Title,
+		Body:      pr.Body,
+		Status:    model.ReviewStatus(pr.Status),
+		Note:      pr.Note,
+		CreatedAt: pr.CreatedAt.String(),
+		UpdatedAt: pr.UpdatedAt.String(),
+	}
+	if pr.ModeratedAt != nil {
+		moderatedAt := pr.ModeratedAt.String()
+		review.ModeratedAt = &moderatedAt
+	}
+
+	return review
+}
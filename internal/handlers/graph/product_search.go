@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// SearchProducts is the resolver for the searchProducts field.
+func (r *queryResolver) SearchProducts(ctx context.Context, query string, limit *int, offset *int, currency *model.Currency) (*model.ProductSearchResponse, error) {
+	filter := controllers.ProductSearchFilter{Query: strings.TrimSpace(query)}
+	if filter.Query == "" {
+		return nil, ErrMissingSearchQuery
+	}
+
+	if limit != nil {
+		if *limit <= 0 {
+			return nil, ErrInvalidProductLimit
+		}
+		filter.Limit = *limit
+	}
+
+	if offset != nil {
+		if *offset < 0 {
+			return nil, ErrInvalidOffset
+		}
+		filter.Offset = *offset
+	}
+
+	if currency != nil {
+		if !currency.IsValid() {
+			return nil, ErrInvalidCurrency
+		}
+		filter.Currency = currency.String()
+	}
+
+	results, totalCount, err := r.Controller.SearchProducts(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	resp := &model.ProductSearchResponse{
+		Results:    make([]*model.ProductSearchResult, 0, len(results)),
+		TotalCount: int(totalCount),
+	}
+	for _, p := range results {
+		resp.Results = append(resp.Results, &model.ProductSearchResult{
+			ID:            p.ID,
+			Name:          p.Name,
+			Description:   p.Description,
+			Price:         p.Price.InexactFloat64(),
+			Quantity:      p.Quantity,
+			Currency:      model.Currency(p.Currency),
+			Category:      p.CategoryName,
+			AuthorID:      p.AuthorID,
+			Rank:          p.Rank,
+			NameHighlight: p.NameHighlight,
+			Snippet:       p.Snippet,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// CreateProductVariant is the resolver for the createProductVariant field.
+func (r *mutationResolver) CreateProductVariant(ctx context.Context, input model.ProductVariantRequest) (bool, error) {
+	if input.ProductID <= 0 {
+		return false, ErrInvalidProductID
+	}
+
+	price, err := validateVariantPrice(input.Price)
+	if err != nil {
+		return false, err
+	}
+	if input.Quantity < 0 {
+		return false, ErrInvalidQuantity
+	}
+
+	sku := strings.TrimSpace(input.Sku)
+	if sku == "" {
+		return false, ErrMissingSKU
+	}
+
+	// an option type can only be given once
+	options := make(map[string]string)
+	for _, o := range input.Options {
+		name, value := strings.TrimSpace(o.Name), strings.TrimSpace(o.Value)
+		if name == "" || value == "" {
+			return false, ErrMissingVariantOptions
+		}
+		if _, ok := options[name]; ok {
+			return false, ErrVariantOptionsMismatch
+		}
+		options[name] = value
+	}
+	if len(options) == 0 {
+		return false, ErrMissingVariantOptions
+	}
+
+	if err := r.Controller.CreateVariant(ctx, controllers.VariantInput{
+		ProductID: input.ProductID,
+		SKU:       sku,
+		Price:     price,
+		Quantity:  input.Quantity,
+		Options:   options,
+	}); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// UpdateProductVariant is the resolver for the updateProductVariant field.
+func (r *mutationResolver) UpdateProductVariant(ctx context.Context, input model.UpdateProductVariantRequest) (bool, error) {
+	if input.ProductID <= 0 {
+		return false, ErrInvalidProductID
+	}
+	if input.VariantID <= 0 {
+		return false, ErrInvalidVariantID
+	}
+
+	price, err := validateVariantPrice(input.Price)
+	if err != nil {
+		return false, err
+	}
+	if input.Quantity < 0 {
+		return false, ErrInvalidQuantity
+	}
+
+	if err := r.Controller.UpdateVariant(ctx, input.ProductID, input.VariantID, price, input.Quantity); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetProductVariants is the resolver for the getProductVariants field.
+func (r *queryResolver) GetProductVariants(ctx context.Context, productID int, currency *model.Currency) ([]*model.ProductVariant, error) {
+	if productID <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	var currencyInput string
+	if currency != nil {
+		if !currency.IsValid() {
+			return nil, ErrInvalidCurrency
+		}
+		currencyInput = currency.String()
+	}
+
+	variants, err := r.Controller.GetVariants(ctx, productID, currencyInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	variantsResp := make([]*model.ProductVariant, 0, len(variants))
+	for _, v := range variants {
+		variant := &model.ProductVariant{
+			ID:        v.ID,
+			ProductID: v.ProductID,
+			Sku:       v.SKU,
+			Price:     v.Price.InexactFloat64(),
+			Currency:  model.Currency(v.Currency),
+			Quantity:  v.Quantity,
+			Options:   make([]*model.VariantOption, 0, len(v.Options)),
+			CreatedAt: v.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt: v.UpdatedAt.Format("02-01-2006 15:04:05"),
+		}
+		for _, o := range v.Options {
+			variant.Options = append(variant.Options, &model.VariantOption{Name: o.Name, Value: o.Value})
+		}
+		variantsResp = append(variantsResp, variant)
+	}
+
+	return variantsResp, nil
+}
+
+// validateVariantPrice converts the price override of a variant, the variant is sold at the price of the product if not given
+func validateVariantPrice(price *float64) (decimal.NullDecimal, error) {
+	if price == nil {
+		return decimal.NullDecimal{}, nil
+	}
+
+	p := decimal.NewFromFloat(*price)
+	if !p.IsPositive() {
+		return decimal.NullDecimal{}, ErrInvalidPrice
+	}
+
+	return decimal.NewNullDecimal(p), nil
+}
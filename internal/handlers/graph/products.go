@@ -31,48 +31,125 @@ func (r *mutationResolver) CreateProduct(ctx context.Context, input model.Produc
 }
 
 // GetProducts is the resolver for the getProducts field.
-func (r *queryResolver) GetProducts(ctx context.Context, queryName string, date string) ([]*model.Product, error) {
+func (r *queryResolver) GetProducts(ctx context.Context, queryName string, date string, currency *model.Currency, filter *model.ProductFilterInput, sorting *model.ProductSortingInput, pagination *model.ProductPaginationInput) (*model.ProductResponse, error) {
 	pCtrlFilter, err := validateAndConvertProductFilter(queryName, date)
 	if err != nil {
 		return nil, err
 	}
+	if currency != nil {
+		pCtrlFilter.Currency = currency.String()
+	}
+	if err := validateAndConvertProductPage(filter, sorting, pagination, &pCtrlFilter); err != nil {
+		return nil, err
+	}
 
-	products, err := r.Controller.GetProductsGraph(ctx, pCtrlFilter)
+	products, pageInfo, err := r.Controller.GetProductsGraph(ctx, pCtrlFilter)
 	if err != nil {
 		log.Println(err)
 		return nil, convertCtrlError(err)
 	}
 
-	var productsResp []*model.Product
+	productsResp := make([]*model.Product, 0, len(products))
 	for _, p := range products {
-		productsResp = append(productsResp, &model.Product{
-			ID:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price.Abs().InexactFloat64(),
-			Quantity:    p.Quantity,
-			Author: &model.User{
-				ID:        p.Author.ID,
-				Name:      p.Author.Name,
-				Email:     p.Author.Email,
-				Role:      p.Author.Role,
-				Status:    p.Author.Status,
-				CreatedAt: p.Author.CreatedAt.String(),
-				UpdatedAt: p.Author.UpdatedAt.String(),
-			},
-			Category: &model.ProductCategory{
-				ID:          p.Category.ID,
-				Name:        p.Category.Name,
-				Description: p.Category.Description,
-				CreatedAt:   p.Category.CreatedAt.String(),
-				UpdatedAt:   p.Category.UpdatedAt.String(),
-			},
-			CreatedAt: p.CreatedAt.String(),
-			UpdatedAt: p.UpdatedAt.String(),
-		})
-	}
-
-	return productsResp, nil
+		productsResp = append(productsResp, toProductModel(p))
+	}
+
+	resp := &model.ProductResponse{
+		Products:   productsResp,
+		TotalCount: int(pageInfo.TotalCount),
+	}
+	if pageInfo.NextCursor != "" {
+		resp.NextCursor = &pageInfo.NextCursor
+	}
+
+	return resp, nil
+}
+
+// GetProduct is the resolver for the getProduct field.
+func (r *queryResolver) GetProduct(ctx context.Context, id int, currency *model.Currency) (*model.Product, error) {
+	if id <= 0 {
+		return nil, ErrInvalidProductID
+	}
+
+	var currencyInput string
+	if currency != nil {
+		if !currency.IsValid() {
+			return nil, ErrInvalidCurrency
+		}
+		currencyInput = currency.String()
+	}
+
+	product, err := r.Controller.GetProduct(ctx, id, currencyInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toProductModel(product), nil
+}
+
+// toProductModel converts a product with its author and category in controller layer to the graph model
+func toProductModel(p controllers.ProductOutputGraph) *model.Product {
+	return &model.Product{
+		ID:          p.ID,
+		Sku:         p.SKU,
+		Slug:        p.Slug,
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price.Abs().InexactFloat64(),
+		Quantity:    p.Quantity,
+		Weight:      p.Weight.InexactFloat64(),
+		Currency:    model.Currency(p.Currency),
+		Author: &model.User{
+			ID:        p.Author.ID,
+			Name:      p.Author.Name,
+			Email:     p.Author.Email,
+			Role:      p.Author.Role,
+			Status:    p.Author.Status,
+			CreatedAt: p.Author.CreatedAt.String(),
+			UpdatedAt: p.Author.UpdatedAt.String(),
+		},
+		Category: &model.ProductCategory{
+			ID:          p.Category.ID,
+			Name:        p.Category.Name,
+			Description: p.Category.Description,
+			CreatedAt:   p.Category.CreatedAt.String(),
+			UpdatedAt:   p.Category.UpdatedAt.String(),
+		},
+		Images:           toProductImageModels(p.Images),
+		Tags:             toProductTagModels(p.Tags),
+		Attributes:       toProductAttributeModels(p.Attributes),
+		CreatedAt:        p.CreatedAt.String(),
+		UpdatedAt:        p.UpdatedAt.String(),
+		ReorderThreshold: p.ReorderThreshold,
+		RatingAverage:    p.RatingAverage.InexactFloat64(),
+		ReviewCount:      p.ReviewCount,
+		Version:          p.Version,
+		Availability:     toWarehouseStockModels(p.Availability),
+	}
+}
+
+// toProductImageModels converts the images of a product in controller layer to the graph model
+func toProductImageModels(images []controllers.ProductImageOutput) []*model.ProductImage {
+	resp := []*model.ProductImage{}
+	for _, i := range images {
+		image := &model.ProductImage{
+			ID:          i.ID,
+			Position:    i.Position,
+			URL:         i.URL,
+			ContentType: i.ContentType,
+			Size:        i.Size,
+			Width:       i.Width,
+			Height:      i.Height,
+			Thumbnails:  []*model.ImageThumbnail{},
+		}
+		for _, t := range i.Thumbnails {
+			image.Thumbnails = append(image.Thumbnails, &model.ImageThumbnail{Size: t.Size, URL: t.URL})
+		}
+		resp = append(resp, image)
+	}
+
+	return resp
 }
 
 // validateAndConvertProduct validates the product from body request and return product struct in controller layer
@@ -105,14 +182,55 @@ func validateAndConvertProduct(pReq model.ProductRequest) (controllers.ProductIn
 		return controllers.ProductInput{}, ErrMissingCategoryName
 	}
 
-	return controllers.ProductInput{
+	product := controllers.ProductInput{
 		Name:         strings.TrimSpace(pReq.Name),
 		Description:  strings.TrimSpace(pReq.Description),
 		Price:        decimal.NewFromFloat(pReq.Price),
 		Quantity:     pReq.Quantity,
 		AuthorID:     pReq.AuthorID,
 		CategoryName: strings.TrimSpace(pReq.CategoryName),
-	}, nil
+	}
+
+	if pReq.Weight != nil {
+		if *pReq.Weight < 0 {
+			return controllers.ProductInput{}, ErrInvalidWeight
+		}
+		product.Weight = decimal.NewFromFloat(*pReq.Weight)
+	}
+
+	if pReq.Currency != nil {
+		product.Currency = pReq.Currency.String()
+	}
+
+	if pReq.ReorderThreshold != nil {
+		if *pReq.ReorderThreshold < 0 {
+			return controllers.ProductInput{}, ErrInvalidReorderThreshold
+		}
+		product.ReorderThreshold = pReq.ReorderThreshold
+	}
+
+	if pReq.Sku != nil {
+		product.SKU = strings.TrimSpace(*pReq.Sku)
+	}
+
+	product.Tags = pReq.Tags
+
+	// the values of the attributes are validated against the types of the attributes in the controller layer
+	if pReq.Attributes != nil {
+		product.Attributes = make(map[string]string)
+		for _, a := range pReq.Attributes {
+			name := strings.TrimSpace(a.Name)
+			if name == "" {
+				return controllers.ProductInput{}, ErrMissingAttributeName
+			}
+			if _, exists := product.Attributes[name]; exists {
+				return controllers.ProductInput{}, ErrInvalidAttributeValue
+			}
+			product.Attributes[name] = a.Value
+		}
+	}
+
+	return product, nil
 }
 
 func validateAndConvertProductFilter(queryName, date string) (controllers.ProductCtrlFilter, error) {
@@ -130,6 +248,112 @@ func validateAndConvertProductFilter(queryName, date string) (controllers.Produc
 	return pCtrlFilter, nil
 }
 
+// productSortFields maps the sort fields of the schema to the columns the products are sorted by in controller layer
+var productSortFields = map[model.ProductSortField]string{
+	model.ProductSortFieldName:      controllers.ProductSortName,
+	model.ProductSortFieldPrice:     controllers.ProductSortPrice,
+	model.ProductSortFieldQuantity:  controllers.ProductSortQuantity,
+	model.ProductSortFieldCreatedAt: controllers.ProductSortCreatedAt,
+}
+
+// validateAndConvertProductPage validates the filter, sorting and pagination of the products and sets them to the filter in controller layer
+func validateAndConvertProductPage(filter *model.ProductFilterInput, sorting *model.ProductSortingInput, pagination *model.ProductPaginationInput, pCtrlFilter *controllers.ProductCtrlFilter) error {
+	if filter != nil {
+		for _, price := range []struct {
+			input *float64
+			ctrl  **decimal.Decimal
+		}{{filter.MinPrice, &pCtrlFilter.MinPrice}, {filter.MaxPrice, &pCtrlFilter.MaxPrice}} {
+			if price.input == nil {
+				continue
+			}
+			if *price.input < 0 {
+				return ErrInvalidPriceFilter
+			}
+			d := decimal.NewFromFloat(*price.input)
+			*price.ctrl = &d
+		}
+
+		if filter.CategoryID != nil {
+			if *filter.CategoryID <= 0 {
+				return ErrInvalidCategoryID
+			}
+			pCtrlFilter.CategoryID = *filter.CategoryID
+		}
+
+		if filter.AuthorID != nil {
+			if *filter.AuthorID <= 0 {
+				return ErrInvalidAuthorID
+			}
+			pCtrlFilter.AuthorID = *filter.AuthorID
+		}
+
+		if filter.InStock != nil {
+			pCtrlFilter.InStock = *filter.InStock
+		}
+
+		for _, date := range []struct {
+			input *string
+			ctrl  *time.Time
+		}{{filter.CreatedFrom, &pCtrlFilter.CreatedFrom}, {filter.CreatedTo, &pCtrlFilter.CreatedTo}} {
+			if date.input == nil || len(strings.TrimSpace(*date.input)) == 0 {
+				continue
+			}
+			d, err := time.Parse("2006-01-02", *date.input)
+			if err != nil {
+				return ErrDateBadRequest
+			}
+			*date.ctrl = d
+		}
+
+		pCtrlFilter.Tags = filter.Tags
+
+		for _, a := range filter.Attributes {
+			attributeFilter := controllers.AttributeFilterInput{Name: a.Name}
+			if a.Value != nil {
+				attributeFilter.Value = *a.Value
+			}
+			if a.Min != nil {
+				min := decimal.NewFromFloat(*a.Min)
+				attributeFilter.Min = &min
+			}
+			if a.Max != nil {
+				max := decimal.NewFromFloat(*a.Max)
+				attributeFilter.Max = &max
+			}
+			pCtrlFilter.Attributes = append(pCtrlFilter.Attributes, attributeFilter)
+		}
+	}
+
+	if sorting != nil {
+		sortBy, ok := productSortFields[sorting.Field]
+		if !ok {
+			return ErrInvalidProductSort
+		}
+		pCtrlFilter.SortBy = sortBy
+		pCtrlFilter.SortDesc = sorting.Desc
+	}
+
+	if pagination != nil {
+		if pagination.Limit != nil {
+			if *pagination.Limit <= 0 {
+				return ErrInvalidProductLimit
+			}
+			pCtrlFilter.Limit = *pagination.Limit
+		}
+		if pagination.Offset != nil {
+			if *pagination.Offset < 0 {
+				return ErrInvalidOffset
+			}
+			pCtrlFilter.Offset = *pagination.Offset
+		}
+		if pagination.Cursor != nil {
+			pCtrlFilter.Cursor = strings.TrimSpace(*pagination.Cursor)
+		}
+	}
+
+	return nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -156,17 +156,28 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 	assert.NoError(t, err)
 
 	type mockProductCtrl struct {
-		query  controllers.ProductCtrlFilter
-		output []controllers.ProductOutputGraph
-		err    error
+		query    controllers.ProductCtrlFilter
+		output   []controllers.ProductOutputGraph
+		pageInfo controllers.ProductPageInfo
+		err      error
 	}
 
+	minPrice, categoryID, inStock, createdFrom := 100.0, 2, true, "2023-06-01"
+	limit, offset, cursor := 1, 1, "next"
+	minPriceCtrl := decimal.NewFromFloat(minPrice)
+
 	testCases := map[string]struct {
 		givenFilterName string // payload provided from end-user
 		givenFilterDate string // payload provided from end-user
+		givenCurrency   *model.Currency
+		givenFilter     *model.ProductFilterInput
+		givenSorting    *model.ProductSortingInput
+		givenPagination *model.ProductPaginationInput
 		mockProductCtrl mockProductCtrl
 		expErr          string
 		expOutput       []*model.Product
+		expTotalCount   int
+		expNextCursor   *string
 		expCall         bool
 	}{
 		"get all products successfully": {
@@ -242,8 +253,12 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 						CreatedAt:   myCreatedTime.String(),
 						UpdatedAt:   myUpdatedTime.String(),
 					},
-					CreatedAt: myCreatedTime.String(),
-					UpdatedAt: myUpdatedTime.String(),
+					Images:       []*model.ProductImage{},
+					Availability: []*model.WarehouseStock{},
+					Tags:         []string{},
+					Attributes:   []*model.ProductAttribute{},
+					CreatedAt:    myCreatedTime.String(),
+					UpdatedAt:    myUpdatedTime.String(),
 				},
 				{
 					ID:          195,
@@ -265,8 +280,12 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 						CreatedAt:   myCreatedTime.String(),
 						UpdatedAt:   myUpdatedTime.String(),
 					},
-					CreatedAt: myCreatedTime.String(),
-					UpdatedAt: myUpdatedTime.String(),
+					Images:       []*model.ProductImage{},
+					Availability: []*model.WarehouseStock{},
+					Tags:         []string{},
+					Attributes:   []*model.ProductAttribute{},
+					CreatedAt:    myCreatedTime.String(),
+					UpdatedAt:    myUpdatedTime.String(),
 				},
 			},
 		},
@@ -324,11 +343,91 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 						CreatedAt:   myCreatedTime.String(),
 						UpdatedAt:   myUpdatedTime.String(),
 					},
-					CreatedAt: myCreatedTime.String(),
-					UpdatedAt: myUpdatedTime.String(),
+					Images:       []*model.ProductImage{},
+					Availability: []*model.WarehouseStock{},
+					Tags:         []string{},
+					Attributes:   []*model.ProductAttribute{},
+					CreatedAt:    myCreatedTime.String(),
+					UpdatedAt:    myUpdatedTime.String(),
 				},
 			},
 		},
+		"get all products in USD successfully": {
+			expCall:       true,
+			givenCurrency: func() *model.Currency { c := model.CurrencyUsd; return &c }(),
+			mockProductCtrl: mockProductCtrl{
+				query: controllers.ProductCtrlFilter{
+					Currency: controllers.CurrencyUSD,
+				},
+				output: []controllers.ProductOutputGraph{
+					{
+						ID:          194,
+						Name:        "iPhone 14",
+						Description: "An Apple smartphone with A15 chip, 6GB RAM and 512GB storage",
+						Price:       decimal.RequireFromString("62.5"),
+						Quantity:    50,
+						Currency:    controllers.CurrencyUSD,
+						Author: controllers.UserOutput{
+							ID:        2,
+							Name:      "Thuy Nguyen",
+							Email:     "qthuy@gmail.com",
+							CreatedAt: myCreatedTime,
+							UpdatedAt: myUpdatedTime,
+						},
+						Category: controllers.PCateOutput{
+							ID:          90,
+							Name:        "Smartwatch",
+							Description: "A wearable computer",
+							CreatedAt:   myCreatedTime,
+							UpdatedAt:   myUpdatedTime,
+						},
+						CreatedAt: myCreatedTime,
+						UpdatedAt: myUpdatedTime,
+					},
+				},
+			},
+			expOutput: []*model.Product{
+				{
+					ID:          194,
+					Name:        "iPhone 14",
+					Description: "An Apple smartphone with A15 chip, 6GB RAM and 512GB storage",
+					Price:       62.5,
+					Quantity:    50,
+					Currency:    model.CurrencyUsd,
+					Author: &model.User{
+						ID:        2,
+						Name:      "Thuy Nguyen",
+						Email:     "qthuy@gmail.com",
+						CreatedAt: myCreatedTime.String(),
+						UpdatedAt: myUpdatedTime.String(),
+					},
+					Category: &model.ProductCategory{
+						ID:          90,
+						Name:        "Smartwatch",
+						Description: "A wearable computer",
+						CreatedAt:   myCreatedTime.String(),
+						UpdatedAt:   myUpdatedTime.String(),
+					},
+					Images:       []*model.ProductImage{},
+					Availability: []*model.WarehouseStock{},
+					Tags:         []string{},
+					Attributes:   []*model.ProductAttribute{},
+					CreatedAt:    myCreatedTime.String(),
+					UpdatedAt:    myUpdatedTime.String(),
+				},
+			},
+		},
+		"exchange rate not found": {
+			expCall:       true,
+			givenCurrency: func() *model.Currency { c := model.CurrencyUsd; return &c }(),
+			mockProductCtrl: mockProductCtrl{
+				query: controllers.ProductCtrlFilter{
+					Currency: controllers.CurrencyUSD,
+				},
+				err: controllers.ErrExchangeRateNotFound,
+			},
+			expErr: ErrExchangeRateNotFound.Error(),
+		},
 		"products not found with filter": {
 			expCall: true,
 			mockProductCtrl: mockProductCtrl{
@@ -337,6 +436,46 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 				},
 			},
 			givenFilterName: "imac",
+			expOutput:       []*model.Product{},
+		},
+		"get a page of products with filters and sorting": {
+			expCall: true,
+			mockProductCtrl: mockProductCtrl{
+				query: controllers.ProductCtrlFilter{
+					MinPrice:    &minPriceCtrl,
+					CategoryID:  2,
+					InStock:     true,
+					CreatedFrom: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+					SortBy:      controllers.ProductSortCreatedAt,
+					SortDesc:    true,
+					Limit:       1,
+					Cursor:      "next",
+				},
+				pageInfo: controllers.ProductPageInfo{TotalCount: 3, NextCursor: "cursor"},
+			},
+			givenFilter:     &model.ProductFilterInput{MinPrice: &minPrice, CategoryID: &categoryID, InStock: &inStock, CreatedFrom: &createdFrom},
+			givenSorting:    &model.ProductSortingInput{Field: model.ProductSortFieldCreatedAt, Desc: true},
+			givenPagination: &model.ProductPaginationInput{Limit: &limit, Cursor: &cursor},
+			expOutput:       []*model.Product{},
+			expTotalCount:   3,
+			expNextCursor:   func() *string { c := "cursor"; return &c }(),
+		},
+		"cursor with offset": {
+			expCall: true,
+			mockProductCtrl: mockProductCtrl{
+				query: controllers.ProductCtrlFilter{Offset: 1, Cursor: "next"},
+				err:   controllers.ErrCursorWithOffset,
+			},
+			givenPagination: &model.ProductPaginationInput{Offset: &offset, Cursor: &cursor},
+			expErr:          ErrCursorWithOffset.Error(),
+		},
+		"invalid limit": {
+			givenPagination: &model.ProductPaginationInput{Limit: func() *int { l := 0; return &l }()},
+			expErr:          ErrInvalidProductLimit.Error(),
+		},
+		"invalid category id": {
+			givenFilter: &model.ProductFilterInput{CategoryID: func() *int { id := -1; return &id }()},
+			expErr:      ErrInvalidCategoryID.Error(),
 		},
 		"bad date format filter": {
 			expCall:         false,
@@ -351,14 +490,17 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 			resolver := Resolver{Controller: mockController}
 
 			if tc.expCall {
-				mockController.On("GetProductsGraph", context.Background(), tc.mockProductCtrl.query).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.err)
+				mockController.On("GetProductsGraph", context.Background(), tc.mockProductCtrl.query).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.pageInfo, tc.mockProductCtrl.err)
 			}
-			result, err := resolver.Query().GetProducts(context.Background(), tc.givenFilterName, tc.givenFilterDate)
+			result, err := resolver.Query().GetProducts(context.Background(), tc.givenFilterName, tc.givenFilterDate, tc.givenCurrency, tc.givenFilter, tc.givenSorting, tc.givenPagination)
 
-			if err != nil {
+			if tc.expErr != "" {
 				assert.EqualError(t, err, tc.expErr)
 			} else {
-				assert.Equal(t, tc.expOutput, result)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, result.Products)
+				assert.Equal(t, tc.expTotalCount, result.TotalCount)
+				assert.Equal(t, tc.expNextCursor, result.NextCursor)
 			}
 
 			if tc.expCall {
@@ -367,3 +509,102 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 		})
 	}
 }
+
+func Test_ProductHandler_GetProduct(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		givenID   int
+		expCall   bool
+		output    controllers.ProductOutputGraph
+		err       error
+		expOutput *model.Product
+		expErr    string
+	}{
+		"get product successfully": {
+			givenID: 1,
+			expCall: true,
+			output: controllers.ProductOutputGraph{
+				ID:       1,
+				Name:     "iPhone 14",
+				Price:    decimal.NewFromInt(20000000),
+				Quantity: 5,
+				Currency: "VND",
+				Author:   controllers.UserOutput{ID: 2, Name: "qthuy", CreatedAt: createdAt, UpdatedAt: createdAt},
+				Category: controllers.PCateOutput{ID: 3, Name: "Smartphone", CreatedAt: createdAt, UpdatedAt: createdAt},
+				Images: []controllers.ProductImageOutput{
+					{
+						ID: 4, ProductID: 1, URL: "/uploads/products/1/abc.jpg", ContentType: "image/jpeg", Size: 2048, Width: 800, Height: 600,
+						Thumbnails: []controllers.ImageThumbnailOutput{{Size: "small", URL: "/uploads/products/1/abc_small.jpg"}},
+						CreatedAt:  createdAt,
+					},
+				},
+				CreatedAt:        createdAt,
+				UpdatedAt:        createdAt,
+				ReorderThreshold: 10,
+				Tags:             []string{"5g", "dual-sim"},
+				Attributes: []controllers.ProductAttributeOutput{
+					{AttributeID: 1, Name: "RAM", Type: "number", Unit: "GB", Value: "8"},
+				},
+				Availability: []controllers.WarehouseStockOutput{
+					{WarehouseID: 1, WarehouseName: "Main warehouse", City: "Ho Chi Minh", Country: "Vietnam", Quantity: 5},
+				},
+			},
+			expOutput: &model.Product{
+				ID:       1,
+				Name:     "iPhone 14",
+				Price:    20000000,
+				Quantity: 5,
+				Currency: model.CurrencyVnd,
+				Author:   &model.User{ID: 2, Name: "qthuy", CreatedAt: createdAt.String(), UpdatedAt: createdAt.String()},
+				Category: &model.ProductCategory{ID: 3, Name: "Smartphone", CreatedAt: createdAt.String(), UpdatedAt: createdAt.String()},
+				Images: []*model.ProductImage{
+					{
+						ID: 4, URL: "/uploads/products/1/abc.jpg", ContentType: "image/jpeg", Size: 2048, Width: 800, Height: 600,
+						Thumbnails: []*model.ImageThumbnail{{Size: "small", URL: "/uploads/products/1/abc_small.jpg"}},
+					},
+				},
+				CreatedAt:        createdAt.String(),
+				UpdatedAt:        createdAt.String(),
+				ReorderThreshold: 10,
+				Tags:             []string{"5g", "dual-sim"},
+				Attributes: []*model.ProductAttribute{
+					{Name: "RAM", Type: model.AttributeTypeNumber, Unit: "GB", Value: "8"},
+				},
+				Availability: []*model.WarehouseStock{
+					{WarehouseID: 1, WarehouseName: "Main warehouse", City: "Ho Chi Minh", Country: "Vietnam", Quantity: 5},
+				},
+			},
+		},
+		"product not found": {
+			givenID: 1,
+			expCall: true,
+			err:     controllers.ErrProductNotFound,
+			expErr:  ErrProductNotFound.Error(),
+		},
+		"invalid product id": {
+			givenID: 0,
+			expErr:  ErrInvalidProductID.Error(),
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			resolver := Resolver{Controller: mockController}
+
+			if tc.expCall {
+				mockController.On("GetProduct", context.Background(), tc.givenID, "").Return(tc.output, tc.err)
+			}
+			result, err := resolver.Query().GetProduct(context.Background(), tc.givenID, nil)
+
+			if tc.expErr != "" {
+				assert.EqualError(t, err, tc.expErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expOutput, result)
+			}
+			mockController.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// CreateReturnRequest is the resolver for the createReturnRequest field.
+func (r *mutationResolver) CreateReturnRequest(ctx context.Context, input model.ReturnRequestInput) (bool, error) {
+	returnRequest, err := validateAndConvertReturnRequest(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.Controller.CreateReturnRequest(ctx, returnRequest); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// UpdateReturnStatus is the resolver for the updateReturnStatus field.
+func (r *mutationResolver) UpdateReturnStatus(ctx context.Context, returnRequestID int, status model.ReturnStatus, note *string) (bool, error) {
+	if returnRequestID <= 0 {
+		return false, ErrInvalidReturnRequestID
+	}
+
+	if !status.IsValid() {
+		return false, ErrInvalidReturnStatus
+	}
+
+	var noteInput string
+	if note != nil {
+		noteInput = strings.TrimSpace(*note)
+	}
+
+	if err := r.Controller.UpdateReturnStatus(ctx, returnRequestID, status.String(), noteInput); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetReturnRequests is the resolver for the getReturnRequests field.
+func (r *queryResolver) GetReturnRequests(ctx context.Context, status *model.ReturnStatus, orderID *int) ([]*model.ReturnRequest, error) {
+	var filter controllers.ReturnRequestFilterCtrl
+	if status != nil {
+		if !status.IsValid() {
+			return nil, ErrInvalidReturnStatus
+		}
+		filter.Status = status.String()
+	}
+	if orderID != nil {
+		if *orderID <= 0 {
+			return nil, ErrInvalidOrderID
+		}
+		filter.OrderID = *orderID
+	}
+
+	returnRequests, err := r.Controller.GetReturnRequests(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	returnRequestsResp := make([]*model.ReturnRequest, 0, len(returnRequests))
+	for _, rr := range returnRequests {
+		returnRequest := &model.ReturnRequest{
+			ID:                 rr.ID,
+			OrderID:            rr.OrderID,
+			Status:             model.ReturnStatus(rr.Status),
+			Reason:             rr.Reason,
+			Note:               rr.Note,
+			ReplacementOrderID: rr.ReplacementOrderID,
+			CreatedAt:          rr.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt:          rr.UpdatedAt.Format("02-01-2006 15:04:05"),
+			Items:              make([]*model.ReturnItem, 0, len(rr.Items)),
+		}
+		if rr.RefundAmount != nil {
+			refundAmount := rr.RefundAmount.InexactFloat64()
+			returnRequest.RefundAmount = &refundAmount
+		}
+		if rr.ReceivedAt != nil {
+			receivedAt := rr.ReceivedAt.Format("02-01-2006 15:04:05")
+			returnRequest.ReceivedAt = &receivedAt
+		}
+		if rr.ResolvedAt != nil {
+			resolvedAt := rr.ResolvedAt.Format("02-01-2006 15:04:05")
+			returnRequest.ResolvedAt = &resolvedAt
+		}
+		for _, ri := range rr.Items {
+			returnRequest.Items = append(returnRequest.Items, &model.ReturnItem{
+				ID:          ri.ID,
+				OrderItemID: ri.OrderItemID,
+				Quantity:    ri.Quantity,
+			})
+		}
+		returnRequestsResp = append(returnRequestsResp, returnRequest)
+	}
+
+	return returnRequestsResp, nil
+}
+
+// validateAndConvertReturnRequest validates the return request from request and returns return request struct in controller layer
+func validateAndConvertReturnRequest(rReq model.ReturnRequestInput) (controllers.ReturnRequestInput, error) {
+	if rReq.OrderID <= 0 {
+		return controllers.ReturnRequestInput{}, ErrInvalidOrderID
+	}
+
+	if len(strings.TrimSpace(rReq.Reason)) == 0 {
+		return controllers.ReturnRequestInput{}, ErrMissingReturnReason
+	}
+
+	if len(rReq.Items) == 0 {
+		return controllers.ReturnRequestInput{}, ErrMissingReturnItems
+	}
+
+	returnRequest := controllers.ReturnRequestInput{
+		OrderID: rReq.OrderID,
+		Reason:  strings.TrimSpace(rReq.Reason),
+	}
+	for _, ri := range rReq.Items {
+		if ri.Quantity <= 0 {
+			return controllers.ReturnRequestInput{}, ErrInvalidReturnQuantity
+		}
+		returnRequest.Items = append(returnRequest.Items, controllers.ReturnItemInput{
+			OrderItemID: ri.OrderItemID,
+			Quantity:    ri.Quantity,
+		})
+	}
+
+	return returnRequest, nil
+}
@@ -0,0 +1,266 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// CreateAddress is the resolver for the createAddress field.
+func (r *mutationResolver) CreateAddress(ctx context.Context, input model.AddressRequest) (bool, error) {
+	address, err := validateAndConvertAddress(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.Controller.CreateAddress(ctx, address); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// DeleteAddress is the resolver for the deleteAddress field.
+func (r *mutationResolver) DeleteAddress(ctx context.Context, userID int, addressID int) (bool, error) {
+	if userID <= 0 {
+		return false, ErrInvalidUserID
+	}
+	if addressID <= 0 {
+		return false, ErrInvalidAddressID
+	}
+
+	if err := r.Controller.DeleteAddress(ctx, userID, addressID); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// CreateShippingMethod is the resolver for the createShippingMethod field.
+func (r *mutationResolver) CreateShippingMethod(ctx context.Context, input model.ShippingMethodRequest) (bool, error) {
+	shippingMethod, err := validateAndConvertShippingMethod(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.Controller.CreateShippingMethod(ctx, shippingMethod); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// CreateShipment is the resolver for the createShipment field.
+func (r *mutationResolver) CreateShipment(ctx context.Context, orderID int, input model.ShipmentRequest) (bool, error) {
+	if orderID <= 0 {
+		return false, ErrInvalidOrderID
+	}
+
+	if len(strings.TrimSpace(input.TrackingNumber)) == 0 {
+		return false, ErrMissingTrackingNumber
+	}
+
+	shipment := controllers.ShipmentInput{
+		TrackingNumber: strings.TrimSpace(input.TrackingNumber),
+	}
+	if input.Carrier != nil {
+		shipment.Carrier = strings.TrimSpace(*input.Carrier)
+	}
+
+	if err := r.Controller.CreateShipment(ctx, orderID, shipment); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// UpdateShipmentStatus is the resolver for the updateShipmentStatus field.
+func (r *mutationResolver) UpdateShipmentStatus(ctx context.Context, shipmentID int, status model.ShipmentStatus) (bool, error) {
+	if shipmentID <= 0 {
+		return false, ErrInvalidShipmentID
+	}
+
+	if err := r.Controller.UpdateShipmentStatus(ctx, shipmentID, status.String()); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetAddresses is the resolver for the getAddresses field.
+func (r *queryResolver) GetAddresses(ctx context.Context, userID int) ([]*model.Address, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidUserID
+	}
+
+	addresses, err := r.Controller.GetAddresses(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	addressesResp := make([]*model.Address, 0, len(addresses))
+	for _, a := range addresses {
+		addressesResp = append(addressesResp, &model.Address{
+			ID:            a.ID,
+			UserID:        a.UserID,
+			RecipientName: a.RecipientName,
+			Phone:         a.Phone,
+			Street:        a.Street,
+			City:          a.City,
+			Region:        a.Region,
+			PostalCode:    a.PostalCode,
+			Country:       a.Country,
+			IsDefault:     a.IsDefault,
+			CreatedAt:     a.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt:     a.UpdatedAt.Format("02-01-2006 15:04:05"),
+		})
+	}
+
+	return addressesResp, nil
+}
+
+// GetShippingMethods is the resolver for the getShippingMethods field.
+func (r *queryResolver) GetShippingMethods(ctx context.Context) ([]*model.ShippingMethod, error) {
+	shippingMethods, err := r.Controller.GetShippingMethods(ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	shippingMethodsResp := make([]*model.ShippingMethod, 0, len(shippingMethods))
+	for _, sm := range shippingMethods {
+		shippingMethodsResp = append(shippingMethodsResp, &model.ShippingMethod{
+			ID:        sm.ID,
+			Name:      sm.Name,
+			Carrier:   sm.Carrier,
+			RateType:  model.ShippingRateType(sm.RateType),
+			BaseRate:  sm.BaseRate.InexactFloat64(),
+			RatePerKg: sm.RatePerKG.InexactFloat64(),
+			CreatedAt: sm.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt: sm.UpdatedAt.Format("02-01-2006 15:04:05"),
+		})
+	}
+
+	return shippingMethodsResp, nil
+}
+
+// GetShipments is the resolver for the getShipments field.
+func (r *queryResolver) GetShipments(ctx context.Context, orderID int) ([]*model.Shipment, error) {
+	if orderID <= 0 {
+		return nil, ErrInvalidOrderID
+	}
+
+	shipments, err := r.Controller.GetShipments(ctx, orderID)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	shipmentsResp := make([]*model.Shipment, 0, len(shipments))
+	for _, s := range shipments {
+		shipment := &model.Shipment{
+			ID:             s.ID,
+			OrderID:        s.OrderID,
+			Carrier:        s.Carrier,
+			TrackingNumber: s.TrackingNumber,
+			Status:         model.ShipmentStatus(s.Status),
+			CreatedAt:      s.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt:      s.UpdatedAt.Format("02-01-2006 15:04:05"),
+		}
+		if s.ShippedAt != nil {
+			shippedAt := s.ShippedAt.Format("02-01-2006 15:04:05")
+			shipment.ShippedAt = &shippedAt
+		}
+		if s.DeliveredAt != nil {
+			deliveredAt := s.DeliveredAt.Format("02-01-2006 15:04:05")
+			shipment.DeliveredAt = &deliveredAt
+		}
+		shipmentsResp = append(shipmentsResp, shipment)
+	}
+
+	return shipmentsResp, nil
+}
+
+// validateAndConvertAddress validates the address from request and returns address struct in controller layer
+func validateAndConvertAddress(aReq model.AddressRequest) (controllers.AddressInput, error) {
+	if aReq.UserID <= 0 {
+		return controllers.AddressInput{}, ErrInvalidUserID
+	}
+
+	if len(strings.TrimSpace(aReq.RecipientName)) == 0 {
+		return controllers.AddressInput{}, ErrMissingName
+	}
+
+	if len(strings.TrimSpace(aReq.Phone)) == 0 {
+		return controllers.AddressInput{}, ErrMissingPhone
+	}
+
+	if len(strings.TrimSpace(aReq.Street)) == 0 || len(strings.TrimSpace(aReq.City)) == 0 || len(strings.TrimSpace(aReq.Country)) == 0 {
+		return controllers.AddressInput{}, ErrMissingAddress
+	}
+
+	address := controllers.AddressInput{
+		UserID:        aReq.UserID,
+		RecipientName: strings.TrimSpace(aReq.RecipientName),
+		Phone:         strings.TrimSpace(aReq.Phone),
+		Street:        strings.TrimSpace(aReq.Street),
+		City:          strings.TrimSpace(aReq.City),
+		Country:       strings.TrimSpace(aReq.Country),
+	}
+	if aReq.Region != nil {
+		address.Region = strings.TrimSpace(*aReq.Region)
+	}
+	if aReq.PostalCode != nil {
+		address.PostalCode = strings.TrimSpace(*aReq.PostalCode)
+	}
+	if aReq.IsDefault != nil {
+		address.IsDefault = *aReq.IsDefault
+	}
+
+	return address, nil
+}
+
+// validateAndConvertShippingMethod validates the shipping method from request and returns shipping method struct in controller layer
+func validateAndConvertShippingMethod(smReq model.ShippingMethodRequest) (controllers.ShippingMethodInput, error) {
+	if len(strings.TrimSpace(smReq.Name)) == 0 {
+		return controllers.ShippingMethodInput{}, ErrMissingName
+	}
+
+	if len(strings.TrimSpace(smReq.Name)) > 255 {
+		return controllers.ShippingMethodInput{}, ErrNameTooLong
+	}
+
+	if len(strings.TrimSpace(smReq.Carrier)) == 0 {
+		return controllers.ShippingMethodInput{}, ErrMissingCarrier
+	}
+
+	if smReq.BaseRate < 0 {
+		return controllers.ShippingMethodInput{}, ErrInvalidShippingRate
+	}
+
+	shippingMethod := controllers.ShippingMethodInput{
+		Name:     strings.TrimSpace(smReq.Name),
+		Carrier:  strings.TrimSpace(smReq.Carrier),
+		RateType: smReq.RateType.String(),
+		BaseRate: decimal.NewFromFloat(smReq.BaseRate),
+	}
+
+	if smReq.RatePerKg != nil {
+		if *smReq.RatePerKg < 0 {
+			return controllers.ShippingMethodInput{}, ErrInvalidShippingRate
+		}
+		shippingMethod.RatePerKG = decimal.NewFromFloat(*smReq.RatePerKg)
+	}
+
+	return shippingMethod, nil
+}
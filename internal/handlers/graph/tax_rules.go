@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// CreateTaxRule is the resolver for the createTaxRule field.
+func (r *mutationResolver) CreateTaxRule(ctx context.Context, input model.TaxRuleRequest) (bool, error) {
+	taxRule, err := validateAndConvertTaxRule(input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.Controller.CreateTaxRule(ctx, taxRule); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
+
+// GetTaxRules is the resolver for the getTaxRules field.
+func (r *queryResolver) GetTaxRules(ctx context.Context, categoryName *string, region *string) ([]*model.TaxRule, error) {
+	var categoryNameInput, regionInput string
+	if categoryName != nil {
+		categoryNameInput = strings.TrimSpace(*categoryName)
+	}
+	if region != nil {
+		regionInput = strings.TrimSpace(*region)
+	}
+
+	taxRules, err := r.Controller.GetTaxRules(ctx, categoryNameInput, regionInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	taxRulesResp := make([]*model.TaxRule, 0, len(taxRules))
+	for _, tr := range taxRules {
+		taxRule := &model.TaxRule{
+			ID:            tr.ID,
+			CategoryID:    tr.CategoryID,
+			Region:        tr.Region,
+			Rate:          tr.Rate.InexactFloat64(),
+			EffectiveFrom: tr.EffectiveFrom.Format("02-01-2006"),
+			CreatedAt:     tr.CreatedAt.Format("02-01-2006 15:04:05"),
+			UpdatedAt:     tr.UpdatedAt.Format("02-01-2006 15:04:05"),
+		}
+		if tr.EffectiveTo != nil {
+			effectiveTo := tr.EffectiveTo.Format("02-01-2006")
+			taxRule.EffectiveTo = &effectiveTo
+		}
+		taxRulesResp = append(taxRulesResp, taxRule)
+	}
+
+	return taxRulesResp, nil
+}
+
+// validateAndConvertTaxRule validates the tax rule from request and returns tax rule struct in controller layer
+func validateAndConvertTaxRule(trReq model.TaxRuleRequest) (controllers.TaxRuleInput, error) {
+	if len(strings.TrimSpace(trReq.CategoryName)) == 0 {
+		return controllers.TaxRuleInput{}, ErrMissingCategoryName
+	}
+
+	rate := decimal.NewFromFloat(trReq.Rate)
+	if rate.IsNegative() || rate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return controllers.TaxRuleInput{}, ErrInvalidTaxRate
+	}
+
+	effectiveFrom, err := time.Parse("02-01-2006", strings.TrimSpace(trReq.EffectiveFrom))
+	if err != nil {
+		return controllers.TaxRuleInput{}, ErrDateBadRequest
+	}
+
+	taxRule := controllers.TaxRuleInput{
+		CategoryName:  strings.TrimSpace(trReq.CategoryName),
+		Rate:          rate,
+		EffectiveFrom: effectiveFrom,
+	}
+
+	if trReq.Region != nil {
+		taxRule.Region = strings.TrimSpace(*trReq.Region)
+	}
+
+	if trReq.EffectiveTo != nil && strings.TrimSpace(*trReq.EffectiveTo) != "" {
+		effectiveTo, err := time.Parse("02-01-2006", strings.TrimSpace(*trReq.EffectiveTo))
+		if err != nil {
+			return controllers.TaxRuleInput{}, ErrDateBadRequest
+		}
+		taxRule.EffectiveTo = effectiveTo
+	}
+
+	return taxRule, nil
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// CreateWarehouse is the resolver for the createWarehouse field.
+func (r *mutationResolver) CreateWarehouse(ctx context.Context, input model.WarehouseRequest) (*model.Warehouse, error) {
+	wInput := controllers.WarehouseInput{Name: strings.TrimSpace(input.Name)}
+	if wInput.Name == "" {
+		return nil, ErrMissingWarehouseName
+	}
+	if len(wInput.Name) > 255 {
+		return nil, ErrNameTooLong
+	}
+	if input.City != nil {
+		wInput.City = strings.TrimSpace(*input.City)
+	}
+	if input.Region != nil {
+		wInput.Region = strings.TrimSpace(*input.Region)
+	}
+	if input.Country != nil {
+		wInput.Country = strings.TrimSpace(*input.Country)
+	}
+	if input.IsDefault != nil {
+		wInput.IsDefault = *input.IsDefault
+	}
+
+	warehouse, err := r.Controller.CreateWarehouse(ctx, wInput)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	return toWarehouseModel(warehouse), nil
+}
+
+// GetWarehouses is the resolver for the getWarehouses field.
+func (r *queryResolver) GetWarehouses(ctx context.Context) ([]*model.Warehouse, error) {
+	warehouses, err := r.Controller.GetWarehouses(ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	resp := make([]*model.Warehouse, 0, len(warehouses))
+	for _, w := range warehouses {
+		resp = append(resp, toWarehouseModel(w))
+	}
+
+	return resp, nil
+}
+
+// toWarehouseModel converts a warehouse in controller layer to the graph model
+func toWarehouseModel(w controllers.WarehouseOutput) *model.Warehouse {
+	return &model.Warehouse{
+		ID:        w.ID,
+		Name:      w.Name,
+		City:      w.City,
+		Region:    w.Region,
+		Country:   w.Country,
+		IsDefault: w.IsDefault,
+		CreatedAt: w.CreatedAt.String(),
+		UpdatedAt: w.UpdatedAt.String(),
+	}
+}
+
+// toWarehouseStockModels converts the stock of a product in the warehouses in controller layer to the graph model
+func toWarehouseStockModels(stocks []controllers.WarehouseStockOutput) []*model.WarehouseStock {
+	resp := []*model.WarehouseStock{}
+	for _, s := range stocks {
+		resp = append(resp, &model.WarehouseStock{
+			WarehouseID:   s.WarehouseID,
+			WarehouseName: s.WarehouseName,
+			City:          s.City,
+			Region:        s.Region,
+			Country:       s.Country,
+			Quantity:      s.Quantity,
+		})
+	}
+
+	return resp
+}
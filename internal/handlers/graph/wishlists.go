@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"log"
+
+	"github.com/qthuy2k1/product-management/internal/handlers/graph/model"
+)
+
+// AddToWishlist is the resolver for the addToWishlist field.
+func (r *mutationResolver) AddToWishlist(ctx context.Context, userID int, productID int) (bool, error) {
+	return r.changeWishlistItem(ctx, userID, productID, r.Controller.AddToWishlist)
+}
+
+// RemoveFromWishlist is the resolver for the removeFromWishlist field.
+func (r *mutationResolver) RemoveFromWishlist(ctx context.Context, userID int, productID int) (bool, error) {
+	return r.changeWishlistItem(ctx, userID, productID, r.Controller.RemoveFromWishlist)
+}
+
+// SubscribeBackInStock is the resolver for the subscribeBackInStock field.
+func (r *mutationResolver) SubscribeBackInStock(ctx context.Context, userID int, productID int) (bool, error) {
+	return r.changeWishlistItem(ctx, userID, productID, r.Controller.SubscribeBackInStock)
+}
+
+// UnsubscribeBackInStock is the resolver for the unsubscribeBackInStock field.
+func (r *mutationResolver) UnsubscribeBackInStock(ctx context.Context, userID int, productID int) (bool, error) {
+	return r.changeWishlistItem(ctx, userID, productID, r.Controller.UnsubscribeBackInStock)
+}
+
+// GetWishlist is the resolver for the getWishlist field.
+func (r *queryResolver) GetWishlist(ctx context.Context, userID int) ([]*model.WishlistItem, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidUserID
+	}
+
+	items, err := r.Controller.GetWishlist(ctx, userID)
+	if err != nil {
+		log.Println(err)
+		return nil, convertCtrlError(err)
+	}
+
+	wModels := make([]*model.WishlistItem, 0, len(items))
+	for _, i := range items {
+		wModels = append(wModels, &model.WishlistItem{
+			ID:                i.ID,
+			ProductID:         i.ProductID,
+			ProductName:       i.ProductName,
+			ProductSlug:       i.ProductSlug,
+			Price:             i.Price.InexactFloat64(),
+			Currency:          model.Currency(i.Currency),
+			InStock:           i.Quantity > 0,
+			NotifyBackInStock: i.NotifyBackInStock,
+			CreatedAt:         i.CreatedAt.String(),
+		})
+	}
+
+	return wModels, nil
+}
+
+// changeWishlistItem validates the user and product and calls the change of the wishlist item with them
+func (r *mutationResolver) changeWishlistItem(ctx context.Context, userID, productID int, change func(ctx context.Context, userID, productID int) error) (bool, error) {
+	if userID <= 0 {
+		return false, ErrInvalidUserID
+	}
+	if productID <= 0 {
+		return false, ErrInvalidProductID
+	}
+
+	if err := change(ctx, userID, productID); err != nil {
+		log.Println(err)
+		return false, convertCtrlError(err)
+	}
+
+	return true, nil
+}
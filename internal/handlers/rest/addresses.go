@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type addressRequest struct {
+	RecipientName string `json:"recipient_name"`
+	Phone         string `json:"phone"`
+	Street        string `json:"street"`
+	City          string `json:"city"`
+	Region        string `json:"region"`
+	PostalCode    string `json:"postal_code"`
+	Country       string `json:"country"`
+	IsDefault     bool   `json:"is_default"`
+}
+
+// CreateAddress gets the address data from body request, calls to CreateAddress controller and returns the status
+func (h *Handler) CreateAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	aReq := addressRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&aReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	address, errResp := validateAndConvertAddress(aReq)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+	address.UserID = userID
+
+	if err := h.Controller.CreateAddress(ctx, address); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+type AddressResponse struct {
+	ID            int       `json:"id"`
+	UserID        int       `json:"user_id"`
+	RecipientName string    `json:"recipient_name"`
+	Phone         string    `json:"phone"`
+	Street        string    `json:"street"`
+	City          string    `json:"city"`
+	Region        string    `json:"region"`
+	PostalCode    string    `json:"postal_code"`
+	Country       string    `json:"country"`
+	IsDefault     bool      `json:"is_default"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// GetAddresses retrieves the address book of a user
+func (h *Handler) GetAddresses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	addresses, err := h.Controller.GetAddresses(ctx, userID)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	var aResp []AddressResponse
+	for _, a := range addresses {
+		aResp = append(aResp, AddressResponse{
+			ID:            a.ID,
+			UserID:        a.UserID,
+			RecipientName: a.RecipientName,
+			Phone:         a.Phone,
+			Street:        a.Street,
+			City:          a.City,
+			Region:        a.Region,
+			PostalCode:    a.PostalCode,
+			Country:       a.Country,
+			IsDefault:     a.IsDefault,
+			CreatedAt:     a.CreatedAt,
+			UpdatedAt:     a.UpdatedAt,
+		})
+	}
+
+	utils.RenderJson(w, aResp, http.StatusOK)
+}
+
+// DeleteAddress removes an address from the address book of a user and returns the status
+func (h *Handler) DeleteAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	addressID, err := strconv.Atoi(chi.URLParam(r, "addressID"))
+	if err != nil || addressID <= 0 {
+		render.Render(w, r, ErrInvalidAddressID)
+		return
+	}
+
+	if err = h.Controller.DeleteAddress(ctx, userID, addressID); err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+// validateAndConvertAddress validates the address from body request and returns address struct in controller layer
+func validateAndConvertAddress(aReq addressRequest) (controllers.AddressInput, *ErrorResponse) {
+	if len(strings.TrimSpace(aReq.RecipientName)) == 0 {
+		return controllers.AddressInput{}, ErrMissingName
+	}
+
+	if len(strings.TrimSpace(aReq.Phone)) == 0 {
+		return controllers.AddressInput{}, ErrMissingPhone
+	}
+
+	if len(strings.TrimSpace(aReq.Street)) == 0 || len(strings.TrimSpace(aReq.City)) == 0 || len(strings.TrimSpace(aReq.Country)) == 0 {
+		return controllers.AddressInput{}, ErrMissingAddress
+	}
+
+	return controllers.AddressInput{
+		RecipientName: strings.TrimSpace(aReq.RecipientName),
+		Phone:         strings.TrimSpace(aReq.Phone),
+		Street:        strings.TrimSpace(aReq.Street),
+		City:          strings.TrimSpace(aReq.City),
+		Region:        strings.TrimSpace(aReq.Region),
+		PostalCode:    strings.TrimSpace(aReq.PostalCode),
+		Country:       strings.TrimSpace(aReq.Country),
+		IsDefault:     aReq.IsDefault,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test CreateAddress in Handler layer
+func Test_AddressHandler_CreateAddress(t *testing.T) {
+	type mockAddressCtrl struct {
+		expCall      bool
+		addressInput controllers.AddressInput
+		err          error
+	}
+	testCases := map[string]struct {
+		userID          int
+		givenInput      string
+		mockAddressCtrl mockAddressCtrl
+		expResp         string
+		expCode         int
+	}{
+		"create address successfully": {
+			userID:     1,
+			givenInput: `{"recipient_name":"Thuy Nguyen","phone":"0909123456","street":"1 Le Loi","city":"Ho Chi Minh","region":"HCM","country":"Vietnam","is_default":true}`,
+			mockAddressCtrl: mockAddressCtrl{
+				expCall: true,
+				addressInput: controllers.AddressInput{
+					UserID:        1,
+					RecipientName: "Thuy Nguyen",
+					Phone:         "0909123456",
+					Street:        "1 Le Loi",
+					City:          "Ho Chi Minh",
+					Region:        "HCM",
+					Country:       "Vietnam",
+					IsDefault:     true,
+				},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusCreated,
+		},
+		"create address with user not found": {
+			userID:     100,
+			givenInput: `{"recipient_name":"Thuy Nguyen","phone":"0909123456","street":"1 Le Loi","city":"Ho Chi Minh","country":"Vietnam"}`,
+			mockAddressCtrl: mockAddressCtrl{
+				expCall: true,
+				addressInput: controllers.AddressInput{
+					UserID:        100,
+					RecipientName: "Thuy Nguyen",
+					Phone:         "0909123456",
+					Street:        "1 Le Loi",
+					City:          "Ho Chi Minh",
+					Country:       "Vietnam",
+				},
+				err: controllers.ErrUserNotFound,
+			},
+			expResp: `{"message":"user not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"create address with invalid user id": {
+			userID:     -1,
+			givenInput: `{"recipient_name":"Thuy Nguyen","phone":"0909123456","street":"1 Le Loi","city":"Ho Chi Minh","country":"Vietnam"}`,
+			expResp:    `{"message":"invalid user ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create address with missing phone": {
+			userID:     1,
+			givenInput: `{"recipient_name":"Thuy Nguyen","street":"1 Le Loi","city":"Ho Chi Minh","country":"Vietnam"}`,
+			expResp:    `{"message":"phone cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create address with missing city": {
+			userID:     1,
+			givenInput: `{"recipient_name":"Thuy Nguyen","phone":"0909123456","street":"1 Le Loi","country":"Vietnam"}`,
+			expResp:    `{"message":"street, city and country cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create address with invalid JSON": {
+			userID:     1,
+			givenInput: `{"recipient_name":"Thuy Nguyen"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/users/addresses", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			// New route
+			rctx := chi.NewRouteContext()
+			// Add user id to url params
+			rctx.URLParams.Add("userID", strconv.Itoa(tc.userID))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockAddressCtrl.expCall {
+				mockController.On("CreateAddress", r.Context(), tc.mockAddressCtrl.addressInput).Return(tc.mockAddressCtrl.err)
+			}
+
+			handler.CreateAddress(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+
+			if tc.mockAddressCtrl.expCall {
+				mockController.AssertCalled(t, "CreateAddress", mock.Anything, tc.mockAddressCtrl.addressInput)
+			}
+		})
+	}
+}
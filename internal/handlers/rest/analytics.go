@@ -0,0 +1,176 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type RevenueResponse struct {
+	Period     string          `json:"period"`
+	Revenue    decimal.Decimal `json:"revenue"`
+	OrderCount int             `json:"order_count"`
+}
+
+// GetRevenue retrieves the revenue in VND and the number of paid orders of each period between the startDate and endDate query params,
+// the period query param is one of day, week or month and defaults to day
+func (h *Handler) GetRevenue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, errResp := parseAnalyticsFilter(r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	period := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("period")))
+	if period == "" {
+		period = controllers.AnalyticsPeriodDay
+	}
+
+	revenue, err := h.Controller.GetRevenueByPeriod(ctx, filter, period)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	rResp := make([]RevenueResponse, 0, len(revenue))
+	for _, rv := range revenue {
+		rResp = append(rResp, RevenueResponse{
+			Period:     rv.Period.Format("2006-01-02"),
+			Revenue:    rv.Revenue,
+			OrderCount: rv.OrderCount,
+		})
+	}
+
+	utils.RenderJson(w, rResp, http.StatusOK)
+}
+
+type TopSellerResponse struct {
+	ID      int             `json:"id"`
+	Name    string          `json:"name"`
+	Units   int             `json:"units"`
+	Revenue decimal.Decimal `json:"revenue"`
+}
+
+// GetTopProducts retrieves the best selling products between the startDate and endDate query params,
+// sorted by the sortBy query param which is revenue (default) or units
+func (h *Handler) GetTopProducts(w http.ResponseWriter, r *http.Request) {
+	h.getTopSellers(w, r, h.Controller.GetTopProducts)
+}
+
+// GetTopCategories retrieves the best selling product categories between the startDate and endDate query params,
+// sorted by the sortBy query param which is revenue (default) or units
+func (h *Handler) GetTopCategories(w http.ResponseWriter, r *http.Request) {
+	h.getTopSellers(w, r, h.Controller.GetTopCategories)
+}
+
+// getTopSellers parses the query params of the top sellers, calls to the given controller and renders the result
+func (h *Handler) getTopSellers(w http.ResponseWriter, r *http.Request, getTopSellers func(ctx context.Context, filter controllers.AnalyticsFilterCtrl, sortBy string, limit int) ([]controllers.TopSellerOutput, error)) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter, errResp := parseAnalyticsFilter(query)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	sortBy := strings.ToLower(strings.TrimSpace(query.Get("sortBy")))
+	if sortBy == "" {
+		sortBy = controllers.AnalyticsSortRevenue
+	}
+
+	var limit int
+	if l := strings.TrimSpace(query.Get("limit")); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			render.Render(w, r, ErrInvalidAnalyticsLimit)
+			return
+		}
+	}
+
+	topSellers, err := getTopSellers(ctx, filter, sortBy, limit)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	tsResp := make([]TopSellerResponse, 0, len(topSellers))
+	for _, ts := range topSellers {
+		tsResp = append(tsResp, TopSellerResponse{
+			ID:      ts.ID,
+			Name:    ts.Name,
+			Units:   ts.Units,
+			Revenue: ts.Revenue,
+		})
+	}
+
+	utils.RenderJson(w, tsResp, http.StatusOK)
+}
+
+type OrderValueResponse struct {
+	OrderCount        int             `json:"order_count"`
+	Revenue           decimal.Decimal `json:"revenue"`
+	AverageOrderValue decimal.Decimal `json:"average_order_value"`
+}
+
+// GetAverageOrderValue retrieves the number of paid orders, the revenue and the average order value in VND
+// between the startDate and endDate query params
+func (h *Handler) GetAverageOrderValue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, errResp := parseAnalyticsFilter(r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	summary, err := h.Controller.GetAverageOrderValue(ctx, filter)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, OrderValueResponse{
+		OrderCount:        summary.OrderCount,
+		Revenue:           summary.Revenue,
+		AverageOrderValue: summary.AverageOrderValue,
+	}, http.StatusOK)
+}
+
+// parseAnalyticsFilter parses the startDate and endDate query params as yyyy-mm-dd, both are required
+func parseAnalyticsFilter(query url.Values) (controllers.AnalyticsFilterCtrl, *ErrorResponse) {
+	startDate := strings.TrimSpace(query.Get("startDate"))
+	endDate := strings.TrimSpace(query.Get("endDate"))
+	if startDate == "" || endDate == "" {
+		return controllers.AnalyticsFilterCtrl{}, ErrMissingDateRange
+	}
+
+	startDateParsed, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return controllers.AnalyticsFilterCtrl{}, ErrDateBadRequest
+	}
+	endDateParsed, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return controllers.AnalyticsFilterCtrl{}, ErrDateBadRequest
+	}
+	if startDateParsed.After(endDateParsed) {
+		return controllers.AnalyticsFilterCtrl{}, ErrStartDateAfterEndDate
+	}
+
+	return controllers.AnalyticsFilterCtrl{
+		StartDate: startDateParsed,
+		EndDate:   endDateParsed,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test GetRevenue in Handler layer
+func Test_AnalyticsHandler_GetRevenue(t *testing.T) {
+	filter := controllers.AnalyticsFilterCtrl{
+		StartDate: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	type mockAnalyticsCtrl struct {
+		expCall bool
+		period  string
+		output  []controllers.RevenuePeriodOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenQuery        string
+		mockAnalyticsCtrl mockAnalyticsCtrl
+		expResp           string
+		expCode           int
+	}{
+		"get revenue by month": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31&period=MONTH",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				period:  controllers.AnalyticsPeriodMonth,
+				output: []controllers.RevenuePeriodOutput{
+					{Period: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), Revenue: decimal.NewFromInt(350000), OrderCount: 3},
+				},
+			},
+			expResp: `[{"period":"2023-07-01","revenue":"350000","order_count":3}]`,
+			expCode: http.StatusOK,
+		},
+		"get revenue by day by default": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				period:  controllers.AnalyticsPeriodDay,
+			},
+			expResp: `[]`,
+			expCode: http.StatusOK,
+		},
+		"get revenue by unknown period": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31&period=year",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				period:  "year",
+				err:     controllers.ErrInvalidAnalyticsPeriod,
+			},
+			expResp: `{"message":"invalid period, period must be day, week or month"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"get revenue without date range": {
+			givenQuery: "startDate=2023-07-01",
+			expResp:    `{"message":"start date and end date cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"get revenue with start date after end date": {
+			givenQuery: "startDate=2023-07-31&endDate=2023-07-01",
+			expResp:    `{"message":"start date must not be after the end date"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"get revenue with invalid date": {
+			givenQuery: "startDate=01-07-2023&endDate=2023-07-31",
+			expResp:    `{"message":"` + ErrDateBadRequest.Message + `"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/analytics/revenue?"+tc.givenQuery, nil)
+			w := httptest.NewRecorder()
+
+			if tc.mockAnalyticsCtrl.expCall {
+				mockController.On("GetRevenueByPeriod", context.Background(), filter, tc.mockAnalyticsCtrl.period).Return(tc.mockAnalyticsCtrl.output, tc.mockAnalyticsCtrl.err)
+			}
+
+			handler.GetRevenue(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
+
+// Test GetTopProducts in Handler layer
+func Test_AnalyticsHandler_GetTopProducts(t *testing.T) {
+	filter := controllers.AnalyticsFilterCtrl{
+		StartDate: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	type mockAnalyticsCtrl struct {
+		expCall bool
+		sortBy  string
+		limit   int
+		output  []controllers.TopSellerOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenQuery        string
+		mockAnalyticsCtrl mockAnalyticsCtrl
+		expResp           string
+		expCode           int
+	}{
+		"get top products by units": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31&sortBy=units&limit=5",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				sortBy:  controllers.AnalyticsSortUnits,
+				limit:   5,
+				output:  []controllers.TopSellerOutput{{ID: 1, Name: "Phone", Units: 5, Revenue: decimal.NewFromInt(500000)}},
+			},
+			expResp: `[{"id":1,"name":"Phone","units":5,"revenue":"500000"}]`,
+			expCode: http.StatusOK,
+		},
+		"get top products by revenue by default": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				sortBy:  controllers.AnalyticsSortRevenue,
+			},
+			expResp: `[]`,
+			expCode: http.StatusOK,
+		},
+		"get top products by unknown sort": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31&sortBy=profit",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				sortBy:  "profit",
+				err:     controllers.ErrInvalidAnalyticsSort,
+			},
+			expResp: `{"message":"invalid sort, sort must be revenue or units"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"get top products with invalid limit": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31&limit=abc",
+			expResp:    `{"message":"limit must be between 1 and 100"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"internal server error": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-07-31",
+			mockAnalyticsCtrl: mockAnalyticsCtrl{
+				expCall: true,
+				sortBy:  controllers.AnalyticsSortRevenue,
+				err:     errors.New("something went wrong"),
+			},
+			expResp: `{"message":"internal server error"}`,
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/analytics/top-products?"+tc.givenQuery, nil)
+			w := httptest.NewRecorder()
+
+			if tc.mockAnalyticsCtrl.expCall {
+				mockController.On("GetTopProducts", context.Background(), filter, tc.mockAnalyticsCtrl.sortBy, tc.mockAnalyticsCtrl.limit).Return(tc.mockAnalyticsCtrl.output, tc.mockAnalyticsCtrl.err)
+			}
+
+			handler.GetTopProducts(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
+
+// Test GetAverageOrderValue in Handler layer
+func Test_AnalyticsHandler_GetAverageOrderValue(t *testing.T) {
+	filter := controllers.AnalyticsFilterCtrl{
+		StartDate: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockController := &controllers.MockIController{}
+	handler := NewHandler(mockController)
+
+	r := httptest.NewRequest(http.MethodGet, "/analytics/average-order-value?startDate=2023-07-01&endDate=2023-07-31", nil)
+	w := httptest.NewRecorder()
+
+	mockController.On("GetAverageOrderValue", context.Background(), filter).
+		Return(controllers.OrderValueOutput{OrderCount: 4, Revenue: decimal.NewFromInt(400000), AverageOrderValue: decimal.NewFromInt(100000)}, nil)
+
+	handler.GetAverageOrderValue(w, r)
+
+	assert.Equal(t, `{"order_count":4,"revenue":"400000","average_order_value":"100000"}`, strings.TrimSpace(w.Body.String()))
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockController.AssertExpectations(t)
+}
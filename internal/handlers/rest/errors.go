@@ -14,29 +14,145 @@ type ErrorResponse struct {
 }
 
 var (
-	ErrInvalidProductID        = &ErrorResponse{StatusCode: 400, Message: "invalid product ID"}
-	ErrInvalidUserID           = &ErrorResponse{StatusCode: 400, Message: "invalid user ID"}
-	ErrMissingName             = &ErrorResponse{StatusCode: 400, Message: "name cannot be blank"}
-	ErrNameTooLong             = &ErrorResponse{StatusCode: 400, Message: "name too long"}
-	ErrMissingDesc             = &ErrorResponse{StatusCode: 400, Message: "description cannot be blank"}
-	ErrMissingCategoryName     = &ErrorResponse{StatusCode: 400, Message: "category cannot be blank"}
-	ErrInvalidPrice            = &ErrorResponse{StatusCode: 400, Message: "price must be greater than 0 and less than 15 digits"}
-	ErrInvalidQuantity         = &ErrorResponse{StatusCode: 400, Message: "quantity must be non-negative"}
-	ErrInvalidEmail            = &ErrorResponse{StatusCode: 400, Message: "invalid email"}
-	ErrInvalidAuthorID         = &ErrorResponse{StatusCode: 400, Message: "invalid author id"}
-	ErrInvalidPassword         = &ErrorResponse{StatusCode: 400, Message: "password must between 6 and 72 characters"}
-	ErrInvalidJson             = &ErrorResponse{StatusCode: 400, Message: "invalid json"}
-	ErrMethodNotAllowed        = &ErrorResponse{StatusCode: 405, Message: "method not allowed"}
-	ErrNotFound                = &ErrorResponse{StatusCode: 404, Message: "not found"}
-	ErrProductNotFound         = &ErrorResponse{StatusCode: 404, Message: "product not found"}
-	ErrProductCategoryNotFound = &ErrorResponse{StatusCode: 404, Message: "product category not found"}
-	ErrUserNotFound            = &ErrorResponse{StatusCode: 404, Message: "user not found"}
-	ErrDateBadRequest          = &ErrorResponse{StatusCode: 400, Message: "invalid date format, dates must follow the format yyyy-mm-dd"}
-	ErrInvalidCSVFileType      = &ErrorResponse{StatusCode: 400, Message: "file must be a csv"}
-	ErrNotEnoughColumns        = &ErrorResponse{StatusCode: 400, Message: "not enough columns"}
-	ErrIncorrectColumnNames    = &ErrorResponse{StatusCode: 400, Message: "incorrect column names, the file must have columns named: Name, Description, Price, Quantity, AuthorID, Category"}
-	ErrCSVFileFormat           = &ErrorResponse{StatusCode: 400, Message: "the file is not a valid CSV file"}
-	ErrProductListEmpty        = &ErrorResponse{StatusCode: 200, Message: "the list of products is empty"}
+	ErrInvalidProductID            = &ErrorResponse{StatusCode: 400, Message: "invalid product ID"}
+	ErrInvalidUserID               = &ErrorResponse{StatusCode: 400, Message: "invalid user ID"}
+	ErrMissingName                 = &ErrorResponse{StatusCode: 400, Message: "name cannot be blank"}
+	ErrNameTooLong                 = &ErrorResponse{StatusCode: 400, Message: "name too long"}
+	ErrMissingDesc                 = &ErrorResponse{StatusCode: 400, Message: "description cannot be blank"}
+	ErrMissingCategoryName         = &ErrorResponse{StatusCode: 400, Message: "category cannot be blank"}
+	ErrInvalidPrice                = &ErrorResponse{StatusCode: 400, Message: "price must be greater than 0 and less than 15 digits"}
+	ErrInvalidQuantity             = &ErrorResponse{StatusCode: 400, Message: "quantity must be non-negative"}
+	ErrInvalidEmail                = &ErrorResponse{StatusCode: 400, Message: "invalid email"}
+	ErrInvalidAuthorID             = &ErrorResponse{StatusCode: 400, Message: "invalid author id"}
+	ErrInvalidPassword             = &ErrorResponse{StatusCode: 400, Message: "password must between 6 and 72 characters"}
+	ErrInvalidJson                 = &ErrorResponse{StatusCode: 400, Message: "invalid json"}
+	ErrMethodNotAllowed            = &ErrorResponse{StatusCode: 405, Message: "method not allowed"}
+	ErrNotFound                    = &ErrorResponse{StatusCode: 404, Message: "not found"}
+	ErrProductNotFound             = &ErrorResponse{StatusCode: 404, Message: "product not found"}
+	ErrProductCategoryNotFound     = &ErrorResponse{StatusCode: 404, Message: "product category not found"}
+	ErrUserNotFound                = &ErrorResponse{StatusCode: 404, Message: "user not found"}
+	ErrDateBadRequest              = &ErrorResponse{StatusCode: 400, Message: "invalid date format, dates must follow the format yyyy-mm-dd"}
+	ErrInvalidCSVFileType          = &ErrorResponse{StatusCode: 400, Message: "file must be a csv"}
+	ErrNotEnoughColumns            = &ErrorResponse{StatusCode: 400, Message: "not enough columns"}
+	ErrIncorrectColumnNames        = &ErrorResponse{StatusCode: 400, Message: "incorrect column names, the file must have columns named: Name, Description, Price, Quantity, AuthorID, Category"}
+	ErrCSVFileFormat               = &ErrorResponse{StatusCode: 400, Message: "the file is not a valid CSV file"}
+	ErrProductListEmpty            = &ErrorResponse{StatusCode: 200, Message: "the list of products is empty"}
+	ErrInvalidTaxRate              = &ErrorResponse{StatusCode: 400, Message: "tax rate must be between 0 and 1"}
+	ErrInvalidEffectiveDate        = &ErrorResponse{StatusCode: 400, Message: "effective end date must be after the effective start date"}
+	ErrInvalidWeight               = &ErrorResponse{StatusCode: 400, Message: "weight must be non-negative"}
+	ErrInvalidAddressID            = &ErrorResponse{StatusCode: 400, Message: "invalid address ID"}
+	ErrMissingPhone                = &ErrorResponse{StatusCode: 400, Message: "phone cannot be blank"}
+	ErrMissingAddress              = &ErrorResponse{StatusCode: 400, Message: "street, city and country cannot be blank"}
+	ErrMissingCarrier              = &ErrorResponse{StatusCode: 400, Message: "carrier cannot be blank"}
+	ErrInvalidShippingRate         = &ErrorResponse{StatusCode: 400, Message: "shipping rate must be non-negative"}
+	ErrInvalidShippingRateType     = &ErrorResponse{StatusCode: 400, Message: "invalid shipping rate type, rate type must be FLAT or WEIGHT"}
+	ErrAddressNotFound             = &ErrorResponse{StatusCode: 404, Message: "address not found"}
+	ErrInvalidCurrency             = &ErrorResponse{StatusCode: 400, Message: "invalid currency, currency must be VND or USD"}
+	ErrInvalidExchangeRate         = &ErrorResponse{StatusCode: 400, Message: "exchange rate must be greater than 0"}
+	ErrIncorrectRateColumns        = &ErrorResponse{StatusCode: 400, Message: "incorrect column names, the file must have columns named: Currency, Rate and optionally EffectiveFrom"}
+	ErrExchangeRateNotFound        = &ErrorResponse{StatusCode: 404, Message: "exchange rate not found"}
+	ErrInvalidExportFormat         = &ErrorResponse{StatusCode: 400, Message: "invalid export format, format must be csv or xlsx"}
+	ErrInvalidOrderStatus          = &ErrorResponse{StatusCode: 400, Message: "invalid order status"}
+	ErrInvalidSortColumn           = &ErrorResponse{StatusCode: 400, Message: "invalid sort, sort must be a list of column[:asc|desc] of id, status, region, subtotal_price, total_price or created_at"}
+	ErrStartDateAfterEndDate       = &ErrorResponse{StatusCode: 400, Message: "start date must not be after the end date"}
+	ErrInvalidOrderID              = &ErrorResponse{StatusCode: 400, Message: "invalid order ID"}
+	ErrInvalidOrderItemID          = &ErrorResponse{StatusCode: 400, Message: "invalid order item ID"}
+	ErrInvalidReturnRequestID      = &ErrorResponse{StatusCode: 400, Message: "invalid return request ID"}
+	ErrMissingReturnReason         = &ErrorResponse{StatusCode: 400, Message: "return reason cannot be blank"}
+	ErrMissingReturnItems          = &ErrorResponse{StatusCode: 400, Message: "a return must have at least one item"}
+	ErrInvalidReturnQuantity       = &ErrorResponse{StatusCode: 400, Message: "return quantity must be greater than 0 and not more than the quantity ordered"}
+	ErrInvalidReturnStatus         = &ErrorResponse{StatusCode: 400, Message: "invalid return status"}
+	ErrOrderNotReturnable          = &ErrorResponse{StatusCode: 400, Message: "only a delivered order can be returned"}
+	ErrInsufficientQuantity        = &ErrorResponse{StatusCode: 400, Message: "insufficient quantity"}
+	ErrOrderNotFound               = &ErrorResponse{StatusCode: 404, Message: "order not found"}
+	ErrOrderItemNotFound           = &ErrorResponse{StatusCode: 404, Message: "order item not found"}
+	ErrReturnRequestNotFound       = &ErrorResponse{StatusCode: 404, Message: "return request not found"}
+	ErrMissingDateRange            = &ErrorResponse{StatusCode: 400, Message: "start date and end date cannot be blank"}
+	ErrInvalidAnalyticsPeriod      = &ErrorResponse{StatusCode: 400, Message: "invalid period, period must be day, week or month"}
+	ErrInvalidAnalyticsSort        = &ErrorResponse{StatusCode: 400, Message: "invalid sort, sort must be revenue or units"}
+	ErrInvalidAnalyticsLimit       = &ErrorResponse{StatusCode: 400, Message: "limit must be between 1 and 100"}
+	ErrInvalidProductSort          = &ErrorResponse{StatusCode: 400, Message: "invalid sort, sort must be one of name, price, quantity or created_at, optionally followed by :asc or :desc"}
+	ErrInvalidProductLimit         = &ErrorResponse{StatusCode: 400, Message: "limit must be between 1 and 100"}
+	ErrInvalidOffset               = &ErrorResponse{StatusCode: 400, Message: "offset must be non-negative"}
+	ErrInvalidCursor               = &ErrorResponse{StatusCode: 400, Message: "invalid cursor"}
+	ErrCursorWithOffset            = &ErrorResponse{StatusCode: 400, Message: "cursor cannot be used with offset"}
+	ErrInvalidPriceRange           = &ErrorResponse{StatusCode: 400, Message: "min price must not be greater than max price"}
+	ErrInvalidCategoryID           = &ErrorResponse{StatusCode: 400, Message: "invalid category id"}
+	ErrInvalidInStock              = &ErrorResponse{StatusCode: 400, Message: "inStock must be true or false"}
+	ErrInvalidPriceFilter          = &ErrorResponse{StatusCode: 400, Message: "minPrice and maxPrice must be non-negative numbers"}
+	ErrMissingSearchQuery          = &ErrorResponse{StatusCode: 400, Message: "search query cannot be blank"}
+	ErrSearchQueryTooLong          = &ErrorResponse{StatusCode: 400, Message: "search query too long"}
+	ErrInvalidVariantID            = &ErrorResponse{StatusCode: 400, Message: "invalid variant ID"}
+	ErrMissingSKU                  = &ErrorResponse{StatusCode: 400, Message: "sku cannot be blank"}
+	ErrSKUTooLong                  = &ErrorResponse{StatusCode: 400, Message: "sku must not be longer than 64 characters"}
+	ErrMissingVariantOptions       = &ErrorResponse{StatusCode: 400, Message: "a variant must have at least one option with a name and a value"}
+	ErrVariantOptionsMismatch      = &ErrorResponse{StatusCode: 400, Message: "the options of a variant must match the option types of the product"}
+	ErrVariantRequired             = &ErrorResponse{StatusCode: 400, Message: "the product has variants, a variant must be given"}
+	ErrVariantNotFound             = &ErrorResponse{StatusCode: 404, Message: "variant not found"}
+	ErrSKUExists                   = &ErrorResponse{StatusCode: 409, Message: "sku already exists"}
+	ErrDuplicateVariant            = &ErrorResponse{StatusCode: 409, Message: "a variant with the same options already exists"}
+	ErrInvalidImageID              = &ErrorResponse{StatusCode: 400, Message: "invalid image ID"}
+	ErrMissingImage                = &ErrorResponse{StatusCode: 400, Message: "the image must be uploaded in the image field of a multipart form"}
+	ErrInvalidImage                = &ErrorResponse{StatusCode: 400, Message: "invalid image"}
+	ErrImageDimensionsTooLarge     = &ErrorResponse{StatusCode: 400, Message: "image dimensions too large"}
+	ErrInvalidImageOrder           = &ErrorResponse{StatusCode: 400, Message: "the image ids must be all the images of the product, each given once"}
+	ErrProductImageNotFound        = &ErrorResponse{StatusCode: 404, Message: "product image not found"}
+	ErrImageTooLarge               = &ErrorResponse{StatusCode: 413, Message: "image must not be larger than 5MB"}
+	ErrUnsupportedImageType        = &ErrorResponse{StatusCode: 415, Message: "unsupported image type, image must be jpeg, png or gif"}
+	ErrMissingArchivedBefore       = &ErrorResponse{StatusCode: 400, Message: "the before date the products were archived before must be given"}
+	ErrProductArchived             = &ErrorResponse{StatusCode: 400, Message: "product is archived and cannot be ordered"}
+	ErrProductNotArchived          = &ErrorResponse{StatusCode: 409, Message: "product is not archived"}
+	ErrInvalidPriceID              = &ErrorResponse{StatusCode: 400, Message: "invalid price ID"}
+	ErrMissingEffectiveFrom        = &ErrorResponse{StatusCode: 400, Message: "effective from cannot be blank"}
+	ErrTimeBadRequest              = &ErrorResponse{StatusCode: 400, Message: "invalid time format, times must follow RFC 3339 such as 2023-07-01T00:00:00Z"}
+	ErrProductPriceNotFound        = &ErrorResponse{StatusCode: 404, Message: "product price not found"}
+	ErrPriceChangeNotInFuture      = &ErrorResponse{StatusCode: 400, Message: "a price change must be scheduled in the future"}
+	ErrPriceNotScheduled           = &ErrorResponse{StatusCode: 409, Message: "only a scheduled price which is not in effect yet can be cancelled"}
+	ErrInvalidStockDelta           = &ErrorResponse{StatusCode: 400, Message: "stock adjustment must not be 0"}
+	ErrInvalidReorderThreshold     = &ErrorResponse{StatusCode: 400, Message: "reorder threshold must not be negative"}
+	ErrInvalidWarehouseID          = &ErrorResponse{StatusCode: 400, Message: "invalid warehouse ID"}
+	ErrMissingWarehouseName        = &ErrorResponse{StatusCode: 400, Message: "warehouse name cannot be blank"}
+	ErrWarehouseNotFound           = &ErrorResponse{StatusCode: 404, Message: "warehouse not found"}
+	ErrWarehouseExists             = &ErrorResponse{StatusCode: 409, Message: "a warehouse with the same name already exists"}
+	ErrInvalidTransferQuantity     = &ErrorResponse{StatusCode: 400, Message: "transfer quantity must be greater than 0"}
+	ErrSameWarehouseTransfer       = &ErrorResponse{StatusCode: 400, Message: "stock cannot be transferred to the same warehouse"}
+	ErrVariantWarehouse            = &ErrorResponse{StatusCode: 400, Message: "the stock of a variant is not kept by warehouse"}
+	ErrMissingAttributeName        = &ErrorResponse{StatusCode: 400, Message: "attribute name cannot be blank"}
+	ErrAttributeUnitTooLong        = &ErrorResponse{StatusCode: 400, Message: "attribute unit must not be longer than 32 characters"}
+	ErrInvalidAttributeType        = &ErrorResponse{StatusCode: 400, Message: "invalid attribute type, type must be text, number or boolean"}
+	ErrAttributeExists             = &ErrorResponse{StatusCode: 409, Message: "an attribute with the same name already exists in the category"}
+	ErrUnknownAttribute            = &ErrorResponse{StatusCode: 400, Message: "the attribute is not defined for the category of the product"}
+	ErrInvalidAttributeValue       = &ErrorResponse{StatusCode: 400, Message: "invalid attribute value, the value must be of the type of the attribute"}
+	ErrInvalidAttributes           = &ErrorResponse{StatusCode: 400, Message: "invalid attributes, attributes must be written as Name=Value pairs separated by ;"}
+	ErrInvalidAttributeFilter      = &ErrorResponse{StatusCode: 400, Message: "invalid attribute filter, the min and max must be numbers and the min must not be greater than the max"}
+	ErrInvalidTag                  = &ErrorResponse{StatusCode: 400, Message: "invalid tag, a tag must not be longer than 64 characters or contain , or ;"}
+	ErrTooManyTags                 = &ErrorResponse{StatusCode: 400, Message: "a product must not have more than 20 tags"}
+	ErrMissingBulkSelection        = &ErrorResponse{StatusCode: 400, Message: "the products must be selected by ids or by the filters in the query params"}
+	ErrInvalidBulkSelection        = &ErrorResponse{StatusCode: 400, Message: "the products must be selected by either a list of positive IDs or a filter"}
+	ErrTooManyBulkProducts         = &ErrorResponse{StatusCode: 400, Message: "a bulk operation must not change more than 1000 products"}
+	ErrInvalidBulkPriceChange      = &ErrorResponse{StatusCode: 400, Message: "either a non-zero amount or a non-zero percent greater than -100 must be given"}
+	ErrInvalidBulkStockChange      = &ErrorResponse{StatusCode: 400, Message: "either a quantity of at least 0 or a non-zero delta must be given"}
+	ErrBulkRolledBack              = &ErrorResponse{StatusCode: 409, Message: "not changed, the bulk operation failed on another product"}
+	ErrInvalidReviewID             = &ErrorResponse{StatusCode: 400, Message: "invalid review ID"}
+	ErrProductReviewNotFound       = &ErrorResponse{StatusCode: 404, Message: "product review not found"}
+	ErrInvalidRating               = &ErrorResponse{StatusCode: 400, Message: "rating must be between 1 and 5"}
+	ErrMissingReviewBody           = &ErrorResponse{StatusCode: 400, Message: "review text cannot be blank"}
+	ErrReviewTooLong               = &ErrorResponse{StatusCode: 400, Message: "review title must not be longer than 255 characters and text not longer than 5000 characters"}
+	ErrReviewerNotVerified         = &ErrorResponse{StatusCode: 403, Message: "only a customer who bought the product can review it"}
+	ErrProductReviewExists         = &ErrorResponse{StatusCode: 409, Message: "the customer has already reviewed the product"}
+	ErrInvalidReviewStatus         = &ErrorResponse{StatusCode: 400, Message: "invalid review status"}
+	ErrInvalidReviewLimit          = &ErrorResponse{StatusCode: 400, Message: "limit must be between 1 and 100"}
+	ErrMissingIfMatch              = &ErrorResponse{StatusCode: 428, Message: "the If-Match header with the ETag of the product is required"}
+	ErrInvalidIfMatch              = &ErrorResponse{StatusCode: 400, Message: "the If-Match header must be the ETag of the product"}
+	ErrProductVersionConflict      = &ErrorResponse{StatusCode: 412, Message: "the product has been changed since it was read, reload it and try again"}
+	ErrNullAttributes              = &ErrorResponse{StatusCode: 400, Message: "attributes cannot be null, set the value of an attribute to null to remove it"}
+	ErrInvalidSKU                  = &ErrorResponse{StatusCode: 400, Message: "sku must only contain letters, digits, ., _ and -"}
+	ErrSKUImmutable                = &ErrorResponse{StatusCode: 400, Message: "the sku of a product cannot be changed"}
+	ErrMissingSlug                 = &ErrorResponse{StatusCode: 400, Message: "slug cannot be blank"}
+	ErrWishlistItemNotFound        = &ErrorResponse{StatusCode: 404, Message: "the product is not in the wishlist"}
+	ErrWishlistItemExists          = &ErrorResponse{StatusCode: 409, Message: "the product is already in the wishlist"}
+	ErrProductInStock              = &ErrorResponse{StatusCode: 409, Message: "the product is in stock, only an out of stock product can be subscribed to"}
+	ErrInvalidRelatedProductsLimit = &ErrorResponse{StatusCode: 400, Message: "limit must be between 1 and 20"}
 )
 
 func (e *ErrorResponse) Render(w http.ResponseWriter, r *http.Request) error {
@@ -67,6 +183,178 @@ func convertCtrlError(err error) *ErrorResponse {
 		return ErrIncorrectColumnNames
 	case controllers.ErrCSVFileFormat:
 		return ErrCSVFileFormat
+	case controllers.ErrInvalidEffectiveDate:
+		return ErrInvalidEffectiveDate
+	case controllers.ErrAddressNotFound:
+		return ErrAddressNotFound
+	case controllers.ErrInvalidShippingRateType:
+		return ErrInvalidShippingRateType
+	case controllers.ErrInvalidCurrency:
+		return ErrInvalidCurrency
+	case controllers.ErrInvalidExchangeRate:
+		return ErrInvalidExchangeRate
+	case controllers.ErrInvalidEffectiveFromDate:
+		return ErrDateBadRequest
+	case controllers.ErrIncorrectExchangeRateColumns:
+		return ErrIncorrectRateColumns
+	case controllers.ErrExchangeRateNotFound:
+		return ErrExchangeRateNotFound
+	case controllers.ErrInvalidExportFormat:
+		return ErrInvalidExportFormat
+	case controllers.ErrOrderNotFound:
+		return ErrOrderNotFound
+	case controllers.ErrOrderItemNotFound:
+		return ErrOrderItemNotFound
+	case controllers.ErrInsufficientQuantity:
+		return ErrInsufficientQuantity
+	case controllers.ErrReturnRequestNotFound:
+		return ErrReturnRequestNotFound
+	case controllers.ErrOrderNotReturnable:
+		return ErrOrderNotReturnable
+	case controllers.ErrMissingReturnReason:
+		return ErrMissingReturnReason
+	case controllers.ErrMissingReturnItems:
+		return ErrMissingReturnItems
+	case controllers.ErrInvalidReturnQuantity:
+		return ErrInvalidReturnQuantity
+	case controllers.ErrInvalidReturnStatus:
+		return ErrInvalidReturnStatus
+	case controllers.ErrMissingDateRange:
+		return ErrMissingDateRange
+	case controllers.ErrStartDateAfterEndDate:
+		return ErrStartDateAfterEndDate
+	case controllers.ErrInvalidAnalyticsPeriod:
+		return ErrInvalidAnalyticsPeriod
+	case controllers.ErrInvalidAnalyticsSort:
+		return ErrInvalidAnalyticsSort
+	case controllers.ErrInvalidAnalyticsLimit:
+		return ErrInvalidAnalyticsLimit
+	case controllers.ErrInvalidProductSort:
+		return ErrInvalidProductSort
+	case controllers.ErrInvalidProductLimit:
+		return ErrInvalidProductLimit
+	case controllers.ErrInvalidOffset:
+		return ErrInvalidOffset
+	case controllers.ErrInvalidCursor:
+		return ErrInvalidCursor
+	case controllers.ErrCursorWithOffset:
+		return ErrCursorWithOffset
+	case controllers.ErrInvalidPriceRange:
+		return ErrInvalidPriceRange
+	case controllers.ErrMissingSearchQuery:
+		return ErrMissingSearchQuery
+	case controllers.ErrSearchQueryTooLong:
+		return ErrSearchQueryTooLong
+	case controllers.ErrMissingSKU:
+		return ErrMissingSKU
+	case controllers.ErrSKUTooLong:
+		return ErrSKUTooLong
+	case controllers.ErrMissingVariantOptions:
+		return ErrMissingVariantOptions
+	case controllers.ErrVariantOptionsMismatch:
+		return ErrVariantOptionsMismatch
+	case controllers.ErrVariantRequired:
+		return ErrVariantRequired
+	case controllers.ErrVariantNotFound:
+		return ErrVariantNotFound
+	case controllers.ErrSKUExists:
+		return ErrSKUExists
+	case controllers.ErrInvalidSKU:
+		return ErrInvalidSKU
+	case controllers.ErrSKUImmutable:
+		return ErrSKUImmutable
+	case controllers.ErrInvalidRelatedProductsLimit:
+		return ErrInvalidRelatedProductsLimit
+	case controllers.ErrWishlistItemNotFound:
+		return ErrWishlistItemNotFound
+	case controllers.ErrWishlistItemExists:
+		return ErrWishlistItemExists
+	case controllers.ErrProductInStock:
+		return ErrProductInStock
+	case controllers.ErrDuplicateVariant:
+		return ErrDuplicateVariant
+	case controllers.ErrProductImageNotFound:
+		return ErrProductImageNotFound
+	case controllers.ErrImageTooLarge:
+		return ErrImageTooLarge
+	case controllers.ErrUnsupportedImageType:
+		return ErrUnsupportedImageType
+	case controllers.ErrInvalidImage:
+		return ErrInvalidImage
+	case controllers.ErrImageDimensionsTooLarge:
+		return ErrImageDimensionsTooLarge
+	case controllers.ErrInvalidImageOrder:
+		return ErrInvalidImageOrder
+	case controllers.ErrMissingArchivedBefore:
+		return ErrMissingArchivedBefore
+	case controllers.ErrProductArchived:
+		return ErrProductArchived
+	case controllers.ErrProductNotArchived:
+		return ErrProductNotArchived
+	case controllers.ErrProductPriceNotFound:
+		return ErrProductPriceNotFound
+	case controllers.ErrPriceChangeNotInFuture:
+		return ErrPriceChangeNotInFuture
+	case controllers.ErrPriceNotScheduled:
+		return ErrPriceNotScheduled
+	case controllers.ErrInvalidStockDelta:
+		return ErrInvalidStockDelta
+	case controllers.ErrInvalidReorderThreshold:
+		return ErrInvalidReorderThreshold
+	case controllers.ErrWarehouseNotFound:
+		return ErrWarehouseNotFound
+	case controllers.ErrWarehouseExists:
+		return ErrWarehouseExists
+	case controllers.ErrInvalidTransferQuantity:
+		return ErrInvalidTransferQuantity
+	case controllers.ErrSameWarehouseTransfer:
+		return ErrSameWarehouseTransfer
+	case controllers.ErrVariantWarehouse:
+		return ErrVariantWarehouse
+	case controllers.ErrInvalidAttributeType:
+		return ErrInvalidAttributeType
+	case controllers.ErrAttributeExists:
+		return ErrAttributeExists
+	case controllers.ErrUnknownAttribute:
+		return ErrUnknownAttribute
+	case controllers.ErrInvalidAttributeValue:
+		return ErrInvalidAttributeValue
+	case controllers.ErrInvalidAttributes:
+		return ErrInvalidAttributes
+	case controllers.ErrInvalidAttributeFilter:
+		return ErrInvalidAttributeFilter
+	case controllers.ErrInvalidTag:
+		return ErrInvalidTag
+	case controllers.ErrTooManyTags:
+		return ErrTooManyTags
+	case controllers.ErrInvalidBulkSelection:
+		return ErrInvalidBulkSelection
+	case controllers.ErrTooManyBulkProducts:
+		return ErrTooManyBulkProducts
+	case controllers.ErrInvalidBulkPriceChange:
+		return ErrInvalidBulkPriceChange
+	case controllers.ErrInvalidBulkStockChange:
+		return ErrInvalidBulkStockChange
+	case controllers.ErrInvalidPrice:
+		return ErrInvalidPrice
+	case controllers.ErrProductReviewNotFound:
+		return ErrProductReviewNotFound
+	case controllers.ErrInvalidRating:
+		return ErrInvalidRating
+	case controllers.ErrMissingReviewBody:
+		return ErrMissingReviewBody
+	case controllers.ErrReviewTooLong:
+		return ErrReviewTooLong
+	case controllers.ErrReviewerNotVerified:
+		return ErrReviewerNotVerified
+	case controllers.ErrProductReviewExists:
+		return ErrProductReviewExists
+	case controllers.ErrInvalidReviewStatus:
+		return ErrInvalidReviewStatus
+	case controllers.ErrInvalidReviewLimit:
+		return ErrInvalidReviewLimit
+	case controllers.ErrProductVersionConflict:
+		return ErrProductVersionConflict
 	default:
 		log.Println(err)
 		return ServerErrorRenderer()
@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type exchangeRateRequest struct {
+	Currency      string          `json:"currency"`
+	Rate          decimal.Decimal `json:"rate"`
+	EffectiveFrom string          `json:"effective_from"`
+}
+
+// CreateExchangeRate gets the exchange rate data from body request, calls to CreateExchangeRate controller and returns the status
+func (h *Handler) CreateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	erReq := exchangeRateRequest{}
+	ctx := r.Context()
+	if err := json.NewDecoder(r.Body).Decode(&erReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	exchangeRate, errResp := validateAndConvertExchangeRate(erReq)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if err := h.Controller.CreateExchangeRate(ctx, exchangeRate); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+// ImportExchangeRatesFromCSV imports list of exchange rates from a CSV file
+func (h *Handler) ImportExchangeRatesFromCSV(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// get the file that was selected from form file input
+	file, fileHeader, err := r.FormFile("exchange_rates")
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+	defer file.Close()
+
+	// check if file is not a csv file
+	if filepath.Ext(fileHeader.Filename) != ".csv" {
+		render.Render(w, r, ErrInvalidCSVFileType)
+		return
+	}
+
+	if err = h.Controller.ImportExchangeRatesFromCSV(ctx, file); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+type ExchangeRateResponse struct {
+	ID            int             `json:"id"`
+	Currency      string          `json:"currency"`
+	Rate          decimal.Decimal `json:"rate"`
+	EffectiveFrom time.Time       `json:"effective_from"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// GetExchangeRates retrieves the exchange rates in db, filtered by the currency query param if given
+func (h *Handler) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		render.Render(w, r, ErrInvalidCurrency)
+		return
+	}
+
+	exchangeRates, err := h.Controller.GetExchangeRates(ctx, currency)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	var erResp []ExchangeRateResponse
+	for _, er := range exchangeRates {
+		erResp = append(erResp, ExchangeRateResponse{
+			ID:            er.ID,
+			Currency:      er.Currency,
+			Rate:          er.Rate,
+			EffectiveFrom: er.EffectiveFrom,
+			CreatedAt:     er.CreatedAt,
+			UpdatedAt:     er.UpdatedAt,
+		})
+	}
+
+	utils.RenderJson(w, erResp, http.StatusOK)
+}
+
+// validateAndConvertExchangeRate validates the exchange rate from body request and returns exchange rate struct in controller layer
+func validateAndConvertExchangeRate(erReq exchangeRateRequest) (controllers.ExchangeRateInput, *ErrorResponse) {
+	currency := strings.ToUpper(strings.TrimSpace(erReq.Currency))
+	if !controllers.IsSupportedCurrency(currency) || currency == controllers.CurrencyVND {
+		return controllers.ExchangeRateInput{}, ErrInvalidCurrency
+	}
+
+	if !erReq.Rate.IsPositive() {
+		return controllers.ExchangeRateInput{}, ErrInvalidExchangeRate
+	}
+
+	exchangeRate := controllers.ExchangeRateInput{
+		Currency: currency,
+		Rate:     erReq.Rate,
+	}
+
+	if strings.TrimSpace(erReq.EffectiveFrom) != "" {
+		effectiveFrom, err := time.Parse("2006-01-02", strings.TrimSpace(erReq.EffectiveFrom))
+		if err != nil {
+			return controllers.ExchangeRateInput{}, ErrDateBadRequest
+		}
+		exchangeRate.EffectiveFrom = effectiveFrom
+	}
+
+	return exchangeRate, nil
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateExchangeRate in Handler layer
+func Test_ExchangeRateHandler_CreateExchangeRate(t *testing.T) {
+	type mockExchangeRateCtrl struct {
+		expCall bool
+		erInput controllers.ExchangeRateInput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput           string
+		mockExchangeRateCtrl mockExchangeRateCtrl
+		expResp              string
+		expCode              int
+	}{
+		"create exchange rate successfully": {
+			givenInput: `{"currency":"usd","rate":"24000","effective_from":"2023-06-01"}`,
+			mockExchangeRateCtrl: mockExchangeRateCtrl{
+				expCall: true,
+				erInput: controllers.ExchangeRateInput{
+					Currency:      controllers.CurrencyUSD,
+					Rate:          decimal.RequireFromString("24000"),
+					EffectiveFrom: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusCreated,
+		},
+		"create exchange rate of the base currency": {
+			givenInput: `{"currency":"VND","rate":"1"}`,
+			expResp:    `{"message":"invalid currency, currency must be VND or USD"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create exchange rate with zero rate": {
+			givenInput: `{"currency":"USD","rate":"0"}`,
+			expResp:    `{"message":"exchange rate must be greater than 0"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create exchange rate with invalid effective date": {
+			givenInput: `{"currency":"USD","rate":"24000","effective_from":"01-06-2023"}`,
+			expResp:    `{"message":"invalid date format, dates must follow the format yyyy-mm-dd"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create exchange rate with invalid JSON": {
+			givenInput: `{"currency":"USD"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/exchange-rates", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockExchangeRateCtrl.expCall {
+				mockController.On("CreateExchangeRate", context.Background(), tc.mockExchangeRateCtrl.erInput).Return(tc.mockExchangeRateCtrl.err)
+			}
+
+			handler.CreateExchangeRate(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+
+			if tc.mockExchangeRateCtrl.expCall {
+				mockController.AssertCalled(t, "CreateExchangeRate", context.Background(), tc.mockExchangeRateCtrl.erInput)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type JobRunResponse struct {
+	Name       string    `json:"name"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Success    bool      `json:"success"`
+	Result     string    `json:"result"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// GetJobRuns retrieves the status of the last run of each background job
+func (h *Handler) GetJobRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	jobRuns, err := h.Controller.GetJobRuns(ctx)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	jrResp := make([]JobRunResponse, 0, len(jobRuns))
+	for _, jr := range jobRuns {
+		jrResp = append(jrResp, JobRunResponse{
+			Name:       jr.Name,
+			StartedAt:  jr.StartedAt,
+			FinishedAt: jr.FinishedAt,
+			Success:    jr.Success,
+			Result:     jr.Result,
+			Error:      jr.Error,
+		})
+	}
+
+	utils.RenderJson(w, jrResp, http.StatusOK)
+}
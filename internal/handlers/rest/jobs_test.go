@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test GetJobRuns in Handler layer
+func Test_JobHandler_GetJobRuns(t *testing.T) {
+	startedAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		output  []controllers.JobRunOutput
+		expResp string
+	}{
+		"get job runs": {
+			output: []controllers.JobRunOutput{
+				{Name: controllers.JobCancelStaleOrders, StartedAt: startedAt, FinishedAt: startedAt.Add(time.Second), Success: true, Result: "2 orders cancelled"},
+			},
+			expResp: `[{"name":"cancel-stale-orders","started_at":"2023-07-01T00:00:00Z","finished_at":"2023-07-01T00:00:01Z","success":true,"result":"2 orders cancelled"}]`,
+		},
+		"no job has run yet": {
+			expResp: `[]`,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/admin/jobs", nil)
+			w := httptest.NewRecorder()
+
+			mockController.On("GetJobRuns", context.Background()).Return(tc.output, nil)
+
+			handler.GetJobRuns(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, http.StatusOK, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// orderSortColumns are the columns of orders the export can be sorted by
+var orderSortColumns = map[string]bool{
+	"id":             true,
+	"status":         true,
+	"region":         true,
+	"subtotal_price": true,
+	"total_price":    true,
+	"created_at":     true,
+}
+
+// ExportOrders exports the orders matching the filter to a csv or xlsx file, the file is streamed
+// in the response or sent by email if a list of emails is given
+func (h *Handler) ExportOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	oCtrlFilter, format, emails, errResp := validateAndConvertOrderExportQuery(r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	reader, err := h.Controller.ExportOrders(ctx, oCtrlFilter, format)
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	if len(emails) != 0 {
+		if err := h.Controller.SendEmailOrders(emails, reader, format); err != nil {
+			log.Println(err)
+			render.Render(w, r, convertCtrlError(err))
+			return
+		}
+	} else {
+		// Set HTTP headers
+		contentType := "text/csv"
+		if format == controllers.ExportFormatXLSX {
+			contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="export_orders.%s"`, format))
+
+		// copy data from pipe reader to HTTP response writer
+		if _, err := io.Copy(w, reader); err != nil {
+			log.Println(err)
+			render.Render(w, r, convertCtrlError(err))
+			return
+		}
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+// validateAndConvertOrderExportQuery validates the query of the order export and returns the order filter
+// in controller layer, the file format and the list of emails to send the file to.
+// The dates follow the format yyyy-mm-dd and the sort is a list of column[:desc] separated by commas
+func validateAndConvertOrderExportQuery(query url.Values) (controllers.OrderFilterCtrl, string, []string, *ErrorResponse) {
+	var filter controllers.OrderFilterCtrl
+
+	format := strings.ToLower(strings.TrimSpace(query.Get("format")))
+	if format == "" {
+		format = controllers.ExportFormatCSV
+	}
+	if format != controllers.ExportFormatCSV && format != controllers.ExportFormatXLSX {
+		return controllers.OrderFilterCtrl{}, "", nil, ErrInvalidExportFormat
+	}
+
+	startDate := strings.TrimSpace(query.Get("startDate"))
+	endDate := strings.TrimSpace(query.Get("endDate"))
+	if startDate != "" || endDate != "" {
+		startDateParsed, err := time.Parse("2006-01-02", startDate)
+		if err != nil {
+			return controllers.OrderFilterCtrl{}, "", nil, ErrDateBadRequest
+		}
+		endDateParsed, err := time.Parse("2006-01-02", endDate)
+		if err != nil {
+			return controllers.OrderFilterCtrl{}, "", nil, ErrDateBadRequest
+		}
+		if startDateParsed.After(endDateParsed) {
+			return controllers.OrderFilterCtrl{}, "", nil, ErrStartDateAfterEndDate
+		}
+
+		// the repository layer expects the dates as dd-mm-yyyy
+		filter.FilterDate = controllers.FilterDate{
+			StartDate: startDateParsed.Format("02-01-2006"),
+			EndDate:   endDateParsed.Format("02-01-2006"),
+		}
+	}
+
+	status := strings.ToUpper(strings.TrimSpace(query.Get("status")))
+	if status != "" {
+		if !controllers.IsValidOrderStatus(status) {
+			return controllers.OrderFilterCtrl{}, "", nil, ErrInvalidOrderStatus
+		}
+		filter.Status = status
+	}
+
+	sort := strings.TrimSpace(query.Get("sort"))
+	if sort != "" {
+		for _, s := range strings.Split(sort, ",") {
+			column, order, _ := strings.Cut(strings.TrimSpace(s), ":")
+			if !orderSortColumns[column] || (order != "" && order != "asc" && order != "desc") {
+				return controllers.OrderFilterCtrl{}, "", nil, ErrInvalidSortColumn
+			}
+			filter.Sorting = append(filter.Sorting, controllers.Sorting{
+				ColumnName: column,
+				Desc:       order == "desc",
+			})
+		}
+	}
+
+	var emailToList []string
+	emails := strings.TrimSpace(query.Get("emails"))
+	if emails != "" {
+		for _, e := range strings.Split(emails, ",") {
+			e = strings.TrimSpace(e)
+			// skip the email if not valid
+			if !isValidEmail(e) {
+				log.Println("email invalid:", e)
+				continue
+			}
+			emailToList = append(emailToList, e)
+		}
+
+		if len(emailToList) == 0 {
+			return controllers.OrderFilterCtrl{}, "", nil, ErrInvalidEmail
+		}
+	}
+
+	return filter, format, emailToList, nil
+}
@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test ExportOrders in Handler layer
+func Test_OrderHandler_ExportOrders(t *testing.T) {
+	type mockOrderCtrl struct {
+		expCall bool
+		filter  controllers.OrderFilterCtrl
+		format  string
+		output  string
+		err     error
+	}
+	testCases := map[string]struct {
+		givenQuery     string
+		mockOrderCtrl  mockOrderCtrl
+		expResp        string
+		expCode        int
+		expContentType string
+	}{
+		"export orders to csv successfully": {
+			givenQuery: "startDate=2023-06-01&endDate=2023-06-30&status=paid&sort=created_at:desc,id",
+			mockOrderCtrl: mockOrderCtrl{
+				expCall: true,
+				filter: controllers.OrderFilterCtrl{
+					FilterDate: controllers.FilterDate{StartDate: "01-06-2023", EndDate: "30-06-2023"},
+					Status:     controllers.OrderStatusPaid,
+					Sorting: []controllers.Sorting{
+						{ColumnName: "created_at", Desc: true},
+						{ColumnName: "id"},
+					},
+				},
+				format: controllers.ExportFormatCSV,
+				output: "OrderID,CreatedAt\n1,2023-06-02 10:00:00",
+			},
+			expResp:        "OrderID,CreatedAt\n1,2023-06-02 10:00:00",
+			expCode:        http.StatusOK,
+			expContentType: "text/csv",
+		},
+		"export orders to xlsx successfully": {
+			givenQuery: "format=xlsx",
+			mockOrderCtrl: mockOrderCtrl{
+				expCall: true,
+				format:  controllers.ExportFormatXLSX,
+				output:  "xlsx",
+			},
+			expResp:        "xlsx",
+			expCode:        http.StatusOK,
+			expContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		},
+		"export orders with invalid format": {
+			givenQuery: "format=pdf",
+			expResp:    `{"message":"invalid export format, format must be csv or xlsx"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"export orders with invalid date": {
+			givenQuery: "startDate=01-06-2023&endDate=2023-06-30",
+			expResp:    `{"message":"invalid date format, dates must follow the format yyyy-mm-dd"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"export orders with start date after end date": {
+			givenQuery: "startDate=2023-07-01&endDate=2023-06-30",
+			expResp:    `{"message":"start date must not be after the end date"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"export orders with invalid status": {
+			givenQuery: "status=LOST",
+			expResp:    `{"message":"invalid order status"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"export orders with invalid sort column": {
+			givenQuery: "sort=password",
+			expResp:    `{"message":"invalid sort, sort must be a list of column[:asc|desc] of id, status, region, subtotal_price, total_price or created_at"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"export orders with invalid emails": {
+			givenQuery: "emails=abc,def",
+			expResp:    `{"message":"invalid email"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/orders/export?"+tc.givenQuery, nil)
+			w := httptest.NewRecorder()
+
+			if tc.mockOrderCtrl.expCall {
+				mockController.On("ExportOrders", context.Background(), tc.mockOrderCtrl.filter, tc.mockOrderCtrl.format).Return(strings.NewReader(tc.mockOrderCtrl.output), tc.mockOrderCtrl.err)
+			}
+
+			handler.ExportOrders(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			if tc.expContentType != "" {
+				assert.Equal(t, tc.expContentType, w.Header().Get("Content-Type"))
+			}
+			mockController.AssertExpectations(t)
+		})
+	}
+}
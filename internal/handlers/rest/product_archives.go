@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// RestoreProduct restores the archived product in url param and returns the status
+func (h *Handler) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || id <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	if err = h.Controller.RestoreProduct(ctx, id); err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+// GetArchivedProducts retrieves a page of the archived products matching the filter query params, as GetProducts
+func (h *Handler) GetArchivedProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	pCtrlFilter, errResp := validateAndConvertProductFilter(query)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if errResp := validateAndConvertProductPage(query, &pCtrlFilter); errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+	pCtrlFilter.Archived = true
+
+	products, pageInfo, err := h.Controller.GetProducts(ctx, pCtrlFilter)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.FormatInt(pageInfo.TotalCount, 10))
+	if pageInfo.NextCursor != "" {
+		w.Header().Set("X-Next-Cursor", pageInfo.NextCursor)
+	}
+
+	pResp := []ProductResponse{}
+	for _, p := range products {
+		pResp = append(pResp, toProductResponse(p))
+	}
+
+	utils.RenderJson(w, pResp, http.StatusOK)
+}
+
+type purgeProductsResponse struct {
+	Purged int `json:"purged"`
+}
+
+// PurgeArchivedProducts deletes the products archived before the date in the before query param, the products which
+// have been ordered are kept. It returns the number of deleted products
+func (h *Handler) PurgeArchivedProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	before := strings.TrimSpace(r.URL.Query().Get("before"))
+	if before == "" {
+		render.Render(w, r, ErrMissingArchivedBefore)
+		return
+	}
+	archivedBefore, err := time.Parse("2006-01-02", before)
+	if err != nil {
+		render.Render(w, r, ErrDateBadRequest)
+		return
+	}
+
+	purged, err := h.Controller.PurgeArchivedProducts(ctx, archivedBefore)
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, purgeProductsResponse{Purged: purged}, http.StatusOK)
+}
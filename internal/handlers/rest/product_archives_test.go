@@ -0,0 +1,181 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test RestoreProduct in Handler layer
+func Test_ProductHandler_RestoreProduct(t *testing.T) {
+	testCases := map[string]struct {
+		productID string
+		expCall   bool
+		err       error
+		expResp   string
+		expCode   int
+	}{
+		"restore product successfully": {
+			productID: "1",
+			expCall:   true,
+			expResp:   `{"success":true}`,
+			expCode:   http.StatusOK,
+		},
+		"restore product not archived": {
+			productID: "1",
+			expCall:   true,
+			err:       controllers.ErrProductNotArchived,
+			expResp:   `{"message":"product is not archived"}`,
+			expCode:   http.StatusConflict,
+		},
+		"restore product not found": {
+			productID: "100",
+			expCall:   true,
+			err:       controllers.ErrProductNotFound,
+			expResp:   `{"message":"product not found"}`,
+			expCode:   http.StatusNotFound,
+		},
+		"restore product with invalid product id": {
+			productID: "abc",
+			expResp:   `{"message":"invalid product ID"}`,
+			expCode:   http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/restore", nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", tc.productID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.expCall {
+				mockController.On("RestoreProduct", r.Context(), mock.AnythingOfType("int")).Return(tc.err)
+			}
+
+			handler.RestoreProduct(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test GetArchivedProducts in Handler layer
+func Test_ProductHandler_GetArchivedProducts(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	archivedAt := time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		query   string
+		expCall bool
+		filter  controllers.ProductCtrlFilter
+		output  []controllers.ProductOutput
+		expResp string
+		expCode int
+	}{
+		"get archived products successfully": {
+			query:   "?queryName=iphone",
+			expCall: true,
+			filter:  controllers.ProductCtrlFilter{Name: "iphone", Archived: true},
+			output: []controllers.ProductOutput{
+				{ID: 1, Name: "iPhone 14", Description: "Apple phone", Price: decimal.NewFromInt(20000000), Quantity: 5, AuthorID: 2, CategoryName: "Smartphone", Currency: "VND", CreatedAt: createdAt, UpdatedAt: createdAt, ArchivedAt: &archivedAt},
+			},
+			expResp: `[{"id":1,"name":"iPhone 14","description":"Apple phone","price":"20000000","quantity":5,"author_id":2,"category":"Smartphone","currency":"VND","created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z","archived_at":"2023-07-02T00:00:00Z","rating_average":"0","review_count":0}]`,
+			expCode: http.StatusOK,
+		},
+		"no archived product": {
+			expCall: true,
+			filter:  controllers.ProductCtrlFilter{Archived: true},
+			expResp: `[]`,
+			expCode: http.StatusOK,
+		},
+		"get archived products with invalid date": {
+			query:   "?date=01-07-2023",
+			expResp: `{"message":"invalid date format, dates must follow the format yyyy-mm-dd"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/admin/products/archived"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			if tc.expCall {
+				mockController.On("GetProducts", r.Context(), tc.filter).Return(tc.output, controllers.ProductPageInfo{TotalCount: int64(len(tc.output))}, nil)
+			}
+
+			handler.GetArchivedProducts(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test PurgeArchivedProducts in Handler layer
+func Test_ProductHandler_PurgeArchivedProducts(t *testing.T) {
+	testCases := map[string]struct {
+		query   string
+		expCall bool
+		before  time.Time
+		purged  int
+		expResp string
+		expCode int
+	}{
+		"purge archived products successfully": {
+			query:   "?before=2023-07-01",
+			expCall: true,
+			before:  time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			purged:  3,
+			expResp: `{"purged":3}`,
+			expCode: http.StatusOK,
+		},
+		"purge archived products without before": {
+			expResp: `{"message":"the before date the products were archived before must be given"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"purge archived products with invalid before": {
+			query:   "?before=01-07-2023",
+			expResp: `{"message":"invalid date format, dates must follow the format yyyy-mm-dd"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodDelete, "/admin/products/archived"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			if tc.expCall {
+				mockController.On("PurgeArchivedProducts", r.Context(), tc.before).Return(tc.purged, nil)
+			}
+
+			handler.PurgeArchivedProducts(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type attributeDefinitionRequest struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Unit string `json:"unit"`
+}
+
+type AttributeDefinitionResponse struct {
+	ID         int       `json:"id"`
+	CategoryID int       `json:"category_id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Unit       string    `json:"unit,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// toAttributeDefinitionResponse converts an attribute definition to the response
+func toAttributeDefinitionResponse(d controllers.AttributeDefinitionOutput) AttributeDefinitionResponse {
+	return AttributeDefinitionResponse{
+		ID:         d.ID,
+		CategoryID: d.CategoryID,
+		Name:       d.Name,
+		Type:       d.Type,
+		Unit:       d.Unit,
+		CreatedAt:  d.CreatedAt,
+		UpdatedAt:  d.UpdatedAt,
+	}
+}
+
+// CreateAttributeDefinition gets the attribute definition of the category in url param from body request, calls to
+// CreateAttributeDefinition controller and returns the created attribute definition
+func (h *Handler) CreateAttributeDefinition(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	categoryID, err := strconv.Atoi(chi.URLParam(r, "categoryID"))
+	if err != nil || categoryID <= 0 {
+		render.Render(w, r, ErrInvalidCategoryID)
+		return
+	}
+
+	adReq := attributeDefinitionRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&adReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	adReq.Name = strings.TrimSpace(adReq.Name)
+	if adReq.Name == "" {
+		render.Render(w, r, ErrMissingAttributeName)
+		return
+	}
+	if len(adReq.Name) > 255 {
+		render.Render(w, r, ErrNameTooLong)
+		return
+	}
+
+	adReq.Type = strings.ToLower(strings.TrimSpace(adReq.Type))
+	if !controllers.IsValidAttributeType(adReq.Type) {
+		render.Render(w, r, ErrInvalidAttributeType)
+		return
+	}
+
+	adReq.Unit = strings.TrimSpace(adReq.Unit)
+	if len(adReq.Unit) > 32 {
+		render.Render(w, r, ErrAttributeUnitTooLong)
+		return
+	}
+
+	definition, err := h.Controller.CreateAttributeDefinition(ctx, controllers.AttributeDefinitionInput{
+		CategoryID: categoryID,
+		Name:       adReq.Name,
+		Type:       adReq.Type,
+		Unit:       adReq.Unit,
+	})
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toAttributeDefinitionResponse(definition), http.StatusCreated)
+}
+
+// GetAttributeDefinitions retrieves the attributes defined for the products of the category in url param
+func (h *Handler) GetAttributeDefinitions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	categoryID, err := strconv.Atoi(chi.URLParam(r, "categoryID"))
+	if err != nil || categoryID <= 0 {
+		render.Render(w, r, ErrInvalidCategoryID)
+		return
+	}
+
+	definitions, err := h.Controller.GetAttributeDefinitions(ctx, categoryID)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := []AttributeDefinitionResponse{}
+	for _, d := range definitions {
+		resp = append(resp, toAttributeDefinitionResponse(d))
+	}
+
+	utils.RenderJson(w, resp, http.StatusOK)
+}
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateAttributeDefinition in Handler layer
+func Test_ProductAttributeHandler_CreateAttributeDefinition(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockAttributeCtrl struct {
+		expCall bool
+		input   controllers.AttributeDefinitionInput
+		output  controllers.AttributeDefinitionOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenCategoryID   string
+		givenInput        string
+		mockAttributeCtrl mockAttributeCtrl
+		expResp           string
+		expCode           int
+	}{
+		"create attribute definition successfully": {
+			givenCategoryID: "3",
+			givenInput:      `{"name":" RAM ","type":"Number","unit":"GB"}`,
+			mockAttributeCtrl: mockAttributeCtrl{
+				expCall: true,
+				input:   controllers.AttributeDefinitionInput{CategoryID: 3, Name: "RAM", Type: "number", Unit: "GB"},
+				output:  controllers.AttributeDefinitionOutput{ID: 1, CategoryID: 3, Name: "RAM", Type: "number", Unit: "GB", CreatedAt: createdAt, UpdatedAt: createdAt},
+			},
+			expResp: `{"id":1,"category_id":3,"name":"RAM","type":"number","unit":"GB","created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusCreated,
+		},
+		"name already exists": {
+			givenCategoryID: "3",
+			givenInput:      `{"name":"RAM","type":"number"}`,
+			mockAttributeCtrl: mockAttributeCtrl{
+				expCall: true,
+				input:   controllers.AttributeDefinitionInput{CategoryID: 3, Name: "RAM", Type: "number"},
+				err:     controllers.ErrAttributeExists,
+			},
+			expResp: `{"message":"an attribute with the same name already exists in the category"}`,
+			expCode: http.StatusConflict,
+		},
+		"category not found": {
+			givenCategoryID: "9",
+			givenInput:      `{"name":"RAM","type":"number"}`,
+			mockAttributeCtrl: mockAttributeCtrl{
+				expCall: true,
+				input:   controllers.AttributeDefinitionInput{CategoryID: 9, Name: "RAM", Type: "number"},
+				err:     controllers.ErrProductCategoryNotFound,
+			},
+			expResp: `{"message":"product category not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"invalid type": {
+			givenCategoryID: "3",
+			givenInput:      `{"name":"RAM","type":"date"}`,
+			expResp:         `{"message":"invalid attribute type, type must be text, number or boolean"}`,
+			expCode:         http.StatusBadRequest,
+		},
+		"missing name": {
+			givenCategoryID: "3",
+			givenInput:      `{"name":" ","type":"text"}`,
+			expResp:         `{"message":"attribute name cannot be blank"}`,
+			expCode:         http.StatusBadRequest,
+		},
+		"invalid category id": {
+			givenCategoryID: "abc",
+			givenInput:      `{"name":"RAM","type":"number"}`,
+			expResp:         `{"message":"invalid category id"}`,
+			expCode:         http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/product-categories/attributes", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("categoryID", tc.givenCategoryID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockAttributeCtrl.expCall {
+				mockController.On("CreateAttributeDefinition", r.Context(), tc.mockAttributeCtrl.input).Return(tc.mockAttributeCtrl.output, tc.mockAttributeCtrl.err)
+			}
+
+			handler.CreateAttributeDefinition(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// bulkSelectionRequest selects the products of a bulk operation by ID. The products can be selected by the filters
+// of GetProducts in the query params instead
+type bulkSelectionRequest struct {
+	IDs []int `json:"ids"`
+	// Transactional changes all the products or none of them, otherwise each product is changed on its own
+	Transactional bool `json:"transactional"`
+}
+
+type bulkPriceRequest struct {
+	bulkSelectionRequest
+	Amount  *decimal.Decimal `json:"amount"`
+	Percent *decimal.Decimal `json:"percent"`
+}
+
+type bulkCategoryRequest struct {
+	bulkSelectionRequest
+	CategoryID int `json:"category_id"`
+}
+
+type bulkStockRequest struct {
+	bulkSelectionRequest
+	Quantity *int `json:"quantity"`
+	Delta    *int `json:"delta"`
+	ActorID  int  `json:"actor_id"`
+}
+
+type BulkItemResponse struct {
+	ProductID int    `json:"product_id"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+type BulkReportResponse struct {
+	Operation     string             `json:"operation"`
+	Transactional bool               `json:"transactional"`
+	Succeeded     int                `json:"succeeded"`
+	Failed        int                `json:"failed"`
+	Items         []BulkItemResponse `json:"items"`
+}
+
+// toBulkReportResponse converts the report of a bulk operation to the response, the error of a product is the message
+// of its error response
+func toBulkReportResponse(report controllers.BulkReport) BulkReportResponse {
+	resp := BulkReportResponse{
+		Operation:     report.Operation,
+		Transactional: report.Transactional,
+		Succeeded:     report.Succeeded,
+		Failed:        report.Failed,
+		Items:         []BulkItemResponse{},
+	}
+	for _, item := range report.Items {
+		itemResp := BulkItemResponse{ProductID: item.ProductID, Success: item.Err == nil}
+		if item.Err != nil {
+			itemResp.Error = convertBulkItemError(item.Err).Message
+		}
+		resp.Items = append(resp.Items, itemResp)
+	}
+
+	return resp
+}
+
+// convertBulkItemError returns the error response of the error of a product in a bulk operation
+func convertBulkItemError(err error) *ErrorResponse {
+	if err == controllers.ErrBulkRolledBack {
+		return ErrBulkRolledBack
+	}
+	return convertCtrlError(err)
+}
+
+// validateAndConvertBulkSelection selects the products by the IDs of the request or by the filters in the query params,
+// only one of them can be given
+func validateAndConvertBulkSelection(sReq bulkSelectionRequest, query url.Values) (controllers.BulkSelection, *ErrorResponse) {
+	selection := controllers.BulkSelection{Transactional: sReq.Transactional}
+
+	if len(sReq.IDs) == 0 {
+		if len(query) == 0 {
+			return controllers.BulkSelection{}, ErrMissingBulkSelection
+		}
+
+		filter, errResp := validateAndConvertProductFilter(query)
+		if errResp != nil {
+			return controllers.BulkSelection{}, errResp
+		}
+		selection.Filter = &filter
+		return selection, nil
+	}
+
+	if len(query) != 0 {
+		return controllers.BulkSelection{}, ErrInvalidBulkSelection
+	}
+	for _, id := range sReq.IDs {
+		if id <= 0 {
+			return controllers.BulkSelection{}, ErrInvalidBulkSelection
+		}
+	}
+	selection.IDs = sReq.IDs
+
+	return selection, nil
+}
+
+// renderBulkReport renders the report of a bulk operation or its error
+func renderBulkReport(w http.ResponseWriter, r *http.Request, report controllers.BulkReport, err error) {
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toBulkReportResponse(report), http.StatusOK)
+}
+
+// BulkChangePrice gets the price change from body request and the selected products, calls to BulkChangePrice controller
+// and returns the report of each product
+func (h *Handler) BulkChangePrice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bpReq := bulkPriceRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&bpReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	selection, errResp := validateAndConvertBulkSelection(bpReq.bulkSelectionRequest, r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if (bpReq.Amount == nil) == (bpReq.Percent == nil) {
+		render.Render(w, r, ErrInvalidBulkPriceChange)
+		return
+	}
+
+	report, err := h.Controller.BulkChangePrice(ctx, controllers.BulkPriceInput{
+		BulkSelection: selection,
+		Amount:        bpReq.Amount,
+		Percent:       bpReq.Percent,
+	})
+	renderBulkReport(w, r, report, err)
+}
+
+// BulkChangeCategory gets the category from body request and the selected products, calls to BulkChangeCategory
+// controller and returns the report of each product
+func (h *Handler) BulkChangeCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bcReq := bulkCategoryRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&bcReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	selection, errResp := validateAndConvertBulkSelection(bcReq.bulkSelectionRequest, r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if bcReq.CategoryID <= 0 {
+		render.Render(w, r, ErrInvalidCategoryID)
+		return
+	}
+
+	report, err := h.Controller.BulkChangeCategory(ctx, controllers.BulkCategoryInput{
+		BulkSelection: selection,
+		CategoryID:    bcReq.CategoryID,
+	})
+	renderBulkReport(w, r, report, err)
+}
+
+// BulkUpdateStock gets the new quantity or the delta from body request and the selected products, calls to BulkUpdateStock
+// controller and returns the report of each product
+func (h *Handler) BulkUpdateStock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bsReq := bulkStockRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&bsReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	selection, errResp := validateAndConvertBulkSelection(bsReq.bulkSelectionRequest, r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if (bsReq.Quantity == nil) == (bsReq.Delta == nil) {
+		render.Render(w, r, ErrInvalidBulkStockChange)
+		return
+	}
+	if bsReq.ActorID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	report, err := h.Controller.BulkUpdateStock(ctx, controllers.BulkStockInput{
+		BulkSelection: selection,
+		Quantity:      bsReq.Quantity,
+		Delta:         bsReq.Delta,
+		ActorID:       bsReq.ActorID,
+	})
+	renderBulkReport(w, r, report, err)
+}
+
+// BulkArchiveProducts gets the selected products, calls to BulkArchiveProducts controller and returns the report of each product
+func (h *Handler) BulkArchiveProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	sReq := bulkSelectionRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&sReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	selection, errResp := validateAndConvertBulkSelection(sReq, r.URL.Query())
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	report, err := h.Controller.BulkArchiveProducts(ctx, selection)
+	renderBulkReport(w, r, report, err)
+}
@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test BulkChangePrice in Handler layer
+func Test_ProductBulkHandler_BulkChangePrice(t *testing.T) {
+	percent := decimal.NewFromInt(-10)
+
+	type mockBulkCtrl struct {
+		expCall bool
+		input   controllers.BulkPriceInput
+		output  controllers.BulkReport
+		err     error
+	}
+	testCases := map[string]struct {
+		givenQuery   string
+		givenInput   string
+		mockBulkCtrl mockBulkCtrl
+		expResp      string
+		expCode      int
+	}{
+		"change prices of products by id successfully": {
+			givenInput: `{"ids":[1,2],"percent":-10}`,
+			mockBulkCtrl: mockBulkCtrl{
+				expCall: true,
+				input:   controllers.BulkPriceInput{BulkSelection: controllers.BulkSelection{IDs: []int{1, 2}}, Percent: &percent},
+				output: controllers.BulkReport{
+					Operation: controllers.BulkOperationPrice,
+					Succeeded: 1,
+					Failed:    1,
+					Items:     []controllers.BulkItemResult{{ProductID: 1}, {ProductID: 2, Err: controllers.ErrProductNotFound}},
+				},
+			},
+			expResp: `{"operation":"price","transactional":false,"succeeded":1,"failed":1,"items":[{"product_id":1,"success":true},{"product_id":2,"success":false,"error":"product not found"}]}`,
+			expCode: http.StatusOK,
+		},
+		"change prices of products matching the filter in a transaction": {
+			givenQuery: "?categoryID=3",
+			givenInput: `{"transactional":true,"percent":-10}`,
+			mockBulkCtrl: mockBulkCtrl{
+				expCall: true,
+				input:   controllers.BulkPriceInput{BulkSelection: controllers.BulkSelection{Filter: &controllers.ProductCtrlFilter{CategoryID: 3}, Transactional: true}, Percent: &percent},
+				output: controllers.BulkReport{
+					Operation:     controllers.BulkOperationPrice,
+					Transactional: true,
+					Failed:        2,
+					Items:         []controllers.BulkItemResult{{ProductID: 1, Err: controllers.ErrBulkRolledBack}, {ProductID: 2, Err: controllers.ErrInvalidPrice}},
+				},
+			},
+			expResp: `{"operation":"price","transactional":true,"succeeded":0,"failed":2,"items":[{"product_id":1,"success":false,"error":"not changed, the bulk operation failed on another product"},{"product_id":2,"success":false,"error":"price must be greater than 0 and less than 15 digits"}]}`,
+			expCode: http.StatusOK,
+		},
+		"too many products": {
+			givenQuery: "?inStock=true",
+			givenInput: `{"percent":-10}`,
+			mockBulkCtrl: mockBulkCtrl{
+				expCall: true,
+				input:   controllers.BulkPriceInput{BulkSelection: controllers.BulkSelection{Filter: &controllers.ProductCtrlFilter{InStock: true}}, Percent: &percent},
+				err:     controllers.ErrTooManyBulkProducts,
+			},
+			expResp: `{"message":"a bulk operation must not change more than 1000 products"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"missing selection": {
+			givenInput: `{"percent":-10}`,
+			expResp:    `{"message":"the products must be selected by ids or by the filters in the query params"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"both ids and filter": {
+			givenQuery: "?categoryID=3",
+			givenInput: `{"ids":[1],"percent":-10}`,
+			expResp:    `{"message":"the products must be selected by either a list of positive IDs or a filter"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"missing price change": {
+			givenInput: `{"ids":[1]}`,
+			expResp:    `{"message":"either a non-zero amount or a non-zero percent greater than -100 must be given"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/bulk/price"+tc.givenQuery, strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockBulkCtrl.expCall {
+				mockController.On("BulkChangePrice", r.Context(), tc.mockBulkCtrl.input).Return(tc.mockBulkCtrl.output, tc.mockBulkCtrl.err)
+			}
+
+			handler.BulkChangePrice(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test BulkArchiveProducts in Handler layer
+func Test_ProductBulkHandler_BulkArchiveProducts(t *testing.T) {
+	type mockBulkCtrl struct {
+		expCall bool
+		input   controllers.BulkSelection
+		output  controllers.BulkReport
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput   string
+		mockBulkCtrl mockBulkCtrl
+		expResp      string
+		expCode      int
+	}{
+		"archive products successfully": {
+			givenInput: `{"ids":[1]}`,
+			mockBulkCtrl: mockBulkCtrl{
+				expCall: true,
+				input:   controllers.BulkSelection{IDs: []int{1}},
+				output: controllers.BulkReport{
+					Operation: controllers.BulkOperationArchive,
+					Succeeded: 1,
+					Items:     []controllers.BulkItemResult{{ProductID: 1}},
+				},
+			},
+			expResp: `{"operation":"archive","transactional":false,"succeeded":1,"failed":0,"items":[{"product_id":1,"success":true}]}`,
+			expCode: http.StatusOK,
+		},
+		"invalid id": {
+			givenInput: `{"ids":[0]}`,
+			expResp:    `{"message":"the products must be selected by either a list of positive IDs or a filter"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid JSON": {
+			givenInput: `{"ids":[1]`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/bulk/archive", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockBulkCtrl.expCall {
+				mockController.On("BulkArchiveProducts", r.Context(), tc.mockBulkCtrl.input).Return(tc.mockBulkCtrl.output, tc.mockBulkCtrl.err)
+			}
+
+			handler.BulkArchiveProducts(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// GetProductBySKU retrieves a product like GetProduct by the sku in url param
+func (h *Handler) GetProductBySKU(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sku := strings.TrimSpace(chi.URLParam(r, "sku"))
+	if sku == "" {
+		render.Render(w, r, ErrMissingSKU)
+		return
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		render.Render(w, r, ErrInvalidCurrency)
+		return
+	}
+
+	product, err := h.Controller.GetProductBySKU(ctx, sku, currency)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	w.Header().Set("ETag", productETag(product.Version))
+	utils.RenderJson(w, toProductDetailResponse(product), http.StatusOK)
+}
+
+// GetProductBySlug retrieves a product like GetProduct by the slug in url param. An old slug of a renamed product
+// is redirected permanently to the current slug of the product
+func (h *Handler) GetProductBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := strings.ToLower(strings.TrimSpace(chi.URLParam(r, "slug")))
+	if slug == "" {
+		render.Render(w, r, ErrMissingSlug)
+		return
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		render.Render(w, r, ErrInvalidCurrency)
+		return
+	}
+
+	product, err := h.Controller.GetProductBySlug(ctx, slug, currency)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	if product.Slug != slug {
+		location := "/products/slug/" + product.Slug
+		if r.URL.RawQuery != "" {
+			location += "?" + r.URL.RawQuery
+		}
+		http.Redirect(w, r, location, http.StatusMovedPermanently)
+		return
+	}
+
+	w.Header().Set("ETag", productETag(product.Version))
+	utils.RenderJson(w, toProductDetailResponse(product), http.StatusOK)
+}
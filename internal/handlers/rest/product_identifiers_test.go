@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test GetProductBySlug in Handler layer
+func Test_ProductHandler_GetProductBySlug(t *testing.T) {
+	type mockSlugCtrl struct {
+		expCall  bool
+		currency string
+		output   controllers.ProductOutputGraph
+		err      error
+	}
+	testCases := map[string]struct {
+		givenSlug    string
+		givenQuery   string
+		mockSlugCtrl mockSlugCtrl
+		expResp      string
+		expLocation  string
+		expCode      int
+	}{
+		"current slug": {
+			givenSlug: "iphone-14",
+			mockSlugCtrl: mockSlugCtrl{
+				expCall: true,
+				output:  controllers.ProductOutputGraph{ID: 1, SKU: "IP14-128", Slug: "iphone-14", Name: "iPhone 14", Version: 2},
+			},
+			expResp: `{"id":1,"sku":"IP14-128","slug":"iphone-14","name":"iPhone 14","description":"","price":"0","quantity":0,"weight":"0","currency":"","author":{"id":0,"name":"","email":""},"category":{"id":0,"name":"","description":""},"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z","rating_average":"0","review_count":0}`,
+			expCode: http.StatusOK,
+		},
+		"old slug of a renamed product": {
+			givenSlug:  "iPhone-14-Pro",
+			givenQuery: "?currency=usd",
+			mockSlugCtrl: mockSlugCtrl{
+				expCall:  true,
+				currency: "USD",
+				output:   controllers.ProductOutputGraph{ID: 1, SKU: "IP14-128", Slug: "iphone-14", Name: "iPhone 14", Version: 2},
+			},
+			expLocation: "/products/slug/iphone-14?currency=usd",
+			expCode:     http.StatusMovedPermanently,
+		},
+		"unknown slug": {
+			givenSlug: "iphone-15",
+			mockSlugCtrl: mockSlugCtrl{
+				expCall: true,
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"invalid currency": {
+			givenSlug:  "iphone-14",
+			givenQuery: "?currency=eur",
+			expResp:    `{"message":"invalid currency, currency must be VND or USD"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/slug/"+tc.givenSlug+tc.givenQuery, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("slug", tc.givenSlug)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockSlugCtrl.expCall {
+				mockController.On("GetProductBySlug", r.Context(), strings.ToLower(tc.givenSlug), tc.mockSlugCtrl.currency).Return(tc.mockSlugCtrl.output, tc.mockSlugCtrl.err)
+			}
+
+			handler.GetProductBySlug(w, r)
+
+			if tc.expResp != "" {
+				assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			}
+			assert.Equal(t, tc.expLocation, w.Header().Get("Location"))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// maxImageFormSize is the maximum size of an image upload request, the image and the multipart headers
+const maxImageFormSize = controllers.MaxImageSize + 1<<20
+
+type ImageThumbnailResponse struct {
+	Size string `json:"size"`
+	URL  string `json:"url"`
+}
+
+type ProductImageResponse struct {
+	ID          int                      `json:"id"`
+	Position    int                      `json:"position"`
+	URL         string                   `json:"url"`
+	ContentType string                   `json:"content_type"`
+	Size        int                      `json:"size"`
+	Width       int                      `json:"width"`
+	Height      int                      `json:"height"`
+	Thumbnails  []ImageThumbnailResponse `json:"thumbnails"`
+	CreatedAt   time.Time                `json:"created_at"`
+}
+
+// toProductImageResponses converts the images of a product to the response, nil if the product has no image
+func toProductImageResponses(images []controllers.ProductImageOutput) []ProductImageResponse {
+	var resp []ProductImageResponse
+	for _, i := range images {
+		iResp := ProductImageResponse{
+			ID:          i.ID,
+			Position:    i.Position,
+			URL:         i.URL,
+			ContentType: i.ContentType,
+			Size:        i.Size,
+			Width:       i.Width,
+			Height:      i.Height,
+			Thumbnails:  []ImageThumbnailResponse{},
+			CreatedAt:   i.CreatedAt,
+		}
+		for _, t := range i.Thumbnails {
+			iResp.Thumbnails = append(iResp.Thumbnails, ImageThumbnailResponse{Size: t.Size, URL: t.URL})
+		}
+		resp = append(resp, iResp)
+	}
+
+	return resp
+}
+
+// UploadProductImage gets the image from the "image" field of a multipart form, calls to UploadProductImage controller
+// and returns the stored image
+func (h *Handler) UploadProductImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImageFormSize)
+	if err := r.ParseMultipartForm(maxImageFormSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			render.Render(w, r, ErrImageTooLarge)
+			return
+		}
+		render.Render(w, r, ErrMissingImage)
+		return
+	}
+
+	file, fileHeader, err := r.FormFile("image")
+	if err != nil {
+		render.Render(w, r, ErrMissingImage)
+		return
+	}
+	defer file.Close()
+
+	image, err := h.Controller.UploadProductImage(ctx, productID, file, fileHeader.Size)
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toProductImageResponses([]controllers.ProductImageOutput{image})[0], http.StatusCreated)
+}
+
+// GetProductImages retrieves the gallery of the product in url param in order
+func (h *Handler) GetProductImages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	images, err := h.Controller.GetProductImages(ctx, productID)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := toProductImageResponses(images)
+	if resp == nil {
+		resp = []ProductImageResponse{}
+	}
+
+	utils.RenderJson(w, resp, http.StatusOK)
+}
+
+type imageOrderRequest struct {
+	ImageIDs []int `json:"image_ids"`
+}
+
+// ReorderProductImages orders the gallery of the product in url param as the image ids in body request and returns the status
+func (h *Handler) ReorderProductImages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	oReq := imageOrderRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&oReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if err := h.Controller.ReorderProductImages(ctx, productID, oReq.ImageIDs); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+// DeleteProductImage removes the image in url param from the gallery of the product and returns the status
+func (h *Handler) DeleteProductImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	imageID, err := strconv.Atoi(chi.URLParam(r, "imageID"))
+	if err != nil || imageID <= 0 {
+		render.Render(w, r, ErrInvalidImageID)
+		return
+	}
+
+	if err := h.Controller.DeleteProductImage(ctx, productID, imageID); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
@@ -0,0 +1,315 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test UploadProductImage in Handler layer
+func Test_ProductImageHandler_UploadProductImage(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockImageCtrl struct {
+		expCall bool
+		output  controllers.ProductImageOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		productID     int
+		field         string
+		mockImageCtrl mockImageCtrl
+		expResp       string
+		expCode       int
+	}{
+		"upload image successfully": {
+			productID: 1,
+			field:     "image",
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				output: controllers.ProductImageOutput{
+					ID: 3, ProductID: 1, Position: 0, URL: "/uploads/products/1/abc.png", ContentType: "image/png", Size: 5, Width: 100, Height: 50,
+					Thumbnails: []controllers.ImageThumbnailOutput{{Size: "small", URL: "/uploads/products/1/abc_small.png"}},
+					CreatedAt:  createdAt,
+				},
+			},
+			expResp: `{"id":3,"position":0,"url":"/uploads/products/1/abc.png","content_type":"image/png","size":5,"width":100,"height":50,"thumbnails":[{"size":"small","url":"/uploads/products/1/abc_small.png"}],"created_at":"2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusCreated,
+		},
+		"upload unsupported image type": {
+			productID: 1,
+			field:     "image",
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				err:     controllers.ErrUnsupportedImageType,
+			},
+			expResp: `{"message":"unsupported image type, image must be jpeg, png or gif"}`,
+			expCode: http.StatusUnsupportedMediaType,
+		},
+		"upload image too large": {
+			productID: 1,
+			field:     "image",
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				err:     controllers.ErrImageTooLarge,
+			},
+			expResp: `{"message":"image must not be larger than 5MB"}`,
+			expCode: http.StatusRequestEntityTooLarge,
+		},
+		"upload image with product not found": {
+			productID: 100,
+			field:     "image",
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"upload image in wrong field": {
+			productID: 1,
+			field:     "file",
+			expResp:   `{"message":"the image must be uploaded in the image field of a multipart form"}`,
+			expCode:   http.StatusBadRequest,
+		},
+		"upload image with invalid product id": {
+			productID: -1,
+			field:     "image",
+			expResp:   `{"message":"invalid product ID"}`,
+			expCode:   http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			part, err := writer.CreateFormFile(tc.field, "image.png")
+			assert.NoError(t, err)
+			part.Write([]byte("image"))
+			writer.Close()
+
+			r := httptest.NewRequest(http.MethodPost, "/products/images", body)
+			r.Header.Set("Content-Type", writer.FormDataContentType())
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", strconv.Itoa(tc.productID))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockImageCtrl.expCall {
+				mockController.On("UploadProductImage", r.Context(), tc.productID, mock.Anything, int64(5)).Return(tc.mockImageCtrl.output, tc.mockImageCtrl.err)
+			}
+
+			handler.UploadProductImage(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test GetProductImages in Handler layer
+func Test_ProductImageHandler_GetProductImages(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockImageCtrl struct {
+		expCall bool
+		output  []controllers.ProductImageOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		productID     int
+		mockImageCtrl mockImageCtrl
+		expResp       string
+		expCode       int
+	}{
+		"get images successfully": {
+			productID: 1,
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				output: []controllers.ProductImageOutput{
+					{ID: 3, ProductID: 1, Position: 0, URL: "/uploads/products/1/abc.jpg", ContentType: "image/jpeg", Size: 2048, Width: 800, Height: 600, CreatedAt: createdAt},
+				},
+			},
+			expResp: `[{"id":3,"position":0,"url":"/uploads/products/1/abc.jpg","content_type":"image/jpeg","size":2048,"width":800,"height":600,"thumbnails":[],"created_at":"2023-07-01T00:00:00Z"}]`,
+			expCode: http.StatusOK,
+		},
+		"get images of product without images": {
+			productID:     1,
+			mockImageCtrl: mockImageCtrl{expCall: true},
+			expResp:       `[]`,
+			expCode:       http.StatusOK,
+		},
+		"get images with product not found": {
+			productID: 100,
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/images", nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", strconv.Itoa(tc.productID))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockImageCtrl.expCall {
+				mockController.On("GetProductImages", r.Context(), tc.productID).Return(tc.mockImageCtrl.output, tc.mockImageCtrl.err)
+			}
+
+			handler.GetProductImages(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test ReorderProductImages in Handler layer
+func Test_ProductImageHandler_ReorderProductImages(t *testing.T) {
+	type mockImageCtrl struct {
+		expCall  bool
+		imageIDs []int
+		err      error
+	}
+	testCases := map[string]struct {
+		givenInput    string
+		mockImageCtrl mockImageCtrl
+		expResp       string
+		expCode       int
+	}{
+		"reorder images successfully": {
+			givenInput: `{"image_ids":[3,1,2]}`,
+			mockImageCtrl: mockImageCtrl{
+				expCall:  true,
+				imageIDs: []int{3, 1, 2},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusOK,
+		},
+		"reorder images with missing image": {
+			givenInput: `{"image_ids":[3,1]}`,
+			mockImageCtrl: mockImageCtrl{
+				expCall:  true,
+				imageIDs: []int{3, 1},
+				err:      controllers.ErrInvalidImageOrder,
+			},
+			expResp: `{"message":"the image ids must be all the images of the product, each given once"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"reorder images with invalid JSON": {
+			givenInput: `{"image_ids":[3,1`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPut, "/products/images/order", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockImageCtrl.expCall {
+				mockController.On("ReorderProductImages", r.Context(), 1, tc.mockImageCtrl.imageIDs).Return(tc.mockImageCtrl.err)
+			}
+
+			handler.ReorderProductImages(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test DeleteProductImage in Handler layer
+func Test_ProductImageHandler_DeleteProductImage(t *testing.T) {
+	type mockImageCtrl struct {
+		expCall bool
+		err     error
+	}
+	testCases := map[string]struct {
+		imageID       string
+		mockImageCtrl mockImageCtrl
+		expResp       string
+		expCode       int
+	}{
+		"delete image successfully": {
+			imageID:       "3",
+			mockImageCtrl: mockImageCtrl{expCall: true},
+			expResp:       `{"success":true}`,
+			expCode:       http.StatusOK,
+		},
+		"delete image not found": {
+			imageID: "30",
+			mockImageCtrl: mockImageCtrl{
+				expCall: true,
+				err:     controllers.ErrProductImageNotFound,
+			},
+			expResp: `{"message":"product image not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"delete image with invalid image id": {
+			imageID: "abc",
+			expResp: `{"message":"invalid image ID"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodDelete, "/products/images", nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			rctx.URLParams.Add("imageID", tc.imageID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			imageID, _ := strconv.Atoi(tc.imageID)
+			if tc.mockImageCtrl.expCall {
+				mockController.On("DeleteProductImage", r.Context(), 1, imageID).Return(tc.mockImageCtrl.err)
+			}
+
+			handler.DeleteProductImage(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// PatchProduct changes the fields of the product in url param given by the JSON merge patch in body request and keeps
+// the others. The If-Match header must be the ETag of the product the patch is based on, the new ETag is returned
+func (h *Handler) PatchProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || id <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	patchReq := map[string]json.RawMessage{}
+	if err := json.NewDecoder(r.Body).Decode(&patchReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	patch, errResp := validateAndConvertProductPatch(patchReq)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	patch.ID = id
+	if patch.ExpectedVersion, errResp = parseIfMatch(r); errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	version, err := h.Controller.PatchProduct(ctx, patch)
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	w.Header().Set("ETag", productETag(version))
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+// decodePatchField decodes the field of a JSON merge patch into dst unless it is null,
+// it reports whether the field is given and whether it is null
+func decodePatchField(patch map[string]json.RawMessage, field string, dst interface{}) (bool, bool, *ErrorResponse) {
+	raw, ok := patch[field]
+	if !ok {
+		return false, false, nil
+	}
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return true, true, nil
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return true, false, ErrInvalidJson
+	}
+
+	return true, false, nil
+}
+
+// validateAndConvertProductPatch validates the fields given in the JSON merge patch of a product one by one and returns
+// the patch in controller layer. A null removes the weight, the reorder threshold, the tags or the value of an attribute,
+// the other fields cannot be null
+func validateAndConvertProductPatch(patchReq map[string]json.RawMessage) (controllers.ProductPatch, *ErrorResponse) {
+	patch := controllers.ProductPatch{}
+
+	var name string
+	if given, null, errResp := decodePatchField(patchReq, "name", &name); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		name = strings.TrimSpace(name)
+		if null || name == "" {
+			return controllers.ProductPatch{}, ErrMissingName
+		}
+		if len(name) > 255 {
+			return controllers.ProductPatch{}, ErrNameTooLong
+		}
+		patch.Name = &name
+	}
+
+	var description string
+	if given, null, errResp := decodePatchField(patchReq, "description", &description); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		description = strings.TrimSpace(description)
+		if null || description == "" {
+			return controllers.ProductPatch{}, ErrMissingDesc
+		}
+		patch.Description = &description
+	}
+
+	var price decimal.Decimal
+	if given, null, errResp := decodePatchField(patchReq, "price", &price); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if null || !price.IsPositive() || len(price.String()) > 15 {
+			return controllers.ProductPatch{}, ErrInvalidPrice
+		}
+		patch.Price = &price
+	}
+
+	var quantity int
+	if given, null, errResp := decodePatchField(patchReq, "quantity", &quantity); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if null || quantity < 0 {
+			return controllers.ProductPatch{}, ErrInvalidQuantity
+		}
+		patch.Quantity = &quantity
+	}
+
+	var authorID int
+	if given, null, errResp := decodePatchField(patchReq, "author_id", &authorID); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if null || authorID <= 0 {
+			return controllers.ProductPatch{}, ErrInvalidAuthorID
+		}
+		patch.AuthorID = &authorID
+	}
+
+	var categoryName string
+	if given, null, errResp := decodePatchField(patchReq, "category", &categoryName); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		categoryName = strings.TrimSpace(categoryName)
+		if null || categoryName == "" {
+			return controllers.ProductPatch{}, ErrMissingCategoryName
+		}
+		patch.CategoryName = &categoryName
+	}
+
+	// a null weight is the default weight 0
+	var weight decimal.Decimal
+	if given, _, errResp := decodePatchField(patchReq, "weight", &weight); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if weight.IsNegative() {
+			return controllers.ProductPatch{}, ErrInvalidWeight
+		}
+		patch.Weight = &weight
+	}
+
+	var currency string
+	if given, null, errResp := decodePatchField(patchReq, "currency", &currency); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if null || !controllers.IsSupportedCurrency(currency) {
+			return controllers.ProductPatch{}, ErrInvalidCurrency
+		}
+		patch.Currency = &currency
+	}
+
+	// the sku cannot be changed, it is only checked against the sku of the product
+	var sku string
+	if given, null, errResp := decodePatchField(patchReq, "sku", &sku); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if null {
+			return controllers.ProductPatch{}, ErrSKUImmutable
+		}
+		sku = strings.TrimSpace(sku)
+		patch.SKU = &sku
+	}
+
+	// a null reorder threshold disables the low stock alerts
+	var reorderThreshold int
+	if given, _, errResp := decodePatchField(patchReq, "reorder_threshold", &reorderThreshold); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if reorderThreshold < 0 {
+			return controllers.ProductPatch{}, ErrInvalidReorderThreshold
+		}
+		patch.ReorderThreshold = &reorderThreshold
+	}
+
+	// the tags are replaced as a whole, null removes them
+	tags := []string{}
+	if given, _, errResp := decodePatchField(patchReq, "tags", &tags); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		patch.Tags = tags
+	}
+
+	// the attributes are merged by name, a null value removes the value of the attribute
+	var attributes map[string]interface{}
+	if given, null, errResp := decodePatchField(patchReq, "attributes", &attributes); errResp != nil {
+		return controllers.ProductPatch{}, errResp
+	} else if given {
+		if null {
+			return controllers.ProductPatch{}, ErrNullAttributes
+		}
+		patch.Attributes = make(map[string]*string)
+		for name, value := range attributes {
+			if strings.TrimSpace(name) == "" {
+				return controllers.ProductPatch{}, ErrMissingAttributeName
+			}
+			var s string
+			switch v := value.(type) {
+			case nil:
+				patch.Attributes[name] = nil
+				continue
+			case string:
+				s = v
+			case float64:
+				s = strconv.FormatFloat(v, 'f', -1, 64)
+			case bool:
+				s = strconv.FormatBool(v)
+			default:
+				return controllers.ProductPatch{}, ErrInvalidAttributeValue
+			}
+			patch.Attributes[name] = &s
+		}
+	}
+
+	return patch, nil
+}
@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test PatchProduct in Handler layer
+func Test_ProductHandler_PatchProduct(t *testing.T) {
+	name, currency, weight := "Phone X", "VND", decimal.Decimal{}
+	price := decimal.RequireFromString("120.5")
+	blue := "blue"
+
+	type mockPatchCtrl struct {
+		expCall bool
+		patch   controllers.ProductPatch
+		version int
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput    string
+		givenIfMatch  string
+		mockPatchCtrl mockPatchCtrl
+		expResp       string
+		expETag       string
+		expCode       int
+	}{
+		"patch name, price and currency successfully": {
+			givenInput:   `{"name":" Phone X ","price":120.5,"currency":" vnd "}`,
+			givenIfMatch: `"3"`,
+			mockPatchCtrl: mockPatchCtrl{
+				expCall: true,
+				patch:   controllers.ProductPatch{ID: 1, Name: &name, Price: &price, Currency: &currency, ExpectedVersion: 3},
+				version: 4,
+			},
+			expResp: `{"success":true}`,
+			expETag: `"4"`,
+			expCode: http.StatusOK,
+		},
+		"remove weight, tags and an attribute": {
+			givenInput:   `{"weight":null,"tags":null,"attributes":{"Color":"blue","Size":null}}`,
+			givenIfMatch: `"3"`,
+			mockPatchCtrl: mockPatchCtrl{
+				expCall: true,
+				patch:   controllers.ProductPatch{ID: 1, Weight: &weight, Tags: []string{}, Attributes: map[string]*string{"Color": &blue, "Size": nil}, ExpectedVersion: 3},
+				version: 4,
+			},
+			expResp: `{"success":true}`,
+			expETag: `"4"`,
+			expCode: http.StatusOK,
+		},
+		"version conflict": {
+			givenInput:   `{"name":"Phone X"}`,
+			givenIfMatch: `"2"`,
+			mockPatchCtrl: mockPatchCtrl{
+				expCall: true,
+				patch:   controllers.ProductPatch{ID: 1, Name: &name, ExpectedVersion: 2},
+				err:     controllers.ErrProductVersionConflict,
+			},
+			expResp: `{"message":"the product has been changed since it was read, reload it and try again"}`,
+			expCode: http.StatusPreconditionFailed,
+		},
+		"null name": {
+			givenInput:   `{"name":null}`,
+			givenIfMatch: `"3"`,
+			expResp:      `{"message":"name cannot be blank"}`,
+			expCode:      http.StatusBadRequest,
+		},
+		"invalid price": {
+			givenInput:   `{"price":-1}`,
+			givenIfMatch: `"3"`,
+			expResp:      `{"message":"price must be greater than 0 and less than 15 digits"}`,
+			expCode:      http.StatusBadRequest,
+		},
+		"null sku": {
+			givenInput:   `{"sku":null}`,
+			givenIfMatch: `"3"`,
+			expResp:      `{"message":"the sku of a product cannot be changed"}`,
+			expCode:      http.StatusBadRequest,
+		},
+		"null attributes": {
+			givenInput:   `{"attributes":null}`,
+			givenIfMatch: `"3"`,
+			expResp:      `{"message":"attributes cannot be null, set the value of an attribute to null to remove it"}`,
+			expCode:      http.StatusBadRequest,
+		},
+		"wrong type": {
+			givenInput:   `{"quantity":"five"}`,
+			givenIfMatch: `"3"`,
+			expResp:      `{"message":"invalid json"}`,
+			expCode:      http.StatusBadRequest,
+		},
+		"missing If-Match": {
+			givenInput: `{"name":"Phone X"}`,
+			expResp:    `{"message":"the If-Match header with the ETag of the product is required"}`,
+			expCode:    http.StatusPreconditionRequired,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPatch, "/products/1", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/merge-patch+json")
+			if tc.givenIfMatch != "" {
+				r.Header.Set("If-Match", tc.givenIfMatch)
+			}
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockPatchCtrl.expCall {
+				mockController.On("PatchProduct", r.Context(), tc.mockPatchCtrl.patch).Return(tc.mockPatchCtrl.version, tc.mockPatchCtrl.err)
+			}
+
+			handler.PatchProduct(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expETag, w.Header().Get("ETag"))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
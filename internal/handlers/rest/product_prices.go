@@ -0,0 +1,174 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type productPriceRequest struct {
+	Price decimal.Decimal `json:"price"`
+	// Currency is the currency of the product if not given
+	Currency      string `json:"currency"`
+	EffectiveFrom string `json:"effective_from"`
+}
+
+type ProductPriceResponse struct {
+	ID            int             `json:"id"`
+	ProductID     int             `json:"product_id"`
+	Price         decimal.Decimal `json:"price"`
+	Currency      string          `json:"currency"`
+	EffectiveFrom time.Time       `json:"effective_from"`
+	EffectiveTo   *time.Time      `json:"effective_to,omitempty"`
+	Scheduled     bool            `json:"scheduled"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// toProductPriceResponse converts a price of the price history to the response
+func toProductPriceResponse(pp controllers.ProductPriceOutput) ProductPriceResponse {
+	return ProductPriceResponse{
+		ID:            pp.ID,
+		ProductID:     pp.ProductID,
+		Price:         pp.Price,
+		Currency:      pp.Currency,
+		EffectiveFrom: pp.EffectiveFrom,
+		EffectiveTo:   pp.EffectiveTo,
+		Scheduled:     pp.Scheduled,
+		CreatedAt:     pp.CreatedAt,
+	}
+}
+
+// SchedulePriceChange gets the new price of the product in url param from body request, calls to SchedulePriceChange
+// controller and returns the scheduled price
+func (h *Handler) SchedulePriceChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	ppReq := productPriceRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&ppReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if !ppReq.Price.IsPositive() || len(ppReq.Price.String()) > 15 {
+		render.Render(w, r, ErrInvalidPrice)
+		return
+	}
+	if strings.TrimSpace(ppReq.EffectiveFrom) == "" {
+		render.Render(w, r, ErrMissingEffectiveFrom)
+		return
+	}
+	effectiveFrom, err := time.Parse(time.RFC3339, strings.TrimSpace(ppReq.EffectiveFrom))
+	if err != nil {
+		render.Render(w, r, ErrTimeBadRequest)
+		return
+	}
+
+	productPrice, err := h.Controller.SchedulePriceChange(ctx, controllers.ProductPriceInput{
+		ProductID:     productID,
+		Price:         ppReq.Price,
+		Currency:      strings.ToUpper(strings.TrimSpace(ppReq.Currency)),
+		EffectiveFrom: effectiveFrom,
+	})
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toProductPriceResponse(productPrice), http.StatusCreated)
+}
+
+// GetProductPriceHistory retrieves the prices of the product in url param sorted by effective time, the scheduled prices included
+func (h *Handler) GetProductPriceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	productPrices, err := h.Controller.GetProductPriceHistory(ctx, productID)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := []ProductPriceResponse{}
+	for _, pp := range productPrices {
+		resp = append(resp, toProductPriceResponse(pp))
+	}
+
+	utils.RenderJson(w, resp, http.StatusOK)
+}
+
+// GetProductPriceAt retrieves the price of the product in url param which is in effect at the time in the at query param,
+// the current price if no time is given
+func (h *Handler) GetProductPriceAt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	at := time.Now()
+	if atParam := strings.TrimSpace(r.URL.Query().Get("at")); atParam != "" {
+		at, err = time.Parse(time.RFC3339, atParam)
+		if err != nil {
+			render.Render(w, r, ErrTimeBadRequest)
+			return
+		}
+	}
+
+	productPrice, err := h.Controller.GetProductPriceAt(ctx, productID, at)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toProductPriceResponse(productPrice), http.StatusOK)
+}
+
+// CancelScheduledPrice cancels the scheduled price in url param of the product in url param and returns the status
+func (h *Handler) CancelScheduledPrice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	priceID, err := strconv.Atoi(chi.URLParam(r, "priceID"))
+	if err != nil || priceID <= 0 {
+		render.Render(w, r, ErrInvalidPriceID)
+		return
+	}
+
+	if err := h.Controller.CancelScheduledPrice(ctx, productID, priceID); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
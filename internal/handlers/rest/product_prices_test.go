@@ -0,0 +1,294 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test SchedulePriceChange in Handler layer
+func Test_ProductPriceHandler_SchedulePriceChange(t *testing.T) {
+	effectiveFrom := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockPriceCtrl struct {
+		expCall bool
+		input   controllers.ProductPriceInput
+		output  controllers.ProductPriceOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput    string
+		mockPriceCtrl mockPriceCtrl
+		expResp       string
+		expCode       int
+	}{
+		"schedule price change successfully": {
+			givenInput: `{"price":"900","currency":"usd","effective_from":"2030-01-01T00:00:00Z"}`,
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				input:   controllers.ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), Currency: "USD", EffectiveFrom: effectiveFrom},
+				output:  controllers.ProductPriceOutput{ID: 5, ProductID: 1, Price: decimal.NewFromInt(900), Currency: "USD", EffectiveFrom: effectiveFrom, Scheduled: true, CreatedAt: createdAt},
+			},
+			expResp: `{"id":5,"product_id":1,"price":"900","currency":"USD","effective_from":"2030-01-01T00:00:00Z","scheduled":true,"created_at":"2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusCreated,
+		},
+		"schedule price change in the past": {
+			givenInput: `{"price":"900","effective_from":"2030-01-01T00:00:00Z"}`,
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				input:   controllers.ProductPriceInput{ProductID: 1, Price: decimal.NewFromInt(900), EffectiveFrom: effectiveFrom},
+				err:     controllers.ErrPriceChangeNotInFuture,
+			},
+			expResp: `{"message":"a price change must be scheduled in the future"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"schedule price change with invalid price": {
+			givenInput: `{"price":"0","effective_from":"2030-01-01T00:00:00Z"}`,
+			expResp:    `{"message":"price must be greater than 0 and less than 15 digits"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"schedule price change without effective from": {
+			givenInput: `{"price":"900"}`,
+			expResp:    `{"message":"effective from cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"schedule price change with invalid effective from": {
+			givenInput: `{"price":"900","effective_from":"01-01-2030"}`,
+			expResp:    `{"message":"invalid time format, times must follow RFC 3339 such as 2023-07-01T00:00:00Z"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"schedule price change with invalid JSON": {
+			givenInput: `{"price":"900"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/prices", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockPriceCtrl.expCall {
+				mockController.On("SchedulePriceChange", r.Context(), tc.mockPriceCtrl.input).Return(tc.mockPriceCtrl.output, tc.mockPriceCtrl.err)
+			}
+
+			handler.SchedulePriceChange(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test GetProductPriceHistory in Handler layer
+func Test_ProductPriceHandler_GetProductPriceHistory(t *testing.T) {
+	effectiveFrom := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	effectiveTo := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockPriceCtrl struct {
+		expCall bool
+		output  []controllers.ProductPriceOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		productID     int
+		mockPriceCtrl mockPriceCtrl
+		expResp       string
+		expCode       int
+	}{
+		"get price history successfully": {
+			productID: 1,
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				output: []controllers.ProductPriceOutput{
+					{ID: 1, ProductID: 1, Price: decimal.NewFromInt(1000), Currency: "VND", EffectiveFrom: effectiveFrom, EffectiveTo: &effectiveTo, CreatedAt: effectiveFrom},
+					{ID: 2, ProductID: 1, Price: decimal.NewFromInt(900), Currency: "VND", EffectiveFrom: effectiveTo, Scheduled: true, CreatedAt: effectiveFrom},
+				},
+			},
+			expResp: `[{"id":1,"product_id":1,"price":"1000","currency":"VND","effective_from":"2023-06-01T00:00:00Z","effective_to":"2023-07-01T00:00:00Z","scheduled":false,"created_at":"2023-06-01T00:00:00Z"},` +
+				`{"id":2,"product_id":1,"price":"900","currency":"VND","effective_from":"2023-07-01T00:00:00Z","scheduled":true,"created_at":"2023-06-01T00:00:00Z"}]`,
+			expCode: http.StatusOK,
+		},
+		"get price history with product not found": {
+			productID: 100,
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"get price history with invalid product id": {
+			productID: -1,
+			expResp:   `{"message":"invalid product ID"}`,
+			expCode:   http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/prices", nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", strconv.Itoa(tc.productID))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockPriceCtrl.expCall {
+				mockController.On("GetProductPriceHistory", r.Context(), tc.productID).Return(tc.mockPriceCtrl.output, tc.mockPriceCtrl.err)
+			}
+
+			handler.GetProductPriceHistory(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test GetProductPriceAt in Handler layer
+func Test_ProductPriceHandler_GetProductPriceAt(t *testing.T) {
+	at := time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+	effectiveFrom := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockPriceCtrl struct {
+		expCall bool
+		output  controllers.ProductPriceOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		query         string
+		mockPriceCtrl mockPriceCtrl
+		expResp       string
+		expCode       int
+	}{
+		"get price at time successfully": {
+			query: "?at=2023-06-15T12:00:00Z",
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				output:  controllers.ProductPriceOutput{ID: 1, ProductID: 1, Price: decimal.NewFromInt(1000), Currency: "VND", EffectiveFrom: effectiveFrom, CreatedAt: effectiveFrom},
+			},
+			expResp: `{"id":1,"product_id":1,"price":"1000","currency":"VND","effective_from":"2023-06-01T00:00:00Z","scheduled":false,"created_at":"2023-06-01T00:00:00Z"}`,
+			expCode: http.StatusOK,
+		},
+		"no price at time": {
+			query: "?at=2023-06-15T12:00:00Z",
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				err:     controllers.ErrProductPriceNotFound,
+			},
+			expResp: `{"message":"product price not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"get price with invalid time": {
+			query:   "?at=15-06-2023",
+			expResp: `{"message":"invalid time format, times must follow RFC 3339 such as 2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/prices/effective"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockPriceCtrl.expCall {
+				mockController.On("GetProductPriceAt", r.Context(), 1, at).Return(tc.mockPriceCtrl.output, tc.mockPriceCtrl.err)
+			}
+
+			handler.GetProductPriceAt(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test CancelScheduledPrice in Handler layer
+func Test_ProductPriceHandler_CancelScheduledPrice(t *testing.T) {
+	type mockPriceCtrl struct {
+		expCall bool
+		err     error
+	}
+	testCases := map[string]struct {
+		priceID       string
+		mockPriceCtrl mockPriceCtrl
+		expResp       string
+		expCode       int
+	}{
+		"cancel scheduled price successfully": {
+			priceID:       "2",
+			mockPriceCtrl: mockPriceCtrl{expCall: true},
+			expResp:       `{"success":true}`,
+			expCode:       http.StatusOK,
+		},
+		"cancel price in effect": {
+			priceID: "2",
+			mockPriceCtrl: mockPriceCtrl{
+				expCall: true,
+				err:     controllers.ErrPriceNotScheduled,
+			},
+			expResp: `{"message":"only a scheduled price which is not in effect yet can be cancelled"}`,
+			expCode: http.StatusConflict,
+		},
+		"cancel price with invalid price id": {
+			priceID: "abc",
+			expResp: `{"message":"invalid price ID"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodDelete, "/products/prices", nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			rctx.URLParams.Add("priceID", tc.priceID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			priceID, _ := strconv.Atoi(tc.priceID)
+			if tc.mockPriceCtrl.expCall {
+				mockController.On("CancelScheduledPrice", r.Context(), 1, priceID).Return(tc.mockPriceCtrl.err)
+			}
+
+			handler.CancelScheduledPrice(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+// GetRelatedProducts retrieves the products frequently bought together with the product in url param,
+// up to the limit in query param. The best-sellers of its category fill up the rest
+func (h *Handler) GetRelatedProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || id <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	query := r.URL.Query()
+	var limit int
+	if l := strings.TrimSpace(query.Get("limit")); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			render.Render(w, r, ErrInvalidRelatedProductsLimit)
+			return
+		}
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(query.Get("currency")))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		render.Render(w, r, ErrInvalidCurrency)
+		return
+	}
+
+	products, err := h.Controller.GetRelatedProducts(ctx, id, limit, currency)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	pResp := make([]ProductDetailResponse, 0, len(products))
+	for _, p := range products {
+		pResp = append(pResp, toProductDetailResponse(p))
+	}
+
+	utils.RenderJson(w, pResp, http.StatusOK)
+}
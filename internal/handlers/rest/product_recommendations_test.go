@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test GetRelatedProducts in Handler layer
+func Test_ProductHandler_GetRelatedProducts(t *testing.T) {
+	type mockRelatedCtrl struct {
+		expCall   bool
+		productID int
+		limit     int
+		currency  string
+		output    []controllers.ProductOutputGraph
+		err       error
+	}
+	testCases := map[string]struct {
+		givenID         string
+		givenQuery      string
+		mockRelatedCtrl mockRelatedCtrl
+		expResp         string
+		expCode         int
+	}{
+		"success": {
+			givenID:    "1",
+			givenQuery: "?limit=1&currency=usd",
+			mockRelatedCtrl: mockRelatedCtrl{
+				expCall:   true,
+				productID: 1,
+				limit:     1,
+				currency:  "USD",
+				output:    []controllers.ProductOutputGraph{{ID: 4, SKU: "CASE-14", Slug: "iphone-14-case", Name: "iPhone 14 case", Currency: "USD"}},
+			},
+			expResp: `[{"id":4,"sku":"CASE-14","slug":"iphone-14-case","name":"iPhone 14 case","description":"","price":"0","quantity":0,"weight":"0","currency":"USD","author":{"id":0,"name":"","email":""},"category":{"id":0,"name":"","description":""},"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z","rating_average":"0","review_count":0}]`,
+			expCode: http.StatusOK,
+		},
+		"no related products": {
+			givenID:         "1",
+			mockRelatedCtrl: mockRelatedCtrl{expCall: true, productID: 1},
+			expResp:         `[]`,
+			expCode:         http.StatusOK,
+		},
+		"product not found": {
+			givenID: "100",
+			mockRelatedCtrl: mockRelatedCtrl{
+				expCall:   true,
+				productID: 100,
+				err:       controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"limit too large": {
+			givenID:    "1",
+			givenQuery: "?limit=50",
+			mockRelatedCtrl: mockRelatedCtrl{
+				expCall:   true,
+				productID: 1,
+				limit:     50,
+				err:       controllers.ErrInvalidRelatedProductsLimit,
+			},
+			expResp: `{"message":"limit must be between 1 and 20"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"invalid limit": {
+			givenID:    "1",
+			givenQuery: "?limit=abc",
+			expResp:    `{"message":"limit must be between 1 and 20"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid product ID": {
+			givenID: "abc",
+			expResp: `{"message":"invalid product ID"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/"+tc.givenID+"/related"+tc.givenQuery, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", tc.givenID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockRelatedCtrl.expCall {
+				mockController.On("GetRelatedProducts", r.Context(), tc.mockRelatedCtrl.productID, tc.mockRelatedCtrl.limit, tc.mockRelatedCtrl.currency).Return(tc.mockRelatedCtrl.output, tc.mockRelatedCtrl.err)
+			}
+
+			handler.GetRelatedProducts(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
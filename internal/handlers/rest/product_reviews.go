@@ -0,0 +1,191 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type productReviewRequest struct {
+	UserID int    `json:"user_id"`
+	Rating int    `json:"rating"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+type ProductReviewResponse struct {
+	ID          int        `json:"id"`
+	ProductID   int        `json:"product_id"`
+	UserID      int        `json:"user_id"`
+	Rating      int        `json:"rating"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	Status      string     `json:"status"`
+	Note        string     `json:"note,omitempty"`
+	ModeratedAt *time.Time `json:"moderated_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// toProductReviewResponse converts a review of a product in controller layer to the response
+func toProductReviewResponse(pr controllers.ProductReviewOutput) ProductReviewResponse {
+	return ProductReviewResponse{
+		ID:          pr.ID,
+		ProductID:   pr.ProductID,
+		UserID:      pr.UserID,
+		Rating:      pr.Rating,
+		Title:       pr.Title,
+		Body:        pr.Body,
+		Status:      pr.Status,
+		Note:        pr.Note,
+		ModeratedAt: pr.ModeratedAt,
+		CreatedAt:   pr.CreatedAt,
+		UpdatedAt:   pr.UpdatedAt,
+	}
+}
+
+// CreateProductReview gets the review of the product in url param from body request, calls to CreateProductReview controller
+// and returns the pending review
+func (h *Handler) CreateProductReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	prReq := productReviewRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&prReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if prReq.UserID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+	if prReq.Rating < 1 || prReq.Rating > 5 {
+		render.Render(w, r, ErrInvalidRating)
+		return
+	}
+	if strings.TrimSpace(prReq.Body) == "" {
+		render.Render(w, r, ErrMissingReviewBody)
+		return
+	}
+
+	review, err := h.Controller.CreateProductReview(ctx, controllers.ProductReviewInput{
+		ProductID: productID,
+		UserID:    prReq.UserID,
+		Rating:    prReq.Rating,
+		Title:     strings.TrimSpace(prReq.Title),
+		Body:      strings.TrimSpace(prReq.Body),
+	})
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toProductReviewResponse(review), http.StatusCreated)
+}
+
+type reviewStatusRequest struct {
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+// ModerateProductReview moves the review in url param to the status from body request and returns the status
+func (h *Handler) ModerateProductReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reviewID, err := strconv.Atoi(chi.URLParam(r, "reviewID"))
+	if err != nil || reviewID <= 0 {
+		render.Render(w, r, ErrInvalidReviewID)
+		return
+	}
+
+	rsReq := reviewStatusRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&rsReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	status := strings.ToUpper(strings.TrimSpace(rsReq.Status))
+	if !controllers.IsValidReviewStatus(status) {
+		render.Render(w, r, ErrInvalidReviewStatus)
+		return
+	}
+
+	if err := h.Controller.ModerateProductReview(ctx, reviewID, status, strings.TrimSpace(rsReq.Note)); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+// GetProductReviews retrieves a page of the reviews of the product in url param, newest first. The approved reviews are
+// returned unless the status query param is given. The total count of the reviews is returned in the X-Total-Count header
+func (h *Handler) GetProductReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	filter := controllers.ProductReviewFilterCtrl{ProductID: productID}
+	if status := strings.ToUpper(strings.TrimSpace(query.Get("status"))); status != "" {
+		if !controllers.IsValidReviewStatus(status) {
+			render.Render(w, r, ErrInvalidReviewStatus)
+			return
+		}
+		filter.Status = status
+	}
+
+	if limit := strings.TrimSpace(query.Get("limit")); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			render.Render(w, r, ErrInvalidReviewLimit)
+			return
+		}
+		filter.Limit = l
+	}
+
+	if offset := strings.TrimSpace(query.Get("offset")); offset != "" {
+		o, err := strconv.Atoi(offset)
+		if err != nil || o < 0 {
+			render.Render(w, r, ErrInvalidOffset)
+			return
+		}
+		filter.Offset = o
+	}
+
+	reviews, totalCount, err := h.Controller.GetProductReviews(ctx, filter)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := make([]ProductReviewResponse, 0, len(reviews))
+	for _, pr := range reviews {
+		resp = append(resp, toProductReviewResponse(pr))
+	}
+
+	w.Header().Set("X-Total-Count", strconv.FormatInt(totalCount, 10))
+	utils.RenderJson(w, resp, http.StatusOK)
+}
@@ -0,0 +1,281 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateProductReview in Handler layer
+func Test_ProductReviewHandler_CreateProductReview(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockReviewCtrl struct {
+		expCall bool
+		input   controllers.ProductReviewInput
+		output  controllers.ProductReviewOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenProductID string
+		givenInput     string
+		mockReviewCtrl mockReviewCtrl
+		expResp        string
+		expCode        int
+	}{
+		"create review successfully": {
+			givenProductID: "1",
+			givenInput:     `{"user_id":2,"rating":4,"title":" Good ","body":" Works well "}`,
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				input:   controllers.ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Title: "Good", Body: "Works well"},
+				output:  controllers.ProductReviewOutput{ID: 5, ProductID: 1, UserID: 2, Rating: 4, Title: "Good", Body: "Works well", Status: controllers.ReviewStatusPending, CreatedAt: createdAt, UpdatedAt: createdAt},
+			},
+			expResp: `{"id":5,"product_id":1,"user_id":2,"rating":4,"title":"Good","body":"Works well","status":"PENDING","created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusCreated,
+		},
+		"not a verified buyer": {
+			givenProductID: "1",
+			givenInput:     `{"user_id":2,"rating":4,"body":"Works well"}`,
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				input:   controllers.ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Body: "Works well"},
+				err:     controllers.ErrReviewerNotVerified,
+			},
+			expResp: `{"message":"only a customer who bought the product can review it"}`,
+			expCode: http.StatusForbidden,
+		},
+		"already reviewed": {
+			givenProductID: "1",
+			givenInput:     `{"user_id":2,"rating":4,"body":"Works well"}`,
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				input:   controllers.ProductReviewInput{ProductID: 1, UserID: 2, Rating: 4, Body: "Works well"},
+				err:     controllers.ErrProductReviewExists,
+			},
+			expResp: `{"message":"the customer has already reviewed the product"}`,
+			expCode: http.StatusConflict,
+		},
+		"invalid rating": {
+			givenProductID: "1",
+			givenInput:     `{"user_id":2,"rating":0,"body":"Works well"}`,
+			expResp:        `{"message":"rating must be between 1 and 5"}`,
+			expCode:        http.StatusBadRequest,
+		},
+		"blank text": {
+			givenProductID: "1",
+			givenInput:     `{"user_id":2,"rating":3}`,
+			expResp:        `{"message":"review text cannot be blank"}`,
+			expCode:        http.StatusBadRequest,
+		},
+		"invalid product id": {
+			givenProductID: "abc",
+			givenInput:     `{"user_id":2,"rating":3,"body":"Works well"}`,
+			expResp:        `{"message":"invalid product ID"}`,
+			expCode:        http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/"+tc.givenProductID+"/reviews", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", tc.givenProductID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockReviewCtrl.expCall {
+				mockController.On("CreateProductReview", r.Context(), tc.mockReviewCtrl.input).Return(tc.mockReviewCtrl.output, tc.mockReviewCtrl.err)
+			}
+
+			handler.CreateProductReview(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test ModerateProductReview in Handler layer
+func Test_ProductReviewHandler_ModerateProductReview(t *testing.T) {
+	type mockReviewCtrl struct {
+		expCall bool
+		status  string
+		note    string
+		err     error
+	}
+	testCases := map[string]struct {
+		givenReviewID  string
+		givenInput     string
+		mockReviewCtrl mockReviewCtrl
+		expResp        string
+		expCode        int
+	}{
+		"approve review successfully": {
+			givenReviewID: "5",
+			givenInput:    `{"status":"approved","note":" looks fine "}`,
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				status:  controllers.ReviewStatusApproved,
+				note:    "looks fine",
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusOK,
+		},
+		"invalid transition": {
+			givenReviewID: "5",
+			givenInput:    `{"status":"PENDING"}`,
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				status:  controllers.ReviewStatusPending,
+				err:     controllers.ErrInvalidReviewStatus,
+			},
+			expResp: `{"message":"invalid review status"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"review not found": {
+			givenReviewID: "5",
+			givenInput:    `{"status":"REJECTED"}`,
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				status:  controllers.ReviewStatusRejected,
+				err:     controllers.ErrProductReviewNotFound,
+			},
+			expResp: `{"message":"product review not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"unknown status": {
+			givenReviewID: "5",
+			givenInput:    `{"status":"DELETED"}`,
+			expResp:       `{"message":"invalid review status"}`,
+			expCode:       http.StatusBadRequest,
+		},
+		"invalid review id": {
+			givenReviewID: "0",
+			givenInput:    `{"status":"APPROVED"}`,
+			expResp:       `{"message":"invalid review ID"}`,
+			expCode:       http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPut, "/reviews/"+tc.givenReviewID+"/status", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("reviewID", tc.givenReviewID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockReviewCtrl.expCall {
+				mockController.On("ModerateProductReview", r.Context(), 5, tc.mockReviewCtrl.status, tc.mockReviewCtrl.note).Return(tc.mockReviewCtrl.err)
+			}
+
+			handler.ModerateProductReview(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test GetProductReviews in Handler layer
+func Test_ProductReviewHandler_GetProductReviews(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockReviewCtrl struct {
+		expCall    bool
+		filter     controllers.ProductReviewFilterCtrl
+		output     []controllers.ProductReviewOutput
+		totalCount int64
+		err        error
+	}
+	testCases := map[string]struct {
+		givenQuery     string
+		mockReviewCtrl mockReviewCtrl
+		expResp        string
+		expTotal       string
+		expCode        int
+	}{
+		"get reviews successfully": {
+			givenQuery: "?limit=1&offset=1",
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				filter:  controllers.ProductReviewFilterCtrl{ProductID: 1, Limit: 1, Offset: 1},
+				output: []controllers.ProductReviewOutput{
+					{ID: 5, ProductID: 1, UserID: 2, Rating: 5, Body: "Great", Status: controllers.ReviewStatusApproved, ModeratedAt: &createdAt, CreatedAt: createdAt, UpdatedAt: createdAt},
+				},
+				totalCount: 2,
+			},
+			expResp:  `[{"id":5,"product_id":1,"user_id":2,"rating":5,"title":"","body":"Great","status":"APPROVED","moderated_at":"2023-07-01T00:00:00Z","created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z"}]`,
+			expTotal: "2",
+			expCode:  http.StatusOK,
+		},
+		"get pending reviews without any": {
+			givenQuery: "?status=pending",
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				filter:  controllers.ProductReviewFilterCtrl{ProductID: 1, Status: controllers.ReviewStatusPending},
+			},
+			expResp:  `[]`,
+			expTotal: "0",
+			expCode:  http.StatusOK,
+		},
+		"product not found": {
+			mockReviewCtrl: mockReviewCtrl{
+				expCall: true,
+				filter:  controllers.ProductReviewFilterCtrl{ProductID: 1},
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"invalid limit": {
+			givenQuery: "?limit=0",
+			expResp:    `{"message":"limit must be between 1 and 100"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid status": {
+			givenQuery: "?status=deleted",
+			expResp:    `{"message":"invalid review status"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/1/reviews"+tc.givenQuery, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockReviewCtrl.expCall {
+				mockController.On("GetProductReviews", r.Context(), tc.mockReviewCtrl.filter).Return(tc.mockReviewCtrl.output, tc.mockReviewCtrl.totalCount, tc.mockReviewCtrl.err)
+			}
+
+			handler.GetProductReviews(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expTotal, w.Header().Get("X-Total-Count"))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type productSearchResponse struct {
+	ProductResponse
+	Rank          float64 `json:"rank"`
+	NameHighlight string  `json:"name_highlight"`
+	Snippet       string  `json:"snippet"`
+}
+
+// SearchProducts retrieves a page of the products whose name or description match the q query param, the best matches first.
+// The total count of the matching products is returned in the X-Total-Count header
+func (h *Handler) SearchProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := controllers.ProductSearchFilter{Query: strings.TrimSpace(query.Get("q"))}
+	if filter.Query == "" {
+		render.Render(w, r, ErrMissingSearchQuery)
+		return
+	}
+
+	if limit := strings.TrimSpace(query.Get("limit")); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			render.Render(w, r, ErrInvalidProductLimit)
+			return
+		}
+		filter.Limit = l
+	}
+
+	if offset := strings.TrimSpace(query.Get("offset")); offset != "" {
+		o, err := strconv.Atoi(offset)
+		if err != nil || o < 0 {
+			render.Render(w, r, ErrInvalidOffset)
+			return
+		}
+		filter.Offset = o
+	}
+
+	// the prices are converted to the currency if given
+	if currency := strings.ToUpper(strings.TrimSpace(query.Get("currency"))); currency != "" {
+		if !controllers.IsSupportedCurrency(currency) {
+			render.Render(w, r, ErrInvalidCurrency)
+			return
+		}
+		filter.Currency = currency
+	}
+
+	results, totalCount, err := h.Controller.SearchProducts(ctx, filter)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := make([]productSearchResponse, 0, len(results))
+	for _, p := range results {
+		resp = append(resp, productSearchResponse{
+			ProductResponse: ProductResponse{
+				ID:            p.ID,
+				Name:          p.Name,
+				Description:   p.Description,
+				Price:         p.Price,
+				Quantity:      p.Quantity,
+				AuthorID:      p.AuthorID,
+				CategoryName:  p.CategoryName,
+				Currency:      p.Currency,
+				CreatedAt:     p.CreatedAt,
+				UpdatedAt:     p.UpdatedAt,
+				RatingAverage: p.RatingAverage,
+				ReviewCount:   p.ReviewCount,
+			},
+			Rank:          p.Rank,
+			NameHighlight: p.NameHighlight,
+			Snippet:       p.Snippet,
+		})
+	}
+
+	w.Header().Set("X-Total-Count", strconv.FormatInt(totalCount, 10))
+	utils.RenderJson(w, resp, http.StatusOK)
+}
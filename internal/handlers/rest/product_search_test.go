@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test SearchProducts in Handler layer
+func Test_ProductHandler_SearchProducts(t *testing.T) {
+	type mockSearchCtrl struct {
+		expCall    bool
+		filter     controllers.ProductSearchFilter
+		output     []controllers.ProductSearchOutput
+		totalCount int64
+		err        error
+	}
+	testCases := map[string]struct {
+		givenQuery     string
+		mockSearchCtrl mockSearchCtrl
+		expResp        string
+		expCode        int
+		expTotalCount  string
+	}{
+		"search products successfully": {
+			givenQuery: "q=dien+thoai&limit=1&offset=2&currency=vnd",
+			mockSearchCtrl: mockSearchCtrl{
+				expCall: true,
+				filter:  controllers.ProductSearchFilter{Query: "dien thoai", Limit: 1, Offset: 2, Currency: controllers.CurrencyVND},
+				output: []controllers.ProductSearchOutput{
+					{
+						ProductOutput: controllers.ProductOutput{ID: 1, Name: "Điện thoại", Description: "Điện thoại Apple", Price: decimal.NewFromInt(20000000), Quantity: 5, AuthorID: 2, CategoryName: "Smartphone", Currency: controllers.CurrencyVND},
+						Rank:          0.5,
+						NameHighlight: "<b>Điện</b> <b>thoại</b>",
+						Snippet:       "<b>Điện</b> <b>thoại</b> Apple",
+					},
+				},
+				totalCount: 3,
+			},
+			expResp:       `[{"id":1,"name":"Điện thoại","description":"Điện thoại Apple","price":"20000000","quantity":5,"author_id":2,"category":"Smartphone","currency":"VND","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z","rating_average":"0","review_count":0,"rank":0.5,"name_highlight":"\u003cb\u003eĐiện\u003c/b\u003e \u003cb\u003ethoại\u003c/b\u003e","snippet":"\u003cb\u003eĐiện\u003c/b\u003e \u003cb\u003ethoại\u003c/b\u003e Apple"}]`,
+			expCode:       http.StatusOK,
+			expTotalCount: "3",
+		},
+		"no product matches": {
+			givenQuery: "q=laptop",
+			mockSearchCtrl: mockSearchCtrl{
+				expCall: true,
+				filter:  controllers.ProductSearchFilter{Query: "laptop"},
+			},
+			expResp:       `[]`,
+			expCode:       http.StatusOK,
+			expTotalCount: "0",
+		},
+		"query too long": {
+			givenQuery: "q=laptop",
+			mockSearchCtrl: mockSearchCtrl{
+				expCall: true,
+				filter:  controllers.ProductSearchFilter{Query: "laptop"},
+				err:     controllers.ErrSearchQueryTooLong,
+			},
+			expResp: `{"message":"search query too long"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"missing query": {
+			givenQuery: "q=+",
+			expResp:    `{"message":"search query cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid limit": {
+			givenQuery: "q=laptop&limit=0",
+			expResp:    `{"message":"limit must be between 1 and 100"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid currency": {
+			givenQuery: "q=laptop&currency=EUR",
+			expResp:    `{"message":"` + ErrInvalidCurrency.Message + `"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/search?"+tc.givenQuery, nil)
+			w := httptest.NewRecorder()
+
+			if tc.mockSearchCtrl.expCall {
+				mockController.On("SearchProducts", context.Background(), tc.mockSearchCtrl.filter).Return(tc.mockSearchCtrl.output, tc.mockSearchCtrl.totalCount, tc.mockSearchCtrl.err)
+			}
+
+			handler.SearchProducts(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			assert.Equal(t, tc.expTotalCount, w.Header().Get("X-Total-Count"))
+			mockController.AssertExpectations(t)
+		})
+	}
+}
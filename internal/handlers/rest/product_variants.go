@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type variantRequest struct {
+	SKU string `json:"sku"`
+	// Price overrides the price of the product, the variant is sold at the price of the product if not given
+	Price    decimal.NullDecimal `json:"price"`
+	Quantity int                 `json:"quantity"`
+	Options  map[string]string   `json:"options"`
+}
+
+// CreateVariant gets the variant data from body request, calls to CreateVariant controller and returns the status
+func (h *Handler) CreateVariant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	vReq := variantRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&vReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if errResp := validateVariant(vReq); errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+	if len(strings.TrimSpace(vReq.SKU)) == 0 {
+		render.Render(w, r, ErrMissingSKU)
+		return
+	}
+	if len(vReq.Options) == 0 {
+		render.Render(w, r, ErrMissingVariantOptions)
+		return
+	}
+
+	options := make(map[string]string)
+	for name, value := range vReq.Options {
+		options[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+
+	if err := h.Controller.CreateVariant(ctx, controllers.VariantInput{
+		ProductID: productID,
+		SKU:       strings.TrimSpace(vReq.SKU),
+		Price:     vReq.Price,
+		Quantity:  vReq.Quantity,
+		Options:   options,
+	}); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+// validateVariant checks the price and the quantity of a variant request
+func validateVariant(vReq variantRequest) *ErrorResponse {
+	if vReq.Price.Valid && !vReq.Price.Decimal.IsPositive() {
+		return ErrInvalidPrice
+	}
+	if vReq.Quantity < 0 {
+		return ErrInvalidQuantity
+	}
+
+	return nil
+}
+
+type VariantOptionResponse struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type VariantResponse struct {
+	ID        int                     `json:"id"`
+	ProductID int                     `json:"product_id"`
+	SKU       string                  `json:"sku"`
+	Price     decimal.Decimal         `json:"price"`
+	Currency  string                  `json:"currency"`
+	Quantity  int                     `json:"quantity"`
+	Options   []VariantOptionResponse `json:"options"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+// GetVariants retrieves the variants of the product in url param, the prices are converted to the currency query param if given
+func (h *Handler) GetVariants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		render.Render(w, r, ErrInvalidCurrency)
+		return
+	}
+
+	variants, err := h.Controller.GetVariants(ctx, productID, currency)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	vResp := []VariantResponse{}
+	for _, v := range variants {
+		resp := VariantResponse{
+			ID:        v.ID,
+			ProductID: v.ProductID,
+			SKU:       v.SKU,
+			Price:     v.Price,
+			Currency:  v.Currency,
+			Quantity:  v.Quantity,
+			Options:   []VariantOptionResponse{},
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		}
+		for _, o := range v.Options {
+			resp.Options = append(resp.Options, VariantOptionResponse{Name: o.Name, Value: o.Value})
+		}
+		vResp = append(vResp, resp)
+	}
+
+	utils.RenderJson(w, vResp, http.StatusOK)
+}
+
+// UpdateVariant updates the price override and the quantity of the variant in url param and returns the status
+func (h *Handler) UpdateVariant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	variantID, err := strconv.Atoi(chi.URLParam(r, "variantID"))
+	if err != nil || variantID <= 0 {
+		render.Render(w, r, ErrInvalidVariantID)
+		return
+	}
+
+	vReq := variantRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&vReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if errResp := validateVariant(vReq); errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if err := h.Controller.UpdateVariant(ctx, productID, variantID, vReq.Price, vReq.Quantity); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
@@ -0,0 +1,309 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Test CreateVariant in Handler layer
+func Test_ProductVariantHandler_CreateVariant(t *testing.T) {
+	type mockVariantCtrl struct {
+		expCall      bool
+		variantInput controllers.VariantInput
+		err          error
+	}
+	testCases := map[string]struct {
+		productID       int
+		givenInput      string
+		mockVariantCtrl mockVariantCtrl
+		expResp         string
+		expCode         int
+	}{
+		"create variant successfully": {
+			productID:  1,
+			givenInput: `{"sku":" TS-M-RED ","price":120000,"quantity":5,"options":{"Size":"M","Color":" Red "}}`,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall: true,
+				variantInput: controllers.VariantInput{
+					ProductID: 1,
+					SKU:       "TS-M-RED",
+					Price:     decimal.NewNullDecimal(decimal.NewFromInt(120000)),
+					Quantity:  5,
+					Options:   map[string]string{"Size": "M", "Color": "Red"},
+				},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusCreated,
+		},
+		"create variant with existing sku": {
+			productID:  1,
+			givenInput: `{"sku":"TS-M-RED","quantity":5,"options":{"Size":"M"}}`,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall: true,
+				variantInput: controllers.VariantInput{
+					ProductID: 1,
+					SKU:       "TS-M-RED",
+					Quantity:  5,
+					Options:   map[string]string{"Size": "M"},
+				},
+				err: controllers.ErrSKUExists,
+			},
+			expResp: `{"message":"sku already exists"}`,
+			expCode: http.StatusConflict,
+		},
+		"create variant with product not found": {
+			productID:  100,
+			givenInput: `{"sku":"TS-M-RED","quantity":5,"options":{"Size":"M"}}`,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall: true,
+				variantInput: controllers.VariantInput{
+					ProductID: 100,
+					SKU:       "TS-M-RED",
+					Quantity:  5,
+					Options:   map[string]string{"Size": "M"},
+				},
+				err: controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"create variant with invalid product id": {
+			productID:  -1,
+			givenInput: `{"sku":"TS-M-RED","quantity":5,"options":{"Size":"M"}}`,
+			expResp:    `{"message":"invalid product ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create variant with missing sku": {
+			productID:  1,
+			givenInput: `{"quantity":5,"options":{"Size":"M"}}`,
+			expResp:    `{"message":"sku cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create variant with missing options": {
+			productID:  1,
+			givenInput: `{"sku":"TS-M-RED","quantity":5}`,
+			expResp:    `{"message":"a variant must have at least one option with a name and a value"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create variant with negative price": {
+			productID:  1,
+			givenInput: `{"sku":"TS-M-RED","price":-1,"quantity":5,"options":{"Size":"M"}}`,
+			expResp:    `{"message":"price must be greater than 0 and less than 15 digits"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create variant with invalid JSON": {
+			productID:  1,
+			givenInput: `{"sku":"TS-M-RED"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/variants", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			// New route
+			rctx := chi.NewRouteContext()
+			// Add product id to url params
+			rctx.URLParams.Add("productID", strconv.Itoa(tc.productID))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockVariantCtrl.expCall {
+				mockController.On("CreateVariant", r.Context(), tc.mockVariantCtrl.variantInput).Return(tc.mockVariantCtrl.err)
+			}
+
+			handler.CreateVariant(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+
+			if tc.mockVariantCtrl.expCall {
+				mockController.AssertCalled(t, "CreateVariant", mock.Anything, tc.mockVariantCtrl.variantInput)
+			}
+		})
+	}
+}
+
+// Test GetVariants in Handler layer
+func Test_ProductVariantHandler_GetVariants(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockVariantCtrl struct {
+		expCall  bool
+		currency string
+		output   []controllers.VariantOutput
+		err      error
+	}
+	testCases := map[string]struct {
+		productID       int
+		currency        string
+		mockVariantCtrl mockVariantCtrl
+		expResp         string
+		expCode         int
+	}{
+		"get variants successfully": {
+			productID: 1,
+			currency:  "usd",
+			mockVariantCtrl: mockVariantCtrl{
+				expCall:  true,
+				currency: controllers.CurrencyUSD,
+				output: []controllers.VariantOutput{
+					{ID: 2, ProductID: 1, SKU: "TS-M-RED", Price: decimal.NewFromFloat(4.8), Currency: controllers.CurrencyUSD, Quantity: 5, Options: []controllers.VariantOptionOutput{{Name: "Color", Value: "Red"}, {Name: "Size", Value: "M"}}, CreatedAt: createdAt, UpdatedAt: createdAt},
+				},
+			},
+			expResp: `[{"id":2,"product_id":1,"sku":"TS-M-RED","price":"4.8","currency":"USD","quantity":5,"options":[{"name":"Color","value":"Red"},{"name":"Size","value":"M"}],"created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z"}]`,
+			expCode: http.StatusOK,
+		},
+		"get variants of product without variants": {
+			productID: 1,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall: true,
+			},
+			expResp: `[]`,
+			expCode: http.StatusOK,
+		},
+		"get variants with product not found": {
+			productID: 100,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall: true,
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"get variants with invalid currency": {
+			productID: 1,
+			currency:  "EUR",
+			expResp:   `{"message":"invalid currency, currency must be VND or USD"}`,
+			expCode:   http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/variants?currency="+tc.currency, nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", strconv.Itoa(tc.productID))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockVariantCtrl.expCall {
+				mockController.On("GetVariants", r.Context(), tc.productID, tc.mockVariantCtrl.currency).Return(tc.mockVariantCtrl.output, tc.mockVariantCtrl.err)
+			}
+
+			handler.GetVariants(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test UpdateVariant in Handler layer
+func Test_ProductVariantHandler_UpdateVariant(t *testing.T) {
+	type mockVariantCtrl struct {
+		expCall  bool
+		price    decimal.NullDecimal
+		quantity int
+		err      error
+	}
+	testCases := map[string]struct {
+		variantID       string
+		givenInput      string
+		mockVariantCtrl mockVariantCtrl
+		expResp         string
+		expCode         int
+	}{
+		"update variant successfully": {
+			variantID:  "2",
+			givenInput: `{"price":90000,"quantity":3}`,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall:  true,
+				price:    decimal.NewNullDecimal(decimal.NewFromInt(90000)),
+				quantity: 3,
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusOK,
+		},
+		"update variant back to the product price": {
+			variantID:  "2",
+			givenInput: `{"quantity":3}`,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall:  true,
+				quantity: 3,
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusOK,
+		},
+		"update variant not found": {
+			variantID:  "20",
+			givenInput: `{"quantity":3}`,
+			mockVariantCtrl: mockVariantCtrl{
+				expCall:  true,
+				quantity: 3,
+				err:      controllers.ErrVariantNotFound,
+			},
+			expResp: `{"message":"variant not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"update variant with invalid variant id": {
+			variantID:  "abc",
+			givenInput: `{"quantity":3}`,
+			expResp:    `{"message":"invalid variant ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"update variant with negative quantity": {
+			variantID:  "2",
+			givenInput: `{"quantity":-3}`,
+			expResp:    `{"message":"quantity must be non-negative"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPut, "/products/variants", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			rctx.URLParams.Add("variantID", tc.variantID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			variantID, _ := strconv.Atoi(tc.variantID)
+			if tc.mockVariantCtrl.expCall {
+				mockController.On("UpdateVariant", r.Context(), 1, variantID, tc.mockVariantCtrl.price, tc.mockVariantCtrl.quantity).Return(tc.mockVariantCtrl.err)
+			}
+
+			handler.UpdateVariant(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
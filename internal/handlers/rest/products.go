@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -26,6 +27,38 @@ type productRequest struct {
 	Quantity     int             `json:"quantity"`
 	AuthorID     int             `json:"author_id"`
 	CategoryName string          `json:"category"`
+	Weight       decimal.Decimal `json:"weight"`
+	Currency     string          `json:"currency"`
+	// SKU is generated on create if not given, it cannot be changed on update
+	SKU string `json:"sku"`
+	// ReorderThreshold is kept on update if not given
+	ReorderThreshold *int `json:"reorder_threshold"`
+	// Tags and Attributes are kept on update if not given, the attributes are given by name as strings, numbers or booleans
+	Tags       []string               `json:"tags"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// ProductAttributeResponse is the value of an attribute of a product
+type ProductAttributeResponse struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Unit  string `json:"unit,omitempty"`
+	Value string `json:"value"`
+}
+
+// toProductAttributeResponses converts the values of the attributes of a product to the responses
+func toProductAttributeResponses(attributes []controllers.ProductAttributeOutput) []ProductAttributeResponse {
+	var resp []ProductAttributeResponse
+	for _, a := range attributes {
+		resp = append(resp, ProductAttributeResponse{
+			Name:  a.Name,
+			Type:  a.Type,
+			Unit:  a.Unit,
+			Value: a.Value,
+		})
+	}
+
+	return resp
 }
 
 // CreateProduct gets the product data from body request, calls to CreateProduct controller and returns the status
@@ -54,7 +87,8 @@ func (h *Handler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 	utils.RenderJson(w, response, http.StatusCreated)
 }
 
-// UpdateProduct gets the product data from body request, calls to UpdateProduct controller and returns the status
+// UpdateProduct gets the product data from body request, calls to UpdateProduct controller and returns the status.
+// The If-Match header must be the ETag of the product the update is based on
 func (h *Handler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -77,6 +111,10 @@ func (h *Handler) UpdateProduct(w http.ResponseWriter, r *http.Request) {
 	}
 
 	product.ID = id
+	if product.ExpectedVersion, errResp = parseIfMatch(r); errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
 
 	if err = h.Controller.UpdateProduct(ctx, product); err != nil {
 		render.Render(w, r, convertCtrlError(err))
@@ -105,25 +143,160 @@ func (h *Handler) DeleteProduct(w http.ResponseWriter, r *http.Request) {
 	utils.RenderJson(w, response, http.StatusOK)
 }
 
+type productAuthorResponse struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type productCategoryResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type ProductDetailResponse struct {
+	ID          int                        `json:"id"`
+	SKU         string                     `json:"sku,omitempty"`
+	Slug        string                     `json:"slug,omitempty"`
+	Name        string                     `json:"name"`
+	Description string                     `json:"description"`
+	Price       decimal.Decimal            `json:"price"`
+	Quantity    int                        `json:"quantity"`
+	Weight      decimal.Decimal            `json:"weight"`
+	Currency    string                     `json:"currency"`
+	Author      productAuthorResponse      `json:"author"`
+	Category    productCategoryResponse    `json:"category"`
+	Images      []ProductImageResponse     `json:"images,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Attributes  []ProductAttributeResponse `json:"attributes,omitempty"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	UpdatedAt   time.Time                  `json:"updated_at"`
+	// ReorderThreshold is omitted if the low stock alerts of the product are disabled
+	ReorderThreshold int `json:"reorder_threshold,omitempty"`
+	// RatingAverage is the average rating of the approved reviews, 0 if the product has none
+	RatingAverage decimal.Decimal `json:"rating_average"`
+	ReviewCount   int             `json:"review_count"`
+	// Availability is the quantity available in each warehouse, the quantity is the total of them
+	Availability []WarehouseStockResponse `json:"availability,omitempty"`
+}
+
+// GetProduct retrieves a product with its author, category and stock in each warehouse by the id in url param,
+// the price is converted to the currency query param if given. The version of the product is returned in the ETag header
+func (h *Handler) GetProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || id <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		render.Render(w, r, ErrInvalidCurrency)
+		return
+	}
+
+	product, err := h.Controller.GetProduct(ctx, id, currency)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	w.Header().Set("ETag", productETag(product.Version))
+	utils.RenderJson(w, toProductDetailResponse(product), http.StatusOK)
+}
+
+// toProductDetailResponse converts a product with its author, category and stock in controller layer to the response
+func toProductDetailResponse(product controllers.ProductOutputGraph) ProductDetailResponse {
+	return ProductDetailResponse{
+		ID:          product.ID,
+		SKU:         product.SKU,
+		Slug:        product.Slug,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Quantity:    product.Quantity,
+		Weight:      product.Weight,
+		Currency:    product.Currency,
+		Author: productAuthorResponse{
+			ID:    product.Author.ID,
+			Name:  product.Author.Name,
+			Email: product.Author.Email,
+		},
+		Category: productCategoryResponse{
+			ID:          product.Category.ID,
+			Name:        product.Category.Name,
+			Description: product.Category.Description,
+		},
+		Images:           toProductImageResponses(product.Images),
+		Tags:             product.Tags,
+		Attributes:       toProductAttributeResponses(product.Attributes),
+		CreatedAt:        product.CreatedAt,
+		UpdatedAt:        product.UpdatedAt,
+		ReorderThreshold: product.ReorderThreshold,
+		RatingAverage:    product.RatingAverage,
+		ReviewCount:      product.ReviewCount,
+		Availability:     toWarehouseStockResponses(product.Availability),
+	}
+}
+
 type ProductFilterReq struct {
-	Name  string
-	Date  string
-	Email []string
+	Name     string
+	Date     string
+	Email    []string
+	Currency string
 }
 
 type ProductResponse struct {
-	ID           int             `json:"id"`
-	Name         string          `json:"name"`
-	Description  string          `json:"description"`
-	Price        decimal.Decimal `json:"price"`
-	Quantity     int             `json:"quantity"`
-	AuthorID     int             `json:"author_id"`
-	CategoryName string          `json:"category"`
-	CreatedAt    time.Time       `json:"created_at"`
-	UpdatedAt    time.Time       `json:"updated_at"`
+	ID           int                        `json:"id"`
+	SKU          string                     `json:"sku,omitempty"`
+	Slug         string                     `json:"slug,omitempty"`
+	Name         string                     `json:"name"`
+	Description  string                     `json:"description"`
+	Price        decimal.Decimal            `json:"price"`
+	Quantity     int                        `json:"quantity"`
+	AuthorID     int                        `json:"author_id"`
+	CategoryName string                     `json:"category"`
+	Currency     string                     `json:"currency,omitempty"`
+	Images       []ProductImageResponse     `json:"images,omitempty"`
+	Tags         []string                   `json:"tags,omitempty"`
+	Attributes   []ProductAttributeResponse `json:"attributes,omitempty"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
+	ArchivedAt   *time.Time                 `json:"archived_at,omitempty"`
+	// RatingAverage is the average rating of the approved reviews, 0 if the product has none
+	RatingAverage decimal.Decimal `json:"rating_average"`
+	ReviewCount   int             `json:"review_count"`
+}
+
+// toProductResponse converts a product in controller layer to the response
+func toProductResponse(p controllers.ProductOutput) ProductResponse {
+	return ProductResponse{
+		ID:            p.ID,
+		SKU:           p.SKU,
+		Slug:          p.Slug,
+		Name:          p.Name,
+		Description:   p.Description,
+		Price:         p.Price,
+		Quantity:      p.Quantity,
+		AuthorID:      p.AuthorID,
+		CategoryName:  p.CategoryName,
+		Currency:      p.Currency,
+		Images:        toProductImageResponses(p.Images),
+		Tags:          p.Tags,
+		Attributes:    toProductAttributeResponses(p.Attributes),
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+		ArchivedAt:    p.ArchivedAt,
+		RatingAverage: p.RatingAverage,
+		ReviewCount:   p.ReviewCount,
+	}
 }
 
-// GetProducts retrieves all the products in db
+// GetProducts retrieves a page of the products in db matching the filter query params.
+// The total count of the matching products is returned in the X-Total-Count header,
+// the cursor of the next page in the X-Next-Cursor header if there may be more products
 func (h *Handler) GetProducts(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	query := r.URL.Query()
@@ -134,25 +307,25 @@ func (h *Handler) GetProducts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	products, err := h.Controller.GetProducts(ctx, pCtrlFilter)
+	if errResp := validateAndConvertProductPage(query, &pCtrlFilter); errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	products, pageInfo, err := h.Controller.GetProducts(ctx, pCtrlFilter)
 	if err != nil {
 		render.Render(w, r, convertCtrlError(err))
 		return
 	}
 
+	w.Header().Set("X-Total-Count", strconv.FormatInt(pageInfo.TotalCount, 10))
+	if pageInfo.NextCursor != "" {
+		w.Header().Set("X-Next-Cursor", pageInfo.NextCursor)
+	}
+
 	var pResp []ProductResponse
 	for _, p := range products {
-		pResp = append(pResp, ProductResponse{
-			ID:           p.ID,
-			Name:         p.Name,
-			Description:  p.Description,
-			Price:        p.Price,
-			Quantity:     p.Quantity,
-			AuthorID:     p.AuthorID,
-			CategoryName: p.CategoryName,
-			CreatedAt:    p.CreatedAt,
-			UpdatedAt:    p.UpdatedAt,
-		})
+		pResp = append(pResp, toProductResponse(p))
 	}
 
 	utils.RenderJson(w, pResp, http.StatusOK)
@@ -226,6 +399,30 @@ func (h *Handler) ExportProductsToCSV(w http.ResponseWriter, r *http.Request) {
 	utils.RenderJson(w, response, http.StatusOK)
 }
 
+// productETag returns the ETag of a product in the version, the ETag is the quoted version
+func productETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseIfMatch parses the version of a product from the ETag in the If-Match header of the request
+func parseIfMatch(r *http.Request) (int, *ErrorResponse) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" {
+		return 0, ErrMissingIfMatch
+	}
+
+	// a weak ETag never matches as the comparison is strong
+	if len(ifMatch) < 2 || !strings.HasPrefix(ifMatch, `"`) || !strings.HasSuffix(ifMatch, `"`) {
+		return 0, ErrInvalidIfMatch
+	}
+	version, err := strconv.Atoi(ifMatch[1 : len(ifMatch)-1])
+	if err != nil || version <= 0 {
+		return 0, ErrInvalidIfMatch
+	}
+
+	return version, nil
+}
+
 // validateAndConvertProduct validates the product from body request and return product struct in controller layer
 func validateAndConvertProduct(pReq productRequest) (controllers.ProductInput, *ErrorResponse) {
 	if len(strings.TrimSpace(pReq.Name)) == 0 {
@@ -256,13 +453,53 @@ func validateAndConvertProduct(pReq productRequest) (controllers.ProductInput, *
 		return controllers.ProductInput{}, ErrMissingCategoryName
 	}
 
+	if pReq.Weight.IsNegative() {
+		return controllers.ProductInput{}, ErrInvalidWeight
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(pReq.Currency))
+	if currency != "" && !controllers.IsSupportedCurrency(currency) {
+		return controllers.ProductInput{}, ErrInvalidCurrency
+	}
+
+	if pReq.ReorderThreshold != nil && *pReq.ReorderThreshold < 0 {
+		return controllers.ProductInput{}, ErrInvalidReorderThreshold
+	}
+
+	// the values of the attributes are validated against the types of the attributes in the controller layer
+	var attributes map[string]string
+	if pReq.Attributes != nil {
+		attributes = make(map[string]string)
+		for name, value := range pReq.Attributes {
+			if strings.TrimSpace(name) == "" {
+				return controllers.ProductInput{}, ErrMissingAttributeName
+			}
+			switch v := value.(type) {
+			case string:
+				attributes[name] = v
+			case float64:
+				attributes[name] = strconv.FormatFloat(v, 'f', -1, 64)
+			case bool:
+				attributes[name] = strconv.FormatBool(v)
+			default:
+				return controllers.ProductInput{}, ErrInvalidAttributeValue
+			}
+		}
+	}
+
 	return controllers.ProductInput{
-		Name:         strings.TrimSpace(pReq.Name),
-		Description:  strings.TrimSpace(pReq.Description),
-		Price:        pReq.Price,
-		Quantity:     pReq.Quantity,
-		AuthorID:     pReq.AuthorID,
-		CategoryName: strings.TrimSpace(pReq.CategoryName),
+		SKU:              strings.TrimSpace(pReq.SKU),
+		Name:             strings.TrimSpace(pReq.Name),
+		Description:      strings.TrimSpace(pReq.Description),
+		Price:            pReq.Price,
+		Quantity:         pReq.Quantity,
+		AuthorID:         pReq.AuthorID,
+		CategoryName:     strings.TrimSpace(pReq.CategoryName),
+		Weight:           pReq.Weight,
+		Currency:         currency,
+		ReorderThreshold: pReq.ReorderThreshold,
+		Tags:             pReq.Tags,
+		Attributes:       attributes,
 	}, nil
 }
 
@@ -299,10 +536,159 @@ func validateAndConvertProductFilter(query url.Values) (controllers.ProductCtrlF
 		filterReq.Email = emailToList
 	}
 
+	// the prices are converted to the currency if given
+	currency := strings.ToUpper(strings.TrimSpace(query.Get("currency")))
+	if currency != "" {
+		if !controllers.IsSupportedCurrency(currency) {
+			return controllers.ProductCtrlFilter{}, ErrInvalidCurrency
+		}
+		filterReq.Currency = currency
+	}
+
 	pCtrlFilter := controllers.ProductCtrlFilter{
-		Name:  filterReq.Name,
-		Date:  filterReq.Date,
-		Email: filterReq.Email,
+		Name:     filterReq.Name,
+		Date:     filterReq.Date,
+		Email:    filterReq.Email,
+		Currency: filterReq.Currency,
+	}
+
+	for param, price := range map[string]**decimal.Decimal{"minPrice": &pCtrlFilter.MinPrice, "maxPrice": &pCtrlFilter.MaxPrice} {
+		if value := strings.TrimSpace(query.Get(param)); value != "" {
+			d, err := decimal.NewFromString(value)
+			if err != nil || d.IsNegative() {
+				return controllers.ProductCtrlFilter{}, ErrInvalidPriceFilter
+			}
+			*price = &d
+		}
 	}
+
+	if categoryID := strings.TrimSpace(query.Get("categoryID")); categoryID != "" {
+		id, err := strconv.Atoi(categoryID)
+		if err != nil || id <= 0 {
+			return controllers.ProductCtrlFilter{}, ErrInvalidCategoryID
+		}
+		pCtrlFilter.CategoryID = id
+	}
+
+	if authorID := strings.TrimSpace(query.Get("authorID")); authorID != "" {
+		id, err := strconv.Atoi(authorID)
+		if err != nil || id <= 0 {
+			return controllers.ProductCtrlFilter{}, ErrInvalidAuthorID
+		}
+		pCtrlFilter.AuthorID = id
+	}
+
+	if inStock := strings.TrimSpace(query.Get("inStock")); inStock != "" {
+		b, err := strconv.ParseBool(inStock)
+		if err != nil {
+			return controllers.ProductCtrlFilter{}, ErrInvalidInStock
+		}
+		pCtrlFilter.InStock = b
+	}
+
+	for param, date := range map[string]*time.Time{"createdFrom": &pCtrlFilter.CreatedFrom, "createdTo": &pCtrlFilter.CreatedTo} {
+		if value := strings.TrimSpace(query.Get(param)); value != "" {
+			d, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return controllers.ProductCtrlFilter{}, ErrDateBadRequest
+			}
+			*date = d
+		}
+	}
+
+	if tags := strings.TrimSpace(query.Get("tags")); tags != "" {
+		pCtrlFilter.Tags = strings.Split(tags, ",")
+	}
+
+	attributes, errResp := validateAndConvertAttributeFilters(query)
+	if errResp != nil {
+		return controllers.ProductCtrlFilter{}, errResp
+	}
+	pCtrlFilter.Attributes = attributes
+
 	return pCtrlFilter, nil
 }
+
+// validateAndConvertAttributeFilters parses the attribute filters of products from the query params attr[Name] of the value
+// and attrMin[Name] and attrMax[Name] of the range of a number attribute, the filters are sorted by name
+func validateAndConvertAttributeFilters(query url.Values) ([]controllers.AttributeFilterInput, *ErrorResponse) {
+	filters := make(map[string]*controllers.AttributeFilterInput)
+	for param, values := range query {
+		prefix, rest, ok := strings.Cut(param, "[")
+		if !ok || (prefix != "attr" && prefix != "attrMin" && prefix != "attrMax") {
+			continue
+		}
+		name := strings.TrimSpace(strings.TrimSuffix(rest, "]"))
+		if !strings.HasSuffix(rest, "]") || name == "" {
+			return nil, ErrInvalidAttributeFilter
+		}
+
+		filter, exists := filters[name]
+		if !exists {
+			filter = &controllers.AttributeFilterInput{Name: name}
+			filters[name] = filter
+		}
+
+		value := strings.TrimSpace(values[0])
+		switch prefix {
+		case "attr":
+			filter.Value = value
+		case "attrMin", "attrMax":
+			d, err := decimal.NewFromString(value)
+			if err != nil {
+				return nil, ErrInvalidAttributeFilter
+			}
+			if prefix == "attrMin" {
+				filter.Min = &d
+			} else {
+				filter.Max = &d
+			}
+		}
+	}
+
+	names := make([]string, 0, len(filters))
+	for name := range filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []controllers.AttributeFilterInput
+	for _, name := range names {
+		result = append(result, *filters[name])
+	}
+
+	return result, nil
+}
+
+// validateAndConvertProductPage parses the sort, limit, offset and cursor query params of a page of products into the filter.
+// The sort is a column of name, price, quantity or created_at, optionally followed by :asc or :desc
+func validateAndConvertProductPage(query url.Values, filter *controllers.ProductCtrlFilter) *ErrorResponse {
+	if sort := strings.TrimSpace(query.Get("sort")); sort != "" {
+		column, order, _ := strings.Cut(sort, ":")
+		if !controllers.IsValidProductSort(column) || (order != "" && order != "asc" && order != "desc") {
+			return ErrInvalidProductSort
+		}
+		filter.SortBy = column
+		filter.SortDesc = order == "desc"
+	}
+
+	if limit := strings.TrimSpace(query.Get("limit")); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			return ErrInvalidProductLimit
+		}
+		filter.Limit = l
+	}
+
+	if offset := strings.TrimSpace(query.Get("offset")); offset != "" {
+		o, err := strconv.Atoi(offset)
+		if err != nil || o < 0 {
+			return ErrInvalidOffset
+		}
+		filter.Offset = o
+	}
+
+	filter.Cursor = strings.TrimSpace(query.Get("cursor"))
+
+	return nil
+}
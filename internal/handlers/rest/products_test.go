@@ -102,6 +102,31 @@ func Test_ProductHandler_CreateProduct(t *testing.T) {
 			expResp: `{"message":"name too long"}`,
 			expCode: http.StatusBadRequest,
 		},
+		"create product with reorder threshold": {
+			mockProductCtrl: mockProductCtrl{
+				expCall: true,
+				productInput: controllers.ProductInput{
+					Name:             "iPhone 14",
+					Description:      "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+					Price:            decimal.New(1500, 0),
+					Quantity:         20,
+					AuthorID:         1,
+					CategoryName:     "Smartphone",
+					ReorderThreshold: func(v int) *int { return &v }(5),
+				},
+			},
+			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"author_id":1,"category":"Smartphone","reorder_threshold":5}`,
+			expResp:    `{"success":true}`,
+			expCode:    http.StatusCreated,
+		},
+		"create product with negative reorder threshold": {
+			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"author_id":1,"category":"Smartphone","reorder_threshold":-1}`,
+			mockProductCtrl: mockProductCtrl{
+				expCall: false,
+			},
+			expResp: `{"message":"reorder threshold must not be negative"}`,
+			expCode: http.StatusBadRequest,
+		},
 		"create product with invalid JSON": {
 			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":1,"author_id":1`,
 			mockProductCtrl: mockProductCtrl{
@@ -153,6 +178,7 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 
 	testCases := map[string]struct {
 		givenInput      string // payload provided from end-user
+		givenIfMatch    string
 		productID       int
 		mockProductCtrl mockProductCtrl
 		expResp         string
@@ -162,61 +188,108 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 			mockProductCtrl: mockProductCtrl{
 				expCall: true,
 				productInput: controllers.ProductInput{
-					ID:           1,
-					Name:         "iPhone 14",
-					Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-					Price:        decimal.New(1500, 0),
-					Quantity:     20,
-					AuthorID:     1,
-					CategoryName: "Smartphone",
+					ID:              1,
+					Name:            "iPhone 14",
+					Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+					Price:           decimal.New(1500, 0),
+					Quantity:        20,
+					AuthorID:        1,
+					CategoryName:    "Smartphone",
+					ExpectedVersion: 3,
 				},
 			},
-			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
-			productID:  1,
-			expResp:    `{"success":true}`,
-			expCode:    http.StatusOK,
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `"3"`,
+			productID:    1,
+			expResp:      `{"success":true}`,
+			expCode:      http.StatusOK,
 		},
 		"user not exists": {
 			mockProductCtrl: mockProductCtrl{
 				expCall: true,
 				productInput: controllers.ProductInput{
-					ID:           1,
-					Name:         "iPhone 14",
-					Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-					Price:        decimal.New(1500, 0),
-					Quantity:     20,
-					AuthorID:     100,
-					CategoryName: "Smartphone",
+					ID:              1,
+					Name:            "iPhone 14",
+					Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+					Price:           decimal.New(1500, 0),
+					Quantity:        20,
+					AuthorID:        100,
+					CategoryName:    "Smartphone",
+					ExpectedVersion: 3,
 				},
 				err: controllers.ErrUserNotFound,
 			},
-			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":100}`,
-			productID:  1,
-			expResp:    `{"message":"user not found"}`,
-			expCode:    http.StatusNotFound,
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":100}`,
+			givenIfMatch: `"3"`,
+			productID:    1,
+			expResp:      `{"message":"user not found"}`,
+			expCode:      http.StatusNotFound,
 		},
 		"product category not exists": {
 			mockProductCtrl: mockProductCtrl{
 				expCall: true,
 				productInput: controllers.ProductInput{
-					ID:           1,
-					Name:         "iPhone 14",
-					Description:  "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
-					Price:        decimal.New(1500, 0),
-					Quantity:     20,
-					AuthorID:     40,
-					CategoryName: "smartwatchhhh",
+					ID:              1,
+					Name:            "iPhone 14",
+					Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+					Price:           decimal.New(1500, 0),
+					Quantity:        20,
+					AuthorID:        40,
+					CategoryName:    "smartwatchhhh",
+					ExpectedVersion: 3,
 				},
 				err: controllers.ErrProductCategoryNotFound,
 			},
-			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"smartwatchhhh","author_id":40}`,
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"smartwatchhhh","author_id":40}`,
+			givenIfMatch: `"3"`,
+			productID:    1,
+			expResp:      `{"message":"product category not found"}`,
+			expCode:      http.StatusNotFound,
+		},
+		"product changed since it was read": {
+			mockProductCtrl: mockProductCtrl{
+				expCall: true,
+				productInput: controllers.ProductInput{
+					ID:              1,
+					Name:            "iPhone 14",
+					Description:     "An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage",
+					Price:           decimal.New(1500, 0),
+					Quantity:        20,
+					AuthorID:        1,
+					CategoryName:    "Smartphone",
+					ExpectedVersion: 2,
+				},
+				err: controllers.ErrProductVersionConflict,
+			},
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `"2"`,
+			productID:    1,
+			expResp:      `{"message":"the product has been changed since it was read, reload it and try again"}`,
+			expCode:      http.StatusPreconditionFailed,
+		},
+		"missing If-Match header": {
+			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
 			productID:  1,
-			expResp:    `{"message":"product category not found"}`,
-			expCode:    http.StatusNotFound,
+			mockProductCtrl: mockProductCtrl{
+				expCall: false,
+			},
+			expResp: `{"message":"the If-Match header with the ETag of the product is required"}`,
+			expCode: http.StatusPreconditionRequired,
+		},
+		"weak ETag in If-Match header": {
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `W/"3"`,
+			productID:    1,
+			mockProductCtrl: mockProductCtrl{
+				expCall: false,
+			},
+			expResp: `{"message":"the If-Match header must be the ETag of the product"}`,
+			expCode: http.StatusBadRequest,
 		},
 		"update product with missing name field": {
-			givenInput: `{"description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
-			productID:  1,
+			givenInput:   `{"description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `"3"`,
+			productID:    1,
 			mockProductCtrl: mockProductCtrl{
 				expCall: false,
 			},
@@ -225,8 +298,9 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 		},
 		"update product with name too long": {
 			// 260 'a' characters
-			givenInput: `{"name":"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
-			productID:  1,
+			givenInput:   `{"name":"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `"3"`,
+			productID:    1,
 			mockProductCtrl: mockProductCtrl{
 				expCall: false,
 			},
@@ -234,8 +308,9 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 			expCode: http.StatusBadRequest,
 		},
 		"update product with invalid JSON": {
-			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1`,
-			productID:  1,
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1`,
+			givenIfMatch: `"3"`,
+			productID:    1,
 			mockProductCtrl: mockProductCtrl{
 				expCall: false,
 			},
@@ -243,8 +318,9 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 			expCode: http.StatusBadRequest,
 		},
 		"update product with wrong type field": {
-			givenInput: `{"name":123123,"description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
-			productID:  1,
+			givenInput:   `{"name":123123,"description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `"3"`,
+			productID:    1,
 			mockProductCtrl: mockProductCtrl{
 				expCall: false,
 			},
@@ -252,8 +328,9 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 			expCode: http.StatusBadRequest,
 		},
 		"invalid product ID": {
-			givenInput: `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
-			productID:  0,
+			givenInput:   `{"name":"iPhone 14","description":"An Apple cellphone with A15 Bionic chip, 6GB RAM and 128GB storage","price":1500,"quantity":20,"category":"Smartphone","author_id":1}`,
+			givenIfMatch: `"3"`,
+			productID:    0,
 			mockProductCtrl: mockProductCtrl{
 				expCall: false,
 			},
@@ -269,6 +346,9 @@ func Test_ProductHandler_UpdateProduct(t *testing.T) {
 
 			r := httptest.NewRequest(http.MethodPut, "/products", strings.NewReader(tc.givenInput))
 			r.Header.Set("Content-Type", "application/json")
+			if tc.givenIfMatch != "" {
+				r.Header.Set("If-Match", tc.givenIfMatch)
+			}
 			w := httptest.NewRecorder()
 
 			// New route
@@ -366,17 +446,22 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 	myUpdatedTime, err := time.Parse("2006-01-02 15:04:05.999999", "2023-06-02 09:08:36.046843")
 	assert.NoError(t, err)
 	type mockProductCtrl struct {
-		expCall bool
-		query   controllers.ProductCtrlFilter
-		output  []controllers.ProductOutput
-		err     error
+		expCall  bool
+		query    controllers.ProductCtrlFilter
+		output   []controllers.ProductOutput
+		pageInfo controllers.ProductPageInfo
+		err      error
 	}
 
+	minPrice, maxPrice := decimal.NewFromInt(100), decimal.NewFromInt(2000)
+
 	testCases := map[string]struct {
 		givenFilter     string // payload provided from end-user
 		mockProductCtrl mockProductCtrl
 		expResp         string
 		expCode         int
+		expTotalCount   string
+		expNextCursor   string
 	}{
 		"get all products successfully": {
 			mockProductCtrl: mockProductCtrl{
@@ -406,8 +491,9 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 					},
 				},
 			},
-			expResp: `[{"id":194,"name":"iPhone 14","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z"},{"id":195,"name":"Macbook","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z"}]`,
-			expCode: http.StatusOK,
+			expResp:       `[{"id":194,"name":"iPhone 14","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z","rating_average":"0","review_count":0},{"id":195,"name":"Macbook","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z","rating_average":"0","review_count":0}]`,
+			expCode:       http.StatusOK,
+			expTotalCount: "0",
 		},
 		"get all products with filter request successfully": {
 			mockProductCtrl: mockProductCtrl{
@@ -429,9 +515,72 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 					},
 				},
 			},
-			givenFilter: "queryName=iPhone",
-			expResp:     `[{"id":194,"name":"iPhone 14","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z"}]`,
-			expCode:     http.StatusOK,
+			givenFilter:   "queryName=iPhone",
+			expTotalCount: "0",
+			expResp:       `[{"id":194,"name":"iPhone 14","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z","rating_average":"0","review_count":0}]`,
+			expCode:       http.StatusOK,
+		},
+		"get a page of products with filters and sort": {
+			mockProductCtrl: mockProductCtrl{
+				expCall: true,
+				query: controllers.ProductCtrlFilter{
+					MinPrice:    &minPrice,
+					MaxPrice:    &maxPrice,
+					CategoryID:  2,
+					AuthorID:    136,
+					InStock:     true,
+					CreatedFrom: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:   time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC),
+					SortBy:      controllers.ProductSortPrice,
+					SortDesc:    true,
+					Limit:       1,
+					Cursor:      "next",
+				},
+				output: []controllers.ProductOutput{
+					{
+						ID:           194,
+						Name:         "iPhone 14",
+						Description:  "An Apple smartphone with A15 chip, 6GB RAM and 512GB storage",
+						Price:        decimal.New(1500, 0),
+						Quantity:     50,
+						AuthorID:     136,
+						CategoryName: "Smartphone",
+						CreatedAt:    myCreatedTime,
+						UpdatedAt:    myUpdatedTime,
+					},
+				},
+				pageInfo: controllers.ProductPageInfo{TotalCount: 5, NextCursor: "cursor"},
+			},
+			givenFilter:   "minPrice=100&maxPrice=2000&categoryID=2&authorID=136&inStock=true&createdFrom=2023-06-01&createdTo=2023-06-30&sort=price:desc&limit=1&cursor=next",
+			expResp:       `[{"id":194,"name":"iPhone 14","description":"An Apple smartphone with A15 chip, 6GB RAM and 512GB storage","price":"1500","quantity":50,"author_id":136,"category":"Smartphone","created_at":"2023-06-02T09:08:36.046843Z","updated_at":"2023-06-02T09:08:36.046843Z","rating_average":"0","review_count":0}]`,
+			expCode:       http.StatusOK,
+			expTotalCount: "5",
+			expNextCursor: "cursor",
+		},
+		"invalid sort": {
+			givenFilter: "sort=author_id",
+			expResp:     `{"message":"invalid sort, sort must be one of name, price, quantity or created_at, optionally followed by :asc or :desc"}`,
+			expCode:     http.StatusBadRequest,
+		},
+		"invalid limit": {
+			givenFilter: "limit=abc",
+			expResp:     `{"message":"limit must be between 1 and 100"}`,
+			expCode:     http.StatusBadRequest,
+		},
+		"invalid min price": {
+			givenFilter: "minPrice=-1",
+			expResp:     `{"message":"minPrice and maxPrice must be non-negative numbers"}`,
+			expCode:     http.StatusBadRequest,
+		},
+		"cursor with offset": {
+			mockProductCtrl: mockProductCtrl{
+				expCall: true,
+				query:   controllers.ProductCtrlFilter{Offset: 20, Cursor: "next"},
+				err:     controllers.ErrCursorWithOffset,
+			},
+			givenFilter: "offset=20&cursor=next",
+			expResp:     `{"message":"cursor cannot be used with offset"}`,
+			expCode:     http.StatusBadRequest,
 		},
 		"products not found with filter": {
 			mockProductCtrl: mockProductCtrl{
@@ -440,9 +589,10 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 					Name: "imac",
 				},
 			},
-			givenFilter: "queryName=imac",
-			expResp:     `null`,
-			expCode:     http.StatusOK,
+			givenFilter:   "queryName=imac",
+			expTotalCount: "0",
+			expResp:       `null`,
+			expCode:       http.StatusOK,
 		},
 	}
 
@@ -456,12 +606,14 @@ func Test_ProductHandler_GetProducts(t *testing.T) {
 			w := httptest.NewRecorder()
 
 			if tc.mockProductCtrl.expCall {
-				mockController.On("GetProducts", context.Background(), tc.mockProductCtrl.query).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.err)
+				mockController.On("GetProducts", context.Background(), tc.mockProductCtrl.query).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.pageInfo, tc.mockProductCtrl.err)
 			}
 			handler.GetProducts(w, r)
 
 			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
 			assert.Equal(t, tc.expCode, w.Code)
+			assert.Equal(t, tc.expTotalCount, w.Header().Get("X-Total-Count"))
+			assert.Equal(t, tc.expNextCursor, w.Header().Get("X-Next-Cursor"))
 
 			if tc.mockProductCtrl.expCall {
 				mockController.AssertCalled(t, "GetProducts", context.Background(), tc.mockProductCtrl.query)
@@ -659,3 +811,103 @@ func Test_ProductHandler_ExportProductsFromCSV(t *testing.T) {
 		})
 	}
 }
+
+func Test_ProductHandler_GetProduct(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockProductCtrl struct {
+		expCall  bool
+		currency string
+		output   controllers.ProductOutputGraph
+		err      error
+	}
+	testCases := map[string]struct {
+		givenID         string
+		givenQuery      string
+		mockProductCtrl mockProductCtrl
+		expResp         string
+		expETag         string
+		expCode         int
+	}{
+		"get product successfully": {
+			givenID: "1",
+			mockProductCtrl: mockProductCtrl{
+				expCall: true,
+				output: controllers.ProductOutputGraph{
+					ID:            1,
+					Name:          "iPhone 14",
+					Description:   "Apple phone",
+					Price:         decimal.NewFromInt(20000000),
+					Quantity:      5,
+					Weight:        decimal.NewFromInt(1),
+					Currency:      controllers.CurrencyVND,
+					Author:        controllers.UserOutput{ID: 2, Name: "qthuy", Email: "qthuy@gmail.com", Password: "secret"},
+					Category:      controllers.PCateOutput{ID: 3, Name: "Smartphone", Description: "Phones"},
+					CreatedAt:     createdAt,
+					UpdatedAt:     createdAt,
+					RatingAverage: decimal.RequireFromString("4.50"),
+					ReviewCount:   2,
+					Version:       3,
+				},
+			},
+			expResp: `{"id":1,"name":"iPhone 14","description":"Apple phone","price":"20000000","quantity":5,"weight":"1","currency":"VND","author":{"id":2,"name":"qthuy","email":"qthuy@gmail.com"},"category":{"id":3,"name":"Smartphone","description":"Phones"},"created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z","rating_average":"4.5","review_count":2}`,
+			expETag: `"3"`,
+			expCode: http.StatusOK,
+		},
+		"get product in another currency": {
+			givenID:    "1",
+			givenQuery: "currency=usd",
+			mockProductCtrl: mockProductCtrl{
+				expCall:  true,
+				currency: controllers.CurrencyUSD,
+				err:      controllers.ErrExchangeRateNotFound,
+			},
+			expResp: `{"message":"exchange rate not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"product not found": {
+			givenID: "1",
+			mockProductCtrl: mockProductCtrl{
+				expCall: true,
+				err:     controllers.ErrProductNotFound,
+			},
+			expResp: `{"message":"product not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"invalid product ID": {
+			givenID: "abc",
+			expResp: `{"message":"invalid product ID"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"invalid currency": {
+			givenID:    "1",
+			givenQuery: "currency=EUR",
+			expResp:    `{"message":"invalid currency, currency must be VND or USD"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", tc.givenID)
+			r := httptest.NewRequest(http.MethodGet, "/products/"+tc.givenID+"?"+tc.givenQuery, nil)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockProductCtrl.expCall {
+				mockController.On("GetProduct", r.Context(), 1, tc.mockProductCtrl.currency).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.err)
+			}
+
+			handler.GetProduct(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expETag, w.Header().Get("ETag"))
+			assert.Equal(t, tc.expCode, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type returnRequestRequest struct {
+	OrderID int                 `json:"order_id"`
+	Reason  string              `json:"reason"`
+	Items   []returnItemRequest `json:"items"`
+}
+
+type returnItemRequest struct {
+	OrderItemID int `json:"order_item_id"`
+	Quantity    int `json:"quantity"`
+}
+
+// CreateReturnRequest gets the return request data from body request, calls to CreateReturnRequest controller and returns the status
+func (h *Handler) CreateReturnRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rReq := returnRequestRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&rReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	returnRequest, errResp := validateAndConvertReturnRequest(rReq)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if err := h.Controller.CreateReturnRequest(ctx, returnRequest); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+type returnStatusRequest struct {
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+// UpdateReturnStatus moves a return request to the status from body request and returns the status
+func (h *Handler) UpdateReturnStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	returnRequestID, err := strconv.Atoi(chi.URLParam(r, "returnRequestID"))
+	if err != nil || returnRequestID <= 0 {
+		render.Render(w, r, ErrInvalidReturnRequestID)
+		return
+	}
+
+	rsReq := returnStatusRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&rsReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	status := strings.ToUpper(strings.TrimSpace(rsReq.Status))
+	if !controllers.IsValidReturnStatus(status) {
+		render.Render(w, r, ErrInvalidReturnStatus)
+		return
+	}
+
+	if err := h.Controller.UpdateReturnStatus(ctx, returnRequestID, status, strings.TrimSpace(rsReq.Note)); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
+
+type ReturnRequestResponse struct {
+	ID                 int                  `json:"id"`
+	OrderID            int                  `json:"order_id"`
+	Status             string               `json:"status"`
+	Reason             string               `json:"reason"`
+	Note               string               `json:"note"`
+	RefundAmount       *decimal.Decimal     `json:"refund_amount,omitempty"`
+	ReplacementOrderID *int                 `json:"replacement_order_id,omitempty"`
+	ReceivedAt         *time.Time           `json:"received_at,omitempty"`
+	ResolvedAt         *time.Time           `json:"resolved_at,omitempty"`
+	CreatedAt          time.Time            `json:"created_at"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+	Items              []ReturnItemResponse `json:"items"`
+}
+
+type ReturnItemResponse struct {
+	ID          int `json:"id"`
+	OrderItemID int `json:"order_item_id"`
+	Quantity    int `json:"quantity"`
+}
+
+// GetReturnRequests retrieves the return requests filtered by the status and orderID query params,
+// the queue of the pending return requests is returned if none of them is given
+func (h *Handler) GetReturnRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var filter controllers.ReturnRequestFilterCtrl
+	status := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("status")))
+	if status != "" {
+		if !controllers.IsValidReturnStatus(status) {
+			render.Render(w, r, ErrInvalidReturnStatus)
+			return
+		}
+		filter.Status = status
+	}
+
+	orderID := strings.TrimSpace(r.URL.Query().Get("orderID"))
+	if orderID != "" {
+		id, err := strconv.Atoi(orderID)
+		if err != nil || id <= 0 {
+			render.Render(w, r, ErrInvalidOrderID)
+			return
+		}
+		filter.OrderID = id
+	}
+
+	returnRequests, err := h.Controller.GetReturnRequests(ctx, filter)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	rResp := make([]ReturnRequestResponse, 0, len(returnRequests))
+	for _, rr := range returnRequests {
+		returnRequest := ReturnRequestResponse{
+			ID:                 rr.ID,
+			OrderID:            rr.OrderID,
+			Status:             rr.Status,
+			Reason:             rr.Reason,
+			Note:               rr.Note,
+			RefundAmount:       rr.RefundAmount,
+			ReplacementOrderID: rr.ReplacementOrderID,
+			ReceivedAt:         rr.ReceivedAt,
+			ResolvedAt:         rr.ResolvedAt,
+			CreatedAt:          rr.CreatedAt,
+			UpdatedAt:          rr.UpdatedAt,
+			Items:              make([]ReturnItemResponse, 0, len(rr.Items)),
+		}
+		for _, ri := range rr.Items {
+			returnRequest.Items = append(returnRequest.Items, ReturnItemResponse{
+				ID:          ri.ID,
+				OrderItemID: ri.OrderItemID,
+				Quantity:    ri.Quantity,
+			})
+		}
+		rResp = append(rResp, returnRequest)
+	}
+
+	utils.RenderJson(w, rResp, http.StatusOK)
+}
+
+// validateAndConvertReturnRequest validates the return request from body request and returns return request struct in controller layer
+func validateAndConvertReturnRequest(rReq returnRequestRequest) (controllers.ReturnRequestInput, *ErrorResponse) {
+	if rReq.OrderID <= 0 {
+		return controllers.ReturnRequestInput{}, ErrInvalidOrderID
+	}
+
+	if len(strings.TrimSpace(rReq.Reason)) == 0 {
+		return controllers.ReturnRequestInput{}, ErrMissingReturnReason
+	}
+
+	if len(rReq.Items) == 0 {
+		return controllers.ReturnRequestInput{}, ErrMissingReturnItems
+	}
+
+	returnRequest := controllers.ReturnRequestInput{
+		OrderID: rReq.OrderID,
+		Reason:  strings.TrimSpace(rReq.Reason),
+	}
+	for _, ri := range rReq.Items {
+		if ri.OrderItemID <= 0 {
+			return controllers.ReturnRequestInput{}, ErrInvalidOrderItemID
+		}
+		if ri.Quantity <= 0 {
+			return controllers.ReturnRequestInput{}, ErrInvalidReturnQuantity
+		}
+		returnRequest.Items = append(returnRequest.Items, controllers.ReturnItemInput{
+			OrderItemID: ri.OrderItemID,
+			Quantity:    ri.Quantity,
+		})
+	}
+
+	return returnRequest, nil
+}
@@ -0,0 +1,259 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateReturnRequest in Handler layer
+func Test_ReturnHandler_CreateReturnRequest(t *testing.T) {
+	type mockReturnCtrl struct {
+		expCall bool
+		rInput  controllers.ReturnRequestInput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput     string
+		mockReturnCtrl mockReturnCtrl
+		expResp        string
+		expCode        int
+	}{
+		"create return request successfully": {
+			givenInput: `{"order_id":1,"reason":" broken screen ","items":[{"order_item_id":10,"quantity":1}]}`,
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				rInput: controllers.ReturnRequestInput{
+					OrderID: 1,
+					Reason:  "broken screen",
+					Items:   []controllers.ReturnItemInput{{OrderItemID: 10, Quantity: 1}},
+				},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusCreated,
+		},
+		"create return request of an order which is not delivered": {
+			givenInput: `{"order_id":1,"reason":"broken","items":[{"order_item_id":10,"quantity":1}]}`,
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				rInput: controllers.ReturnRequestInput{
+					OrderID: 1,
+					Reason:  "broken",
+					Items:   []controllers.ReturnItemInput{{OrderItemID: 10, Quantity: 1}},
+				},
+				err: controllers.ErrOrderNotReturnable,
+			},
+			expResp: `{"message":"only a delivered order can be returned"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"create return request without reason": {
+			givenInput: `{"order_id":1,"items":[{"order_item_id":10,"quantity":1}]}`,
+			expResp:    `{"message":"return reason cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create return request without items": {
+			givenInput: `{"order_id":1,"reason":"broken"}`,
+			expResp:    `{"message":"a return must have at least one item"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create return request with zero quantity": {
+			givenInput: `{"order_id":1,"reason":"broken","items":[{"order_item_id":10,"quantity":0}]}`,
+			expResp:    `{"message":"return quantity must be greater than 0 and not more than the quantity ordered"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create return request with invalid JSON": {
+			givenInput: `{"order_id":1`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/returns", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockReturnCtrl.expCall {
+				mockController.On("CreateReturnRequest", context.Background(), tc.mockReturnCtrl.rInput).Return(tc.mockReturnCtrl.err)
+			}
+
+			handler.CreateReturnRequest(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
+
+// Test UpdateReturnStatus in Handler layer
+func Test_ReturnHandler_UpdateReturnStatus(t *testing.T) {
+	type mockReturnCtrl struct {
+		expCall bool
+		status  string
+		note    string
+		err     error
+	}
+	testCases := map[string]struct {
+		givenID        string
+		givenInput     string
+		mockReturnCtrl mockReturnCtrl
+		expResp        string
+		expCode        int
+	}{
+		"approve return request successfully": {
+			givenID:    "1",
+			givenInput: `{"status":"approved","note":"please use the prepaid label"}`,
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				status:  controllers.ReturnStatusApproved,
+				note:    "please use the prepaid label",
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusOK,
+		},
+		"refund return request which is not received": {
+			givenID:    "1",
+			givenInput: `{"status":"REFUNDED"}`,
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				status:  controllers.ReturnStatusRefunded,
+				err:     controllers.ErrInvalidReturnStatus,
+			},
+			expResp: `{"message":"invalid return status"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"return request not found": {
+			givenID:    "1",
+			givenInput: `{"status":"APPROVED"}`,
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				status:  controllers.ReturnStatusApproved,
+				err:     controllers.ErrReturnRequestNotFound,
+			},
+			expResp: `{"message":"return request not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"unknown status": {
+			givenID:    "1",
+			givenInput: `{"status":"LOST"}`,
+			expResp:    `{"message":"invalid return status"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid return request ID": {
+			givenID:    "abc",
+			givenInput: `{"status":"APPROVED"}`,
+			expResp:    `{"message":"invalid return request ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("returnRequestID", tc.givenID)
+			r := httptest.NewRequest(http.MethodPut, "/returns/"+tc.givenID+"/status", strings.NewReader(tc.givenInput))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockReturnCtrl.expCall {
+				mockController.On("UpdateReturnStatus", r.Context(), 1, tc.mockReturnCtrl.status, tc.mockReturnCtrl.note).Return(tc.mockReturnCtrl.err)
+			}
+
+			handler.UpdateReturnStatus(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
+
+// Test GetReturnRequests in Handler layer
+func Test_ReturnHandler_GetReturnRequests(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockReturnCtrl struct {
+		expCall bool
+		filter  controllers.ReturnRequestFilterCtrl
+		output  []controllers.ReturnRequestOutput
+	}
+	testCases := map[string]struct {
+		givenQuery     string
+		mockReturnCtrl mockReturnCtrl
+		expResp        string
+		expCode        int
+	}{
+		"get pending return requests": {
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				output: []controllers.ReturnRequestOutput{
+					{
+						ID:        1,
+						OrderID:   1,
+						Status:    controllers.ReturnStatusRequested,
+						Reason:    "broken",
+						CreatedAt: createdAt,
+						UpdatedAt: createdAt,
+						Items:     []controllers.ReturnItemOutput{{ID: 1, OrderItemID: 10, Quantity: 1}},
+					},
+				},
+			},
+			expResp: `[{"id":1,"order_id":1,"status":"REQUESTED","reason":"broken","note":"","created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z","items":[{"id":1,"order_item_id":10,"quantity":1}]}]`,
+			expCode: http.StatusOK,
+		},
+		"get return requests by status and order": {
+			givenQuery: "status=approved&orderID=1",
+			mockReturnCtrl: mockReturnCtrl{
+				expCall: true,
+				filter:  controllers.ReturnRequestFilterCtrl{Status: controllers.ReturnStatusApproved, OrderID: 1},
+			},
+			expResp: `[]`,
+			expCode: http.StatusOK,
+		},
+		"get return requests with unknown status": {
+			givenQuery: "status=LOST",
+			expResp:    `{"message":"invalid return status"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"get return requests with invalid order ID": {
+			givenQuery: "orderID=abc",
+			expResp:    `{"message":"invalid order ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/returns?"+tc.givenQuery, nil)
+			w := httptest.NewRecorder()
+
+			if tc.mockReturnCtrl.expCall {
+				mockController.On("GetReturnRequests", context.Background(), tc.mockReturnCtrl.filter).Return(tc.mockReturnCtrl.output, nil)
+			}
+
+			handler.GetReturnRequests(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+			mockController.AssertExpectations(t)
+		})
+	}
+}
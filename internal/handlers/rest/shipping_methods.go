@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type shippingMethodRequest struct {
+	Name      string          `json:"name"`
+	Carrier   string          `json:"carrier"`
+	RateType  string          `json:"rate_type"`
+	BaseRate  decimal.Decimal `json:"base_rate"`
+	RatePerKG decimal.Decimal `json:"rate_per_kg"`
+}
+
+// CreateShippingMethod gets the shipping method data from body request, calls to CreateShippingMethod controller and returns the status
+func (h *Handler) CreateShippingMethod(w http.ResponseWriter, r *http.Request) {
+	smReq := shippingMethodRequest{}
+	ctx := r.Context()
+	if err := json.NewDecoder(r.Body).Decode(&smReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	shippingMethod, errResp := validateAndConvertShippingMethod(smReq)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if err := h.Controller.CreateShippingMethod(ctx, shippingMethod); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+type ShippingMethodResponse struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	Carrier   string          `json:"carrier"`
+	RateType  string          `json:"rate_type"`
+	BaseRate  decimal.Decimal `json:"base_rate"`
+	RatePerKG decimal.Decimal `json:"rate_per_kg"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// GetShippingMethods retrieves all the shipping methods in db
+func (h *Handler) GetShippingMethods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	shippingMethods, err := h.Controller.GetShippingMethods(ctx)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	var smResp []ShippingMethodResponse
+	for _, sm := range shippingMethods {
+		smResp = append(smResp, ShippingMethodResponse{
+			ID:        sm.ID,
+			Name:      sm.Name,
+			Carrier:   sm.Carrier,
+			RateType:  sm.RateType,
+			BaseRate:  sm.BaseRate,
+			RatePerKG: sm.RatePerKG,
+			CreatedAt: sm.CreatedAt,
+			UpdatedAt: sm.UpdatedAt,
+		})
+	}
+
+	utils.RenderJson(w, smResp, http.StatusOK)
+}
+
+// validateAndConvertShippingMethod validates the shipping method from body request and returns shipping method struct in controller layer
+func validateAndConvertShippingMethod(smReq shippingMethodRequest) (controllers.ShippingMethodInput, *ErrorResponse) {
+	if len(strings.TrimSpace(smReq.Name)) == 0 {
+		return controllers.ShippingMethodInput{}, ErrMissingName
+	}
+
+	if len(strings.TrimSpace(smReq.Name)) > 255 {
+		return controllers.ShippingMethodInput{}, ErrNameTooLong
+	}
+
+	if len(strings.TrimSpace(smReq.Carrier)) == 0 {
+		return controllers.ShippingMethodInput{}, ErrMissingCarrier
+	}
+
+	rateType := strings.ToUpper(strings.TrimSpace(smReq.RateType))
+	if rateType != controllers.ShippingRateFlat && rateType != controllers.ShippingRateWeight {
+		return controllers.ShippingMethodInput{}, ErrInvalidShippingRateType
+	}
+
+	if smReq.BaseRate.IsNegative() || smReq.RatePerKG.IsNegative() {
+		return controllers.ShippingMethodInput{}, ErrInvalidShippingRate
+	}
+
+	return controllers.ShippingMethodInput{
+		Name:      strings.TrimSpace(smReq.Name),
+		Carrier:   strings.TrimSpace(smReq.Carrier),
+		RateType:  rateType,
+		BaseRate:  smReq.BaseRate,
+		RatePerKG: smReq.RatePerKG,
+	}, nil
+}
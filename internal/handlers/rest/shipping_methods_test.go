@@ -0,0 +1,88 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateShippingMethod in Handler layer
+func Test_ShippingMethodHandler_CreateShippingMethod(t *testing.T) {
+	type mockShippingMethodCtrl struct {
+		expCall bool
+		smInput controllers.ShippingMethodInput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput             string
+		mockShippingMethodCtrl mockShippingMethodCtrl
+		expResp                string
+		expCode                int
+	}{
+		"create shipping method successfully": {
+			givenInput: `{"name":"Express","carrier":"GHN","rate_type":"weight","base_rate":"20000","rate_per_kg":"5000"}`,
+			mockShippingMethodCtrl: mockShippingMethodCtrl{
+				expCall: true,
+				smInput: controllers.ShippingMethodInput{
+					Name:      "Express",
+					Carrier:   "GHN",
+					RateType:  controllers.ShippingRateWeight,
+					BaseRate:  decimal.RequireFromString("20000"),
+					RatePerKG: decimal.RequireFromString("5000"),
+				},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusCreated,
+		},
+		"create shipping method with invalid rate type": {
+			givenInput: `{"name":"Express","carrier":"GHN","rate_type":"distance","base_rate":"20000"}`,
+			expResp:    `{"message":"invalid shipping rate type, rate type must be FLAT or WEIGHT"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create shipping method with negative rate": {
+			givenInput: `{"name":"Express","carrier":"GHN","rate_type":"flat","base_rate":"-1"}`,
+			expResp:    `{"message":"shipping rate must be non-negative"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create shipping method with missing carrier": {
+			givenInput: `{"name":"Express","rate_type":"flat","base_rate":"20000"}`,
+			expResp:    `{"message":"carrier cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create shipping method with invalid JSON": {
+			givenInput: `{"name":"Express"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/shipping-methods", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockShippingMethodCtrl.expCall {
+				mockController.On("CreateShippingMethod", context.Background(), tc.mockShippingMethodCtrl.smInput).Return(tc.mockShippingMethodCtrl.err)
+			}
+
+			handler.CreateShippingMethod(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+
+			if tc.mockShippingMethodCtrl.expCall {
+				mockController.AssertCalled(t, "CreateShippingMethod", context.Background(), tc.mockShippingMethodCtrl.smInput)
+			}
+		})
+	}
+}
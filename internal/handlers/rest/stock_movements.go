@@ -0,0 +1,160 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type stockAdjustmentRequest struct {
+	// VariantID is the variant whose stock is adjusted, it must be given if the product has variants
+	VariantID int `json:"variant_id"`
+	// WarehouseID is the warehouse the stock of the product is adjusted in, the default warehouse if not given
+	WarehouseID int    `json:"warehouse_id"`
+	Delta       int    `json:"delta"`
+	ActorID     int    `json:"actor_id"`
+	Note        string `json:"note"`
+}
+
+type StockMovementResponse struct {
+	ID            int       `json:"id"`
+	ProductID     int       `json:"product_id"`
+	VariantID     *int      `json:"variant_id,omitempty"`
+	WarehouseID   *int      `json:"warehouse_id,omitempty"`
+	Delta         int       `json:"delta"`
+	Reason        string    `json:"reason"`
+	ReferenceID   *int      `json:"reference_id,omitempty"`
+	ActorID       *int      `json:"actor_id,omitempty"`
+	Note          string    `json:"note"`
+	QuantityAfter int       `json:"quantity_after"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type StockReportResponse struct {
+	ProductID      int                     `json:"product_id"`
+	VariantID      *int                    `json:"variant_id,omitempty"`
+	Quantity       int                     `json:"quantity"`
+	LedgerQuantity int                     `json:"ledger_quantity"`
+	Consistent     bool                    `json:"consistent"`
+	Totals         map[string]int          `json:"totals"`
+	Movements      []StockMovementResponse `json:"movements"`
+}
+
+// toStockMovementResponse converts a movement of the stock ledger to the response
+func toStockMovementResponse(sm controllers.StockMovementOutput) StockMovementResponse {
+	return StockMovementResponse{
+		ID:            sm.ID,
+		ProductID:     sm.ProductID,
+		VariantID:     sm.VariantID,
+		WarehouseID:   sm.WarehouseID,
+		Delta:         sm.Delta,
+		Reason:        sm.Reason,
+		ReferenceID:   sm.ReferenceID,
+		ActorID:       sm.ActorID,
+		Note:          sm.Note,
+		QuantityAfter: sm.QuantityAfter,
+		CreatedAt:     sm.CreatedAt,
+	}
+}
+
+// AdjustStock gets the adjustment of the stock of the product in url param from body request, calls to AdjustStock
+// controller and returns the recorded movement
+func (h *Handler) AdjustStock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	saReq := stockAdjustmentRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&saReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if saReq.VariantID < 0 {
+		render.Render(w, r, ErrInvalidVariantID)
+		return
+	}
+	if saReq.WarehouseID < 0 {
+		render.Render(w, r, ErrInvalidWarehouseID)
+		return
+	}
+	if saReq.ActorID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+	if saReq.Delta == 0 {
+		render.Render(w, r, ErrInvalidStockDelta)
+		return
+	}
+
+	stockMovement, err := h.Controller.AdjustStock(ctx, controllers.StockAdjustmentInput{
+		ProductID:   productID,
+		VariantID:   saReq.VariantID,
+		WarehouseID: saReq.WarehouseID,
+		Delta:       saReq.Delta,
+		ActorID:     saReq.ActorID,
+		Note:        strings.TrimSpace(saReq.Note),
+	})
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toStockMovementResponse(stockMovement), http.StatusCreated)
+}
+
+// GetStockReport retrieves the current stock of the product in url param, or of its variant in the variant_id query param,
+// with the movements it is derived from
+func (h *Handler) GetStockReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	variantID := 0
+	if v := r.URL.Query().Get("variant_id"); v != "" {
+		variantID, err = strconv.Atoi(v)
+		if err != nil || variantID <= 0 {
+			render.Render(w, r, ErrInvalidVariantID)
+			return
+		}
+	}
+
+	report, err := h.Controller.GetStockReport(ctx, productID, variantID)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := StockReportResponse{
+		ProductID:      report.ProductID,
+		VariantID:      report.VariantID,
+		Quantity:       report.Quantity,
+		LedgerQuantity: report.LedgerQuantity,
+		Consistent:     report.Consistent,
+		Totals:         report.Totals,
+		Movements:      []StockMovementResponse{},
+	}
+	for _, sm := range report.Movements {
+		resp.Movements = append(resp.Movements, toStockMovementResponse(sm))
+	}
+
+	utils.RenderJson(w, resp, http.StatusOK)
+}
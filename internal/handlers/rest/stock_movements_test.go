@@ -0,0 +1,168 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test AdjustStock in Handler layer
+func Test_StockHandler_AdjustStock(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	actorID := 3
+
+	type mockStockCtrl struct {
+		expCall bool
+		input   controllers.StockAdjustmentInput
+		output  controllers.StockMovementOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput    string
+		mockStockCtrl mockStockCtrl
+		expResp       string
+		expCode       int
+	}{
+		"adjust stock successfully": {
+			givenInput: `{"delta":-2,"actor_id":3,"note":" damaged "}`,
+			mockStockCtrl: mockStockCtrl{
+				expCall: true,
+				input:   controllers.StockAdjustmentInput{ProductID: 1, Delta: -2, ActorID: 3, Note: "damaged"},
+				output:  controllers.StockMovementOutput{ID: 9, ProductID: 1, Delta: -2, Reason: "adjustment", ActorID: &actorID, Note: "damaged", QuantityAfter: 3, CreatedAt: createdAt},
+			},
+			expResp: `{"id":9,"product_id":1,"delta":-2,"reason":"adjustment","actor_id":3,"note":"damaged","quantity_after":3,"created_at":"2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusCreated,
+		},
+		"stock would be negative": {
+			givenInput: `{"delta":-20,"actor_id":3}`,
+			mockStockCtrl: mockStockCtrl{
+				expCall: true,
+				input:   controllers.StockAdjustmentInput{ProductID: 1, Delta: -20, ActorID: 3},
+				err:     controllers.ErrInsufficientQuantity,
+			},
+			expResp: `{"message":"insufficient quantity"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"zero delta": {
+			givenInput: `{"delta":0,"actor_id":3}`,
+			expResp:    `{"message":"stock adjustment must not be 0"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"missing actor": {
+			givenInput: `{"delta":2}`,
+			expResp:    `{"message":"invalid user ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid JSON": {
+			givenInput: `{"delta":2`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/stock-adjustments", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockStockCtrl.expCall {
+				mockController.On("AdjustStock", r.Context(), tc.mockStockCtrl.input).Return(tc.mockStockCtrl.output, tc.mockStockCtrl.err)
+			}
+
+			handler.AdjustStock(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test GetStockReport in Handler layer
+func Test_StockHandler_GetStockReport(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	variantID := 7
+
+	type mockStockCtrl struct {
+		expCall   bool
+		variantID int
+		output    controllers.StockReportOutput
+		err       error
+	}
+	testCases := map[string]struct {
+		query         string
+		mockStockCtrl mockStockCtrl
+		expResp       string
+		expCode       int
+	}{
+		"get stock report of variant successfully": {
+			query: "?variant_id=7",
+			mockStockCtrl: mockStockCtrl{
+				expCall:   true,
+				variantID: 7,
+				output: controllers.StockReportOutput{
+					ProductID: 1, VariantID: &variantID, Quantity: 4, LedgerQuantity: 4, Consistent: true,
+					Totals: map[string]int{"adjustment": 5, "sale": -1},
+					Movements: []controllers.StockMovementOutput{
+						{ID: 1, ProductID: 1, VariantID: &variantID, Delta: 5, Reason: "adjustment", QuantityAfter: 5, CreatedAt: createdAt},
+						{ID: 2, ProductID: 1, VariantID: &variantID, Delta: -1, Reason: "sale", QuantityAfter: 4, CreatedAt: createdAt},
+					},
+				},
+			},
+			expResp: `{"product_id":1,"variant_id":7,"quantity":4,"ledger_quantity":4,"consistent":true,"totals":{"adjustment":5,"sale":-1},"movements":[` +
+				`{"id":1,"product_id":1,"variant_id":7,"delta":5,"reason":"adjustment","note":"","quantity_after":5,"created_at":"2023-07-01T00:00:00Z"},` +
+				`{"id":2,"product_id":1,"variant_id":7,"delta":-1,"reason":"sale","note":"","quantity_after":4,"created_at":"2023-07-01T00:00:00Z"}]}`,
+			expCode: http.StatusOK,
+		},
+		"product with variants requires a variant": {
+			mockStockCtrl: mockStockCtrl{
+				expCall: true,
+				err:     controllers.ErrVariantRequired,
+			},
+			expResp: `{"message":"the product has variants, a variant must be given"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"invalid variant id": {
+			query:   "?variant_id=abc",
+			expResp: `{"message":"invalid variant ID"}`,
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodGet, "/products/stock-report"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockStockCtrl.expCall {
+				mockController.On("GetStockReport", r.Context(), 1, tc.mockStockCtrl.variantID).Return(tc.mockStockCtrl.output, tc.mockStockCtrl.err)
+			}
+
+			handler.GetStockReport(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type taxRuleRequest struct {
+	CategoryName  string          `json:"category"`
+	Region        string          `json:"region"`
+	Rate          decimal.Decimal `json:"rate"`
+	EffectiveFrom string          `json:"effective_from"`
+	EffectiveTo   string          `json:"effective_to"`
+}
+
+// CreateTaxRule gets the tax rule data from body request, calls to CreateTaxRule controller and returns the status
+func (h *Handler) CreateTaxRule(w http.ResponseWriter, r *http.Request) {
+	trReq := taxRuleRequest{}
+	ctx := r.Context()
+	if err := json.NewDecoder(r.Body).Decode(&trReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	taxRule, errResp := validateAndConvertTaxRule(trReq)
+	if errResp != nil {
+		render.Render(w, r, errResp)
+		return
+	}
+
+	if err := h.Controller.CreateTaxRule(ctx, taxRule); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+type TaxRuleResponse struct {
+	ID            int             `json:"id"`
+	CategoryID    int             `json:"category_id"`
+	Region        string          `json:"region"`
+	Rate          decimal.Decimal `json:"rate"`
+	EffectiveFrom time.Time       `json:"effective_from"`
+	EffectiveTo   *time.Time      `json:"effective_to"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// GetTaxRules retrieves the tax rules in db, filtered by the category and region query params if given
+func (h *Handler) GetTaxRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	taxRules, err := h.Controller.GetTaxRules(ctx, strings.TrimSpace(query.Get("category")), strings.TrimSpace(query.Get("region")))
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	var trResp []TaxRuleResponse
+	for _, tr := range taxRules {
+		trResp = append(trResp, TaxRuleResponse{
+			ID:            tr.ID,
+			CategoryID:    tr.CategoryID,
+			Region:        tr.Region,
+			Rate:          tr.Rate,
+			EffectiveFrom: tr.EffectiveFrom,
+			EffectiveTo:   tr.EffectiveTo,
+			CreatedAt:     tr.CreatedAt,
+			UpdatedAt:     tr.UpdatedAt,
+		})
+	}
+
+	utils.RenderJson(w, trResp, http.StatusOK)
+}
+
+// validateAndConvertTaxRule validates the tax rule from body request and returns tax rule struct in controller layer
+func validateAndConvertTaxRule(trReq taxRuleRequest) (controllers.TaxRuleInput, *ErrorResponse) {
+	if len(strings.TrimSpace(trReq.CategoryName)) == 0 {
+		return controllers.TaxRuleInput{}, ErrMissingCategoryName
+	}
+
+	if trReq.Rate.IsNegative() || trReq.Rate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return controllers.TaxRuleInput{}, ErrInvalidTaxRate
+	}
+
+	effectiveFrom, err := time.Parse("2006-01-02", strings.TrimSpace(trReq.EffectiveFrom))
+	if err != nil {
+		return controllers.TaxRuleInput{}, ErrDateBadRequest
+	}
+
+	taxRule := controllers.TaxRuleInput{
+		CategoryName:  strings.TrimSpace(trReq.CategoryName),
+		Region:        strings.TrimSpace(trReq.Region),
+		Rate:          trReq.Rate,
+		EffectiveFrom: effectiveFrom,
+	}
+
+	if strings.TrimSpace(trReq.EffectiveTo) != "" {
+		effectiveTo, err := time.Parse("2006-01-02", strings.TrimSpace(trReq.EffectiveTo))
+		if err != nil {
+			return controllers.TaxRuleInput{}, ErrDateBadRequest
+		}
+		taxRule.EffectiveTo = effectiveTo
+	}
+
+	return taxRule, nil
+}
@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateTaxRule in Handler layer
+func Test_TaxRuleHandler_CreateTaxRule(t *testing.T) {
+	type mockTaxRuleCtrl struct {
+		expCall bool
+		trInput controllers.TaxRuleInput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput      string
+		mockTaxRuleCtrl mockTaxRuleCtrl
+		expResp         string
+		expCode         int
+	}{
+		"create tax rule successfully": {
+			givenInput: `{"category":"Food","region":"HCM","rate":"0.05","effective_from":"2023-06-01","effective_to":"2023-12-31"}`,
+			mockTaxRuleCtrl: mockTaxRuleCtrl{
+				expCall: true,
+				trInput: controllers.TaxRuleInput{
+					CategoryName:  "Food",
+					Region:        "HCM",
+					Rate:          decimal.RequireFromString("0.05"),
+					EffectiveFrom: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+					EffectiveTo:   time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			expResp: `{"success":true}`,
+			expCode: http.StatusCreated,
+		},
+		"create tax rule with missing category": {
+			givenInput: `{"rate":"0.05","effective_from":"2023-06-01"}`,
+			expResp:    `{"message":"category cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create tax rule with invalid rate": {
+			givenInput: `{"category":"Food","rate":"1.5","effective_from":"2023-06-01"}`,
+			expResp:    `{"message":"tax rate must be between 0 and 1"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create tax rule with invalid date": {
+			givenInput: `{"category":"Food","rate":"0.05","effective_from":"01-06-2023"}`,
+			expResp:    `{"message":"invalid date format, dates must follow the format yyyy-mm-dd"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"create tax rule with product category not found": {
+			givenInput: `{"category":"Food","rate":"0.05","effective_from":"2023-06-01"}`,
+			mockTaxRuleCtrl: mockTaxRuleCtrl{
+				expCall: true,
+				trInput: controllers.TaxRuleInput{
+					CategoryName:  "Food",
+					Rate:          decimal.RequireFromString("0.05"),
+					EffectiveFrom: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+				err: controllers.ErrProductCategoryNotFound,
+			},
+			expResp: `{"message":"product category not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"create tax rule with invalid JSON": {
+			givenInput: `{"category":"Food"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/tax-rules", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockTaxRuleCtrl.expCall {
+				mockController.On("CreateTaxRule", context.Background(), tc.mockTaxRuleCtrl.trInput).Return(tc.mockTaxRuleCtrl.err)
+			}
+
+			handler.CreateTaxRule(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+
+			if tc.mockTaxRuleCtrl.expCall {
+				mockController.AssertCalled(t, "CreateTaxRule", context.Background(), tc.mockTaxRuleCtrl.trInput)
+			}
+		})
+	}
+}
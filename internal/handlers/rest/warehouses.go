@@ -0,0 +1,190 @@
+package rest
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type warehouseRequest struct {
+	Name      string `json:"name"`
+	City      string `json:"city"`
+	Region    string `json:"region"`
+	Country   string `json:"country"`
+	IsDefault bool   `json:"is_default"`
+}
+
+type WarehouseResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	City      string    `json:"city"`
+	Region    string    `json:"region"`
+	Country   string    `json:"country"`
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WarehouseStockResponse is the quantity of a product available in a warehouse
+type WarehouseStockResponse struct {
+	WarehouseID   int    `json:"warehouse_id"`
+	WarehouseName string `json:"warehouse_name"`
+	City          string `json:"city"`
+	Region        string `json:"region"`
+	Country       string `json:"country"`
+	Quantity      int    `json:"quantity"`
+}
+
+type stockTransferRequest struct {
+	FromWarehouseID int    `json:"from_warehouse_id"`
+	ToWarehouseID   int    `json:"to_warehouse_id"`
+	Quantity        int    `json:"quantity"`
+	ActorID         int    `json:"actor_id"`
+	Note            string `json:"note"`
+}
+
+// toWarehouseResponse converts a warehouse to the response
+func toWarehouseResponse(w controllers.WarehouseOutput) WarehouseResponse {
+	return WarehouseResponse{
+		ID:        w.ID,
+		Name:      w.Name,
+		City:      w.City,
+		Region:    w.Region,
+		Country:   w.Country,
+		IsDefault: w.IsDefault,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// toWarehouseStockResponses converts the stock of a product in the warehouses to the responses
+func toWarehouseStockResponses(stocks []controllers.WarehouseStockOutput) []WarehouseStockResponse {
+	var resp []WarehouseStockResponse
+	for _, s := range stocks {
+		resp = append(resp, WarehouseStockResponse{
+			WarehouseID:   s.WarehouseID,
+			WarehouseName: s.WarehouseName,
+			City:          s.City,
+			Region:        s.Region,
+			Country:       s.Country,
+			Quantity:      s.Quantity,
+		})
+	}
+
+	return resp
+}
+
+// CreateWarehouse gets the warehouse data from body request, calls to CreateWarehouse controller and returns the created warehouse
+func (h *Handler) CreateWarehouse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	wReq := warehouseRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&wReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	wReq.Name = strings.TrimSpace(wReq.Name)
+	if wReq.Name == "" {
+		render.Render(w, r, ErrMissingWarehouseName)
+		return
+	}
+	if len(wReq.Name) > 255 {
+		render.Render(w, r, ErrNameTooLong)
+		return
+	}
+
+	warehouse, err := h.Controller.CreateWarehouse(ctx, controllers.WarehouseInput{
+		Name:      wReq.Name,
+		City:      strings.TrimSpace(wReq.City),
+		Region:    strings.TrimSpace(wReq.Region),
+		Country:   strings.TrimSpace(wReq.Country),
+		IsDefault: wReq.IsDefault,
+	})
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	utils.RenderJson(w, toWarehouseResponse(warehouse), http.StatusCreated)
+}
+
+// GetWarehouses retrieves all the warehouses
+func (h *Handler) GetWarehouses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	warehouses, err := h.Controller.GetWarehouses(ctx)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	wResp := []WarehouseResponse{}
+	for _, wh := range warehouses {
+		wResp = append(wResp, toWarehouseResponse(wh))
+	}
+
+	utils.RenderJson(w, wResp, http.StatusOK)
+}
+
+// TransferStock gets the transfer of the stock of the product in url param from body request, calls to TransferStock
+// controller and returns the movements recorded out of and into the warehouses
+func (h *Handler) TransferStock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	stReq := stockTransferRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&stReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+
+	if stReq.FromWarehouseID <= 0 || stReq.ToWarehouseID <= 0 {
+		render.Render(w, r, ErrInvalidWarehouseID)
+		return
+	}
+	if stReq.ActorID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+	if stReq.Quantity <= 0 {
+		render.Render(w, r, ErrInvalidTransferQuantity)
+		return
+	}
+
+	stockMovements, err := h.Controller.TransferStock(ctx, controllers.StockTransferInput{
+		ProductID:       productID,
+		FromWarehouseID: stReq.FromWarehouseID,
+		ToWarehouseID:   stReq.ToWarehouseID,
+		Quantity:        stReq.Quantity,
+		ActorID:         stReq.ActorID,
+		Note:            strings.TrimSpace(stReq.Note),
+	})
+	if err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	resp := []StockMovementResponse{}
+	for _, sm := range stockMovements {
+		resp = append(resp, toStockMovementResponse(sm))
+	}
+
+	utils.RenderJson(w, resp, http.StatusCreated)
+}
@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test CreateWarehouse in Handler layer
+func Test_WarehouseHandler_CreateWarehouse(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	type mockWarehouseCtrl struct {
+		expCall bool
+		input   controllers.WarehouseInput
+		output  controllers.WarehouseOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput        string
+		mockWarehouseCtrl mockWarehouseCtrl
+		expResp           string
+		expCode           int
+	}{
+		"create warehouse successfully": {
+			givenInput: `{"name":" Ha Noi ","city":"Ha Noi","region":"North","country":"Vietnam"}`,
+			mockWarehouseCtrl: mockWarehouseCtrl{
+				expCall: true,
+				input:   controllers.WarehouseInput{Name: "Ha Noi", City: "Ha Noi", Region: "North", Country: "Vietnam"},
+				output:  controllers.WarehouseOutput{ID: 2, Name: "Ha Noi", City: "Ha Noi", Region: "North", Country: "Vietnam", CreatedAt: createdAt, UpdatedAt: createdAt},
+			},
+			expResp: `{"id":2,"name":"Ha Noi","city":"Ha Noi","region":"North","country":"Vietnam","is_default":false,"created_at":"2023-07-01T00:00:00Z","updated_at":"2023-07-01T00:00:00Z"}`,
+			expCode: http.StatusCreated,
+		},
+		"name already exists": {
+			givenInput: `{"name":"Main warehouse"}`,
+			mockWarehouseCtrl: mockWarehouseCtrl{
+				expCall: true,
+				input:   controllers.WarehouseInput{Name: "Main warehouse"},
+				err:     controllers.ErrWarehouseExists,
+			},
+			expResp: `{"message":"a warehouse with the same name already exists"}`,
+			expCode: http.StatusConflict,
+		},
+		"missing name": {
+			givenInput: `{"name":" ","city":"Ha Noi"}`,
+			expResp:    `{"message":"warehouse name cannot be blank"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"invalid JSON": {
+			givenInput: `{"name":"Ha Noi"`,
+			expResp:    `{"message":"invalid json"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/warehouses", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			if tc.mockWarehouseCtrl.expCall {
+				mockController.On("CreateWarehouse", r.Context(), tc.mockWarehouseCtrl.input).Return(tc.mockWarehouseCtrl.output, tc.mockWarehouseCtrl.err)
+			}
+
+			handler.CreateWarehouse(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test TransferStock in Handler layer
+func Test_WarehouseHandler_TransferStock(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	fromID, toID, actorID := 1, 2, 3
+
+	type mockWarehouseCtrl struct {
+		expCall bool
+		input   controllers.StockTransferInput
+		output  []controllers.StockMovementOutput
+		err     error
+	}
+	testCases := map[string]struct {
+		givenInput        string
+		mockWarehouseCtrl mockWarehouseCtrl
+		expResp           string
+		expCode           int
+	}{
+		"transfer stock successfully": {
+			givenInput: `{"from_warehouse_id":1,"to_warehouse_id":2,"quantity":2,"actor_id":3}`,
+			mockWarehouseCtrl: mockWarehouseCtrl{
+				expCall: true,
+				input:   controllers.StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 2, Quantity: 2, ActorID: 3},
+				output: []controllers.StockMovementOutput{
+					{ID: 7, ProductID: 1, WarehouseID: &fromID, Delta: -2, Reason: "transfer", ActorID: &actorID, QuantityAfter: 5, CreatedAt: createdAt},
+					{ID: 8, ProductID: 1, WarehouseID: &toID, Delta: 2, Reason: "transfer", ActorID: &actorID, QuantityAfter: 5, CreatedAt: createdAt},
+				},
+			},
+			expResp: `[{"id":7,"product_id":1,"warehouse_id":1,"delta":-2,"reason":"transfer","actor_id":3,"note":"","quantity_after":5,"created_at":"2023-07-01T00:00:00Z"},` +
+				`{"id":8,"product_id":1,"warehouse_id":2,"delta":2,"reason":"transfer","actor_id":3,"note":"","quantity_after":5,"created_at":"2023-07-01T00:00:00Z"}]`,
+			expCode: http.StatusCreated,
+		},
+		"source warehouse does not have the quantity": {
+			givenInput: `{"from_warehouse_id":1,"to_warehouse_id":2,"quantity":20,"actor_id":3}`,
+			mockWarehouseCtrl: mockWarehouseCtrl{
+				expCall: true,
+				input:   controllers.StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 2, Quantity: 20, ActorID: 3},
+				err:     controllers.ErrInsufficientQuantity,
+			},
+			expResp: `{"message":"insufficient quantity"}`,
+			expCode: http.StatusBadRequest,
+		},
+		"warehouse not found": {
+			givenInput: `{"from_warehouse_id":1,"to_warehouse_id":9,"quantity":2,"actor_id":3}`,
+			mockWarehouseCtrl: mockWarehouseCtrl{
+				expCall: true,
+				input:   controllers.StockTransferInput{ProductID: 1, FromWarehouseID: 1, ToWarehouseID: 9, Quantity: 2, ActorID: 3},
+				err:     controllers.ErrWarehouseNotFound,
+			},
+			expResp: `{"message":"warehouse not found"}`,
+			expCode: http.StatusNotFound,
+		},
+		"missing warehouse": {
+			givenInput: `{"from_warehouse_id":1,"quantity":2,"actor_id":3}`,
+			expResp:    `{"message":"invalid warehouse ID"}`,
+			expCode:    http.StatusBadRequest,
+		},
+		"zero quantity": {
+			givenInput: `{"from_warehouse_id":1,"to_warehouse_id":2,"actor_id":3}`,
+			expResp:    `{"message":"transfer quantity must be greater than 0"}`,
+			expCode:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/products/stock-transfers", strings.NewReader(tc.givenInput))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			if tc.mockWarehouseCtrl.expCall {
+				mockController.On("TransferStock", r.Context(), tc.mockWarehouseCtrl.input).Return(tc.mockWarehouseCtrl.output, tc.mockWarehouseCtrl.err)
+			}
+
+			handler.TransferStock(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+
+	"github.com/qthuy2k1/product-management/internal/utils"
+)
+
+type wishlistItemRequest struct {
+	ProductID int `json:"product_id"`
+}
+
+// AddToWishlist gets the product from body request and saves it to the wishlist of the user in url param
+func (h *Handler) AddToWishlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	wReq := wishlistItemRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&wReq); err != nil {
+		render.Render(w, r, ErrInvalidJson)
+		return
+	}
+	if wReq.ProductID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	if err := h.Controller.AddToWishlist(ctx, userID, wReq.ProductID); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusCreated)
+}
+
+type WishlistItemResponse struct {
+	ID                int             `json:"id"`
+	ProductID         int             `json:"product_id"`
+	ProductName       string          `json:"product_name"`
+	ProductSlug       string          `json:"product_slug"`
+	Price             decimal.Decimal `json:"price"`
+	Currency          string          `json:"currency"`
+	InStock           bool            `json:"in_stock"`
+	NotifyBackInStock bool            `json:"notify_back_in_stock"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+// GetWishlist retrieves the wishlist of the user in url param
+func (h *Handler) GetWishlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	items, err := h.Controller.GetWishlist(ctx, userID)
+	if err != nil {
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	wResp := make([]WishlistItemResponse, 0, len(items))
+	for _, i := range items {
+		wResp = append(wResp, WishlistItemResponse{
+			ID:                i.ID,
+			ProductID:         i.ProductID,
+			ProductName:       i.ProductName,
+			ProductSlug:       i.ProductSlug,
+			Price:             i.Price,
+			Currency:          i.Currency,
+			InStock:           i.Quantity > 0,
+			NotifyBackInStock: i.NotifyBackInStock,
+			CreatedAt:         i.CreatedAt,
+		})
+	}
+
+	utils.RenderJson(w, wResp, http.StatusOK)
+}
+
+// RemoveFromWishlist removes the product in url param from the wishlist of the user in url param and returns the status
+func (h *Handler) RemoveFromWishlist(w http.ResponseWriter, r *http.Request) {
+	h.changeWishlistItem(w, r, h.Controller.RemoveFromWishlist)
+}
+
+// SubscribeBackInStock subscribes the user in url param to the back in stock email of the product in url param
+// of their wishlist and returns the status
+func (h *Handler) SubscribeBackInStock(w http.ResponseWriter, r *http.Request) {
+	h.changeWishlistItem(w, r, h.Controller.SubscribeBackInStock)
+}
+
+// UnsubscribeBackInStock unsubscribes the user in url param from the back in stock email of the product in url param
+// of their wishlist and returns the status
+func (h *Handler) UnsubscribeBackInStock(w http.ResponseWriter, r *http.Request) {
+	h.changeWishlistItem(w, r, h.Controller.UnsubscribeBackInStock)
+}
+
+// changeWishlistItem validates the user and product in url param and calls the change of the wishlist item with them
+func (h *Handler) changeWishlistItem(w http.ResponseWriter, r *http.Request, change func(ctx context.Context, userID, productID int) error) {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		render.Render(w, r, ErrInvalidUserID)
+		return
+	}
+
+	productID, err := strconv.Atoi(chi.URLParam(r, "productID"))
+	if err != nil || productID <= 0 {
+		render.Render(w, r, ErrInvalidProductID)
+		return
+	}
+
+	if err = change(ctx, userID, productID); err != nil {
+		log.Println(err)
+		render.Render(w, r, convertCtrlError(err))
+		return
+	}
+
+	response := map[string]bool{"success": true}
+	utils.RenderJson(w, response, http.StatusOK)
+}
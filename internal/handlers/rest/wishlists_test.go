@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/qthuy2k1/product-management/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test AddToWishlist in Handler layer
+func Test_WishlistHandler_AddToWishlist(t *testing.T) {
+	type mockAddCtrl struct {
+		expCall bool
+		err     error
+	}
+	testCases := map[string]struct {
+		givenUserID string
+		givenBody   string
+		mockAddCtrl mockAddCtrl
+		expResp     string
+		expCode     int
+	}{
+		"success": {
+			givenUserID: "2",
+			givenBody:   `{"product_id":1}`,
+			mockAddCtrl: mockAddCtrl{expCall: true},
+			expResp:     `{"success":true}`,
+			expCode:     http.StatusCreated,
+		},
+		"product already in the wishlist": {
+			givenUserID: "2",
+			givenBody:   `{"product_id":1}`,
+			mockAddCtrl: mockAddCtrl{expCall: true, err: controllers.ErrWishlistItemExists},
+			expResp:     `{"message":"the product is already in the wishlist"}`,
+			expCode:     http.StatusConflict,
+		},
+		"missing product": {
+			givenUserID: "2",
+			givenBody:   `{}`,
+			expResp:     `{"message":"invalid product ID"}`,
+			expCode:     http.StatusBadRequest,
+		},
+		"invalid user ID": {
+			givenUserID: "abc",
+			givenBody:   `{"product_id":1}`,
+			expResp:     `{"message":"invalid user ID"}`,
+			expCode:     http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPost, "/users/"+tc.givenUserID+"/wishlist", strings.NewReader(tc.givenBody))
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("userID", tc.givenUserID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockAddCtrl.expCall {
+				mockController.On("AddToWishlist", r.Context(), 2, 1).Return(tc.mockAddCtrl.err)
+			}
+
+			handler.AddToWishlist(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
+
+// Test SubscribeBackInStock in Handler layer
+func Test_WishlistHandler_SubscribeBackInStock(t *testing.T) {
+	type mockSubscribeCtrl struct {
+		expCall bool
+		err     error
+	}
+	testCases := map[string]struct {
+		givenProductID    string
+		mockSubscribeCtrl mockSubscribeCtrl
+		expResp           string
+		expCode           int
+	}{
+		"success": {
+			givenProductID:    "1",
+			mockSubscribeCtrl: mockSubscribeCtrl{expCall: true},
+			expResp:           `{"success":true}`,
+			expCode:           http.StatusOK,
+		},
+		"product in stock": {
+			givenProductID:    "1",
+			mockSubscribeCtrl: mockSubscribeCtrl{expCall: true, err: controllers.ErrProductInStock},
+			expResp:           `{"message":"the product is in stock, only an out of stock product can be subscribed to"}`,
+			expCode:           http.StatusConflict,
+		},
+		"product not in the wishlist": {
+			givenProductID:    "1",
+			mockSubscribeCtrl: mockSubscribeCtrl{expCall: true, err: controllers.ErrWishlistItemNotFound},
+			expResp:           `{"message":"the product is not in the wishlist"}`,
+			expCode:           http.StatusNotFound,
+		},
+		"invalid product ID": {
+			givenProductID: "-1",
+			expResp:        `{"message":"invalid product ID"}`,
+			expCode:        http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			mockController := &controllers.MockIController{}
+			handler := NewHandler(mockController)
+
+			r := httptest.NewRequest(http.MethodPut, "/users/2/wishlist/"+tc.givenProductID+"/back-in-stock", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("userID", "2")
+			rctx.URLParams.Add("productID", tc.givenProductID)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			w := httptest.NewRecorder()
+
+			if tc.mockSubscribeCtrl.expCall {
+				mockController.On("SubscribeBackInStock", r.Context(), 2, 1).Return(tc.mockSubscribeCtrl.err)
+			}
+
+			handler.SubscribeBackInStock(w, r)
+
+			assert.Equal(t, tc.expResp, strings.TrimSpace(w.Body.String()))
+			assert.Equal(t, tc.expCode, w.Code)
+		})
+	}
+}
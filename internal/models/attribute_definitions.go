@@ -0,0 +1,1329 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AttributeDefinition is an object representing the database table.
+type AttributeDefinition struct {
+	ID         int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CategoryID int       `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Type       string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Unit       string    `boil:"unit" json:"unit" toml:"unit" yaml:"unit"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *attributeDefinitionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L attributeDefinitionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AttributeDefinitionColumns = struct {
+	ID         string
+	CategoryID string
+	Name       string
+	Type       string
+	Unit       string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	CategoryID: "category_id",
+	Name:       "name",
+	Type:       "type",
+	Unit:       "unit",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var AttributeDefinitionTableColumns = struct {
+	ID         string
+	CategoryID string
+	Name       string
+	Type       string
+	Unit       string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "attribute_definitions.id",
+	CategoryID: "attribute_definitions.category_id",
+	Name:       "attribute_definitions.name",
+	Type:       "attribute_definitions.type",
+	Unit:       "attribute_definitions.unit",
+	CreatedAt:  "attribute_definitions.created_at",
+	UpdatedAt:  "attribute_definitions.updated_at",
+}
+
+// Generated where
+
+var AttributeDefinitionWhere = struct {
+	ID         whereHelperint
+	CategoryID whereHelperint
+	Name       whereHelperstring
+	Type       whereHelperstring
+	Unit       whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint{field: "\"attribute_definitions\".\"id\""},
+	CategoryID: whereHelperint{field: "\"attribute_definitions\".\"category_id\""},
+	Name:       whereHelperstring{field: "\"attribute_definitions\".\"name\""},
+	Type:       whereHelperstring{field: "\"attribute_definitions\".\"type\""},
+	Unit:       whereHelperstring{field: "\"attribute_definitions\".\"unit\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"attribute_definitions\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"attribute_definitions\".\"updated_at\""},
+}
+
+// AttributeDefinitionRels is where relationship names are stored.
+var AttributeDefinitionRels = struct {
+	Category                        string
+	AttributeProductAttributeValues string
+}{
+	Category:                        "Category",
+	AttributeProductAttributeValues: "AttributeProductAttributeValues",
+}
+
+// attributeDefinitionR is where relationships are stored.
+type attributeDefinitionR struct {
+	Category                        *ProductCategory           `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	AttributeProductAttributeValues ProductAttributeValueSlice `boil:"AttributeProductAttributeValues" json:"AttributeProductAttributeValues" toml:"AttributeProductAttributeValues" yaml:"AttributeProductAttributeValues"`
+}
+
+// NewStruct creates a new relationship struct
+func (*attributeDefinitionR) NewStruct() *attributeDefinitionR {
+	return &attributeDefinitionR{}
+}
+
+func (r *attributeDefinitionR) GetCategory() *ProductCategory {
+	if r == nil {
+		return nil
+	}
+	return r.Category
+}
+
+func (r *attributeDefinitionR) GetAttributeProductAttributeValues() ProductAttributeValueSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AttributeProductAttributeValues
+}
+
+// attributeDefinitionL is where Load methods for each relationship are stored.
+type attributeDefinitionL struct{}
+
+var (
+	attributeDefinitionAllColumns            = []string{"id", "category_id", "name", "type", "unit", "created_at", "updated_at"}
+	attributeDefinitionColumnsWithoutDefault = []string{"category_id", "name", "type"}
+	attributeDefinitionColumnsWithDefault    = []string{"id", "unit", "created_at", "updated_at"}
+	attributeDefinitionPrimaryKeyColumns     = []string{"id"}
+	attributeDefinitionGeneratedColumns      = []string{}
+)
+
+type (
+	// AttributeDefinitionSlice is an alias for a slice of pointers to AttributeDefinition.
+	// This should almost always be used instead of []AttributeDefinition.
+	AttributeDefinitionSlice []*AttributeDefinition
+	// AttributeDefinitionHook is the signature for custom AttributeDefinition hook methods
+	AttributeDefinitionHook func(context.Context, boil.ContextExecutor, *AttributeDefinition) error
+
+	attributeDefinitionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	attributeDefinitionType                 = reflect.TypeOf(&AttributeDefinition{})
+	attributeDefinitionMapping              = queries.MakeStructMapping(attributeDefinitionType)
+	attributeDefinitionPrimaryKeyMapping, _ = queries.BindMapping(attributeDefinitionType, attributeDefinitionMapping, attributeDefinitionPrimaryKeyColumns)
+	attributeDefinitionInsertCacheMut       sync.RWMutex
+	attributeDefinitionInsertCache          = make(map[string]insertCache)
+	attributeDefinitionUpdateCacheMut       sync.RWMutex
+	attributeDefinitionUpdateCache          = make(map[string]updateCache)
+	attributeDefinitionUpsertCacheMut       sync.RWMutex
+	attributeDefinitionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var attributeDefinitionAfterSelectHooks []AttributeDefinitionHook
+
+var attributeDefinitionBeforeInsertHooks []AttributeDefinitionHook
+var attributeDefinitionAfterInsertHooks []AttributeDefinitionHook
+
+var attributeDefinitionBeforeUpdateHooks []AttributeDefinitionHook
+var attributeDefinitionAfterUpdateHooks []AttributeDefinitionHook
+
+var attributeDefinitionBeforeDeleteHooks []AttributeDefinitionHook
+var attributeDefinitionAfterDeleteHooks []AttributeDefinitionHook
+
+var attributeDefinitionBeforeUpsertHooks []AttributeDefinitionHook
+var attributeDefinitionAfterUpsertHooks []AttributeDefinitionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AttributeDefinition) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AttributeDefinition) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AttributeDefinition) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AttributeDefinition) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AttributeDefinition) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AttributeDefinition) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AttributeDefinition) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AttributeDefinition) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AttributeDefinition) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range attributeDefinitionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAttributeDefinitionHook registers your hook function for all future operations.
+func AddAttributeDefinitionHook(hookPoint boil.HookPoint, attributeDefinitionHook AttributeDefinitionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		attributeDefinitionAfterSelectHooks = append(attributeDefinitionAfterSelectHooks, attributeDefinitionHook)
+	case boil.BeforeInsertHook:
+		attributeDefinitionBeforeInsertHooks = append(attributeDefinitionBeforeInsertHooks, attributeDefinitionHook)
+	case boil.AfterInsertHook:
+		attributeDefinitionAfterInsertHooks = append(attributeDefinitionAfterInsertHooks, attributeDefinitionHook)
+	case boil.BeforeUpdateHook:
+		attributeDefinitionBeforeUpdateHooks = append(attributeDefinitionBeforeUpdateHooks, attributeDefinitionHook)
+	case boil.AfterUpdateHook:
+		attributeDefinitionAfterUpdateHooks = append(attributeDefinitionAfterUpdateHooks, attributeDefinitionHook)
+	case boil.BeforeDeleteHook:
+		attributeDefinitionBeforeDeleteHooks = append(attributeDefinitionBeforeDeleteHooks, attributeDefinitionHook)
+	case boil.AfterDeleteHook:
+		attributeDefinitionAfterDeleteHooks = append(attributeDefinitionAfterDeleteHooks, attributeDefinitionHook)
+	case boil.BeforeUpsertHook:
+		attributeDefinitionBeforeUpsertHooks = append(attributeDefinitionBeforeUpsertHooks, attributeDefinitionHook)
+	case boil.AfterUpsertHook:
+		attributeDefinitionAfterUpsertHooks = append(attributeDefinitionAfterUpsertHooks, attributeDefinitionHook)
+	}
+}
+
+// One returns a single attributeDefinition record from the query.
+func (q attributeDefinitionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AttributeDefinition, error) {
+	o := &AttributeDefinition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for attribute_definitions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AttributeDefinition records from the query.
+func (q attributeDefinitionQuery) All(ctx context.Context, exec boil.ContextExecutor) (AttributeDefinitionSlice, error) {
+	var o []*AttributeDefinition
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AttributeDefinition slice")
+	}
+
+	if len(attributeDefinitionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AttributeDefinition records in the query.
+func (q attributeDefinitionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count attribute_definitions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q attributeDefinitionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if attribute_definitions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Category pointed to by the foreign key.
+func (o *AttributeDefinition) Category(mods ...qm.QueryMod) productCategoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CategoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ProductCategories(queryMods...)
+}
+
+// AttributeProductAttributeValues retrieves all the product_attribute_value's ProductAttributeValues with an executor via attribute_id column.
+func (o *AttributeDefinition) AttributeProductAttributeValues(mods ...qm.QueryMod) productAttributeValueQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_attribute_values\".\"attribute_id\"=?", o.ID),
+	)
+
+	return ProductAttributeValues(queryMods...)
+}
+
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (attributeDefinitionL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttributeDefinition interface{}, mods queries.Applicator) error {
+	var slice []*AttributeDefinition
+	var object *AttributeDefinition
+
+	if singular {
+		var ok bool
+		object, ok = maybeAttributeDefinition.(*AttributeDefinition)
+		if !ok {
+			object = new(AttributeDefinition)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAttributeDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAttributeDefinition))
+			}
+		}
+	} else {
+		s, ok := maybeAttributeDefinition.(*[]*AttributeDefinition)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAttributeDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAttributeDefinition))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attributeDefinitionR{}
+		}
+		args = append(args, object.CategoryID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attributeDefinitionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CategoryID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CategoryID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_categories`),
+		qm.WhereIn(`product_categories.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ProductCategory")
+	}
+
+	var resultSlice []*ProductCategory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ProductCategory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for product_categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_categories")
+	}
+
+	if len(attributeDefinitionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &productCategoryR{}
+		}
+		foreign.R.CategoryAttributeDefinitions = append(foreign.R.CategoryAttributeDefinitions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CategoryID == foreign.ID {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &productCategoryR{}
+				}
+				foreign.R.CategoryAttributeDefinitions = append(foreign.R.CategoryAttributeDefinitions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAttributeProductAttributeValues allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (attributeDefinitionL) LoadAttributeProductAttributeValues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAttributeDefinition interface{}, mods queries.Applicator) error {
+	var slice []*AttributeDefinition
+	var object *AttributeDefinition
+
+	if singular {
+		var ok bool
+		object, ok = maybeAttributeDefinition.(*AttributeDefinition)
+		if !ok {
+			object = new(AttributeDefinition)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAttributeDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAttributeDefinition))
+			}
+		}
+	} else {
+		s, ok := maybeAttributeDefinition.(*[]*AttributeDefinition)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAttributeDefinition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAttributeDefinition))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &attributeDefinitionR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &attributeDefinitionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_attribute_values`),
+		qm.WhereIn(`product_attribute_values.attribute_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_attribute_values")
+	}
+
+	var resultSlice []*ProductAttributeValue
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_attribute_values")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_attribute_values")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_attribute_values")
+	}
+
+	if len(productAttributeValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AttributeProductAttributeValues = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productAttributeValueR{}
+			}
+			foreign.R.Attribute = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AttributeID {
+				local.R.AttributeProductAttributeValues = append(local.R.AttributeProductAttributeValues, foreign)
+				if foreign.R == nil {
+					foreign.R = &productAttributeValueR{}
+				}
+				foreign.R.Attribute = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCategory of the attributeDefinition to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.CategoryAttributeDefinitions.
+func (o *AttributeDefinition) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ProductCategory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"attribute_definitions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 2, attributeDefinitionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CategoryID = related.ID
+	if o.R == nil {
+		o.R = &attributeDefinitionR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &productCategoryR{
+			CategoryAttributeDefinitions: AttributeDefinitionSlice{o},
+		}
+	} else {
+		related.R.CategoryAttributeDefinitions = append(related.R.CategoryAttributeDefinitions, o)
+	}
+
+	return nil
+}
+
+// AddAttributeProductAttributeValues adds the given related objects to the existing relationships
+// of the attribute_definition, optionally inserting them as new records.
+// Appends related to o.R.AttributeProductAttributeValues.
+// Sets related.R.Attribute appropriately.
+func (o *AttributeDefinition) AddAttributeProductAttributeValues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductAttributeValue) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AttributeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_attribute_values\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"attribute_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productAttributeValuePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AttributeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &attributeDefinitionR{
+			AttributeProductAttributeValues: related,
+		}
+	} else {
+		o.R.AttributeProductAttributeValues = append(o.R.AttributeProductAttributeValues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productAttributeValueR{
+				Attribute: o,
+			}
+		} else {
+			rel.R.Attribute = o
+		}
+	}
+	return nil
+}
+
+// AttributeDefinitions retrieves all the records using an executor.
+func AttributeDefinitions(mods ...qm.QueryMod) attributeDefinitionQuery {
+	mods = append(mods, qm.From("\"attribute_definitions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"attribute_definitions\".*"})
+	}
+
+	return attributeDefinitionQuery{q}
+}
+
+// FindAttributeDefinition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAttributeDefinition(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AttributeDefinition, error) {
+	attributeDefinitionObj := &AttributeDefinition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"attribute_definitions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, attributeDefinitionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from attribute_definitions")
+	}
+
+	if err = attributeDefinitionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return attributeDefinitionObj, err
+	}
+
+	return attributeDefinitionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AttributeDefinition) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attribute_definitions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attributeDefinitionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	attributeDefinitionInsertCacheMut.RLock()
+	cache, cached := attributeDefinitionInsertCache[key]
+	attributeDefinitionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			attributeDefinitionAllColumns,
+			attributeDefinitionColumnsWithDefault,
+			attributeDefinitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(attributeDefinitionType, attributeDefinitionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(attributeDefinitionType, attributeDefinitionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"attribute_definitions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"attribute_definitions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into attribute_definitions")
+	}
+
+	if !cached {
+		attributeDefinitionInsertCacheMut.Lock()
+		attributeDefinitionInsertCache[key] = cache
+		attributeDefinitionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AttributeDefinition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AttributeDefinition) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	attributeDefinitionUpdateCacheMut.RLock()
+	cache, cached := attributeDefinitionUpdateCache[key]
+	attributeDefinitionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			attributeDefinitionAllColumns,
+			attributeDefinitionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update attribute_definitions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"attribute_definitions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, attributeDefinitionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(attributeDefinitionType, attributeDefinitionMapping, append(wl, attributeDefinitionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update attribute_definitions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for attribute_definitions")
+	}
+
+	if !cached {
+		attributeDefinitionUpdateCacheMut.Lock()
+		attributeDefinitionUpdateCache[key] = cache
+		attributeDefinitionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q attributeDefinitionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for attribute_definitions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for attribute_definitions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AttributeDefinitionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attributeDefinitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"attribute_definitions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, attributeDefinitionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in attributeDefinition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all attributeDefinition")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AttributeDefinition) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no attribute_definitions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(attributeDefinitionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	attributeDefinitionUpsertCacheMut.RLock()
+	cache, cached := attributeDefinitionUpsertCache[key]
+	attributeDefinitionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			attributeDefinitionAllColumns,
+			attributeDefinitionColumnsWithDefault,
+			attributeDefinitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			attributeDefinitionAllColumns,
+			attributeDefinitionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert attribute_definitions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(attributeDefinitionPrimaryKeyColumns))
+			copy(conflict, attributeDefinitionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"attribute_definitions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(attributeDefinitionType, attributeDefinitionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(attributeDefinitionType, attributeDefinitionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert attribute_definitions")
+	}
+
+	if !cached {
+		attributeDefinitionUpsertCacheMut.Lock()
+		attributeDefinitionUpsertCache[key] = cache
+		attributeDefinitionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AttributeDefinition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AttributeDefinition) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AttributeDefinition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), attributeDefinitionPrimaryKeyMapping)
+	sql := "DELETE FROM \"attribute_definitions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from attribute_definitions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for attribute_definitions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q attributeDefinitionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no attributeDefinitionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attribute_definitions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attribute_definitions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AttributeDefinitionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(attributeDefinitionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attributeDefinitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"attribute_definitions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attributeDefinitionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from attributeDefinition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for attribute_definitions")
+	}
+
+	if len(attributeDefinitionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AttributeDefinition) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAttributeDefinition(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AttributeDefinitionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AttributeDefinitionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), attributeDefinitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"attribute_definitions\".* FROM \"attribute_definitions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, attributeDefinitionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AttributeDefinitionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AttributeDefinitionExists checks if the AttributeDefinition row exists.
+func AttributeDefinitionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"attribute_definitions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if attribute_definitions exists")
+	}
+
+	return exists, nil
+}
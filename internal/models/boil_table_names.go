@@ -4,21 +4,65 @@
 package models
 
 var TableNames = struct {
-	OrderItems        string
-	Orders            string
-	PaymentDetails    string
-	Payments          string
-	ProductCategories string
-	Products          string
-	SchemaMigrations  string
-	Users             string
+	Addresses              string
+	AttributeDefinitions   string
+	ExchangeRates          string
+	LowStockAlerts         string
+	OptionTypes            string
+	OrderItems             string
+	Orders                 string
+	PaymentDetails         string
+	Payments               string
+	ProductAttributeValues string
+	ProductCategories      string
+	ProductImages          string
+	ProductPrices          string
+	ProductReviews         string
+	ProductSlugRedirects   string
+	ProductTags            string
+	ProductVariants        string
+	Products               string
+	ReturnItems            string
+	ReturnRequests         string
+	SchemaMigrations       string
+	Shipments              string
+	ShippingMethods        string
+	StockMovements         string
+	TaxRules               string
+	Users                  string
+	VariantOptionValues    string
+	WarehouseStocks        string
+	Warehouses             string
+	WishlistItems          string
 }{
-	OrderItems:        "order_items",
-	Orders:            "orders",
-	PaymentDetails:    "payment_details",
-	Payments:          "payments",
-	ProductCategories: "product_categories",
-	Products:          "products",
-	SchemaMigrations:  "schema_migrations",
-	Users:             "users",
+	Addresses:              "addresses",
+	AttributeDefinitions:   "attribute_definitions",
+	ExchangeRates:          "exchange_rates",
+	LowStockAlerts:         "low_stock_alerts",
+	OptionTypes:            "option_types",
+	OrderItems:             "order_items",
+	Orders:                 "orders",
+	PaymentDetails:         "payment_details",
+	Payments:               "payments",
+	ProductAttributeValues: "product_attribute_values",
+	ProductCategories:      "product_categories",
+	ProductImages:          "product_images",
+	ProductPrices:          "product_prices",
+	ProductReviews:         "product_reviews",
+	ProductSlugRedirects:   "product_slug_redirects",
+	ProductTags:            "product_tags",
+	ProductVariants:        "product_variants",
+	Products:               "products",
+	ReturnItems:            "return_items",
+	ReturnRequests:         "return_requests",
+	SchemaMigrations:       "schema_migrations",
+	Shipments:              "shipments",
+	ShippingMethods:        "shipping_methods",
+	StockMovements:         "stock_movements",
+	TaxRules:               "tax_rules",
+	Users:                  "users",
+	VariantOptionValues:    "variant_option_values",
+	WarehouseStocks:        "warehouse_stocks",
+	Warehouses:             "warehouses",
+	WishlistItems:          "wishlist_items",
 }
@@ -0,0 +1,1322 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OptionType is an object representing the database table.
+type OptionType struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ProductID int       `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Position  int       `boil:"position" json:"position" toml:"position" yaml:"position"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *optionTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L optionTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OptionTypeColumns = struct {
+	ID        string
+	ProductID string
+	Name      string
+	Position  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	ProductID: "product_id",
+	Name:      "name",
+	Position:  "position",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var OptionTypeTableColumns = struct {
+	ID        string
+	ProductID string
+	Name      string
+	Position  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "option_types.id",
+	ProductID: "option_types.product_id",
+	Name:      "option_types.name",
+	Position:  "option_types.position",
+	CreatedAt: "option_types.created_at",
+	UpdatedAt: "option_types.updated_at",
+}
+
+// Generated where
+
+var OptionTypeWhere = struct {
+	ID        whereHelperint
+	ProductID whereHelperint
+	Name      whereHelperstring
+	Position  whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "\"option_types\".\"id\""},
+	ProductID: whereHelperint{field: "\"option_types\".\"product_id\""},
+	Name:      whereHelperstring{field: "\"option_types\".\"name\""},
+	Position:  whereHelperint{field: "\"option_types\".\"position\""},
+	CreatedAt: whereHelpertime_Time{field: "\"option_types\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"option_types\".\"updated_at\""},
+}
+
+// OptionTypeRels is where relationship names are stored.
+var OptionTypeRels = struct {
+	Product             string
+	VariantOptionValues string
+}{
+	Product:             "Product",
+	VariantOptionValues: "VariantOptionValues",
+}
+
+// optionTypeR is where relationships are stored.
+type optionTypeR struct {
+	Product             *Product                `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	VariantOptionValues VariantOptionValueSlice `boil:"VariantOptionValues" json:"VariantOptionValues" toml:"VariantOptionValues" yaml:"VariantOptionValues"`
+}
+
+// NewStruct creates a new relationship struct
+func (*optionTypeR) NewStruct() *optionTypeR {
+	return &optionTypeR{}
+}
+
+func (r *optionTypeR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+func (r *optionTypeR) GetVariantOptionValues() VariantOptionValueSlice {
+	if r == nil {
+		return nil
+	}
+	return r.VariantOptionValues
+}
+
+// optionTypeL is where Load methods for each relationship are stored.
+type optionTypeL struct{}
+
+var (
+	optionTypeAllColumns            = []string{"id", "product_id", "name", "position", "created_at", "updated_at"}
+	optionTypeColumnsWithoutDefault = []string{"product_id", "name"}
+	optionTypeColumnsWithDefault    = []string{"id", "position", "created_at", "updated_at"}
+	optionTypePrimaryKeyColumns     = []string{"id"}
+	optionTypeGeneratedColumns      = []string{}
+)
+
+type (
+	// OptionTypeSlice is an alias for a slice of pointers to OptionType.
+	// This should almost always be used instead of []OptionType.
+	OptionTypeSlice []*OptionType
+	// OptionTypeHook is the signature for custom OptionType hook methods
+	OptionTypeHook func(context.Context, boil.ContextExecutor, *OptionType) error
+
+	optionTypeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	optionTypeType                 = reflect.TypeOf(&OptionType{})
+	optionTypeMapping              = queries.MakeStructMapping(optionTypeType)
+	optionTypePrimaryKeyMapping, _ = queries.BindMapping(optionTypeType, optionTypeMapping, optionTypePrimaryKeyColumns)
+	optionTypeInsertCacheMut       sync.RWMutex
+	optionTypeInsertCache          = make(map[string]insertCache)
+	optionTypeUpdateCacheMut       sync.RWMutex
+	optionTypeUpdateCache          = make(map[string]updateCache)
+	optionTypeUpsertCacheMut       sync.RWMutex
+	optionTypeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var optionTypeAfterSelectHooks []OptionTypeHook
+
+var optionTypeBeforeInsertHooks []OptionTypeHook
+var optionTypeAfterInsertHooks []OptionTypeHook
+
+var optionTypeBeforeUpdateHooks []OptionTypeHook
+var optionTypeAfterUpdateHooks []OptionTypeHook
+
+var optionTypeBeforeDeleteHooks []OptionTypeHook
+var optionTypeAfterDeleteHooks []OptionTypeHook
+
+var optionTypeBeforeUpsertHooks []OptionTypeHook
+var optionTypeAfterUpsertHooks []OptionTypeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OptionType) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OptionType) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OptionType) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OptionType) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OptionType) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OptionType) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OptionType) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OptionType) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OptionType) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range optionTypeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOptionTypeHook registers your hook function for all future operations.
+func AddOptionTypeHook(hookPoint boil.HookPoint, optionTypeHook OptionTypeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		optionTypeAfterSelectHooks = append(optionTypeAfterSelectHooks, optionTypeHook)
+	case boil.BeforeInsertHook:
+		optionTypeBeforeInsertHooks = append(optionTypeBeforeInsertHooks, optionTypeHook)
+	case boil.AfterInsertHook:
+		optionTypeAfterInsertHooks = append(optionTypeAfterInsertHooks, optionTypeHook)
+	case boil.BeforeUpdateHook:
+		optionTypeBeforeUpdateHooks = append(optionTypeBeforeUpdateHooks, optionTypeHook)
+	case boil.AfterUpdateHook:
+		optionTypeAfterUpdateHooks = append(optionTypeAfterUpdateHooks, optionTypeHook)
+	case boil.BeforeDeleteHook:
+		optionTypeBeforeDeleteHooks = append(optionTypeBeforeDeleteHooks, optionTypeHook)
+	case boil.AfterDeleteHook:
+		optionTypeAfterDeleteHooks = append(optionTypeAfterDeleteHooks, optionTypeHook)
+	case boil.BeforeUpsertHook:
+		optionTypeBeforeUpsertHooks = append(optionTypeBeforeUpsertHooks, optionTypeHook)
+	case boil.AfterUpsertHook:
+		optionTypeAfterUpsertHooks = append(optionTypeAfterUpsertHooks, optionTypeHook)
+	}
+}
+
+// One returns a single optionType record from the query.
+func (q optionTypeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OptionType, error) {
+	o := &OptionType{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for option_types")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OptionType records from the query.
+func (q optionTypeQuery) All(ctx context.Context, exec boil.ContextExecutor) (OptionTypeSlice, error) {
+	var o []*OptionType
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OptionType slice")
+	}
+
+	if len(optionTypeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OptionType records in the query.
+func (q optionTypeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count option_types rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q optionTypeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if option_types exists")
+	}
+
+	return count > 0, nil
+}
+
+// Product pointed to by the foreign key.
+func (o *OptionType) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// VariantOptionValues retrieves all the variant_option_value's VariantOptionValues with an executor.
+func (o *OptionType) VariantOptionValues(mods ...qm.QueryMod) variantOptionValueQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"variant_option_values\".\"option_type_id\"=?", o.ID),
+	)
+
+	return VariantOptionValues(queryMods...)
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (optionTypeL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOptionType interface{}, mods queries.Applicator) error {
+	var slice []*OptionType
+	var object *OptionType
+
+	if singular {
+		var ok bool
+		object, ok = maybeOptionType.(*OptionType)
+		if !ok {
+			object = new(OptionType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOptionType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOptionType))
+			}
+		}
+	} else {
+		s, ok := maybeOptionType.(*[]*OptionType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOptionType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOptionType))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &optionTypeR{}
+		}
+		args = append(args, object.ProductID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &optionTypeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProductID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProductID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(optionTypeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.OptionTypes = append(foreign.R.OptionTypes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.OptionTypes = append(foreign.R.OptionTypes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVariantOptionValues allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (optionTypeL) LoadVariantOptionValues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOptionType interface{}, mods queries.Applicator) error {
+	var slice []*OptionType
+	var object *OptionType
+
+	if singular {
+		var ok bool
+		object, ok = maybeOptionType.(*OptionType)
+		if !ok {
+			object = new(OptionType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOptionType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOptionType))
+			}
+		}
+	} else {
+		s, ok := maybeOptionType.(*[]*OptionType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOptionType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOptionType))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &optionTypeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &optionTypeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`variant_option_values`),
+		qm.WhereIn(`variant_option_values.option_type_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load variant_option_values")
+	}
+
+	var resultSlice []*VariantOptionValue
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice variant_option_values")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on variant_option_values")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for variant_option_values")
+	}
+
+	if len(variantOptionValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.VariantOptionValues = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &variantOptionValueR{}
+			}
+			foreign.R.OptionType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OptionTypeID {
+				local.R.VariantOptionValues = append(local.R.VariantOptionValues, foreign)
+				if foreign.R == nil {
+					foreign.R = &variantOptionValueR{}
+				}
+				foreign.R.OptionType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProduct of the optionType to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.OptionTypes.
+func (o *OptionType) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"option_types\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, optionTypePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &optionTypeR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			OptionTypes: OptionTypeSlice{o},
+		}
+	} else {
+		related.R.OptionTypes = append(related.R.OptionTypes, o)
+	}
+
+	return nil
+}
+
+// AddVariantOptionValues adds the given related objects to the existing relationships
+// of the option_type, optionally inserting them as new records.
+// Appends related to o.R.VariantOptionValues.
+// Sets related.R.OptionType appropriately.
+func (o *OptionType) AddVariantOptionValues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*VariantOptionValue) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OptionTypeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"variant_option_values\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"option_type_id"}),
+				strmangle.WhereClause("\"", "\"", 2, variantOptionValuePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OptionTypeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &optionTypeR{
+			VariantOptionValues: related,
+		}
+	} else {
+		o.R.VariantOptionValues = append(o.R.VariantOptionValues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &variantOptionValueR{
+				OptionType: o,
+			}
+		} else {
+			rel.R.OptionType = o
+		}
+	}
+	return nil
+}
+
+// OptionTypes retrieves all the records using an executor.
+func OptionTypes(mods ...qm.QueryMod) optionTypeQuery {
+	mods = append(mods, qm.From("\"option_types\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"option_types\".*"})
+	}
+
+	return optionTypeQuery{q}
+}
+
+// FindOptionType retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOptionType(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*OptionType, error) {
+	optionTypeObj := &OptionType{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"option_types\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, optionTypeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from option_types")
+	}
+
+	if err = optionTypeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return optionTypeObj, err
+	}
+
+	return optionTypeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OptionType) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no option_types provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(optionTypeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	optionTypeInsertCacheMut.RLock()
+	cache, cached := optionTypeInsertCache[key]
+	optionTypeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			optionTypeAllColumns,
+			optionTypeColumnsWithDefault,
+			optionTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(optionTypeType, optionTypeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(optionTypeType, optionTypeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"option_types\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"option_types\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into option_types")
+	}
+
+	if !cached {
+		optionTypeInsertCacheMut.Lock()
+		optionTypeInsertCache[key] = cache
+		optionTypeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OptionType.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OptionType) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	optionTypeUpdateCacheMut.RLock()
+	cache, cached := optionTypeUpdateCache[key]
+	optionTypeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			optionTypeAllColumns,
+			optionTypePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update option_types, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"option_types\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, optionTypePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(optionTypeType, optionTypeMapping, append(wl, optionTypePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update option_types row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for option_types")
+	}
+
+	if !cached {
+		optionTypeUpdateCacheMut.Lock()
+		optionTypeUpdateCache[key] = cache
+		optionTypeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q optionTypeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for option_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for option_types")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OptionTypeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), optionTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"option_types\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, optionTypePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in optionType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all optionType")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OptionType) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no option_types provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(optionTypeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	optionTypeUpsertCacheMut.RLock()
+	cache, cached := optionTypeUpsertCache[key]
+	optionTypeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			optionTypeAllColumns,
+			optionTypeColumnsWithDefault,
+			optionTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			optionTypeAllColumns,
+			optionTypePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert option_types, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(optionTypePrimaryKeyColumns))
+			copy(conflict, optionTypePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"option_types\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(optionTypeType, optionTypeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(optionTypeType, optionTypeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert option_types")
+	}
+
+	if !cached {
+		optionTypeUpsertCacheMut.Lock()
+		optionTypeUpsertCache[key] = cache
+		optionTypeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OptionType record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OptionType) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OptionType provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), optionTypePrimaryKeyMapping)
+	sql := "DELETE FROM \"option_types\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from option_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for option_types")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q optionTypeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no optionTypeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from option_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for option_types")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OptionTypeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(optionTypeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), optionTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"option_types\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, optionTypePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from optionType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for option_types")
+	}
+
+	if len(optionTypeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OptionType) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOptionType(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OptionTypeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OptionTypeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), optionTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"option_types\".* FROM \"option_types\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, optionTypePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OptionTypeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OptionTypeExists checks if the OptionType row exists.
+func OptionTypeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"option_types\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if option_types exists")
+	}
+
+	return exists, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/friendsofgo/errors"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,72 +25,104 @@ import (
 
 // OrderItem is an object representing the database table.
 type OrderItem struct {
-	ID        int             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	OrderID   int             `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
-	ProductID int             `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
-	Price     decimal.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
-	Quantity  int             `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
-	CreatedAt time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID          int             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrderID     int             `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	ProductID   int             `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Price       decimal.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Quantity    int             `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	CreatedAt   time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	TaxRate     decimal.Decimal `boil:"tax_rate" json:"tax_rate" toml:"tax_rate" yaml:"tax_rate"`
+	TaxAmount   decimal.Decimal `boil:"tax_amount" json:"tax_amount" toml:"tax_amount" yaml:"tax_amount"`
+	VariantID   null.Int        `boil:"variant_id" json:"variant_id,omitempty" toml:"variant_id" yaml:"variant_id,omitempty"`
+	WarehouseID null.Int        `boil:"warehouse_id" json:"warehouse_id,omitempty" toml:"warehouse_id" yaml:"warehouse_id,omitempty"`
 
 	R *orderItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var OrderItemColumns = struct {
-	ID        string
-	OrderID   string
-	ProductID string
-	Price     string
-	Quantity  string
-	CreatedAt string
-	UpdatedAt string
+	ID          string
+	OrderID     string
+	ProductID   string
+	Price       string
+	Quantity    string
+	CreatedAt   string
+	UpdatedAt   string
+	TaxRate     string
+	TaxAmount   string
+	VariantID   string
+	WarehouseID string
 }{
-	ID:        "id",
-	OrderID:   "order_id",
-	ProductID: "product_id",
-	Price:     "price",
-	Quantity:  "quantity",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:          "id",
+	OrderID:     "order_id",
+	ProductID:   "product_id",
+	Price:       "price",
+	Quantity:    "quantity",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	TaxRate:     "tax_rate",
+	TaxAmount:   "tax_amount",
+	VariantID:   "variant_id",
+	WarehouseID: "warehouse_id",
 }
 
 var OrderItemTableColumns = struct {
-	ID        string
-	OrderID   string
-	ProductID string
-	Price     string
-	Quantity  string
-	CreatedAt string
-	UpdatedAt string
+	ID          string
+	OrderID     string
+	ProductID   string
+	Price       string
+	Quantity    string
+	CreatedAt   string
+	UpdatedAt   string
+	TaxRate     string
+	TaxAmount   string
+	VariantID   string
+	WarehouseID string
 }{
-	ID:        "order_items.id",
-	OrderID:   "order_items.order_id",
-	ProductID: "order_items.product_id",
-	Price:     "order_items.price",
-	Quantity:  "order_items.quantity",
-	CreatedAt: "order_items.created_at",
-	UpdatedAt: "order_items.updated_at",
+	ID:          "order_items.id",
+	OrderID:     "order_items.order_id",
+	ProductID:   "order_items.product_id",
+	Price:       "order_items.price",
+	Quantity:    "order_items.quantity",
+	CreatedAt:   "order_items.created_at",
+	UpdatedAt:   "order_items.updated_at",
+	TaxRate:     "order_items.tax_rate",
+	TaxAmount:   "order_items.tax_amount",
+	VariantID:   "order_items.variant_id",
+	WarehouseID: "order_items.warehouse_id",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
+type whereHelpernull_Int struct{ field string }
 
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -97,79 +130,57 @@ func (w whereHelperint) NIN(slice []int) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelperdecimal_Decimal struct{ field string }
-
-func (w whereHelperdecimal_Decimal) EQ(x decimal.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelperdecimal_Decimal) NEQ(x decimal.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelperdecimal_Decimal) LT(x decimal.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelperdecimal_Decimal) LTE(x decimal.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelperdecimal_Decimal) GT(x decimal.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelperdecimal_Decimal) GTE(x decimal.Decimal) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var OrderItemWhere = struct {
-	ID        whereHelperint
-	OrderID   whereHelperint
-	ProductID whereHelperint
-	Price     whereHelperdecimal_Decimal
-	Quantity  whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
+	ID          whereHelperint
+	OrderID     whereHelperint
+	ProductID   whereHelperint
+	Price       whereHelperdecimal_Decimal
+	Quantity    whereHelperint
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	TaxRate     whereHelperdecimal_Decimal
+	TaxAmount   whereHelperdecimal_Decimal
+	VariantID   whereHelpernull_Int
+	WarehouseID whereHelpernull_Int
 }{
-	ID:        whereHelperint{field: "\"order_items\".\"id\""},
-	OrderID:   whereHelperint{field: "\"order_items\".\"order_id\""},
-	ProductID: whereHelperint{field: "\"order_items\".\"product_id\""},
-	Price:     whereHelperdecimal_Decimal{field: "\"order_items\".\"price\""},
-	Quantity:  whereHelperint{field: "\"order_items\".\"quantity\""},
-	CreatedAt: whereHelpertime_Time{field: "\"order_items\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"order_items\".\"updated_at\""},
+	ID:          whereHelperint{field: "\"order_items\".\"id\""},
+	OrderID:     whereHelperint{field: "\"order_items\".\"order_id\""},
+	ProductID:   whereHelperint{field: "\"order_items\".\"product_id\""},
+	Price:       whereHelperdecimal_Decimal{field: "\"order_items\".\"price\""},
+	Quantity:    whereHelperint{field: "\"order_items\".\"quantity\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"order_items\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"order_items\".\"updated_at\""},
+	TaxRate:     whereHelperdecimal_Decimal{field: "\"order_items\".\"tax_rate\""},
+	TaxAmount:   whereHelperdecimal_Decimal{field: "\"order_items\".\"tax_amount\""},
+	VariantID:   whereHelpernull_Int{field: "\"order_items\".\"variant_id\""},
+	WarehouseID: whereHelpernull_Int{field: "\"order_items\".\"warehouse_id\""},
 }
 
 // OrderItemRels is where relationship names are stored.
 var OrderItemRels = struct {
-	Order   string
-	Product string
+	Order       string
+	Product     string
+	Variant     string
+	Warehouse   string
+	ReturnItems string
 }{
-	Order:   "Order",
-	Product: "Product",
+	Order:       "Order",
+	Product:     "Product",
+	Variant:     "Variant",
+	Warehouse:   "Warehouse",
+	ReturnItems: "ReturnItems",
 }
 
 // orderItemR is where relationships are stored.
 type orderItemR struct {
-	Order   *Order   `boil:"Order" json:"Order" toml:"Order" yaml:"Order"`
-	Product *Product `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	Order       *Order          `boil:"Order" json:"Order" toml:"Order" yaml:"Order"`
+	Product     *Product        `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	Variant     *ProductVariant `boil:"Variant" json:"Variant" toml:"Variant" yaml:"Variant"`
+	Warehouse   *Warehouse      `boil:"Warehouse" json:"Warehouse" toml:"Warehouse" yaml:"Warehouse"`
+	ReturnItems ReturnItemSlice `boil:"ReturnItems" json:"ReturnItems" toml:"ReturnItems" yaml:"ReturnItems"`
 }
 
 // NewStruct creates a new relationship struct
@@ -191,13 +202,34 @@ func (r *orderItemR) GetProduct() *Product {
 	return r.Product
 }
 
+func (r *orderItemR) GetVariant() *ProductVariant {
+	if r == nil {
+		return nil
+	}
+	return r.Variant
+}
+
+func (r *orderItemR) GetWarehouse() *Warehouse {
+	if r == nil {
+		return nil
+	}
+	return r.Warehouse
+}
+
+func (r *orderItemR) GetReturnItems() ReturnItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReturnItems
+}
+
 // orderItemL is where Load methods for each relationship are stored.
 type orderItemL struct{}
 
 var (
-	orderItemAllColumns            = []string{"id", "order_id", "product_id", "price", "quantity", "created_at", "updated_at"}
+	orderItemAllColumns            = []string{"id", "order_id", "product_id", "price", "quantity", "created_at", "updated_at", "tax_rate", "tax_amount", "variant_id", "warehouse_id"}
 	orderItemColumnsWithoutDefault = []string{"order_id", "product_id", "price", "quantity"}
-	orderItemColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	orderItemColumnsWithDefault    = []string{"id", "created_at", "updated_at", "tax_rate", "tax_amount", "variant_id", "warehouse_id"}
 	orderItemPrimaryKeyColumns     = []string{"id"}
 	orderItemGeneratedColumns      = []string{}
 )
@@ -502,6 +534,42 @@ func (o *OrderItem) Product(mods ...qm.QueryMod) productQuery {
 	return Products(queryMods...)
 }
 
+// Variant pointed to by the foreign key.
+func (o *OrderItem) Variant(mods ...qm.QueryMod) productVariantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VariantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ProductVariants(queryMods...)
+}
+
+// Warehouse pointed to by the foreign key.
+func (o *OrderItem) Warehouse(mods ...qm.QueryMod) warehouseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WarehouseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Warehouses(queryMods...)
+}
+
+// ReturnItems retrieves all the return_item's ReturnItems with an executor.
+func (o *OrderItem) ReturnItems(mods ...qm.QueryMod) returnItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"return_items\".\"order_item_id\"=?", o.ID),
+	)
+
+	return ReturnItems(queryMods...)
+}
+
 // LoadOrder allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (orderItemL) LoadOrder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderItem interface{}, mods queries.Applicator) error {
@@ -742,6 +810,368 @@ func (orderItemL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// LoadVariant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (orderItemL) LoadVariant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderItem interface{}, mods queries.Applicator) error {
+	var slice []*OrderItem
+	var object *OrderItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrderItem.(*OrderItem)
+		if !ok {
+			object = new(OrderItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrderItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrderItem))
+			}
+		}
+	} else {
+		s, ok := maybeOrderItem.(*[]*OrderItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrderItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrderItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderItemR{}
+		}
+		if !queries.IsNil(object.VariantID) {
+			args = append(args, object.VariantID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.VariantID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.VariantID) {
+				args = append(args, obj.VariantID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_variants`),
+		qm.WhereIn(`product_variants.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ProductVariant")
+	}
+
+	var resultSlice []*ProductVariant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ProductVariant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for product_variants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_variants")
+	}
+
+	if len(orderItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Variant = foreign
+		if foreign.R == nil {
+			foreign.R = &productVariantR{}
+		}
+		foreign.R.VariantOrderItems = append(foreign.R.VariantOrderItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.VariantID, foreign.ID) {
+				local.R.Variant = foreign
+				if foreign.R == nil {
+					foreign.R = &productVariantR{}
+				}
+				foreign.R.VariantOrderItems = append(foreign.R.VariantOrderItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWarehouse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (orderItemL) LoadWarehouse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderItem interface{}, mods queries.Applicator) error {
+	var slice []*OrderItem
+	var object *OrderItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrderItem.(*OrderItem)
+		if !ok {
+			object = new(OrderItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrderItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrderItem))
+			}
+		}
+	} else {
+		s, ok := maybeOrderItem.(*[]*OrderItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrderItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrderItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderItemR{}
+		}
+		if !queries.IsNil(object.WarehouseID) {
+			args = append(args, object.WarehouseID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.WarehouseID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.WarehouseID) {
+				args = append(args, obj.WarehouseID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`warehouses`),
+		qm.WhereIn(`warehouses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Warehouse")
+	}
+
+	var resultSlice []*Warehouse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Warehouse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for warehouses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for warehouses")
+	}
+
+	if len(orderItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Warehouse = foreign
+		if foreign.R == nil {
+			foreign.R = &warehouseR{}
+		}
+		foreign.R.OrderItems = append(foreign.R.OrderItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.WarehouseID, foreign.ID) {
+				local.R.Warehouse = foreign
+				if foreign.R == nil {
+					foreign.R = &warehouseR{}
+				}
+				foreign.R.OrderItems = append(foreign.R.OrderItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReturnItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderItemL) LoadReturnItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderItem interface{}, mods queries.Applicator) error {
+	var slice []*OrderItem
+	var object *OrderItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrderItem.(*OrderItem)
+		if !ok {
+			object = new(OrderItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrderItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrderItem))
+			}
+		}
+	} else {
+		s, ok := maybeOrderItem.(*[]*OrderItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrderItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrderItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderItemR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`return_items`),
+		qm.WhereIn(`return_items.order_item_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load return_items")
+	}
+
+	var resultSlice []*ReturnItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice return_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on return_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for return_items")
+	}
+
+	if len(returnItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReturnItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &returnItemR{}
+			}
+			foreign.R.OrderItem = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderItemID {
+				local.R.ReturnItems = append(local.R.ReturnItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &returnItemR{}
+				}
+				foreign.R.OrderItem = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetOrder of the orderItem to the related item.
 // Sets o.R.Order to related.
 // Adds o to related.R.OrderItems.
@@ -836,6 +1266,219 @@ func (o *OrderItem) SetProduct(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// SetVariant of the orderItem to the related item.
+// Sets o.R.Variant to related.
+// Adds o to related.R.VariantOrderItems.
+func (o *OrderItem) SetVariant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ProductVariant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"order_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"variant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.VariantID, related.ID)
+	if o.R == nil {
+		o.R = &orderItemR{
+			Variant: related,
+		}
+	} else {
+		o.R.Variant = related
+	}
+
+	if related.R == nil {
+		related.R = &productVariantR{
+			VariantOrderItems: OrderItemSlice{o},
+		}
+	} else {
+		related.R.VariantOrderItems = append(related.R.VariantOrderItems, o)
+	}
+
+	return nil
+}
+
+// RemoveVariant relationship.
+// Sets o.R.Variant to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *OrderItem) RemoveVariant(ctx context.Context, exec boil.ContextExecutor, related *ProductVariant) error {
+	var err error
+
+	queries.SetScanner(&o.VariantID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("variant_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Variant = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.VariantOrderItems {
+		if queries.Equal(o.VariantID, ri.VariantID) {
+			continue
+		}
+
+		ln := len(related.R.VariantOrderItems)
+		if ln > 1 && i < ln-1 {
+			related.R.VariantOrderItems[i] = related.R.VariantOrderItems[ln-1]
+		}
+		related.R.VariantOrderItems = related.R.VariantOrderItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetWarehouse of the orderItem to the related item.
+// Sets o.R.Warehouse to related.
+// Adds o to related.R.OrderItems.
+func (o *OrderItem) SetWarehouse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Warehouse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"order_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"warehouse_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.WarehouseID, related.ID)
+	if o.R == nil {
+		o.R = &orderItemR{
+			Warehouse: related,
+		}
+	} else {
+		o.R.Warehouse = related
+	}
+
+	if related.R == nil {
+		related.R = &warehouseR{
+			OrderItems: OrderItemSlice{o},
+		}
+	} else {
+		related.R.OrderItems = append(related.R.OrderItems, o)
+	}
+
+	return nil
+}
+
+// RemoveWarehouse relationship.
+// Sets o.R.Warehouse to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *OrderItem) RemoveWarehouse(ctx context.Context, exec boil.ContextExecutor, related *Warehouse) error {
+	var err error
+
+	queries.SetScanner(&o.WarehouseID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("warehouse_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Warehouse = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OrderItems {
+		if queries.Equal(o.WarehouseID, ri.WarehouseID) {
+			continue
+		}
+
+		ln := len(related.R.OrderItems)
+		if ln > 1 && i < ln-1 {
+			related.R.OrderItems[i] = related.R.OrderItems[ln-1]
+		}
+		related.R.OrderItems = related.R.OrderItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddReturnItems adds the given related objects to the existing relationships
+// of the order_item, optionally inserting them as new records.
+// Appends related to o.R.ReturnItems.
+// Sets related.R.OrderItem appropriately.
+func (o *OrderItem) AddReturnItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReturnItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderItemID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"return_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"order_item_id"}),
+				strmangle.WhereClause("\"", "\"", 2, returnItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderItemID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderItemR{
+			ReturnItems: related,
+		}
+	} else {
+		o.R.ReturnItems = append(o.R.ReturnItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &returnItemR{
+				OrderItem: o,
+			}
+		} else {
+			rel.R.OrderItem = o
+		}
+	}
+	return nil
+}
+
 // OrderItems retrieves all the records using an executor.
 func OrderItems(mods ...qm.QueryMod) orderItemQuery {
 	mods = append(mods, qm.From("\"order_items\""))
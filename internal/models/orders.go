@@ -15,6 +15,7 @@ import (
 
 	"github.com/friendsofgo/errors"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,74 +25,96 @@ import (
 
 // Order is an object representing the database table.
 type Order struct {
-	ID         int                 `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID     int                 `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Status     string              `boil:"status" json:"status" toml:"status" yaml:"status"`
-	CreatedAt  time.Time           `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  time.Time           `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	TotalPrice decimal.NullDecimal `boil:"total_price" json:"total_price,omitempty" toml:"total_price" yaml:"total_price,omitempty"`
+	ID               int                 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID           int                 `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Status           string              `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt        time.Time           `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time           `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	TotalPrice       decimal.NullDecimal `boil:"total_price" json:"total_price,omitempty" toml:"total_price" yaml:"total_price,omitempty"`
+	Region           string              `boil:"region" json:"region" toml:"region" yaml:"region"`
+	SubtotalPrice    decimal.NullDecimal `boil:"subtotal_price" json:"subtotal_price,omitempty" toml:"subtotal_price" yaml:"subtotal_price,omitempty"`
+	TaxPrice         decimal.NullDecimal `boil:"tax_price" json:"tax_price,omitempty" toml:"tax_price" yaml:"tax_price,omitempty"`
+	AddressID        null.Int            `boil:"address_id" json:"address_id,omitempty" toml:"address_id" yaml:"address_id,omitempty"`
+	ShippingMethodID null.Int            `boil:"shipping_method_id" json:"shipping_method_id,omitempty" toml:"shipping_method_id" yaml:"shipping_method_id,omitempty"`
+	ShippingPrice    decimal.NullDecimal `boil:"shipping_price" json:"shipping_price,omitempty" toml:"shipping_price" yaml:"shipping_price,omitempty"`
+	Currency         string              `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	ExchangeRate     decimal.NullDecimal `boil:"exchange_rate" json:"exchange_rate,omitempty" toml:"exchange_rate" yaml:"exchange_rate,omitempty"`
+	Version          int                 `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *orderR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var OrderColumns = struct {
-	ID         string
-	UserID     string
-	Status     string
-	CreatedAt  string
-	UpdatedAt  string
-	TotalPrice string
+	ID               string
+	UserID           string
+	Status           string
+	CreatedAt        string
+	UpdatedAt        string
+	TotalPrice       string
+	Region           string
+	SubtotalPrice    string
+	TaxPrice         string
+	AddressID        string
+	ShippingMethodID string
+	ShippingPrice    string
+	Currency         string
+	ExchangeRate     string
+	Version          string
 }{
-	ID:         "id",
-	UserID:     "user_id",
-	Status:     "status",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
-	TotalPrice: "total_price",
+	ID:               "id",
+	UserID:           "user_id",
+	Status:           "status",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	TotalPrice:       "total_price",
+	Region:           "region",
+	SubtotalPrice:    "subtotal_price",
+	TaxPrice:         "tax_price",
+	AddressID:        "address_id",
+	ShippingMethodID: "shipping_method_id",
+	ShippingPrice:    "shipping_price",
+	Currency:         "currency",
+	ExchangeRate:     "exchange_rate",
+	Version:          "version",
 }
 
 var OrderTableColumns = struct {
-	ID         string
-	UserID     string
-	Status     string
-	CreatedAt  string
-	UpdatedAt  string
-	TotalPrice string
+	ID               string
+	UserID           string
+	Status           string
+	CreatedAt        string
+	UpdatedAt        string
+	TotalPrice       string
+	Region           string
+	SubtotalPrice    string
+	TaxPrice         string
+	AddressID        string
+	ShippingMethodID string
+	ShippingPrice    string
+	Currency         string
+	ExchangeRate     string
+	Version          string
 }{
-	ID:         "orders.id",
-	UserID:     "orders.user_id",
-	Status:     "orders.status",
-	CreatedAt:  "orders.created_at",
-	UpdatedAt:  "orders.updated_at",
-	TotalPrice: "orders.total_price",
+	ID:               "orders.id",
+	UserID:           "orders.user_id",
+	Status:           "orders.status",
+	CreatedAt:        "orders.created_at",
+	UpdatedAt:        "orders.updated_at",
+	TotalPrice:       "orders.total_price",
+	Region:           "orders.region",
+	SubtotalPrice:    "orders.subtotal_price",
+	TaxPrice:         "orders.tax_price",
+	AddressID:        "orders.address_id",
+	ShippingMethodID: "orders.shipping_method_id",
+	ShippingPrice:    "orders.shipping_price",
+	Currency:         "orders.currency",
+	ExchangeRate:     "orders.exchange_rate",
+	Version:          "orders.version",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperdecimal_NullDecimal struct{ field string }
 
 func (w whereHelperdecimal_NullDecimal) EQ(x decimal.NullDecimal) qm.QueryMod {
@@ -119,37 +142,70 @@ func (w whereHelperdecimal_NullDecimal) IsNotNull() qm.QueryMod {
 }
 
 var OrderWhere = struct {
-	ID         whereHelperint
-	UserID     whereHelperint
-	Status     whereHelperstring
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpertime_Time
-	TotalPrice whereHelperdecimal_NullDecimal
+	ID               whereHelperint
+	UserID           whereHelperint
+	Status           whereHelperstring
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+	TotalPrice       whereHelperdecimal_NullDecimal
+	Region           whereHelperstring
+	SubtotalPrice    whereHelperdecimal_NullDecimal
+	TaxPrice         whereHelperdecimal_NullDecimal
+	AddressID        whereHelpernull_Int
+	ShippingMethodID whereHelpernull_Int
+	ShippingPrice    whereHelperdecimal_NullDecimal
+	Currency         whereHelperstring
+	ExchangeRate     whereHelperdecimal_NullDecimal
+	Version          whereHelperint
 }{
-	ID:         whereHelperint{field: "\"orders\".\"id\""},
-	UserID:     whereHelperint{field: "\"orders\".\"user_id\""},
-	Status:     whereHelperstring{field: "\"orders\".\"status\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"orders\".\"created_at\""},
-	UpdatedAt:  whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
-	TotalPrice: whereHelperdecimal_NullDecimal{field: "\"orders\".\"total_price\""},
+	ID:               whereHelperint{field: "\"orders\".\"id\""},
+	UserID:           whereHelperint{field: "\"orders\".\"user_id\""},
+	Status:           whereHelperstring{field: "\"orders\".\"status\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"orders\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
+	TotalPrice:       whereHelperdecimal_NullDecimal{field: "\"orders\".\"total_price\""},
+	Region:           whereHelperstring{field: "\"orders\".\"region\""},
+	SubtotalPrice:    whereHelperdecimal_NullDecimal{field: "\"orders\".\"subtotal_price\""},
+	TaxPrice:         whereHelperdecimal_NullDecimal{field: "\"orders\".\"tax_price\""},
+	AddressID:        whereHelpernull_Int{field: "\"orders\".\"address_id\""},
+	ShippingMethodID: whereHelpernull_Int{field: "\"orders\".\"shipping_method_id\""},
+	ShippingPrice:    whereHelperdecimal_NullDecimal{field: "\"orders\".\"shipping_price\""},
+	Currency:         whereHelperstring{field: "\"orders\".\"currency\""},
+	ExchangeRate:     whereHelperdecimal_NullDecimal{field: "\"orders\".\"exchange_rate\""},
+	Version:          whereHelperint{field: "\"orders\".\"version\""},
 }
 
 // OrderRels is where relationship names are stored.
 var OrderRels = struct {
-	User           string
-	OrderItems     string
-	PaymentDetails string
+	Address                        string
+	ShippingMethod                 string
+	User                           string
+	OrderItems                     string
+	PaymentDetails                 string
+	ReturnRequests                 string
+	ReplacementOrderReturnRequests string
+	Shipments                      string
 }{
-	User:           "User",
-	OrderItems:     "OrderItems",
-	PaymentDetails: "PaymentDetails",
+	Address:                        "Address",
+	ShippingMethod:                 "ShippingMethod",
+	User:                           "User",
+	OrderItems:                     "OrderItems",
+	PaymentDetails:                 "PaymentDetails",
+	ReturnRequests:                 "ReturnRequests",
+	ReplacementOrderReturnRequests: "ReplacementOrderReturnRequests",
+	Shipments:                      "Shipments",
 }
 
 // orderR is where relationships are stored.
 type orderR struct {
-	User           *User              `boil:"User" json:"User" toml:"User" yaml:"User"`
-	OrderItems     OrderItemSlice     `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
-	PaymentDetails PaymentDetailSlice `boil:"PaymentDetails" json:"PaymentDetails" toml:"PaymentDetails" yaml:"PaymentDetails"`
+	Address                        *Address           `boil:"Address" json:"Address" toml:"Address" yaml:"Address"`
+	ShippingMethod                 *ShippingMethod    `boil:"ShippingMethod" json:"ShippingMethod" toml:"ShippingMethod" yaml:"ShippingMethod"`
+	User                           *User              `boil:"User" json:"User" toml:"User" yaml:"User"`
+	OrderItems                     OrderItemSlice     `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	PaymentDetails                 PaymentDetailSlice `boil:"PaymentDetails" json:"PaymentDetails" toml:"PaymentDetails" yaml:"PaymentDetails"`
+	ReturnRequests                 ReturnRequestSlice `boil:"ReturnRequests" json:"ReturnRequests" toml:"ReturnRequests" yaml:"ReturnRequests"`
+	ReplacementOrderReturnRequests ReturnRequestSlice `boil:"ReplacementOrderReturnRequests" json:"ReplacementOrderReturnRequests" toml:"ReplacementOrderReturnRequests" yaml:"ReplacementOrderReturnRequests"`
+	Shipments                      ShipmentSlice      `boil:"Shipments" json:"Shipments" toml:"Shipments" yaml:"Shipments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -157,6 +213,20 @@ func (*orderR) NewStruct() *orderR {
 	return &orderR{}
 }
 
+func (r *orderR) GetAddress() *Address {
+	if r == nil {
+		return nil
+	}
+	return r.Address
+}
+
+func (r *orderR) GetShippingMethod() *ShippingMethod {
+	if r == nil {
+		return nil
+	}
+	return r.ShippingMethod
+}
+
 func (r *orderR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -178,13 +248,34 @@ func (r *orderR) GetPaymentDetails() PaymentDetailSlice {
 	return r.PaymentDetails
 }
 
+func (r *orderR) GetReturnRequests() ReturnRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReturnRequests
+}
+
+func (r *orderR) GetReplacementOrderReturnRequests() ReturnRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReplacementOrderReturnRequests
+}
+
+func (r *orderR) GetShipments() ShipmentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Shipments
+}
+
 // orderL is where Load methods for each relationship are stored.
 type orderL struct{}
 
 var (
-	orderAllColumns            = []string{"id", "user_id", "status", "created_at", "updated_at", "total_price"}
+	orderAllColumns            = []string{"id", "user_id", "status", "created_at", "updated_at", "total_price", "region", "subtotal_price", "tax_price", "address_id", "shipping_method_id", "shipping_price", "currency", "exchange_rate", "version"}
 	orderColumnsWithoutDefault = []string{"user_id", "status"}
-	orderColumnsWithDefault    = []string{"id", "created_at", "updated_at", "total_price"}
+	orderColumnsWithDefault    = []string{"id", "created_at", "updated_at", "total_price", "region", "subtotal_price", "tax_price", "address_id", "shipping_method_id", "shipping_price", "currency", "exchange_rate", "version"}
 	orderPrimaryKeyColumns     = []string{"id"}
 	orderGeneratedColumns      = []string{}
 )
@@ -467,6 +558,28 @@ func (q orderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// Address pointed to by the foreign key.
+func (o *Order) Address(mods ...qm.QueryMod) addressQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AddressID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Addresses(queryMods...)
+}
+
+// ShippingMethod pointed to by the foreign key.
+func (o *Order) ShippingMethod(mods ...qm.QueryMod) shippingMethodQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ShippingMethodID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ShippingMethods(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *Order) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -506,9 +619,51 @@ func (o *Order) PaymentDetails(mods ...qm.QueryMod) paymentDetailQuery {
 	return PaymentDetails(queryMods...)
 }
 
-// LoadUser allows an eager lookup of values, cached into the
+// ReturnRequests retrieves all the return_request's ReturnRequests with an executor.
+func (o *Order) ReturnRequests(mods ...qm.QueryMod) returnRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"return_requests\".\"order_id\"=?", o.ID),
+	)
+
+	return ReturnRequests(queryMods...)
+}
+
+// ReplacementOrderReturnRequests retrieves all the return_request's ReturnRequests with an executor via replacement_order_id column.
+func (o *Order) ReplacementOrderReturnRequests(mods ...qm.QueryMod) returnRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"return_requests\".\"replacement_order_id\"=?", o.ID),
+	)
+
+	return ReturnRequests(queryMods...)
+}
+
+// Shipments retrieves all the shipment's Shipments with an executor.
+func (o *Order) Shipments(mods ...qm.QueryMod) shipmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"shipments\".\"order_id\"=?", o.ID),
+	)
+
+	return Shipments(queryMods...)
+}
+
+// LoadAddress allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+func (orderL) LoadAddress(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
 	var slice []*Order
 	var object *Order
 
@@ -539,7 +694,9 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 		if object.R == nil {
 			object.R = &orderR{}
 		}
-		args = append(args, object.UserID)
+		if !queries.IsNil(object.AddressID) {
+			args = append(args, object.AddressID)
+		}
 
 	} else {
 	Outer:
@@ -549,12 +706,14 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 			}
 
 			for _, a := range args {
-				if a == obj.UserID {
+				if queries.Equal(a, obj.AddressID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.UserID)
+			if !queries.IsNil(obj.AddressID) {
+				args = append(args, obj.AddressID)
+			}
 
 		}
 	}
@@ -564,8 +723,8 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 	}
 
 	query := NewQuery(
-		qm.From(`users`),
-		qm.WhereIn(`users.id in ?`, args...),
+		qm.From(`addresses`),
+		qm.WhereIn(`addresses.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -573,19 +732,19 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load User")
+		return errors.Wrap(err, "failed to eager load Address")
 	}
 
-	var resultSlice []*User
+	var resultSlice []*Address
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice User")
+		return errors.Wrap(err, "failed to bind eager loaded slice Address")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for users")
+		return errors.Wrap(err, "failed to close results of eager load for addresses")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for addresses")
 	}
 
 	if len(orderAfterSelectHooks) != 0 {
@@ -602,9 +761,9 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.User = foreign
+		object.R.Address = foreign
 		if foreign.R == nil {
-			foreign.R = &userR{}
+			foreign.R = &addressR{}
 		}
 		foreign.R.Orders = append(foreign.R.Orders, object)
 		return nil
@@ -612,10 +771,10 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.UserID == foreign.ID {
-				local.R.User = foreign
+			if queries.Equal(local.AddressID, foreign.ID) {
+				local.R.Address = foreign
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &addressR{}
 				}
 				foreign.R.Orders = append(foreign.R.Orders, local)
 				break
@@ -626,9 +785,9 @@ func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadOrderItems allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+// LoadShippingMethod allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (orderL) LoadShippingMethod(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
 	var slice []*Order
 	var object *Order
 
@@ -659,7 +818,10 @@ func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singul
 		if object.R == nil {
 			object.R = &orderR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.ShippingMethodID) {
+			args = append(args, object.ShippingMethodID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -668,12 +830,15 @@ func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singul
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ShippingMethodID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.ShippingMethodID) {
+				args = append(args, obj.ShippingMethodID)
+			}
+
 		}
 	}
 
@@ -682,8 +847,8 @@ func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`order_items`),
-		qm.WhereIn(`order_items.order_id in ?`, args...),
+		qm.From(`shipping_methods`),
+		qm.WhereIn(`shipping_methods.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -691,47 +856,51 @@ func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load order_items")
+		return errors.Wrap(err, "failed to eager load ShippingMethod")
 	}
 
-	var resultSlice []*OrderItem
+	var resultSlice []*ShippingMethod
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice order_items")
+		return errors.Wrap(err, "failed to bind eager loaded slice ShippingMethod")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on order_items")
+		return errors.Wrap(err, "failed to close results of eager load for shipping_methods")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_items")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for shipping_methods")
 	}
 
-	if len(orderItemAfterSelectHooks) != 0 {
+	if len(orderAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.OrderItems = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &orderItemR{}
-			}
-			foreign.R.Order = object
+		foreign := resultSlice[0]
+		object.R.ShippingMethod = foreign
+		if foreign.R == nil {
+			foreign.R = &shippingMethodR{}
 		}
+		foreign.R.Orders = append(foreign.R.Orders, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.OrderID {
-				local.R.OrderItems = append(local.R.OrderItems, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ShippingMethodID, foreign.ID) {
+				local.R.ShippingMethod = foreign
 				if foreign.R == nil {
-					foreign.R = &orderItemR{}
+					foreign.R = &shippingMethodR{}
 				}
-				foreign.R.Order = local
+				foreign.R.Orders = append(foreign.R.Orders, local)
 				break
 			}
 		}
@@ -740,9 +909,9 @@ func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadPaymentDetails allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
 	var slice []*Order
 	var object *Order
 
@@ -773,7 +942,8 @@ func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, si
 		if object.R == nil {
 			object.R = &orderR{}
 		}
-		args = append(args, object.ID)
+		args = append(args, object.UserID)
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -782,12 +952,13 @@ func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, si
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if a == obj.UserID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			args = append(args, obj.UserID)
+
 		}
 	}
 
@@ -796,8 +967,8 @@ func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`payment_details`),
-		qm.WhereIn(`payment_details.order_id in ?`, args...),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -805,47 +976,51 @@ func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load payment_details")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*PaymentDetail
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice payment_details")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on payment_details")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for payment_details")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(paymentDetailAfterSelectHooks) != 0 {
+	if len(orderAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.PaymentDetails = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &paymentDetailR{}
-			}
-			foreign.R.Order = object
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
 		}
+		foreign.R.Orders = append(foreign.R.Orders, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.OrderID {
-				local.R.PaymentDetails = append(local.R.PaymentDetails, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
 				if foreign.R == nil {
-					foreign.R = &paymentDetailR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Order = local
+				foreign.R.Orders = append(foreign.R.Orders, local)
 				break
 			}
 		}
@@ -854,51 +1029,781 @@ func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// SetUser of the order to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.Orders.
-func (o *Order) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"orders\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-		strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadOrderItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
 
-	o.UserID = related.ID
-	if o.R == nil {
-		o.R = &orderR{
-			User: related,
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
 		}
 	} else {
-		o.R.User = related
-	}
-
-	if related.R == nil {
-		related.R = &userR{
-			Orders: OrderSlice{o},
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
 		}
-	} else {
-		related.R.Orders = append(related.R.Orders, o)
 	}
 
-	return nil
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`order_items`),
+		qm.WhereIn(`order_items.order_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load order_items")
+	}
+
+	var resultSlice []*OrderItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice order_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on order_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_items")
+	}
+
+	if len(orderItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrderItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderItemR{}
+			}
+			foreign.R.Order = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderID {
+				local.R.OrderItems = append(local.R.OrderItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderItemR{}
+				}
+				foreign.R.Order = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPaymentDetails allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadPaymentDetails(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
+		}
+	} else {
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`payment_details`),
+		qm.WhereIn(`payment_details.order_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load payment_details")
+	}
+
+	var resultSlice []*PaymentDetail
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice payment_details")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on payment_details")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for payment_details")
+	}
+
+	if len(paymentDetailAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PaymentDetails = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &paymentDetailR{}
+			}
+			foreign.R.Order = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderID {
+				local.R.PaymentDetails = append(local.R.PaymentDetails, foreign)
+				if foreign.R == nil {
+					foreign.R = &paymentDetailR{}
+				}
+				foreign.R.Order = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReturnRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadReturnRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
+		}
+	} else {
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`return_requests`),
+		qm.WhereIn(`return_requests.order_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load return_requests")
+	}
+
+	var resultSlice []*ReturnRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice return_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on return_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for return_requests")
+	}
+
+	if len(returnRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReturnRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &returnRequestR{}
+			}
+			foreign.R.Order = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderID {
+				local.R.ReturnRequests = append(local.R.ReturnRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &returnRequestR{}
+				}
+				foreign.R.Order = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReplacementOrderReturnRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadReplacementOrderReturnRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
+		}
+	} else {
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`return_requests`),
+		qm.WhereIn(`return_requests.replacement_order_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load return_requests")
+	}
+
+	var resultSlice []*ReturnRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice return_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on return_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for return_requests")
+	}
+
+	if len(returnRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReplacementOrderReturnRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &returnRequestR{}
+			}
+			foreign.R.ReplacementOrder = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReplacementOrderID) {
+				local.R.ReplacementOrderReturnRequests = append(local.R.ReplacementOrderReturnRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &returnRequestR{}
+				}
+				foreign.R.ReplacementOrder = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadShipments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadShipments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
+		}
+	} else {
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`shipments`),
+		qm.WhereIn(`shipments.order_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load shipments")
+	}
+
+	var resultSlice []*Shipment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice shipments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on shipments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for shipments")
+	}
+
+	if len(shipmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Shipments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &shipmentR{}
+			}
+			foreign.R.Order = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderID {
+				local.R.Shipments = append(local.R.Shipments, foreign)
+				if foreign.R == nil {
+					foreign.R = &shipmentR{}
+				}
+				foreign.R.Order = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAddress of the order to the related item.
+// Sets o.R.Address to related.
+// Adds o to related.R.Orders.
+func (o *Order) SetAddress(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Address) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"orders\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"address_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AddressID, related.ID)
+	if o.R == nil {
+		o.R = &orderR{
+			Address: related,
+		}
+	} else {
+		o.R.Address = related
+	}
+
+	if related.R == nil {
+		related.R = &addressR{
+			Orders: OrderSlice{o},
+		}
+	} else {
+		related.R.Orders = append(related.R.Orders, o)
+	}
+
+	return nil
+}
+
+// RemoveAddress relationship.
+// Sets o.R.Address to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Order) RemoveAddress(ctx context.Context, exec boil.ContextExecutor, related *Address) error {
+	var err error
+
+	queries.SetScanner(&o.AddressID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("address_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Address = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Orders {
+		if queries.Equal(o.AddressID, ri.AddressID) {
+			continue
+		}
+
+		ln := len(related.R.Orders)
+		if ln > 1 && i < ln-1 {
+			related.R.Orders[i] = related.R.Orders[ln-1]
+		}
+		related.R.Orders = related.R.Orders[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetShippingMethod of the order to the related item.
+// Sets o.R.ShippingMethod to related.
+// Adds o to related.R.Orders.
+func (o *Order) SetShippingMethod(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ShippingMethod) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"orders\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"shipping_method_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ShippingMethodID, related.ID)
+	if o.R == nil {
+		o.R = &orderR{
+			ShippingMethod: related,
+		}
+	} else {
+		o.R.ShippingMethod = related
+	}
+
+	if related.R == nil {
+		related.R = &shippingMethodR{
+			Orders: OrderSlice{o},
+		}
+	} else {
+		related.R.Orders = append(related.R.Orders, o)
+	}
+
+	return nil
+}
+
+// RemoveShippingMethod relationship.
+// Sets o.R.ShippingMethod to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Order) RemoveShippingMethod(ctx context.Context, exec boil.ContextExecutor, related *ShippingMethod) error {
+	var err error
+
+	queries.SetScanner(&o.ShippingMethodID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("shipping_method_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ShippingMethod = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Orders {
+		if queries.Equal(o.ShippingMethodID, ri.ShippingMethodID) {
+			continue
+		}
+
+		ln := len(related.R.Orders)
+		if ln > 1 && i < ln-1 {
+			related.R.Orders[i] = related.R.Orders[ln-1]
+		}
+		related.R.Orders = related.R.Orders[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the order to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Orders.
+func (o *Order) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"orders\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &orderR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Orders: OrderSlice{o},
+		}
+	} else {
+		related.R.Orders = append(related.R.Orders, o)
+	}
+
+	return nil
 }
 
 // AddOrderItems adds the given related objects to the existing relationships
@@ -1007,6 +1912,239 @@ func (o *Order) AddPaymentDetails(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddReturnRequests adds the given related objects to the existing relationships
+// of the order, optionally inserting them as new records.
+// Appends related to o.R.ReturnRequests.
+// Sets related.R.Order appropriately.
+func (o *Order) AddReturnRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReturnRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"return_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"order_id"}),
+				strmangle.WhereClause("\"", "\"", 2, returnRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderR{
+			ReturnRequests: related,
+		}
+	} else {
+		o.R.ReturnRequests = append(o.R.ReturnRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &returnRequestR{
+				Order: o,
+			}
+		} else {
+			rel.R.Order = o
+		}
+	}
+	return nil
+}
+
+// AddReplacementOrderReturnRequests adds the given related objects to the existing relationships
+// of the order, optionally inserting them as new records.
+// Appends related to o.R.ReplacementOrderReturnRequests.
+// Sets related.R.ReplacementOrder appropriately.
+func (o *Order) AddReplacementOrderReturnRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReturnRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReplacementOrderID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"return_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"replacement_order_id"}),
+				strmangle.WhereClause("\"", "\"", 2, returnRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReplacementOrderID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderR{
+			ReplacementOrderReturnRequests: related,
+		}
+	} else {
+		o.R.ReplacementOrderReturnRequests = append(o.R.ReplacementOrderReturnRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &returnRequestR{
+				ReplacementOrder: o,
+			}
+		} else {
+			rel.R.ReplacementOrder = o
+		}
+	}
+	return nil
+}
+
+// SetReplacementOrderReturnRequests removes all previously related items of the
+// order replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ReplacementOrder's ReplacementOrderReturnRequests accordingly.
+// Replaces o.R.ReplacementOrderReturnRequests with related.
+// Sets related.R.ReplacementOrder's ReplacementOrderReturnRequests accordingly.
+func (o *Order) SetReplacementOrderReturnRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReturnRequest) error {
+	query := "update \"return_requests\" set \"replacement_order_id\" = null where \"replacement_order_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReplacementOrderReturnRequests {
+			queries.SetScanner(&rel.ReplacementOrderID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ReplacementOrder = nil
+		}
+		o.R.ReplacementOrderReturnRequests = nil
+	}
+
+	return o.AddReplacementOrderReturnRequests(ctx, exec, insert, related...)
+}
+
+// RemoveReplacementOrderReturnRequests relationships from objects passed in.
+// Removes related items from R.ReplacementOrderReturnRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.ReplacementOrder.
+func (o *Order) RemoveReplacementOrderReturnRequests(ctx context.Context, exec boil.ContextExecutor, related ...*ReturnRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReplacementOrderID, nil)
+		if rel.R != nil {
+			rel.R.ReplacementOrder = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("replacement_order_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReplacementOrderReturnRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReplacementOrderReturnRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.ReplacementOrderReturnRequests[i] = o.R.ReplacementOrderReturnRequests[ln-1]
+			}
+			o.R.ReplacementOrderReturnRequests = o.R.ReplacementOrderReturnRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddShipments adds the given related objects to the existing relationships
+// of the order, optionally inserting them as new records.
+// Appends related to o.R.Shipments.
+// Sets related.R.Order appropriately.
+func (o *Order) AddShipments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Shipment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"shipments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"order_id"}),
+				strmangle.WhereClause("\"", "\"", 2, shipmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderR{
+			Shipments: related,
+		}
+	} else {
+		o.R.Shipments = append(o.R.Shipments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &shipmentR{
+				Order: o,
+			}
+		} else {
+			rel.R.Order = o
+		}
+	}
+	return nil
+}
+
 // Orders retrieves all the records using an executor.
 func Orders(mods ...qm.QueryMod) orderQuery {
 	mods = append(mods, qm.From("\"orders\""))
@@ -0,0 +1,1327 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ProductAttributeValue is an object representing the database table.
+type ProductAttributeValue struct {
+	ID          int                 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ProductID   int                 `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	AttributeID int                 `boil:"attribute_id" json:"attribute_id" toml:"attribute_id" yaml:"attribute_id"`
+	Value       string              `boil:"value" json:"value" toml:"value" yaml:"value"`
+	ValueNumber decimal.NullDecimal `boil:"value_number" json:"value_number,omitempty" toml:"value_number" yaml:"value_number,omitempty"`
+	CreatedAt   time.Time           `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time           `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *productAttributeValueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L productAttributeValueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProductAttributeValueColumns = struct {
+	ID          string
+	ProductID   string
+	AttributeID string
+	Value       string
+	ValueNumber string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	ProductID:   "product_id",
+	AttributeID: "attribute_id",
+	Value:       "value",
+	ValueNumber: "value_number",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var ProductAttributeValueTableColumns = struct {
+	ID          string
+	ProductID   string
+	AttributeID string
+	Value       string
+	ValueNumber string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "product_attribute_values.id",
+	ProductID:   "product_attribute_values.product_id",
+	AttributeID: "product_attribute_values.attribute_id",
+	Value:       "product_attribute_values.value",
+	ValueNumber: "product_attribute_values.value_number",
+	CreatedAt:   "product_attribute_values.created_at",
+	UpdatedAt:   "product_attribute_values.updated_at",
+}
+
+// Generated where
+
+var ProductAttributeValueWhere = struct {
+	ID          whereHelperint
+	ProductID   whereHelperint
+	AttributeID whereHelperint
+	Value       whereHelperstring
+	ValueNumber whereHelperdecimal_NullDecimal
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "\"product_attribute_values\".\"id\""},
+	ProductID:   whereHelperint{field: "\"product_attribute_values\".\"product_id\""},
+	AttributeID: whereHelperint{field: "\"product_attribute_values\".\"attribute_id\""},
+	Value:       whereHelperstring{field: "\"product_attribute_values\".\"value\""},
+	ValueNumber: whereHelperdecimal_NullDecimal{field: "\"product_attribute_values\".\"value_number\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"product_attribute_values\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"product_attribute_values\".\"updated_at\""},
+}
+
+// ProductAttributeValueRels is where relationship names are stored.
+var ProductAttributeValueRels = struct {
+	Attribute string
+	Product   string
+}{
+	Attribute: "Attribute",
+	Product:   "Product",
+}
+
+// productAttributeValueR is where relationships are stored.
+type productAttributeValueR struct {
+	Attribute *AttributeDefinition `boil:"Attribute" json:"Attribute" toml:"Attribute" yaml:"Attribute"`
+	Product   *Product             `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+}
+
+// NewStruct creates a new relationship struct
+func (*productAttributeValueR) NewStruct() *productAttributeValueR {
+	return &productAttributeValueR{}
+}
+
+func (r *productAttributeValueR) GetAttribute() *AttributeDefinition {
+	if r == nil {
+		return nil
+	}
+	return r.Attribute
+}
+
+func (r *productAttributeValueR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+// productAttributeValueL is where Load methods for each relationship are stored.
+type productAttributeValueL struct{}
+
+var (
+	productAttributeValueAllColumns            = []string{"id", "product_id", "attribute_id", "value", "value_number", "created_at", "updated_at"}
+	productAttributeValueColumnsWithoutDefault = []string{"product_id", "attribute_id", "value"}
+	productAttributeValueColumnsWithDefault    = []string{"id", "value_number", "created_at", "updated_at"}
+	productAttributeValuePrimaryKeyColumns     = []string{"id"}
+	productAttributeValueGeneratedColumns      = []string{}
+)
+
+type (
+	// ProductAttributeValueSlice is an alias for a slice of pointers to ProductAttributeValue.
+	// This should almost always be used instead of []ProductAttributeValue.
+	ProductAttributeValueSlice []*ProductAttributeValue
+	// ProductAttributeValueHook is the signature for custom ProductAttributeValue hook methods
+	ProductAttributeValueHook func(context.Context, boil.ContextExecutor, *ProductAttributeValue) error
+
+	productAttributeValueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	productAttributeValueType                 = reflect.TypeOf(&ProductAttributeValue{})
+	productAttributeValueMapping              = queries.MakeStructMapping(productAttributeValueType)
+	productAttributeValuePrimaryKeyMapping, _ = queries.BindMapping(productAttributeValueType, productAttributeValueMapping, productAttributeValuePrimaryKeyColumns)
+	productAttributeValueInsertCacheMut       sync.RWMutex
+	productAttributeValueInsertCache          = make(map[string]insertCache)
+	productAttributeValueUpdateCacheMut       sync.RWMutex
+	productAttributeValueUpdateCache          = make(map[string]updateCache)
+	productAttributeValueUpsertCacheMut       sync.RWMutex
+	productAttributeValueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var productAttributeValueAfterSelectHooks []ProductAttributeValueHook
+
+var productAttributeValueBeforeInsertHooks []ProductAttributeValueHook
+var productAttributeValueAfterInsertHooks []ProductAttributeValueHook
+
+var productAttributeValueBeforeUpdateHooks []ProductAttributeValueHook
+var productAttributeValueAfterUpdateHooks []ProductAttributeValueHook
+
+var productAttributeValueBeforeDeleteHooks []ProductAttributeValueHook
+var productAttributeValueAfterDeleteHooks []ProductAttributeValueHook
+
+var productAttributeValueBeforeUpsertHooks []ProductAttributeValueHook
+var productAttributeValueAfterUpsertHooks []ProductAttributeValueHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ProductAttributeValue) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ProductAttributeValue) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ProductAttributeValue) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ProductAttributeValue) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ProductAttributeValue) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ProductAttributeValue) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ProductAttributeValue) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ProductAttributeValue) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ProductAttributeValue) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAttributeValueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProductAttributeValueHook registers your hook function for all future operations.
+func AddProductAttributeValueHook(hookPoint boil.HookPoint, productAttributeValueHook ProductAttributeValueHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		productAttributeValueAfterSelectHooks = append(productAttributeValueAfterSelectHooks, productAttributeValueHook)
+	case boil.BeforeInsertHook:
+		productAttributeValueBeforeInsertHooks = append(productAttributeValueBeforeInsertHooks, productAttributeValueHook)
+	case boil.AfterInsertHook:
+		productAttributeValueAfterInsertHooks = append(productAttributeValueAfterInsertHooks, productAttributeValueHook)
+	case boil.BeforeUpdateHook:
+		productAttributeValueBeforeUpdateHooks = append(productAttributeValueBeforeUpdateHooks, productAttributeValueHook)
+	case boil.AfterUpdateHook:
+		productAttributeValueAfterUpdateHooks = append(productAttributeValueAfterUpdateHooks, productAttributeValueHook)
+	case boil.BeforeDeleteHook:
+		productAttributeValueBeforeDeleteHooks = append(productAttributeValueBeforeDeleteHooks, productAttributeValueHook)
+	case boil.AfterDeleteHook:
+		productAttributeValueAfterDeleteHooks = append(productAttributeValueAfterDeleteHooks, productAttributeValueHook)
+	case boil.BeforeUpsertHook:
+		productAttributeValueBeforeUpsertHooks = append(productAttributeValueBeforeUpsertHooks, productAttributeValueHook)
+	case boil.AfterUpsertHook:
+		productAttributeValueAfterUpsertHooks = append(productAttributeValueAfterUpsertHooks, productAttributeValueHook)
+	}
+}
+
+// One returns a single productAttributeValue record from the query.
+func (q productAttributeValueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ProductAttributeValue, error) {
+	o := &ProductAttributeValue{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for product_attribute_values")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ProductAttributeValue records from the query.
+func (q productAttributeValueQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProductAttributeValueSlice, error) {
+	var o []*ProductAttributeValue
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ProductAttributeValue slice")
+	}
+
+	if len(productAttributeValueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ProductAttributeValue records in the query.
+func (q productAttributeValueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count product_attribute_values rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q productAttributeValueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if product_attribute_values exists")
+	}
+
+	return count > 0, nil
+}
+
+// Attribute pointed to by the foreign key.
+func (o *ProductAttributeValue) Attribute(mods ...qm.QueryMod) attributeDefinitionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AttributeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AttributeDefinitions(queryMods...)
+}
+
+// Product pointed to by the foreign key.
+func (o *ProductAttributeValue) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// LoadAttribute allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productAttributeValueL) LoadAttribute(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductAttributeValue interface{}, mods queries.Applicator) error {
+	var slice []*ProductAttributeValue
+	var object *ProductAttributeValue
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductAttributeValue.(*ProductAttributeValue)
+		if !ok {
+			object = new(ProductAttributeValue)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductAttributeValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductAttributeValue))
+			}
+		}
+	} else {
+		s, ok := maybeProductAttributeValue.(*[]*ProductAttributeValue)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductAttributeValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductAttributeValue))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productAttributeValueR{}
+		}
+		args = append(args, object.AttributeID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productAttributeValueR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AttributeID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AttributeID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attribute_definitions`),
+		qm.WhereIn(`attribute_definitions.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AttributeDefinition")
+	}
+
+	var resultSlice []*AttributeDefinition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AttributeDefinition")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for attribute_definitions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attribute_definitions")
+	}
+
+	if len(productAttributeValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Attribute = foreign
+		if foreign.R == nil {
+			foreign.R = &attributeDefinitionR{}
+		}
+		foreign.R.AttributeProductAttributeValues = append(foreign.R.AttributeProductAttributeValues, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AttributeID == foreign.ID {
+				local.R.Attribute = foreign
+				if foreign.R == nil {
+					foreign.R = &attributeDefinitionR{}
+				}
+				foreign.R.AttributeProductAttributeValues = append(foreign.R.AttributeProductAttributeValues, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productAttributeValueL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductAttributeValue interface{}, mods queries.Applicator) error {
+	var slice []*ProductAttributeValue
+	var object *ProductAttributeValue
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductAttributeValue.(*ProductAttributeValue)
+		if !ok {
+			object = new(ProductAttributeValue)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductAttributeValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductAttributeValue))
+			}
+		}
+	} else {
+		s, ok := maybeProductAttributeValue.(*[]*ProductAttributeValue)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductAttributeValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductAttributeValue))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productAttributeValueR{}
+		}
+		args = append(args, object.ProductID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productAttributeValueR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProductID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProductID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAttributeValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.ProductAttributeValues = append(foreign.R.ProductAttributeValues, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.ProductAttributeValues = append(foreign.R.ProductAttributeValues, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAttribute of the productAttributeValue to the related item.
+// Sets o.R.Attribute to related.
+// Adds o to related.R.AttributeProductAttributeValues.
+func (o *ProductAttributeValue) SetAttribute(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AttributeDefinition) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_attribute_values\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"attribute_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productAttributeValuePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AttributeID = related.ID
+	if o.R == nil {
+		o.R = &productAttributeValueR{
+			Attribute: related,
+		}
+	} else {
+		o.R.Attribute = related
+	}
+
+	if related.R == nil {
+		related.R = &attributeDefinitionR{
+			AttributeProductAttributeValues: ProductAttributeValueSlice{o},
+		}
+	} else {
+		related.R.AttributeProductAttributeValues = append(related.R.AttributeProductAttributeValues, o)
+	}
+
+	return nil
+}
+
+// SetProduct of the productAttributeValue to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.ProductAttributeValues.
+func (o *ProductAttributeValue) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_attribute_values\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productAttributeValuePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &productAttributeValueR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			ProductAttributeValues: ProductAttributeValueSlice{o},
+		}
+	} else {
+		related.R.ProductAttributeValues = append(related.R.ProductAttributeValues, o)
+	}
+
+	return nil
+}
+
+// ProductAttributeValues retrieves all the records using an executor.
+func ProductAttributeValues(mods ...qm.QueryMod) productAttributeValueQuery {
+	mods = append(mods, qm.From("\"product_attribute_values\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"product_attribute_values\".*"})
+	}
+
+	return productAttributeValueQuery{q}
+}
+
+// FindProductAttributeValue retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProductAttributeValue(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ProductAttributeValue, error) {
+	productAttributeValueObj := &ProductAttributeValue{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"product_attribute_values\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, productAttributeValueObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from product_attribute_values")
+	}
+
+	if err = productAttributeValueObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return productAttributeValueObj, err
+	}
+
+	return productAttributeValueObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ProductAttributeValue) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_attribute_values provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productAttributeValueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	productAttributeValueInsertCacheMut.RLock()
+	cache, cached := productAttributeValueInsertCache[key]
+	productAttributeValueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			productAttributeValueAllColumns,
+			productAttributeValueColumnsWithDefault,
+			productAttributeValueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(productAttributeValueType, productAttributeValueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(productAttributeValueType, productAttributeValueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"product_attribute_values\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"product_attribute_values\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into product_attribute_values")
+	}
+
+	if !cached {
+		productAttributeValueInsertCacheMut.Lock()
+		productAttributeValueInsertCache[key] = cache
+		productAttributeValueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ProductAttributeValue.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ProductAttributeValue) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	productAttributeValueUpdateCacheMut.RLock()
+	cache, cached := productAttributeValueUpdateCache[key]
+	productAttributeValueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			productAttributeValueAllColumns,
+			productAttributeValuePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update product_attribute_values, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"product_attribute_values\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, productAttributeValuePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(productAttributeValueType, productAttributeValueMapping, append(wl, productAttributeValuePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update product_attribute_values row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for product_attribute_values")
+	}
+
+	if !cached {
+		productAttributeValueUpdateCacheMut.Lock()
+		productAttributeValueUpdateCache[key] = cache
+		productAttributeValueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q productAttributeValueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for product_attribute_values")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for product_attribute_values")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProductAttributeValueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productAttributeValuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"product_attribute_values\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, productAttributeValuePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in productAttributeValue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all productAttributeValue")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ProductAttributeValue) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_attribute_values provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productAttributeValueColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	productAttributeValueUpsertCacheMut.RLock()
+	cache, cached := productAttributeValueUpsertCache[key]
+	productAttributeValueUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			productAttributeValueAllColumns,
+			productAttributeValueColumnsWithDefault,
+			productAttributeValueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			productAttributeValueAllColumns,
+			productAttributeValuePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert product_attribute_values, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(productAttributeValuePrimaryKeyColumns))
+			copy(conflict, productAttributeValuePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"product_attribute_values\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(productAttributeValueType, productAttributeValueMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(productAttributeValueType, productAttributeValueMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert product_attribute_values")
+	}
+
+	if !cached {
+		productAttributeValueUpsertCacheMut.Lock()
+		productAttributeValueUpsertCache[key] = cache
+		productAttributeValueUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ProductAttributeValue record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ProductAttributeValue) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ProductAttributeValue provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), productAttributeValuePrimaryKeyMapping)
+	sql := "DELETE FROM \"product_attribute_values\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from product_attribute_values")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for product_attribute_values")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q productAttributeValueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no productAttributeValueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from product_attribute_values")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_attribute_values")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProductAttributeValueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(productAttributeValueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productAttributeValuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"product_attribute_values\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productAttributeValuePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from productAttributeValue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_attribute_values")
+	}
+
+	if len(productAttributeValueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ProductAttributeValue) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProductAttributeValue(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProductAttributeValueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProductAttributeValueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productAttributeValuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"product_attribute_values\".* FROM \"product_attribute_values\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productAttributeValuePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProductAttributeValueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProductAttributeValueExists checks if the ProductAttributeValue row exists.
+func ProductAttributeValueExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"product_attribute_values\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if product_attribute_values exists")
+	}
+
+	return exists, nil
+}
@@ -79,14 +79,20 @@ var ProductCategoryWhere = struct {
 
 // ProductCategoryRels is where relationship names are stored.
 var ProductCategoryRels = struct {
-	CategoryProducts string
+	CategoryAttributeDefinitions string
+	CategoryProducts             string
+	CategoryTaxRules             string
 }{
-	CategoryProducts: "CategoryProducts",
+	CategoryAttributeDefinitions: "CategoryAttributeDefinitions",
+	CategoryProducts:             "CategoryProducts",
+	CategoryTaxRules:             "CategoryTaxRules",
 }
 
 // productCategoryR is where relationships are stored.
 type productCategoryR struct {
-	CategoryProducts ProductSlice `boil:"CategoryProducts" json:"CategoryProducts" toml:"CategoryProducts" yaml:"CategoryProducts"`
+	CategoryAttributeDefinitions AttributeDefinitionSlice `boil:"CategoryAttributeDefinitions" json:"CategoryAttributeDefinitions" toml:"CategoryAttributeDefinitions" yaml:"CategoryAttributeDefinitions"`
+	CategoryProducts             ProductSlice             `boil:"CategoryProducts" json:"CategoryProducts" toml:"CategoryProducts" yaml:"CategoryProducts"`
+	CategoryTaxRules             TaxRuleSlice             `boil:"CategoryTaxRules" json:"CategoryTaxRules" toml:"CategoryTaxRules" yaml:"CategoryTaxRules"`
 }
 
 // NewStruct creates a new relationship struct
@@ -94,6 +100,13 @@ func (*productCategoryR) NewStruct() *productCategoryR {
 	return &productCategoryR{}
 }
 
+func (r *productCategoryR) GetCategoryAttributeDefinitions() AttributeDefinitionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CategoryAttributeDefinitions
+}
+
 func (r *productCategoryR) GetCategoryProducts() ProductSlice {
 	if r == nil {
 		return nil
@@ -101,6 +114,13 @@ func (r *productCategoryR) GetCategoryProducts() ProductSlice {
 	return r.CategoryProducts
 }
 
+func (r *productCategoryR) GetCategoryTaxRules() TaxRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CategoryTaxRules
+}
+
 // productCategoryL is where Load methods for each relationship are stored.
 type productCategoryL struct{}
 
@@ -390,6 +410,20 @@ func (q productCategoryQuery) Exists(ctx context.Context, exec boil.ContextExecu
 	return count > 0, nil
 }
 
+// CategoryAttributeDefinitions retrieves all the attribute_definition's AttributeDefinitions with an executor via category_id column.
+func (o *ProductCategory) CategoryAttributeDefinitions(mods ...qm.QueryMod) attributeDefinitionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attribute_definitions\".\"category_id\"=?", o.ID),
+	)
+
+	return AttributeDefinitions(queryMods...)
+}
+
 // CategoryProducts retrieves all the product's Products with an executor via category_id column.
 func (o *ProductCategory) CategoryProducts(mods ...qm.QueryMod) productQuery {
 	var queryMods []qm.QueryMod
@@ -404,6 +438,134 @@ func (o *ProductCategory) CategoryProducts(mods ...qm.QueryMod) productQuery {
 	return Products(queryMods...)
 }
 
+// CategoryTaxRules retrieves all the tax_rule's TaxRules with an executor via category_id column.
+func (o *ProductCategory) CategoryTaxRules(mods ...qm.QueryMod) taxRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tax_rules\".\"category_id\"=?", o.ID),
+	)
+
+	return TaxRules(queryMods...)
+}
+
+// LoadCategoryAttributeDefinitions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productCategoryL) LoadCategoryAttributeDefinitions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductCategory interface{}, mods queries.Applicator) error {
+	var slice []*ProductCategory
+	var object *ProductCategory
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductCategory.(*ProductCategory)
+		if !ok {
+			object = new(ProductCategory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductCategory))
+			}
+		}
+	} else {
+		s, ok := maybeProductCategory.(*[]*ProductCategory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductCategory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productCategoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productCategoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attribute_definitions`),
+		qm.WhereIn(`attribute_definitions.category_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attribute_definitions")
+	}
+
+	var resultSlice []*AttributeDefinition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attribute_definitions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attribute_definitions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attribute_definitions")
+	}
+
+	if len(attributeDefinitionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CategoryAttributeDefinitions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attributeDefinitionR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CategoryID {
+				local.R.CategoryAttributeDefinitions = append(local.R.CategoryAttributeDefinitions, foreign)
+				if foreign.R == nil {
+					foreign.R = &attributeDefinitionR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadCategoryProducts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (productCategoryL) LoadCategoryProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductCategory interface{}, mods queries.Applicator) error {
@@ -518,6 +680,173 @@ func (productCategoryL) LoadCategoryProducts(ctx context.Context, e boil.Context
 	return nil
 }
 
+// LoadCategoryTaxRules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productCategoryL) LoadCategoryTaxRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductCategory interface{}, mods queries.Applicator) error {
+	var slice []*ProductCategory
+	var object *ProductCategory
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductCategory.(*ProductCategory)
+		if !ok {
+			object = new(ProductCategory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductCategory))
+			}
+		}
+	} else {
+		s, ok := maybeProductCategory.(*[]*ProductCategory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductCategory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productCategoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productCategoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tax_rules`),
+		qm.WhereIn(`tax_rules.category_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tax_rules")
+	}
+
+	var resultSlice []*TaxRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tax_rules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tax_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tax_rules")
+	}
+
+	if len(taxRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CategoryTaxRules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &taxRuleR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CategoryID {
+				local.R.CategoryTaxRules = append(local.R.CategoryTaxRules, foreign)
+				if foreign.R == nil {
+					foreign.R = &taxRuleR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddCategoryAttributeDefinitions adds the given related objects to the existing relationships
+// of the product_category, optionally inserting them as new records.
+// Appends related to o.R.CategoryAttributeDefinitions.
+// Sets related.R.Category appropriately.
+func (o *ProductCategory) AddCategoryAttributeDefinitions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AttributeDefinition) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CategoryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attribute_definitions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attributeDefinitionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CategoryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productCategoryR{
+			CategoryAttributeDefinitions: related,
+		}
+	} else {
+		o.R.CategoryAttributeDefinitions = append(o.R.CategoryAttributeDefinitions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attributeDefinitionR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
 // AddCategoryProducts adds the given related objects to the existing relationships
 // of the product_category, optionally inserting them as new records.
 // Appends related to o.R.CategoryProducts.
@@ -571,6 +900,59 @@ func (o *ProductCategory) AddCategoryProducts(ctx context.Context, exec boil.Con
 	return nil
 }
 
+// AddCategoryTaxRules adds the given related objects to the existing relationships
+// of the product_category, optionally inserting them as new records.
+// Appends related to o.R.CategoryTaxRules.
+// Sets related.R.Category appropriately.
+func (o *ProductCategory) AddCategoryTaxRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TaxRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CategoryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"tax_rules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 2, taxRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CategoryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productCategoryR{
+			CategoryTaxRules: related,
+		}
+	} else {
+		o.R.CategoryTaxRules = append(o.R.CategoryTaxRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &taxRuleR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
 // ProductCategories retrieves all the records using an executor.
 func ProductCategories(mods ...qm.QueryMod) productCategoryQuery {
 	mods = append(mods, qm.From("\"product_categories\""))
@@ -0,0 +1,1355 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ProductReview is an object representing the database table.
+type ProductReview struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ProductID   int       `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	UserID      int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Rating      int       `boil:"rating" json:"rating" toml:"rating" yaml:"rating"`
+	Title       string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Body        string    `boil:"body" json:"body" toml:"body" yaml:"body"`
+	Status      string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Note        string    `boil:"note" json:"note" toml:"note" yaml:"note"`
+	ModeratedAt null.Time `boil:"moderated_at" json:"moderated_at,omitempty" toml:"moderated_at" yaml:"moderated_at,omitempty"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *productReviewR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L productReviewL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProductReviewColumns = struct {
+	ID          string
+	ProductID   string
+	UserID      string
+	Rating      string
+	Title       string
+	Body        string
+	Status      string
+	Note        string
+	ModeratedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	ProductID:   "product_id",
+	UserID:      "user_id",
+	Rating:      "rating",
+	Title:       "title",
+	Body:        "body",
+	Status:      "status",
+	Note:        "note",
+	ModeratedAt: "moderated_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var ProductReviewTableColumns = struct {
+	ID          string
+	ProductID   string
+	UserID      string
+	Rating      string
+	Title       string
+	Body        string
+	Status      string
+	Note        string
+	ModeratedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "product_reviews.id",
+	ProductID:   "product_reviews.product_id",
+	UserID:      "product_reviews.user_id",
+	Rating:      "product_reviews.rating",
+	Title:       "product_reviews.title",
+	Body:        "product_reviews.body",
+	Status:      "product_reviews.status",
+	Note:        "product_reviews.note",
+	ModeratedAt: "product_reviews.moderated_at",
+	CreatedAt:   "product_reviews.created_at",
+	UpdatedAt:   "product_reviews.updated_at",
+}
+
+// Generated where
+
+var ProductReviewWhere = struct {
+	ID          whereHelperint
+	ProductID   whereHelperint
+	UserID      whereHelperint
+	Rating      whereHelperint
+	Title       whereHelperstring
+	Body        whereHelperstring
+	Status      whereHelperstring
+	Note        whereHelperstring
+	ModeratedAt whereHelpernull_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "\"product_reviews\".\"id\""},
+	ProductID:   whereHelperint{field: "\"product_reviews\".\"product_id\""},
+	UserID:      whereHelperint{field: "\"product_reviews\".\"user_id\""},
+	Rating:      whereHelperint{field: "\"product_reviews\".\"rating\""},
+	Title:       whereHelperstring{field: "\"product_reviews\".\"title\""},
+	Body:        whereHelperstring{field: "\"product_reviews\".\"body\""},
+	Status:      whereHelperstring{field: "\"product_reviews\".\"status\""},
+	Note:        whereHelperstring{field: "\"product_reviews\".\"note\""},
+	ModeratedAt: whereHelpernull_Time{field: "\"product_reviews\".\"moderated_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"product_reviews\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"product_reviews\".\"updated_at\""},
+}
+
+// ProductReviewRels is where relationship names are stored.
+var ProductReviewRels = struct {
+	Product string
+	User    string
+}{
+	Product: "Product",
+	User:    "User",
+}
+
+// productReviewR is where relationships are stored.
+type productReviewR struct {
+	Product *Product `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	User    *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*productReviewR) NewStruct() *productReviewR {
+	return &productReviewR{}
+}
+
+func (r *productReviewR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+func (r *productReviewR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// productReviewL is where Load methods for each relationship are stored.
+type productReviewL struct{}
+
+var (
+	productReviewAllColumns            = []string{"id", "product_id", "user_id", "rating", "title", "body", "status", "note", "moderated_at", "created_at", "updated_at"}
+	productReviewColumnsWithoutDefault = []string{"product_id", "user_id", "rating"}
+	productReviewColumnsWithDefault    = []string{"id", "title", "body", "status", "note", "moderated_at", "created_at", "updated_at"}
+	productReviewPrimaryKeyColumns     = []string{"id"}
+	productReviewGeneratedColumns      = []string{}
+)
+
+type (
+	// ProductReviewSlice is an alias for a slice of pointers to ProductReview.
+	// This should almost always be used instead of []ProductReview.
+	ProductReviewSlice []*ProductReview
+	// ProductReviewHook is the signature for custom ProductReview hook methods
+	ProductReviewHook func(context.Context, boil.ContextExecutor, *ProductReview) error
+
+	productReviewQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	productReviewType                 = reflect.TypeOf(&ProductReview{})
+	productReviewMapping              = queries.MakeStructMapping(productReviewType)
+	productReviewPrimaryKeyMapping, _ = queries.BindMapping(productReviewType, productReviewMapping, productReviewPrimaryKeyColumns)
+	productReviewInsertCacheMut       sync.RWMutex
+	productReviewInsertCache          = make(map[string]insertCache)
+	productReviewUpdateCacheMut       sync.RWMutex
+	productReviewUpdateCache          = make(map[string]updateCache)
+	productReviewUpsertCacheMut       sync.RWMutex
+	productReviewUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var productReviewAfterSelectHooks []ProductReviewHook
+
+var productReviewBeforeInsertHooks []ProductReviewHook
+var productReviewAfterInsertHooks []ProductReviewHook
+
+var productReviewBeforeUpdateHooks []ProductReviewHook
+var productReviewAfterUpdateHooks []ProductReviewHook
+
+var productReviewBeforeDeleteHooks []ProductReviewHook
+var productReviewAfterDeleteHooks []ProductReviewHook
+
+var productReviewBeforeUpsertHooks []ProductReviewHook
+var productReviewAfterUpsertHooks []ProductReviewHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ProductReview) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ProductReview) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ProductReview) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ProductReview) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ProductReview) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ProductReview) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ProductReview) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ProductReview) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ProductReview) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productReviewAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProductReviewHook registers your hook function for all future operations.
+func AddProductReviewHook(hookPoint boil.HookPoint, productReviewHook ProductReviewHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		productReviewAfterSelectHooks = append(productReviewAfterSelectHooks, productReviewHook)
+	case boil.BeforeInsertHook:
+		productReviewBeforeInsertHooks = append(productReviewBeforeInsertHooks, productReviewHook)
+	case boil.AfterInsertHook:
+		productReviewAfterInsertHooks = append(productReviewAfterInsertHooks, productReviewHook)
+	case boil.BeforeUpdateHook:
+		productReviewBeforeUpdateHooks = append(productReviewBeforeUpdateHooks, productReviewHook)
+	case boil.AfterUpdateHook:
+		productReviewAfterUpdateHooks = append(productReviewAfterUpdateHooks, productReviewHook)
+	case boil.BeforeDeleteHook:
+		productReviewBeforeDeleteHooks = append(productReviewBeforeDeleteHooks, productReviewHook)
+	case boil.AfterDeleteHook:
+		productReviewAfterDeleteHooks = append(productReviewAfterDeleteHooks, productReviewHook)
+	case boil.BeforeUpsertHook:
+		productReviewBeforeUpsertHooks = append(productReviewBeforeUpsertHooks, productReviewHook)
+	case boil.AfterUpsertHook:
+		productReviewAfterUpsertHooks = append(productReviewAfterUpsertHooks, productReviewHook)
+	}
+}
+
+// One returns a single productReview record from the query.
+func (q productReviewQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ProductReview, error) {
+	o := &ProductReview{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for product_reviews")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ProductReview records from the query.
+func (q productReviewQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProductReviewSlice, error) {
+	var o []*ProductReview
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ProductReview slice")
+	}
+
+	if len(productReviewAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ProductReview records in the query.
+func (q productReviewQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count product_reviews rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q productReviewQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if product_reviews exists")
+	}
+
+	return count > 0, nil
+}
+
+// Product pointed to by the foreign key.
+func (o *ProductReview) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ProductReview) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productReviewL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductReview interface{}, mods queries.Applicator) error {
+	var slice []*ProductReview
+	var object *ProductReview
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductReview.(*ProductReview)
+		if !ok {
+			object = new(ProductReview)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductReview)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductReview))
+			}
+		}
+	} else {
+		s, ok := maybeProductReview.(*[]*ProductReview)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductReview)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductReview))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productReviewR{}
+		}
+		args = append(args, object.ProductID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productReviewR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProductID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProductID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productReviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.ProductReviews = append(foreign.R.ProductReviews, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.ProductReviews = append(foreign.R.ProductReviews, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productReviewL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductReview interface{}, mods queries.Applicator) error {
+	var slice []*ProductReview
+	var object *ProductReview
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductReview.(*ProductReview)
+		if !ok {
+			object = new(ProductReview)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductReview)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductReview))
+			}
+		}
+	} else {
+		s, ok := maybeProductReview.(*[]*ProductReview)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductReview)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductReview))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productReviewR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productReviewR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(productReviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ProductReviews = append(foreign.R.ProductReviews, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ProductReviews = append(foreign.R.ProductReviews, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProduct of the productReview to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.ProductReviews.
+func (o *ProductReview) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_reviews\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productReviewPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &productReviewR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			ProductReviews: ProductReviewSlice{o},
+		}
+	} else {
+		related.R.ProductReviews = append(related.R.ProductReviews, o)
+	}
+
+	return nil
+}
+
+// SetUser of the productReview to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ProductReviews.
+func (o *ProductReview) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_reviews\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productReviewPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &productReviewR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ProductReviews: ProductReviewSlice{o},
+		}
+	} else {
+		related.R.ProductReviews = append(related.R.ProductReviews, o)
+	}
+
+	return nil
+}
+
+// ProductReviews retrieves all the records using an executor.
+func ProductReviews(mods ...qm.QueryMod) productReviewQuery {
+	mods = append(mods, qm.From("\"product_reviews\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"product_reviews\".*"})
+	}
+
+	return productReviewQuery{q}
+}
+
+// FindProductReview retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProductReview(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ProductReview, error) {
+	productReviewObj := &ProductReview{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"product_reviews\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, productReviewObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from product_reviews")
+	}
+
+	if err = productReviewObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return productReviewObj, err
+	}
+
+	return productReviewObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ProductReview) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_reviews provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productReviewColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	productReviewInsertCacheMut.RLock()
+	cache, cached := productReviewInsertCache[key]
+	productReviewInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			productReviewAllColumns,
+			productReviewColumnsWithDefault,
+			productReviewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(productReviewType, productReviewMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(productReviewType, productReviewMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"product_reviews\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"product_reviews\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into product_reviews")
+	}
+
+	if !cached {
+		productReviewInsertCacheMut.Lock()
+		productReviewInsertCache[key] = cache
+		productReviewInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ProductReview.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ProductReview) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	productReviewUpdateCacheMut.RLock()
+	cache, cached := productReviewUpdateCache[key]
+	productReviewUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			productReviewAllColumns,
+			productReviewPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update product_reviews, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"product_reviews\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, productReviewPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(productReviewType, productReviewMapping, append(wl, productReviewPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update product_reviews row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for product_reviews")
+	}
+
+	if !cached {
+		productReviewUpdateCacheMut.Lock()
+		productReviewUpdateCache[key] = cache
+		productReviewUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q productReviewQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for product_reviews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for product_reviews")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProductReviewSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productReviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"product_reviews\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, productReviewPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in productReview slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all productReview")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ProductReview) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_reviews provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productReviewColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	productReviewUpsertCacheMut.RLock()
+	cache, cached := productReviewUpsertCache[key]
+	productReviewUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			productReviewAllColumns,
+			productReviewColumnsWithDefault,
+			productReviewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			productReviewAllColumns,
+			productReviewPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert product_reviews, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(productReviewPrimaryKeyColumns))
+			copy(conflict, productReviewPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"product_reviews\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(productReviewType, productReviewMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(productReviewType, productReviewMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert product_reviews")
+	}
+
+	if !cached {
+		productReviewUpsertCacheMut.Lock()
+		productReviewUpsertCache[key] = cache
+		productReviewUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ProductReview record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ProductReview) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ProductReview provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), productReviewPrimaryKeyMapping)
+	sql := "DELETE FROM \"product_reviews\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from product_reviews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for product_reviews")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q productReviewQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no productReviewQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from product_reviews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_reviews")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProductReviewSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(productReviewBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productReviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"product_reviews\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productReviewPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from productReview slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_reviews")
+	}
+
+	if len(productReviewAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ProductReview) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProductReview(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProductReviewSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProductReviewSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productReviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"product_reviews\".* FROM \"product_reviews\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productReviewPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProductReviewSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProductReviewExists checks if the ProductReview row exists.
+func ProductReviewExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"product_reviews\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if product_reviews exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1107 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ProductSlugRedirect is an object representing the database table.
+type ProductSlugRedirect struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ProductID int       `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Slug      string    `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *productSlugRedirectR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L productSlugRedirectL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProductSlugRedirectColumns = struct {
+	ID        string
+	ProductID string
+	Slug      string
+	CreatedAt string
+}{
+	ID:        "id",
+	ProductID: "product_id",
+	Slug:      "slug",
+	CreatedAt: "created_at",
+}
+
+var ProductSlugRedirectTableColumns = struct {
+	ID        string
+	ProductID string
+	Slug      string
+	CreatedAt string
+}{
+	ID:        "product_slug_redirects.id",
+	ProductID: "product_slug_redirects.product_id",
+	Slug:      "product_slug_redirects.slug",
+	CreatedAt: "product_slug_redirects.created_at",
+}
+
+// Generated where
+
+var ProductSlugRedirectWhere = struct {
+	ID        whereHelperint
+	ProductID whereHelperint
+	Slug      whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "\"product_slug_redirects\".\"id\""},
+	ProductID: whereHelperint{field: "\"product_slug_redirects\".\"product_id\""},
+	Slug:      whereHelperstring{field: "\"product_slug_redirects\".\"slug\""},
+	CreatedAt: whereHelpertime_Time{field: "\"product_slug_redirects\".\"created_at\""},
+}
+
+// ProductSlugRedirectRels is where relationship names are stored.
+var ProductSlugRedirectRels = struct {
+	Product string
+}{
+	Product: "Product",
+}
+
+// productSlugRedirectR is where relationships are stored.
+type productSlugRedirectR struct {
+	Product *Product `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+}
+
+// NewStruct creates a new relationship struct
+func (*productSlugRedirectR) NewStruct() *productSlugRedirectR {
+	return &productSlugRedirectR{}
+}
+
+func (r *productSlugRedirectR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+// productSlugRedirectL is where Load methods for each relationship are stored.
+type productSlugRedirectL struct{}
+
+var (
+	productSlugRedirectAllColumns            = []string{"id", "product_id", "slug", "created_at"}
+	productSlugRedirectColumnsWithoutDefault = []string{"product_id", "slug"}
+	productSlugRedirectColumnsWithDefault    = []string{"id", "created_at"}
+	productSlugRedirectPrimaryKeyColumns     = []string{"id"}
+	productSlugRedirectGeneratedColumns      = []string{}
+)
+
+type (
+	// ProductSlugRedirectSlice is an alias for a slice of pointers to ProductSlugRedirect.
+	// This should almost always be used instead of []ProductSlugRedirect.
+	ProductSlugRedirectSlice []*ProductSlugRedirect
+	// ProductSlugRedirectHook is the signature for custom ProductSlugRedirect hook methods
+	ProductSlugRedirectHook func(context.Context, boil.ContextExecutor, *ProductSlugRedirect) error
+
+	productSlugRedirectQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	productSlugRedirectType                 = reflect.TypeOf(&ProductSlugRedirect{})
+	productSlugRedirectMapping              = queries.MakeStructMapping(productSlugRedirectType)
+	productSlugRedirectPrimaryKeyMapping, _ = queries.BindMapping(productSlugRedirectType, productSlugRedirectMapping, productSlugRedirectPrimaryKeyColumns)
+	productSlugRedirectInsertCacheMut       sync.RWMutex
+	productSlugRedirectInsertCache          = make(map[string]insertCache)
+	productSlugRedirectUpdateCacheMut       sync.RWMutex
+	productSlugRedirectUpdateCache          = make(map[string]updateCache)
+	productSlugRedirectUpsertCacheMut       sync.RWMutex
+	productSlugRedirectUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var productSlugRedirectAfterSelectHooks []ProductSlugRedirectHook
+
+var productSlugRedirectBeforeInsertHooks []ProductSlugRedirectHook
+var productSlugRedirectAfterInsertHooks []ProductSlugRedirectHook
+
+var productSlugRedirectBeforeUpdateHooks []ProductSlugRedirectHook
+var productSlugRedirectAfterUpdateHooks []ProductSlugRedirectHook
+
+var productSlugRedirectBeforeDeleteHooks []ProductSlugRedirectHook
+var productSlugRedirectAfterDeleteHooks []ProductSlugRedirectHook
+
+var productSlugRedirectBeforeUpsertHooks []ProductSlugRedirectHook
+var productSlugRedirectAfterUpsertHooks []ProductSlugRedirectHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ProductSlugRedirect) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ProductSlugRedirect) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ProductSlugRedirect) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ProductSlugRedirect) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ProductSlugRedirect) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ProductSlugRedirect) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ProductSlugRedirect) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ProductSlugRedirect) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ProductSlugRedirect) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productSlugRedirectAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProductSlugRedirectHook registers your hook function for all future operations.
+func AddProductSlugRedirectHook(hookPoint boil.HookPoint, productSlugRedirectHook ProductSlugRedirectHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		productSlugRedirectAfterSelectHooks = append(productSlugRedirectAfterSelectHooks, productSlugRedirectHook)
+	case boil.BeforeInsertHook:
+		productSlugRedirectBeforeInsertHooks = append(productSlugRedirectBeforeInsertHooks, productSlugRedirectHook)
+	case boil.AfterInsertHook:
+		productSlugRedirectAfterInsertHooks = append(productSlugRedirectAfterInsertHooks, productSlugRedirectHook)
+	case boil.BeforeUpdateHook:
+		productSlugRedirectBeforeUpdateHooks = append(productSlugRedirectBeforeUpdateHooks, productSlugRedirectHook)
+	case boil.AfterUpdateHook:
+		productSlugRedirectAfterUpdateHooks = append(productSlugRedirectAfterUpdateHooks, productSlugRedirectHook)
+	case boil.BeforeDeleteHook:
+		productSlugRedirectBeforeDeleteHooks = append(productSlugRedirectBeforeDeleteHooks, productSlugRedirectHook)
+	case boil.AfterDeleteHook:
+		productSlugRedirectAfterDeleteHooks = append(productSlugRedirectAfterDeleteHooks, productSlugRedirectHook)
+	case boil.BeforeUpsertHook:
+		productSlugRedirectBeforeUpsertHooks = append(productSlugRedirectBeforeUpsertHooks, productSlugRedirectHook)
+	case boil.AfterUpsertHook:
+		productSlugRedirectAfterUpsertHooks = append(productSlugRedirectAfterUpsertHooks, productSlugRedirectHook)
+	}
+}
+
+// One returns a single productSlugRedirect record from the query.
+func (q productSlugRedirectQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ProductSlugRedirect, error) {
+	o := &ProductSlugRedirect{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for product_slug_redirects")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ProductSlugRedirect records from the query.
+func (q productSlugRedirectQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProductSlugRedirectSlice, error) {
+	var o []*ProductSlugRedirect
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ProductSlugRedirect slice")
+	}
+
+	if len(productSlugRedirectAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ProductSlugRedirect records in the query.
+func (q productSlugRedirectQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count product_slug_redirects rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q productSlugRedirectQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if product_slug_redirects exists")
+	}
+
+	return count > 0, nil
+}
+
+// Product pointed to by the foreign key.
+func (o *ProductSlugRedirect) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productSlugRedirectL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductSlugRedirect interface{}, mods queries.Applicator) error {
+	var slice []*ProductSlugRedirect
+	var object *ProductSlugRedirect
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductSlugRedirect.(*ProductSlugRedirect)
+		if !ok {
+			object = new(ProductSlugRedirect)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductSlugRedirect)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductSlugRedirect))
+			}
+		}
+	} else {
+		s, ok := maybeProductSlugRedirect.(*[]*ProductSlugRedirect)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductSlugRedirect)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductSlugRedirect))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productSlugRedirectR{}
+		}
+		args = append(args, object.ProductID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productSlugRedirectR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProductID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProductID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productSlugRedirectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.ProductSlugRedirects = append(foreign.R.ProductSlugRedirects, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.ProductSlugRedirects = append(foreign.R.ProductSlugRedirects, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProduct of the productSlugRedirect to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.ProductSlugRedirects.
+func (o *ProductSlugRedirect) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_slug_redirects\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productSlugRedirectPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &productSlugRedirectR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			ProductSlugRedirects: ProductSlugRedirectSlice{o},
+		}
+	} else {
+		related.R.ProductSlugRedirects = append(related.R.ProductSlugRedirects, o)
+	}
+
+	return nil
+}
+
+// ProductSlugRedirects retrieves all the records using an executor.
+func ProductSlugRedirects(mods ...qm.QueryMod) productSlugRedirectQuery {
+	mods = append(mods, qm.From("\"product_slug_redirects\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"product_slug_redirects\".*"})
+	}
+
+	return productSlugRedirectQuery{q}
+}
+
+// FindProductSlugRedirect retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProductSlugRedirect(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ProductSlugRedirect, error) {
+	productSlugRedirectObj := &ProductSlugRedirect{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"product_slug_redirects\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, productSlugRedirectObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from product_slug_redirects")
+	}
+
+	if err = productSlugRedirectObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return productSlugRedirectObj, err
+	}
+
+	return productSlugRedirectObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ProductSlugRedirect) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_slug_redirects provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productSlugRedirectColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	productSlugRedirectInsertCacheMut.RLock()
+	cache, cached := productSlugRedirectInsertCache[key]
+	productSlugRedirectInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			productSlugRedirectAllColumns,
+			productSlugRedirectColumnsWithDefault,
+			productSlugRedirectColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(productSlugRedirectType, productSlugRedirectMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(productSlugRedirectType, productSlugRedirectMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"product_slug_redirects\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"product_slug_redirects\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into product_slug_redirects")
+	}
+
+	if !cached {
+		productSlugRedirectInsertCacheMut.Lock()
+		productSlugRedirectInsertCache[key] = cache
+		productSlugRedirectInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ProductSlugRedirect.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ProductSlugRedirect) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	productSlugRedirectUpdateCacheMut.RLock()
+	cache, cached := productSlugRedirectUpdateCache[key]
+	productSlugRedirectUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			productSlugRedirectAllColumns,
+			productSlugRedirectPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update product_slug_redirects, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"product_slug_redirects\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, productSlugRedirectPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(productSlugRedirectType, productSlugRedirectMapping, append(wl, productSlugRedirectPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update product_slug_redirects row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for product_slug_redirects")
+	}
+
+	if !cached {
+		productSlugRedirectUpdateCacheMut.Lock()
+		productSlugRedirectUpdateCache[key] = cache
+		productSlugRedirectUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q productSlugRedirectQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for product_slug_redirects")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for product_slug_redirects")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProductSlugRedirectSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productSlugRedirectPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"product_slug_redirects\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, productSlugRedirectPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in productSlugRedirect slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all productSlugRedirect")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ProductSlugRedirect) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_slug_redirects provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productSlugRedirectColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	productSlugRedirectUpsertCacheMut.RLock()
+	cache, cached := productSlugRedirectUpsertCache[key]
+	productSlugRedirectUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			productSlugRedirectAllColumns,
+			productSlugRedirectColumnsWithDefault,
+			productSlugRedirectColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			productSlugRedirectAllColumns,
+			productSlugRedirectPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert product_slug_redirects, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(productSlugRedirectPrimaryKeyColumns))
+			copy(conflict, productSlugRedirectPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"product_slug_redirects\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(productSlugRedirectType, productSlugRedirectMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(productSlugRedirectType, productSlugRedirectMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert product_slug_redirects")
+	}
+
+	if !cached {
+		productSlugRedirectUpsertCacheMut.Lock()
+		productSlugRedirectUpsertCache[key] = cache
+		productSlugRedirectUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ProductSlugRedirect record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ProductSlugRedirect) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ProductSlugRedirect provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), productSlugRedirectPrimaryKeyMapping)
+	sql := "DELETE FROM \"product_slug_redirects\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from product_slug_redirects")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for product_slug_redirects")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q productSlugRedirectQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no productSlugRedirectQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from product_slug_redirects")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_slug_redirects")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProductSlugRedirectSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(productSlugRedirectBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productSlugRedirectPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"product_slug_redirects\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productSlugRedirectPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from productSlugRedirect slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_slug_redirects")
+	}
+
+	if len(productSlugRedirectAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ProductSlugRedirect) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProductSlugRedirect(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProductSlugRedirectSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProductSlugRedirectSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productSlugRedirectPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"product_slug_redirects\".* FROM \"product_slug_redirects\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productSlugRedirectPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProductSlugRedirectSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProductSlugRedirectExists checks if the ProductSlugRedirect row exists.
+func ProductSlugRedirectExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"product_slug_redirects\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if product_slug_redirects exists")
+	}
+
+	return exists, nil
+}
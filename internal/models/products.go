@@ -15,6 +15,7 @@ import (
 
 	"github.com/friendsofgo/errors"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,104 +25,210 @@ import (
 
 // Product is an object representing the database table.
 type Product struct {
-	ID          int             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name        string          `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Description string          `boil:"description" json:"description" toml:"description" yaml:"description"`
-	Price       decimal.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
-	Quantity    int             `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
-	CategoryID  int             `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
-	AuthorID    int             `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
-	CreatedAt   time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID               int             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name             string          `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description      string          `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Price            decimal.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Quantity         int             `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	CategoryID       int             `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
+	AuthorID         int             `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
+	CreatedAt        time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Weight           decimal.Decimal `boil:"weight" json:"weight" toml:"weight" yaml:"weight"`
+	Currency         string          `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	DeletedAt        null.Time       `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ReorderThreshold int             `boil:"reorder_threshold" json:"reorder_threshold" toml:"reorder_threshold" yaml:"reorder_threshold"`
+	ReviewCount      int             `boil:"review_count" json:"review_count" toml:"review_count" yaml:"review_count"`
+	RatingTotal      int             `boil:"rating_total" json:"rating_total" toml:"rating_total" yaml:"rating_total"`
+	RatingAverage    decimal.Decimal `boil:"rating_average" json:"rating_average" toml:"rating_average" yaml:"rating_average"`
+	Version          int             `boil:"version" json:"version" toml:"version" yaml:"version"`
+	Sku              string          `boil:"sku" json:"sku" toml:"sku" yaml:"sku"`
+	Slug             string          `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
 
 	R *productR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L productL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ProductColumns = struct {
-	ID          string
-	Name        string
-	Description string
-	Price       string
-	Quantity    string
-	CategoryID  string
-	AuthorID    string
-	CreatedAt   string
-	UpdatedAt   string
+	ID               string
+	Name             string
+	Description      string
+	Price            string
+	Quantity         string
+	CategoryID       string
+	AuthorID         string
+	CreatedAt        string
+	UpdatedAt        string
+	Weight           string
+	Currency         string
+	DeletedAt        string
+	ReorderThreshold string
+	ReviewCount      string
+	RatingTotal      string
+	RatingAverage    string
+	Version          string
+	Sku              string
+	Slug             string
 }{
-	ID:          "id",
-	Name:        "name",
-	Description: "description",
-	Price:       "price",
-	Quantity:    "quantity",
-	CategoryID:  "category_id",
-	AuthorID:    "author_id",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
+	ID:               "id",
+	Name:             "name",
+	Description:      "description",
+	Price:            "price",
+	Quantity:         "quantity",
+	CategoryID:       "category_id",
+	AuthorID:         "author_id",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	Weight:           "weight",
+	Currency:         "currency",
+	DeletedAt:        "deleted_at",
+	ReorderThreshold: "reorder_threshold",
+	ReviewCount:      "review_count",
+	RatingTotal:      "rating_total",
+	RatingAverage:    "rating_average",
+	Version:          "version",
+	Sku:              "sku",
+	Slug:             "slug",
 }
 
 var ProductTableColumns = struct {
-	ID          string
-	Name        string
-	Description string
-	Price       string
-	Quantity    string
-	CategoryID  string
-	AuthorID    string
-	CreatedAt   string
-	UpdatedAt   string
+	ID               string
+	Name             string
+	Description      string
+	Price            string
+	Quantity         string
+	CategoryID       string
+	AuthorID         string
+	CreatedAt        string
+	UpdatedAt        string
+	Weight           string
+	Currency         string
+	DeletedAt        string
+	ReorderThreshold string
+	ReviewCount      string
+	RatingTotal      string
+	RatingAverage    string
+	Version          string
+	Sku              string
+	Slug             string
 }{
-	ID:          "products.id",
-	Name:        "products.name",
-	Description: "products.description",
-	Price:       "products.price",
-	Quantity:    "products.quantity",
-	CategoryID:  "products.category_id",
-	AuthorID:    "products.author_id",
-	CreatedAt:   "products.created_at",
-	UpdatedAt:   "products.updated_at",
+	ID:               "products.id",
+	Name:             "products.name",
+	Description:      "products.description",
+	Price:            "products.price",
+	Quantity:         "products.quantity",
+	CategoryID:       "products.category_id",
+	AuthorID:         "products.author_id",
+	CreatedAt:        "products.created_at",
+	UpdatedAt:        "products.updated_at",
+	Weight:           "products.weight",
+	Currency:         "products.currency",
+	DeletedAt:        "products.deleted_at",
+	ReorderThreshold: "products.reorder_threshold",
+	ReviewCount:      "products.review_count",
+	RatingTotal:      "products.rating_total",
+	RatingAverage:    "products.rating_average",
+	Version:          "products.version",
+	Sku:              "products.sku",
+	Slug:             "products.slug",
 }
 
 // Generated where
 
 var ProductWhere = struct {
-	ID          whereHelperint
-	Name        whereHelperstring
-	Description whereHelperstring
-	Price       whereHelperdecimal_Decimal
-	Quantity    whereHelperint
-	CategoryID  whereHelperint
-	AuthorID    whereHelperint
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	ID               whereHelperint
+	Name             whereHelperstring
+	Description      whereHelperstring
+	Price            whereHelperdecimal_Decimal
+	Quantity         whereHelperint
+	CategoryID       whereHelperint
+	AuthorID         whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+	Weight           whereHelperdecimal_Decimal
+	Currency         whereHelperstring
+	DeletedAt        whereHelpernull_Time
+	ReorderThreshold whereHelperint
+	ReviewCount      whereHelperint
+	RatingTotal      whereHelperint
+	RatingAverage    whereHelperdecimal_Decimal
+	Version          whereHelperint
+	Sku              whereHelperstring
+	Slug             whereHelperstring
 }{
-	ID:          whereHelperint{field: "\"products\".\"id\""},
-	Name:        whereHelperstring{field: "\"products\".\"name\""},
-	Description: whereHelperstring{field: "\"products\".\"description\""},
-	Price:       whereHelperdecimal_Decimal{field: "\"products\".\"price\""},
-	Quantity:    whereHelperint{field: "\"products\".\"quantity\""},
-	CategoryID:  whereHelperint{field: "\"products\".\"category_id\""},
-	AuthorID:    whereHelperint{field: "\"products\".\"author_id\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"products\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"products\".\"updated_at\""},
+	ID:               whereHelperint{field: "\"products\".\"id\""},
+	Name:             whereHelperstring{field: "\"products\".\"name\""},
+	Description:      whereHelperstring{field: "\"products\".\"description\""},
+	Price:            whereHelperdecimal_Decimal{field: "\"products\".\"price\""},
+	Quantity:         whereHelperint{field: "\"products\".\"quantity\""},
+	CategoryID:       whereHelperint{field: "\"products\".\"category_id\""},
+	AuthorID:         whereHelperint{field: "\"products\".\"author_id\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"products\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"products\".\"updated_at\""},
+	Weight:           whereHelperdecimal_Decimal{field: "\"products\".\"weight\""},
+	Currency:         whereHelperstring{field: "\"products\".\"currency\""},
+	DeletedAt:        whereHelpernull_Time{field: "\"products\".\"deleted_at\""},
+	ReorderThreshold: whereHelperint{field: "\"products\".\"reorder_threshold\""},
+	ReviewCount:      whereHelperint{field: "\"products\".\"review_count\""},
+	RatingTotal:      whereHelperint{field: "\"products\".\"rating_total\""},
+	RatingAverage:    whereHelperdecimal_Decimal{field: "\"products\".\"rating_average\""},
+	Version:          whereHelperint{field: "\"products\".\"version\""},
+	Sku:              whereHelperstring{field: "\"products\".\"sku\""},
+	Slug:             whereHelperstring{field: "\"products\".\"slug\""},
 }
 
 // ProductRels is where relationship names are stored.
 var ProductRels = struct {
-	Author     string
-	Category   string
-	OrderItems string
+	Author                 string
+	Category               string
+	LowStockAlerts         string
+	OptionTypes            string
+	OrderItems             string
+	ProductAttributeValues string
+	ProductImages          string
+	ProductPrices          string
+	ProductReviews         string
+	ProductSlugRedirects   string
+	ProductTags            string
+	ProductVariants        string
+	StockMovements         string
+	WarehouseStocks        string
+	WishlistItems          string
 }{
-	Author:     "Author",
-	Category:   "Category",
-	OrderItems: "OrderItems",
+	Author:                 "Author",
+	Category:               "Category",
+	LowStockAlerts:         "LowStockAlerts",
+	OptionTypes:            "OptionTypes",
+	OrderItems:             "OrderItems",
+	ProductAttributeValues: "ProductAttributeValues",
+	ProductImages:          "ProductImages",
+	ProductPrices:          "ProductPrices",
+	ProductReviews:         "ProductReviews",
+	ProductSlugRedirects:   "ProductSlugRedirects",
+	ProductTags:            "ProductTags",
+	ProductVariants:        "ProductVariants",
+	StockMovements:         "StockMovements",
+	WarehouseStocks:        "WarehouseStocks",
+	WishlistItems:          "WishlistItems",
 }
 
 // productR is where relationships are stored.
 type productR struct {
-	Author     *User            `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
-	Category   *ProductCategory `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
-	OrderItems OrderItemSlice   `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	Author                 *User                      `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+	Category               *ProductCategory           `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	LowStockAlerts         LowStockAlertSlice         `boil:"LowStockAlerts" json:"LowStockAlerts" toml:"LowStockAlerts" yaml:"LowStockAlerts"`
+	OptionTypes            OptionTypeSlice            `boil:"OptionTypes" json:"OptionTypes" toml:"OptionTypes" yaml:"OptionTypes"`
+	OrderItems             OrderItemSlice             `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	ProductAttributeValues ProductAttributeValueSlice `boil:"ProductAttributeValues" json:"ProductAttributeValues" toml:"ProductAttributeValues" yaml:"ProductAttributeValues"`
+	ProductImages          ProductImageSlice          `boil:"ProductImages" json:"ProductImages" toml:"ProductImages" yaml:"ProductImages"`
+	ProductPrices          ProductPriceSlice          `boil:"ProductPrices" json:"ProductPrices" toml:"ProductPrices" yaml:"ProductPrices"`
+	ProductReviews         ProductReviewSlice         `boil:"ProductReviews" json:"ProductReviews" toml:"ProductReviews" yaml:"ProductReviews"`
+	ProductSlugRedirects   ProductSlugRedirectSlice   `boil:"ProductSlugRedirects" json:"ProductSlugRedirects" toml:"ProductSlugRedirects" yaml:"ProductSlugRedirects"`
+	ProductTags            ProductTagSlice            `boil:"ProductTags" json:"ProductTags" toml:"ProductTags" yaml:"ProductTags"`
+	ProductVariants        ProductVariantSlice        `boil:"ProductVariants" json:"ProductVariants" toml:"ProductVariants" yaml:"ProductVariants"`
+	StockMovements         StockMovementSlice         `boil:"StockMovements" json:"StockMovements" toml:"StockMovements" yaml:"StockMovements"`
+	WarehouseStocks        WarehouseStockSlice        `boil:"WarehouseStocks" json:"WarehouseStocks" toml:"WarehouseStocks" yaml:"WarehouseStocks"`
+	WishlistItems          WishlistItemSlice          `boil:"WishlistItems" json:"WishlistItems" toml:"WishlistItems" yaml:"WishlistItems"`
 }
 
 // NewStruct creates a new relationship struct
@@ -143,6 +250,20 @@ func (r *productR) GetCategory() *ProductCategory {
 	return r.Category
 }
 
+func (r *productR) GetLowStockAlerts() LowStockAlertSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LowStockAlerts
+}
+
+func (r *productR) GetOptionTypes() OptionTypeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OptionTypes
+}
+
 func (r *productR) GetOrderItems() OrderItemSlice {
 	if r == nil {
 		return nil
@@ -150,13 +271,83 @@ func (r *productR) GetOrderItems() OrderItemSlice {
 	return r.OrderItems
 }
 
+func (r *productR) GetProductAttributeValues() ProductAttributeValueSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductAttributeValues
+}
+
+func (r *productR) GetProductImages() ProductImageSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductImages
+}
+
+func (r *productR) GetProductPrices() ProductPriceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductPrices
+}
+
+func (r *productR) GetProductReviews() ProductReviewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductReviews
+}
+
+func (r *productR) GetProductSlugRedirects() ProductSlugRedirectSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductSlugRedirects
+}
+
+func (r *productR) GetProductTags() ProductTagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductTags
+}
+
+func (r *productR) GetProductVariants() ProductVariantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductVariants
+}
+
+func (r *productR) GetStockMovements() StockMovementSlice {
+	if r == nil {
+		return nil
+	}
+	return r.StockMovements
+}
+
+func (r *productR) GetWarehouseStocks() WarehouseStockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WarehouseStocks
+}
+
+func (r *productR) GetWishlistItems() WishlistItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WishlistItems
+}
+
 // productL is where Load methods for each relationship are stored.
 type productL struct{}
 
 var (
-	productAllColumns            = []string{"id", "name", "description", "price", "quantity", "category_id", "author_id", "created_at", "updated_at"}
+	productAllColumns            = []string{"id", "name", "description", "price", "quantity", "category_id", "author_id", "created_at", "updated_at", "weight", "currency", "deleted_at", "reorder_threshold", "review_count", "rating_total", "rating_average", "version", "sku", "slug"}
 	productColumnsWithoutDefault = []string{"name", "description", "price", "quantity", "category_id", "author_id"}
-	productColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	productColumnsWithDefault    = []string{"id", "created_at", "updated_at", "weight", "currency", "deleted_at", "reorder_threshold", "review_count", "rating_total", "rating_average", "version", "sku", "slug"}
 	productPrimaryKeyColumns     = []string{"id"}
 	productGeneratedColumns      = []string{}
 )
@@ -461,6 +652,34 @@ func (o *Product) Category(mods ...qm.QueryMod) productCategoryQuery {
 	return ProductCategories(queryMods...)
 }
 
+// LowStockAlerts retrieves all the low_stock_alert's LowStockAlerts with an executor.
+func (o *Product) LowStockAlerts(mods ...qm.QueryMod) lowStockAlertQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"low_stock_alerts\".\"product_id\"=?", o.ID),
+	)
+
+	return LowStockAlerts(queryMods...)
+}
+
+// OptionTypes retrieves all the option_type's OptionTypes with an executor.
+func (o *Product) OptionTypes(mods ...qm.QueryMod) optionTypeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"option_types\".\"product_id\"=?", o.ID),
+	)
+
+	return OptionTypes(queryMods...)
+}
+
 // OrderItems retrieves all the order_item's OrderItems with an executor.
 func (o *Product) OrderItems(mods ...qm.QueryMod) orderItemQuery {
 	var queryMods []qm.QueryMod
@@ -475,6 +694,146 @@ func (o *Product) OrderItems(mods ...qm.QueryMod) orderItemQuery {
 	return OrderItems(queryMods...)
 }
 
+// ProductAttributeValues retrieves all the product_attribute_value's ProductAttributeValues with an executor.
+func (o *Product) ProductAttributeValues(mods ...qm.QueryMod) productAttributeValueQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_attribute_values\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductAttributeValues(queryMods...)
+}
+
+// ProductImages retrieves all the product_image's ProductImages with an executor.
+func (o *Product) ProductImages(mods ...qm.QueryMod) productImageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_images\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductImages(queryMods...)
+}
+
+// ProductPrices retrieves all the product_price's ProductPrices with an executor.
+func (o *Product) ProductPrices(mods ...qm.QueryMod) productPriceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_prices\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductPrices(queryMods...)
+}
+
+// ProductReviews retrieves all the product_review's ProductReviews with an executor.
+func (o *Product) ProductReviews(mods ...qm.QueryMod) productReviewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_reviews\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductReviews(queryMods...)
+}
+
+// ProductSlugRedirects retrieves all the product_slug_redirect's ProductSlugRedirects with an executor.
+func (o *Product) ProductSlugRedirects(mods ...qm.QueryMod) productSlugRedirectQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_slug_redirects\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductSlugRedirects(queryMods...)
+}
+
+// ProductTags retrieves all the product_tag's ProductTags with an executor.
+func (o *Product) ProductTags(mods ...qm.QueryMod) productTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_tags\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductTags(queryMods...)
+}
+
+// ProductVariants retrieves all the product_variant's ProductVariants with an executor.
+func (o *Product) ProductVariants(mods ...qm.QueryMod) productVariantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_variants\".\"product_id\"=?", o.ID),
+	)
+
+	return ProductVariants(queryMods...)
+}
+
+// StockMovements retrieves all the stock_movement's StockMovements with an executor.
+func (o *Product) StockMovements(mods ...qm.QueryMod) stockMovementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"stock_movements\".\"product_id\"=?", o.ID),
+	)
+
+	return StockMovements(queryMods...)
+}
+
+// WarehouseStocks retrieves all the warehouse_stock's WarehouseStocks with an executor.
+func (o *Product) WarehouseStocks(mods ...qm.QueryMod) warehouseStockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"warehouse_stocks\".\"product_id\"=?", o.ID),
+	)
+
+	return WarehouseStocks(queryMods...)
+}
+
+// WishlistItems retrieves all the wishlist_item's WishlistItems with an executor.
+func (o *Product) WishlistItems(mods ...qm.QueryMod) wishlistItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wishlist_items\".\"product_id\"=?", o.ID),
+	)
+
+	return WishlistItems(queryMods...)
+}
+
 // LoadAuthor allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (productL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
@@ -715,9 +1074,9 @@ func (productL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadOrderItems allows an eager lookup of values, cached into the
+// LoadLowStockAlerts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+func (productL) LoadLowStockAlerts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
 	var slice []*Product
 	var object *Product
 
@@ -771,8 +1130,8 @@ func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`order_items`),
-		qm.WhereIn(`order_items.product_id in ?`, args...),
+		qm.From(`low_stock_alerts`),
+		qm.WhereIn(`low_stock_alerts.product_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -780,22 +1139,22 @@ func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load order_items")
+		return errors.Wrap(err, "failed to eager load low_stock_alerts")
 	}
 
-	var resultSlice []*OrderItem
+	var resultSlice []*LowStockAlert
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice order_items")
+		return errors.Wrap(err, "failed to bind eager loaded slice low_stock_alerts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on order_items")
+		return errors.Wrap(err, "failed to close results in eager load on low_stock_alerts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_items")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for low_stock_alerts")
 	}
 
-	if len(orderItemAfterSelectHooks) != 0 {
+	if len(lowStockAlertAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -803,10 +1162,10 @@ func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.OrderItems = resultSlice
+		object.R.LowStockAlerts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &orderItemR{}
+				foreign.R = &lowStockAlertR{}
 			}
 			foreign.R.Product = object
 		}
@@ -816,9 +1175,9 @@ func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ProductID {
-				local.R.OrderItems = append(local.R.OrderItems, foreign)
+				local.R.LowStockAlerts = append(local.R.LowStockAlerts, foreign)
 				if foreign.R == nil {
-					foreign.R = &orderItemR{}
+					foreign.R = &lowStockAlertR{}
 				}
 				foreign.R.Product = local
 				break
@@ -829,105 +1188,2003 @@ func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// SetAuthor of the product to the related item.
-// Sets o.R.Author to related.
-// Adds o to related.R.AuthorProducts.
-func (o *Product) SetAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"products\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
-		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadOptionTypes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadOptionTypes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
 
-	o.AuthorID = related.ID
-	if o.R == nil {
-		o.R = &productR{
-			Author: related,
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
 		}
 	} else {
-		o.R.Author = related
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			AuthorProducts: ProductSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.AuthorProducts = append(related.R.AuthorProducts, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`option_types`),
+		qm.WhereIn(`option_types.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load option_types")
+	}
+
+	var resultSlice []*OptionType
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice option_types")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on option_types")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for option_types")
+	}
+
+	if len(optionTypeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OptionTypes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &optionTypeR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.OptionTypes = append(local.R.OptionTypes, foreign)
+				if foreign.R == nil {
+					foreign.R = &optionTypeR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrderItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`order_items`),
+		qm.WhereIn(`order_items.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load order_items")
+	}
+
+	var resultSlice []*OrderItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice order_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on order_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_items")
+	}
+
+	if len(orderItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrderItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderItemR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.OrderItems = append(local.R.OrderItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderItemR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductAttributeValues allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductAttributeValues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_attribute_values`),
+		qm.WhereIn(`product_attribute_values.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_attribute_values")
+	}
+
+	var resultSlice []*ProductAttributeValue
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_attribute_values")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_attribute_values")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_attribute_values")
+	}
+
+	if len(productAttributeValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductAttributeValues = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productAttributeValueR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductAttributeValues = append(local.R.ProductAttributeValues, foreign)
+				if foreign.R == nil {
+					foreign.R = &productAttributeValueR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductImages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_images`),
+		qm.WhereIn(`product_images.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_images")
+	}
+
+	var resultSlice []*ProductImage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_images")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_images")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_images")
+	}
+
+	if len(productImageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductImages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productImageR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductImages = append(local.R.ProductImages, foreign)
+				if foreign.R == nil {
+					foreign.R = &productImageR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductPrices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductPrices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_prices`),
+		qm.WhereIn(`product_prices.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_prices")
+	}
+
+	var resultSlice []*ProductPrice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_prices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_prices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_prices")
+	}
+
+	if len(productPriceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductPrices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productPriceR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductPrices = append(local.R.ProductPrices, foreign)
+				if foreign.R == nil {
+					foreign.R = &productPriceR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductReviews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductReviews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_reviews`),
+		qm.WhereIn(`product_reviews.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_reviews")
+	}
+
+	var resultSlice []*ProductReview
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_reviews")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_reviews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_reviews")
+	}
+
+	if len(productReviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductReviews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productReviewR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductReviews = append(local.R.ProductReviews, foreign)
+				if foreign.R == nil {
+					foreign.R = &productReviewR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductSlugRedirects allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductSlugRedirects(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_slug_redirects`),
+		qm.WhereIn(`product_slug_redirects.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_slug_redirects")
+	}
+
+	var resultSlice []*ProductSlugRedirect
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_slug_redirects")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_slug_redirects")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_slug_redirects")
+	}
+
+	if len(productSlugRedirectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductSlugRedirects = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productSlugRedirectR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductSlugRedirects = append(local.R.ProductSlugRedirects, foreign)
+				if foreign.R == nil {
+					foreign.R = &productSlugRedirectR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_tags`),
+		qm.WhereIn(`product_tags.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_tags")
+	}
+
+	var resultSlice []*ProductTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_tags")
+	}
+
+	if len(productTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productTagR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductTags = append(local.R.ProductTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &productTagR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProductVariants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadProductVariants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_variants`),
+		qm.WhereIn(`product_variants.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_variants")
+	}
+
+	var resultSlice []*ProductVariant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_variants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_variants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_variants")
+	}
+
+	if len(productVariantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductVariants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productVariantR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.ProductVariants = append(local.R.ProductVariants, foreign)
+				if foreign.R == nil {
+					foreign.R = &productVariantR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStockMovements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadStockMovements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`stock_movements`),
+		qm.WhereIn(`stock_movements.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load stock_movements")
+	}
+
+	var resultSlice []*StockMovement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice stock_movements")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on stock_movements")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for stock_movements")
+	}
+
+	if len(stockMovementAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.StockMovements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &stockMovementR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.StockMovements = append(local.R.StockMovements, foreign)
+				if foreign.R == nil {
+					foreign.R = &stockMovementR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWarehouseStocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadWarehouseStocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`warehouse_stocks`),
+		qm.WhereIn(`warehouse_stocks.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load warehouse_stocks")
+	}
+
+	var resultSlice []*WarehouseStock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice warehouse_stocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on warehouse_stocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for warehouse_stocks")
+	}
+
+	if len(warehouseStockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WarehouseStocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &warehouseStockR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.WarehouseStocks = append(local.R.WarehouseStocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &warehouseStockR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWishlistItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadWishlistItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wishlist_items`),
+		qm.WhereIn(`wishlist_items.product_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wishlist_items")
+	}
+
+	var resultSlice []*WishlistItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wishlist_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wishlist_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wishlist_items")
+	}
+
+	if len(wishlistItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WishlistItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &wishlistItemR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.WishlistItems = append(local.R.WishlistItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &wishlistItemR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAuthor of the product to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.AuthorProducts.
+func (o *Product) SetAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuthorID = related.ID
+	if o.R == nil {
+		o.R = &productR{
+			Author: related,
+		}
+	} else {
+		o.R.Author = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AuthorProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.AuthorProducts = append(related.R.AuthorProducts, o)
+	}
+
+	return nil
+}
+
+// SetCategory of the product to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.CategoryProducts.
+func (o *Product) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ProductCategory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CategoryID = related.ID
+	if o.R == nil {
+		o.R = &productR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &productCategoryR{
+			CategoryProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.CategoryProducts = append(related.R.CategoryProducts, o)
+	}
+
+	return nil
+}
+
+// AddLowStockAlerts adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.LowStockAlerts.
+// Sets related.R.Product appropriately.
+func (o *Product) AddLowStockAlerts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LowStockAlert) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"low_stock_alerts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, lowStockAlertPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			LowStockAlerts: related,
+		}
+	} else {
+		o.R.LowStockAlerts = append(o.R.LowStockAlerts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &lowStockAlertR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddOptionTypes adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.OptionTypes.
+// Sets related.R.Product appropriately.
+func (o *Product) AddOptionTypes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OptionType) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"option_types\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, optionTypePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			OptionTypes: related,
+		}
+	} else {
+		o.R.OptionTypes = append(o.R.OptionTypes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &optionTypeR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddOrderItems adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.OrderItems.
+// Sets related.R.Product appropriately.
+func (o *Product) AddOrderItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"order_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			OrderItems: related,
+		}
+	} else {
+		o.R.OrderItems = append(o.R.OrderItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderItemR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddProductAttributeValues adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductAttributeValues.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductAttributeValues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductAttributeValue) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_attribute_values\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productAttributeValuePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			ProductAttributeValues: related,
+		}
+	} else {
+		o.R.ProductAttributeValues = append(o.R.ProductAttributeValues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productAttributeValueR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddProductImages adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductImages.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductImage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_images\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productImagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			ProductImages: related,
+		}
+	} else {
+		o.R.ProductImages = append(o.R.ProductImages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productImageR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddProductPrices adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductPrices.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductPrices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductPrice) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_prices\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPricePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			ProductPrices: related,
+		}
+	} else {
+		o.R.ProductPrices = append(o.R.ProductPrices, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productPriceR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddProductReviews adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductReviews.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductReview) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_reviews\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productReviewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			ProductReviews: related,
+		}
+	} else {
+		o.R.ProductReviews = append(o.R.ProductReviews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productReviewR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
 	return nil
 }
 
-// SetCategory of the product to the related item.
-// Sets o.R.Category to related.
-// Adds o to related.R.CategoryProducts.
-func (o *Product) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ProductCategory) error {
+// AddProductSlugRedirects adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductSlugRedirects.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductSlugRedirects(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductSlugRedirect) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_slug_redirects\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productSlugRedirectPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"products\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
-		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	if o.R == nil {
+		o.R = &productR{
+			ProductSlugRedirects: related,
+		}
+	} else {
+		o.R.ProductSlugRedirects = append(o.R.ProductSlugRedirects, related...)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productSlugRedirectR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	return nil
+}
+
+// AddProductTags adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductTags.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductTag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_tags\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productTagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
 	}
 
-	o.CategoryID = related.ID
 	if o.R == nil {
 		o.R = &productR{
-			Category: related,
+			ProductTags: related,
 		}
 	} else {
-		o.R.Category = related
+		o.R.ProductTags = append(o.R.ProductTags, related...)
 	}
 
-	if related.R == nil {
-		related.R = &productCategoryR{
-			CategoryProducts: ProductSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productTagR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddProductVariants adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.ProductVariants.
+// Sets related.R.Product appropriately.
+func (o *Product) AddProductVariants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductVariant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_variants\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productVariantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			ProductVariants: related,
 		}
 	} else {
-		related.R.CategoryProducts = append(related.R.CategoryProducts, o)
+		o.R.ProductVariants = append(o.R.ProductVariants, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productVariantR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
 	return nil
 }
 
-// AddOrderItems adds the given related objects to the existing relationships
+// AddStockMovements adds the given related objects to the existing relationships
 // of the product, optionally inserting them as new records.
-// Appends related to o.R.OrderItems.
+// Appends related to o.R.StockMovements.
 // Sets related.R.Product appropriately.
-func (o *Product) AddOrderItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderItem) error {
+func (o *Product) AddStockMovements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StockMovement) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -937,9 +3194,9 @@ func (o *Product) AddOrderItems(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"order_items\" SET %s WHERE %s",
+				"UPDATE \"stock_movements\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
-				strmangle.WhereClause("\"", "\"", 2, orderItemPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, stockMovementPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -958,15 +3215,121 @@ func (o *Product) AddOrderItems(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &productR{
-			OrderItems: related,
+			StockMovements: related,
 		}
 	} else {
-		o.R.OrderItems = append(o.R.OrderItems, related...)
+		o.R.StockMovements = append(o.R.StockMovements, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &orderItemR{
+			rel.R = &stockMovementR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddWarehouseStocks adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.WarehouseStocks.
+// Sets related.R.Product appropriately.
+func (o *Product) AddWarehouseStocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WarehouseStock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"warehouse_stocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, warehouseStockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			WarehouseStocks: related,
+		}
+	} else {
+		o.R.WarehouseStocks = append(o.R.WarehouseStocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &warehouseStockR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddWishlistItems adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.WishlistItems.
+// Sets related.R.Product appropriately.
+func (o *Product) AddWishlistItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WishlistItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wishlist_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, wishlistItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			WishlistItems: related,
+		}
+	} else {
+		o.R.WishlistItems = append(o.R.WishlistItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &wishlistItemR{
 				Product: o,
 			}
 		} else {
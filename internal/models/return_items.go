@@ -0,0 +1,1319 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReturnItem is an object representing the database table.
+type ReturnItem struct {
+	ID              int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReturnRequestID int       `boil:"return_request_id" json:"return_request_id" toml:"return_request_id" yaml:"return_request_id"`
+	OrderItemID     int       `boil:"order_item_id" json:"order_item_id" toml:"order_item_id" yaml:"order_item_id"`
+	Quantity        int       `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *returnItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L returnItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReturnItemColumns = struct {
+	ID              string
+	ReturnRequestID string
+	OrderItemID     string
+	Quantity        string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	ReturnRequestID: "return_request_id",
+	OrderItemID:     "order_item_id",
+	Quantity:        "quantity",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var ReturnItemTableColumns = struct {
+	ID              string
+	ReturnRequestID string
+	OrderItemID     string
+	Quantity        string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "return_items.id",
+	ReturnRequestID: "return_items.return_request_id",
+	OrderItemID:     "return_items.order_item_id",
+	Quantity:        "return_items.quantity",
+	CreatedAt:       "return_items.created_at",
+	UpdatedAt:       "return_items.updated_at",
+}
+
+// Generated where
+
+var ReturnItemWhere = struct {
+	ID              whereHelperint
+	ReturnRequestID whereHelperint
+	OrderItemID     whereHelperint
+	Quantity        whereHelperint
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperint{field: "\"return_items\".\"id\""},
+	ReturnRequestID: whereHelperint{field: "\"return_items\".\"return_request_id\""},
+	OrderItemID:     whereHelperint{field: "\"return_items\".\"order_item_id\""},
+	Quantity:        whereHelperint{field: "\"return_items\".\"quantity\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"return_items\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"return_items\".\"updated_at\""},
+}
+
+// ReturnItemRels is where relationship names are stored.
+var ReturnItemRels = struct {
+	OrderItem     string
+	ReturnRequest string
+}{
+	OrderItem:     "OrderItem",
+	ReturnRequest: "ReturnRequest",
+}
+
+// returnItemR is where relationships are stored.
+type returnItemR struct {
+	OrderItem     *OrderItem     `boil:"OrderItem" json:"OrderItem" toml:"OrderItem" yaml:"OrderItem"`
+	ReturnRequest *ReturnRequest `boil:"ReturnRequest" json:"ReturnRequest" toml:"ReturnRequest" yaml:"ReturnRequest"`
+}
+
+// NewStruct creates a new relationship struct
+func (*returnItemR) NewStruct() *returnItemR {
+	return &returnItemR{}
+}
+
+func (r *returnItemR) GetOrderItem() *OrderItem {
+	if r == nil {
+		return nil
+	}
+	return r.OrderItem
+}
+
+func (r *returnItemR) GetReturnRequest() *ReturnRequest {
+	if r == nil {
+		return nil
+	}
+	return r.ReturnRequest
+}
+
+// returnItemL is where Load methods for each relationship are stored.
+type returnItemL struct{}
+
+var (
+	returnItemAllColumns            = []string{"id", "return_request_id", "order_item_id", "quantity", "created_at", "updated_at"}
+	returnItemColumnsWithoutDefault = []string{"return_request_id", "order_item_id", "quantity"}
+	returnItemColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	returnItemPrimaryKeyColumns     = []string{"id"}
+	returnItemGeneratedColumns      = []string{}
+)
+
+type (
+	// ReturnItemSlice is an alias for a slice of pointers to ReturnItem.
+	// This should almost always be used instead of []ReturnItem.
+	ReturnItemSlice []*ReturnItem
+	// ReturnItemHook is the signature for custom ReturnItem hook methods
+	ReturnItemHook func(context.Context, boil.ContextExecutor, *ReturnItem) error
+
+	returnItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	returnItemType                 = reflect.TypeOf(&ReturnItem{})
+	returnItemMapping              = queries.MakeStructMapping(returnItemType)
+	returnItemPrimaryKeyMapping, _ = queries.BindMapping(returnItemType, returnItemMapping, returnItemPrimaryKeyColumns)
+	returnItemInsertCacheMut       sync.RWMutex
+	returnItemInsertCache          = make(map[string]insertCache)
+	returnItemUpdateCacheMut       sync.RWMutex
+	returnItemUpdateCache          = make(map[string]updateCache)
+	returnItemUpsertCacheMut       sync.RWMutex
+	returnItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var returnItemAfterSelectHooks []ReturnItemHook
+
+var returnItemBeforeInsertHooks []ReturnItemHook
+var returnItemAfterInsertHooks []ReturnItemHook
+
+var returnItemBeforeUpdateHooks []ReturnItemHook
+var returnItemAfterUpdateHooks []ReturnItemHook
+
+var returnItemBeforeDeleteHooks []ReturnItemHook
+var returnItemAfterDeleteHooks []ReturnItemHook
+
+var returnItemBeforeUpsertHooks []ReturnItemHook
+var returnItemAfterUpsertHooks []ReturnItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReturnItem) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReturnItem) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReturnItem) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReturnItem) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReturnItem) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReturnItem) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReturnItem) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReturnItem) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReturnItem) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnItemAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReturnItemHook registers your hook function for all future operations.
+func AddReturnItemHook(hookPoint boil.HookPoint, returnItemHook ReturnItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		returnItemAfterSelectHooks = append(returnItemAfterSelectHooks, returnItemHook)
+	case boil.BeforeInsertHook:
+		returnItemBeforeInsertHooks = append(returnItemBeforeInsertHooks, returnItemHook)
+	case boil.AfterInsertHook:
+		returnItemAfterInsertHooks = append(returnItemAfterInsertHooks, returnItemHook)
+	case boil.BeforeUpdateHook:
+		returnItemBeforeUpdateHooks = append(returnItemBeforeUpdateHooks, returnItemHook)
+	case boil.AfterUpdateHook:
+		returnItemAfterUpdateHooks = append(returnItemAfterUpdateHooks, returnItemHook)
+	case boil.BeforeDeleteHook:
+		returnItemBeforeDeleteHooks = append(returnItemBeforeDeleteHooks, returnItemHook)
+	case boil.AfterDeleteHook:
+		returnItemAfterDeleteHooks = append(returnItemAfterDeleteHooks, returnItemHook)
+	case boil.BeforeUpsertHook:
+		returnItemBeforeUpsertHooks = append(returnItemBeforeUpsertHooks, returnItemHook)
+	case boil.AfterUpsertHook:
+		returnItemAfterUpsertHooks = append(returnItemAfterUpsertHooks, returnItemHook)
+	}
+}
+
+// One returns a single returnItem record from the query.
+func (q returnItemQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReturnItem, error) {
+	o := &ReturnItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for return_items")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReturnItem records from the query.
+func (q returnItemQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReturnItemSlice, error) {
+	var o []*ReturnItem
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReturnItem slice")
+	}
+
+	if len(returnItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReturnItem records in the query.
+func (q returnItemQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count return_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q returnItemQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if return_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// OrderItem pointed to by the foreign key.
+func (o *ReturnItem) OrderItem(mods ...qm.QueryMod) orderItemQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrderItemID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OrderItems(queryMods...)
+}
+
+// ReturnRequest pointed to by the foreign key.
+func (o *ReturnItem) ReturnRequest(mods ...qm.QueryMod) returnRequestQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReturnRequestID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ReturnRequests(queryMods...)
+}
+
+// LoadOrderItem allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (returnItemL) LoadOrderItem(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReturnItem interface{}, mods queries.Applicator) error {
+	var slice []*ReturnItem
+	var object *ReturnItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeReturnItem.(*ReturnItem)
+		if !ok {
+			object = new(ReturnItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReturnItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReturnItem))
+			}
+		}
+	} else {
+		s, ok := maybeReturnItem.(*[]*ReturnItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReturnItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReturnItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &returnItemR{}
+		}
+		args = append(args, object.OrderItemID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &returnItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OrderItemID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OrderItemID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`order_items`),
+		qm.WhereIn(`order_items.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OrderItem")
+	}
+
+	var resultSlice []*OrderItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OrderItem")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for order_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order_items")
+	}
+
+	if len(returnItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OrderItem = foreign
+		if foreign.R == nil {
+			foreign.R = &orderItemR{}
+		}
+		foreign.R.ReturnItems = append(foreign.R.ReturnItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrderItemID == foreign.ID {
+				local.R.OrderItem = foreign
+				if foreign.R == nil {
+					foreign.R = &orderItemR{}
+				}
+				foreign.R.ReturnItems = append(foreign.R.ReturnItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReturnRequest allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (returnItemL) LoadReturnRequest(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReturnItem interface{}, mods queries.Applicator) error {
+	var slice []*ReturnItem
+	var object *ReturnItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeReturnItem.(*ReturnItem)
+		if !ok {
+			object = new(ReturnItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReturnItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReturnItem))
+			}
+		}
+	} else {
+		s, ok := maybeReturnItem.(*[]*ReturnItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReturnItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReturnItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &returnItemR{}
+		}
+		args = append(args, object.ReturnRequestID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &returnItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ReturnRequestID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ReturnRequestID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`return_requests`),
+		qm.WhereIn(`return_requests.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ReturnRequest")
+	}
+
+	var resultSlice []*ReturnRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ReturnRequest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for return_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for return_requests")
+	}
+
+	if len(returnItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReturnRequest = foreign
+		if foreign.R == nil {
+			foreign.R = &returnRequestR{}
+		}
+		foreign.R.ReturnItems = append(foreign.R.ReturnItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReturnRequestID == foreign.ID {
+				local.R.ReturnRequest = foreign
+				if foreign.R == nil {
+					foreign.R = &returnRequestR{}
+				}
+				foreign.R.ReturnItems = append(foreign.R.ReturnItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrderItem of the returnItem to the related item.
+// Sets o.R.OrderItem to related.
+// Adds o to related.R.ReturnItems.
+func (o *ReturnItem) SetOrderItem(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OrderItem) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"return_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"order_item_id"}),
+		strmangle.WhereClause("\"", "\"", 2, returnItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrderItemID = related.ID
+	if o.R == nil {
+		o.R = &returnItemR{
+			OrderItem: related,
+		}
+	} else {
+		o.R.OrderItem = related
+	}
+
+	if related.R == nil {
+		related.R = &orderItemR{
+			ReturnItems: ReturnItemSlice{o},
+		}
+	} else {
+		related.R.ReturnItems = append(related.R.ReturnItems, o)
+	}
+
+	return nil
+}
+
+// SetReturnRequest of the returnItem to the related item.
+// Sets o.R.ReturnRequest to related.
+// Adds o to related.R.ReturnItems.
+func (o *ReturnItem) SetReturnRequest(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ReturnRequest) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"return_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"return_request_id"}),
+		strmangle.WhereClause("\"", "\"", 2, returnItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReturnRequestID = related.ID
+	if o.R == nil {
+		o.R = &returnItemR{
+			ReturnRequest: related,
+		}
+	} else {
+		o.R.ReturnRequest = related
+	}
+
+	if related.R == nil {
+		related.R = &returnRequestR{
+			ReturnItems: ReturnItemSlice{o},
+		}
+	} else {
+		related.R.ReturnItems = append(related.R.ReturnItems, o)
+	}
+
+	return nil
+}
+
+// ReturnItems retrieves all the records using an executor.
+func ReturnItems(mods ...qm.QueryMod) returnItemQuery {
+	mods = append(mods, qm.From("\"return_items\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"return_items\".*"})
+	}
+
+	return returnItemQuery{q}
+}
+
+// FindReturnItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReturnItem(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ReturnItem, error) {
+	returnItemObj := &ReturnItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"return_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, returnItemObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from return_items")
+	}
+
+	if err = returnItemObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return returnItemObj, err
+	}
+
+	return returnItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReturnItem) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no return_items provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(returnItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	returnItemInsertCacheMut.RLock()
+	cache, cached := returnItemInsertCache[key]
+	returnItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			returnItemAllColumns,
+			returnItemColumnsWithDefault,
+			returnItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(returnItemType, returnItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(returnItemType, returnItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"return_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"return_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into return_items")
+	}
+
+	if !cached {
+		returnItemInsertCacheMut.Lock()
+		returnItemInsertCache[key] = cache
+		returnItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReturnItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReturnItem) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	returnItemUpdateCacheMut.RLock()
+	cache, cached := returnItemUpdateCache[key]
+	returnItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			returnItemAllColumns,
+			returnItemPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update return_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"return_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, returnItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(returnItemType, returnItemMapping, append(wl, returnItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update return_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for return_items")
+	}
+
+	if !cached {
+		returnItemUpdateCacheMut.Lock()
+		returnItemUpdateCache[key] = cache
+		returnItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q returnItemQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for return_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for return_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReturnItemSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), returnItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"return_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, returnItemPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in returnItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all returnItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReturnItem) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no return_items provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(returnItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	returnItemUpsertCacheMut.RLock()
+	cache, cached := returnItemUpsertCache[key]
+	returnItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			returnItemAllColumns,
+			returnItemColumnsWithDefault,
+			returnItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			returnItemAllColumns,
+			returnItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert return_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(returnItemPrimaryKeyColumns))
+			copy(conflict, returnItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"return_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(returnItemType, returnItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(returnItemType, returnItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert return_items")
+	}
+
+	if !cached {
+		returnItemUpsertCacheMut.Lock()
+		returnItemUpsertCache[key] = cache
+		returnItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReturnItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReturnItem) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReturnItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), returnItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"return_items\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from return_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for return_items")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q returnItemQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no returnItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from return_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for return_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReturnItemSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(returnItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), returnItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"return_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, returnItemPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from returnItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for return_items")
+	}
+
+	if len(returnItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReturnItem) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReturnItem(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReturnItemSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReturnItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), returnItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"return_items\".* FROM \"return_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, returnItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReturnItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReturnItemExists checks if the ReturnItem row exists.
+func ReturnItemExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"return_items\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if return_items exists")
+	}
+
+	return exists, nil
+}
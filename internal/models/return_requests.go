@@ -0,0 +1,1584 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReturnRequest is an object representing the database table.
+type ReturnRequest struct {
+	ID                 int                 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrderID            int                 `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	Status             string              `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Reason             string              `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Note               string              `boil:"note" json:"note" toml:"note" yaml:"note"`
+	RefundAmount       decimal.NullDecimal `boil:"refund_amount" json:"refund_amount,omitempty" toml:"refund_amount" yaml:"refund_amount,omitempty"`
+	ReplacementOrderID null.Int            `boil:"replacement_order_id" json:"replacement_order_id,omitempty" toml:"replacement_order_id" yaml:"replacement_order_id,omitempty"`
+	ReceivedAt         null.Time           `boil:"received_at" json:"received_at,omitempty" toml:"received_at" yaml:"received_at,omitempty"`
+	ResolvedAt         null.Time           `boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+	CreatedAt          time.Time           `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time           `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *returnRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L returnRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReturnRequestColumns = struct {
+	ID                 string
+	OrderID            string
+	Status             string
+	Reason             string
+	Note               string
+	RefundAmount       string
+	ReplacementOrderID string
+	ReceivedAt         string
+	ResolvedAt         string
+	CreatedAt          string
+	UpdatedAt          string
+}{
+	ID:                 "id",
+	OrderID:            "order_id",
+	Status:             "status",
+	Reason:             "reason",
+	Note:               "note",
+	RefundAmount:       "refund_amount",
+	ReplacementOrderID: "replacement_order_id",
+	ReceivedAt:         "received_at",
+	ResolvedAt:         "resolved_at",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+}
+
+var ReturnRequestTableColumns = struct {
+	ID                 string
+	OrderID            string
+	Status             string
+	Reason             string
+	Note               string
+	RefundAmount       string
+	ReplacementOrderID string
+	ReceivedAt         string
+	ResolvedAt         string
+	CreatedAt          string
+	UpdatedAt          string
+}{
+	ID:                 "return_requests.id",
+	OrderID:            "return_requests.order_id",
+	Status:             "return_requests.status",
+	Reason:             "return_requests.reason",
+	Note:               "return_requests.note",
+	RefundAmount:       "return_requests.refund_amount",
+	ReplacementOrderID: "return_requests.replacement_order_id",
+	ReceivedAt:         "return_requests.received_at",
+	ResolvedAt:         "return_requests.resolved_at",
+	CreatedAt:          "return_requests.created_at",
+	UpdatedAt:          "return_requests.updated_at",
+}
+
+// Generated where
+
+var ReturnRequestWhere = struct {
+	ID                 whereHelperint
+	OrderID            whereHelperint
+	Status             whereHelperstring
+	Reason             whereHelperstring
+	Note               whereHelperstring
+	RefundAmount       whereHelperdecimal_NullDecimal
+	ReplacementOrderID whereHelpernull_Int
+	ReceivedAt         whereHelpernull_Time
+	ResolvedAt         whereHelpernull_Time
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+}{
+	ID:                 whereHelperint{field: "\"return_requests\".\"id\""},
+	OrderID:            whereHelperint{field: "\"return_requests\".\"order_id\""},
+	Status:             whereHelperstring{field: "\"return_requests\".\"status\""},
+	Reason:             whereHelperstring{field: "\"return_requests\".\"reason\""},
+	Note:               whereHelperstring{field: "\"return_requests\".\"note\""},
+	RefundAmount:       whereHelperdecimal_NullDecimal{field: "\"return_requests\".\"refund_amount\""},
+	ReplacementOrderID: whereHelpernull_Int{field: "\"return_requests\".\"replacement_order_id\""},
+	ReceivedAt:         whereHelpernull_Time{field: "\"return_requests\".\"received_at\""},
+	ResolvedAt:         whereHelpernull_Time{field: "\"return_requests\".\"resolved_at\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"return_requests\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"return_requests\".\"updated_at\""},
+}
+
+// ReturnRequestRels is where relationship names are stored.
+var ReturnRequestRels = struct {
+	Order            string
+	ReplacementOrder string
+	ReturnItems      string
+}{
+	Order:            "Order",
+	ReplacementOrder: "ReplacementOrder",
+	ReturnItems:      "ReturnItems",
+}
+
+// returnRequestR is where relationships are stored.
+type returnRequestR struct {
+	Order            *Order          `boil:"Order" json:"Order" toml:"Order" yaml:"Order"`
+	ReplacementOrder *Order          `boil:"ReplacementOrder" json:"ReplacementOrder" toml:"ReplacementOrder" yaml:"ReplacementOrder"`
+	ReturnItems      ReturnItemSlice `boil:"ReturnItems" json:"ReturnItems" toml:"ReturnItems" yaml:"ReturnItems"`
+}
+
+// NewStruct creates a new relationship struct
+func (*returnRequestR) NewStruct() *returnRequestR {
+	return &returnRequestR{}
+}
+
+func (r *returnRequestR) GetOrder() *Order {
+	if r == nil {
+		return nil
+	}
+	return r.Order
+}
+
+func (r *returnRequestR) GetReplacementOrder() *Order {
+	if r == nil {
+		return nil
+	}
+	return r.ReplacementOrder
+}
+
+func (r *returnRequestR) GetReturnItems() ReturnItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReturnItems
+}
+
+// returnRequestL is where Load methods for each relationship are stored.
+type returnRequestL struct{}
+
+var (
+	returnRequestAllColumns            = []string{"id", "order_id", "status", "reason", "note", "refund_amount", "replacement_order_id", "received_at", "resolved_at", "created_at", "updated_at"}
+	returnRequestColumnsWithoutDefault = []string{"order_id", "status", "reason"}
+	returnRequestColumnsWithDefault    = []string{"id", "note", "refund_amount", "replacement_order_id", "received_at", "resolved_at", "created_at", "updated_at"}
+	returnRequestPrimaryKeyColumns     = []string{"id"}
+	returnRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// ReturnRequestSlice is an alias for a slice of pointers to ReturnRequest.
+	// This should almost always be used instead of []ReturnRequest.
+	ReturnRequestSlice []*ReturnRequest
+	// ReturnRequestHook is the signature for custom ReturnRequest hook methods
+	ReturnRequestHook func(context.Context, boil.ContextExecutor, *ReturnRequest) error
+
+	returnRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	returnRequestType                 = reflect.TypeOf(&ReturnRequest{})
+	returnRequestMapping              = queries.MakeStructMapping(returnRequestType)
+	returnRequestPrimaryKeyMapping, _ = queries.BindMapping(returnRequestType, returnRequestMapping, returnRequestPrimaryKeyColumns)
+	returnRequestInsertCacheMut       sync.RWMutex
+	returnRequestInsertCache          = make(map[string]insertCache)
+	returnRequestUpdateCacheMut       sync.RWMutex
+	returnRequestUpdateCache          = make(map[string]updateCache)
+	returnRequestUpsertCacheMut       sync.RWMutex
+	returnRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var returnRequestAfterSelectHooks []ReturnRequestHook
+
+var returnRequestBeforeInsertHooks []ReturnRequestHook
+var returnRequestAfterInsertHooks []ReturnRequestHook
+
+var returnRequestBeforeUpdateHooks []ReturnRequestHook
+var returnRequestAfterUpdateHooks []ReturnRequestHook
+
+var returnRequestBeforeDeleteHooks []ReturnRequestHook
+var returnRequestAfterDeleteHooks []ReturnRequestHook
+
+var returnRequestBeforeUpsertHooks []ReturnRequestHook
+var returnRequestAfterUpsertHooks []ReturnRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReturnRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReturnRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReturnRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReturnRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReturnRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReturnRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReturnRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReturnRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReturnRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range returnRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReturnRequestHook registers your hook function for all future operations.
+func AddReturnRequestHook(hookPoint boil.HookPoint, returnRequestHook ReturnRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		returnRequestAfterSelectHooks = append(returnRequestAfterSelectHooks, returnRequestHook)
+	case boil.BeforeInsertHook:
+		returnRequestBeforeInsertHooks = append(returnRequestBeforeInsertHooks, returnRequestHook)
+	case boil.AfterInsertHook:
+		returnRequestAfterInsertHooks = append(returnRequestAfterInsertHooks, returnRequestHook)
+	case boil.BeforeUpdateHook:
+		returnRequestBeforeUpdateHooks = append(returnRequestBeforeUpdateHooks, returnRequestHook)
+	case boil.AfterUpdateHook:
+		returnRequestAfterUpdateHooks = append(returnRequestAfterUpdateHooks, returnRequestHook)
+	case boil.BeforeDeleteHook:
+		returnRequestBeforeDeleteHooks = append(returnRequestBeforeDeleteHooks, returnRequestHook)
+	case boil.AfterDeleteHook:
+		returnRequestAfterDeleteHooks = append(returnRequestAfterDeleteHooks, returnRequestHook)
+	case boil.BeforeUpsertHook:
+		returnRequestBeforeUpsertHooks = append(returnRequestBeforeUpsertHooks, returnRequestHook)
+	case boil.AfterUpsertHook:
+		returnRequestAfterUpsertHooks = append(returnRequestAfterUpsertHooks, returnRequestHook)
+	}
+}
+
+// One returns a single returnRequest record from the query.
+func (q returnRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReturnRequest, error) {
+	o := &ReturnRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for return_requests")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReturnRequest records from the query.
+func (q returnRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReturnRequestSlice, error) {
+	var o []*ReturnRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReturnRequest slice")
+	}
+
+	if len(returnRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReturnRequest records in the query.
+func (q returnRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count return_requests rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q returnRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if return_requests exists")
+	}
+
+	return count > 0, nil
+}
+
+// Order pointed to by the foreign key.
+func (o *ReturnRequest) Order(mods ...qm.QueryMod) orderQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Orders(queryMods...)
+}
+
+// ReplacementOrder pointed to by the foreign key.
+func (o *ReturnRequest) ReplacementOrder(mods ...qm.QueryMod) orderQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReplacementOrderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Orders(queryMods...)
+}
+
+// ReturnItems retrieves all the return_item's ReturnItems with an executor.
+func (o *ReturnRequest) ReturnItems(mods ...qm.QueryMod) returnItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"return_items\".\"return_request_id\"=?", o.ID),
+	)
+
+	return ReturnItems(queryMods...)
+}
+
+// LoadOrder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (returnRequestL) LoadOrder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReturnRequest interface{}, mods queries.Applicator) error {
+	var slice []*ReturnRequest
+	var object *ReturnRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeReturnRequest.(*ReturnRequest)
+		if !ok {
+			object = new(ReturnRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReturnRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReturnRequest))
+			}
+		}
+	} else {
+		s, ok := maybeReturnRequest.(*[]*ReturnRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReturnRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReturnRequest))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &returnRequestR{}
+		}
+		args = append(args, object.OrderID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &returnRequestR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OrderID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OrderID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`orders`),
+		qm.WhereIn(`orders.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Order")
+	}
+
+	var resultSlice []*Order
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Order")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orders")
+	}
+
+	if len(returnRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Order = foreign
+		if foreign.R == nil {
+			foreign.R = &orderR{}
+		}
+		foreign.R.ReturnRequests = append(foreign.R.ReturnRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrderID == foreign.ID {
+				local.R.Order = foreign
+				if foreign.R == nil {
+					foreign.R = &orderR{}
+				}
+				foreign.R.ReturnRequests = append(foreign.R.ReturnRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReplacementOrder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (returnRequestL) LoadReplacementOrder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReturnRequest interface{}, mods queries.Applicator) error {
+	var slice []*ReturnRequest
+	var object *ReturnRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeReturnRequest.(*ReturnRequest)
+		if !ok {
+			object = new(ReturnRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReturnRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReturnRequest))
+			}
+		}
+	} else {
+		s, ok := maybeReturnRequest.(*[]*ReturnRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReturnRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReturnRequest))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &returnRequestR{}
+		}
+		if !queries.IsNil(object.ReplacementOrderID) {
+			args = append(args, object.ReplacementOrderID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &returnRequestR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ReplacementOrderID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ReplacementOrderID) {
+				args = append(args, obj.ReplacementOrderID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`orders`),
+		qm.WhereIn(`orders.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Order")
+	}
+
+	var resultSlice []*Order
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Order")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orders")
+	}
+
+	if len(returnRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ReplacementOrder = foreign
+		if foreign.R == nil {
+			foreign.R = &orderR{}
+		}
+		foreign.R.ReplacementOrderReturnRequests = append(foreign.R.ReplacementOrderReturnRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReplacementOrderID, foreign.ID) {
+				local.R.ReplacementOrder = foreign
+				if foreign.R == nil {
+					foreign.R = &orderR{}
+				}
+				foreign.R.ReplacementOrderReturnRequests = append(foreign.R.ReplacementOrderReturnRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReturnItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (returnRequestL) LoadReturnItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReturnRequest interface{}, mods queries.Applicator) error {
+	var slice []*ReturnRequest
+	var object *ReturnRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeReturnRequest.(*ReturnRequest)
+		if !ok {
+			object = new(ReturnRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReturnRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReturnRequest))
+			}
+		}
+	} else {
+		s, ok := maybeReturnRequest.(*[]*ReturnRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReturnRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReturnRequest))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &returnRequestR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &returnRequestR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`return_items`),
+		qm.WhereIn(`return_items.return_request_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load return_items")
+	}
+
+	var resultSlice []*ReturnItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice return_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on return_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for return_items")
+	}
+
+	if len(returnItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReturnItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &returnItemR{}
+			}
+			foreign.R.ReturnRequest = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReturnRequestID {
+				local.R.ReturnItems = append(local.R.ReturnItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &returnItemR{}
+				}
+				foreign.R.ReturnRequest = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrder of the returnRequest to the related item.
+// Sets o.R.Order to related.
+// Adds o to related.R.ReturnRequests.
+func (o *ReturnRequest) SetOrder(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Order) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"return_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"order_id"}),
+		strmangle.WhereClause("\"", "\"", 2, returnRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrderID = related.ID
+	if o.R == nil {
+		o.R = &returnRequestR{
+			Order: related,
+		}
+	} else {
+		o.R.Order = related
+	}
+
+	if related.R == nil {
+		related.R = &orderR{
+			ReturnRequests: ReturnRequestSlice{o},
+		}
+	} else {
+		related.R.ReturnRequests = append(related.R.ReturnRequests, o)
+	}
+
+	return nil
+}
+
+// SetReplacementOrder of the returnRequest to the related item.
+// Sets o.R.ReplacementOrder to related.
+// Adds o to related.R.ReplacementOrderReturnRequests.
+func (o *ReturnRequest) SetReplacementOrder(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Order) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"return_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"replacement_order_id"}),
+		strmangle.WhereClause("\"", "\"", 2, returnRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReplacementOrderID, related.ID)
+	if o.R == nil {
+		o.R = &returnRequestR{
+			ReplacementOrder: related,
+		}
+	} else {
+		o.R.ReplacementOrder = related
+	}
+
+	if related.R == nil {
+		related.R = &orderR{
+			ReplacementOrderReturnRequests: ReturnRequestSlice{o},
+		}
+	} else {
+		related.R.ReplacementOrderReturnRequests = append(related.R.ReplacementOrderReturnRequests, o)
+	}
+
+	return nil
+}
+
+// RemoveReplacementOrder relationship.
+// Sets o.R.ReplacementOrder to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ReturnRequest) RemoveReplacementOrder(ctx context.Context, exec boil.ContextExecutor, related *Order) error {
+	var err error
+
+	queries.SetScanner(&o.ReplacementOrderID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("replacement_order_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ReplacementOrder = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReplacementOrderReturnRequests {
+		if queries.Equal(o.ReplacementOrderID, ri.ReplacementOrderID) {
+			continue
+		}
+
+		ln := len(related.R.ReplacementOrderReturnRequests)
+		if ln > 1 && i < ln-1 {
+			related.R.ReplacementOrderReturnRequests[i] = related.R.ReplacementOrderReturnRequests[ln-1]
+		}
+		related.R.ReplacementOrderReturnRequests = related.R.ReplacementOrderReturnRequests[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddReturnItems adds the given related objects to the existing relationships
+// of the return_request, optionally inserting them as new records.
+// Appends related to o.R.ReturnItems.
+// Sets related.R.ReturnRequest appropriately.
+func (o *ReturnRequest) AddReturnItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReturnItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReturnRequestID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"return_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"return_request_id"}),
+				strmangle.WhereClause("\"", "\"", 2, returnItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReturnRequestID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &returnRequestR{
+			ReturnItems: related,
+		}
+	} else {
+		o.R.ReturnItems = append(o.R.ReturnItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &returnItemR{
+				ReturnRequest: o,
+			}
+		} else {
+			rel.R.ReturnRequest = o
+		}
+	}
+	return nil
+}
+
+// ReturnRequests retrieves all the records using an executor.
+func ReturnRequests(mods ...qm.QueryMod) returnRequestQuery {
+	mods = append(mods, qm.From("\"return_requests\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"return_requests\".*"})
+	}
+
+	return returnRequestQuery{q}
+}
+
+// FindReturnRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReturnRequest(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ReturnRequest, error) {
+	returnRequestObj := &ReturnRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"return_requests\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, returnRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from return_requests")
+	}
+
+	if err = returnRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return returnRequestObj, err
+	}
+
+	return returnRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReturnRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no return_requests provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(returnRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	returnRequestInsertCacheMut.RLock()
+	cache, cached := returnRequestInsertCache[key]
+	returnRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			returnRequestAllColumns,
+			returnRequestColumnsWithDefault,
+			returnRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(returnRequestType, returnRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(returnRequestType, returnRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"return_requests\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"return_requests\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into return_requests")
+	}
+
+	if !cached {
+		returnRequestInsertCacheMut.Lock()
+		returnRequestInsertCache[key] = cache
+		returnRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReturnRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReturnRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	returnRequestUpdateCacheMut.RLock()
+	cache, cached := returnRequestUpdateCache[key]
+	returnRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			returnRequestAllColumns,
+			returnRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update return_requests, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"return_requests\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, returnRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(returnRequestType, returnRequestMapping, append(wl, returnRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update return_requests row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for return_requests")
+	}
+
+	if !cached {
+		returnRequestUpdateCacheMut.Lock()
+		returnRequestUpdateCache[key] = cache
+		returnRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q returnRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for return_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for return_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReturnRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), returnRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"return_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, returnRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in returnRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all returnRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReturnRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no return_requests provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(returnRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	returnRequestUpsertCacheMut.RLock()
+	cache, cached := returnRequestUpsertCache[key]
+	returnRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			returnRequestAllColumns,
+			returnRequestColumnsWithDefault,
+			returnRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			returnRequestAllColumns,
+			returnRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert return_requests, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(returnRequestPrimaryKeyColumns))
+			copy(conflict, returnRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"return_requests\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(returnRequestType, returnRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(returnRequestType, returnRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert return_requests")
+	}
+
+	if !cached {
+		returnRequestUpsertCacheMut.Lock()
+		returnRequestUpsertCache[key] = cache
+		returnRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReturnRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReturnRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReturnRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), returnRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"return_requests\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from return_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for return_requests")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q returnRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no returnRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from return_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for return_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReturnRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(returnRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), returnRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"return_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, returnRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from returnRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for return_requests")
+	}
+
+	if len(returnRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReturnRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReturnRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReturnRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReturnRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), returnRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"return_requests\".* FROM \"return_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, returnRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReturnRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReturnRequestExists checks if the ReturnRequest row exists.
+func ReturnRequestExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"return_requests\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if return_requests exists")
+	}
+
+	return exists, nil
+}
@@ -100,20 +100,32 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Orders         string
-	Payments       string
-	AuthorProducts string
+	Addresses           string
+	Orders              string
+	Payments            string
+	ProductReviews      string
+	AuthorProducts      string
+	ActorStockMovements string
+	WishlistItems       string
 }{
-	Orders:         "Orders",
-	Payments:       "Payments",
-	AuthorProducts: "AuthorProducts",
+	Addresses:           "Addresses",
+	Orders:              "Orders",
+	Payments:            "Payments",
+	ProductReviews:      "ProductReviews",
+	AuthorProducts:      "AuthorProducts",
+	ActorStockMovements: "ActorStockMovements",
+	WishlistItems:       "WishlistItems",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Orders         OrderSlice   `boil:"Orders" json:"Orders" toml:"Orders" yaml:"Orders"`
-	Payments       PaymentSlice `boil:"Payments" json:"Payments" toml:"Payments" yaml:"Payments"`
-	AuthorProducts ProductSlice `boil:"AuthorProducts" json:"AuthorProducts" toml:"AuthorProducts" yaml:"AuthorProducts"`
+	Addresses           AddressSlice       `boil:"Addresses" json:"Addresses" toml:"Addresses" yaml:"Addresses"`
+	Orders              OrderSlice         `boil:"Orders" json:"Orders" toml:"Orders" yaml:"Orders"`
+	Payments            PaymentSlice       `boil:"Payments" json:"Payments" toml:"Payments" yaml:"Payments"`
+	ProductReviews      ProductReviewSlice `boil:"ProductReviews" json:"ProductReviews" toml:"ProductReviews" yaml:"ProductReviews"`
+	AuthorProducts      ProductSlice       `boil:"AuthorProducts" json:"AuthorProducts" toml:"AuthorProducts" yaml:"AuthorProducts"`
+	ActorStockMovements StockMovementSlice `boil:"ActorStockMovements" json:"ActorStockMovements" toml:"ActorStockMovements" yaml:"ActorStockMovements"`
+	WishlistItems       WishlistItemSlice  `boil:"WishlistItems" json:"WishlistItems" toml:"WishlistItems" yaml:"WishlistItems"`
 }
 
 // NewStruct creates a new relationship struct
@@ -121,6 +133,13 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetAddresses() AddressSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Addresses
+}
+
 func (r *userR) GetOrders() OrderSlice {
 	if r == nil {
 		return nil
@@ -135,6 +154,13 @@ func (r *userR) GetPayments() PaymentSlice {
 	return r.Payments
 }
 
+func (r *userR) GetProductReviews() ProductReviewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ProductReviews
+}
+
 func (r *userR) GetAuthorProducts() ProductSlice {
 	if r == nil {
 		return nil
@@ -142,6 +168,20 @@ func (r *userR) GetAuthorProducts() ProductSlice {
 	return r.AuthorProducts
 }
 
+func (r *userR) GetActorStockMovements() StockMovementSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorStockMovements
+}
+
+func (r *userR) GetWishlistItems() WishlistItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WishlistItems
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -431,6 +471,20 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Addresses retrieves all the address's Addresses with an executor.
+func (o *User) Addresses(mods ...qm.QueryMod) addressQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"addresses\".\"user_id\"=?", o.ID),
+	)
+
+	return Addresses(queryMods...)
+}
+
 // Orders retrieves all the order's Orders with an executor.
 func (o *User) Orders(mods ...qm.QueryMod) orderQuery {
 	var queryMods []qm.QueryMod
@@ -459,6 +513,20 @@ func (o *User) Payments(mods ...qm.QueryMod) paymentQuery {
 	return Payments(queryMods...)
 }
 
+// ProductReviews retrieves all the product_review's ProductReviews with an executor.
+func (o *User) ProductReviews(mods ...qm.QueryMod) productReviewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_reviews\".\"user_id\"=?", o.ID),
+	)
+
+	return ProductReviews(queryMods...)
+}
+
 // AuthorProducts retrieves all the product's Products with an executor via author_id column.
 func (o *User) AuthorProducts(mods ...qm.QueryMod) productQuery {
 	var queryMods []qm.QueryMod
@@ -473,6 +541,148 @@ func (o *User) AuthorProducts(mods ...qm.QueryMod) productQuery {
 	return Products(queryMods...)
 }
 
+// ActorStockMovements retrieves all the stock_movement's StockMovements with an executor via actor_id column.
+func (o *User) ActorStockMovements(mods ...qm.QueryMod) stockMovementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"stock_movements\".\"actor_id\"=?", o.ID),
+	)
+
+	return StockMovements(queryMods...)
+}
+
+// WishlistItems retrieves all the wishlist_item's WishlistItems with an executor.
+func (o *User) WishlistItems(mods ...qm.QueryMod) wishlistItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"wishlist_items\".\"user_id\"=?", o.ID),
+	)
+
+	return WishlistItems(queryMods...)
+}
+
+// LoadAddresses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`addresses`),
+		qm.WhereIn(`addresses.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load addresses")
+	}
+
+	var resultSlice []*Address
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice addresses")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on addresses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for addresses")
+	}
+
+	if len(addressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Addresses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &addressR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Addresses = append(local.R.Addresses, foreign)
+				if foreign.R == nil {
+					foreign.R = &addressR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadOrders allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadOrders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -701,9 +911,9 @@ func (userL) LoadPayments(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadAuthorProducts allows an eager lookup of values, cached into the
+// LoadProductReviews allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAuthorProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadProductReviews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -757,8 +967,8 @@ func (userL) LoadAuthorProducts(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`products`),
-		qm.WhereIn(`products.author_id in ?`, args...),
+		qm.From(`product_reviews`),
+		qm.WhereIn(`product_reviews.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -766,22 +976,22 @@ func (userL) LoadAuthorProducts(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load products")
+		return errors.Wrap(err, "failed to eager load product_reviews")
 	}
 
-	var resultSlice []*Product
+	var resultSlice []*ProductReview
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice products")
+		return errors.Wrap(err, "failed to bind eager loaded slice product_reviews")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on products")
+		return errors.Wrap(err, "failed to close results in eager load on product_reviews")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_reviews")
 	}
 
-	if len(productAfterSelectHooks) != 0 {
+	if len(productReviewAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -789,24 +999,24 @@ func (userL) LoadAuthorProducts(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.AuthorProducts = resultSlice
+		object.R.ProductReviews = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &productR{}
+				foreign.R = &productReviewR{}
 			}
-			foreign.R.Author = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.AuthorID {
-				local.R.AuthorProducts = append(local.R.AuthorProducts, foreign)
+			if local.ID == foreign.UserID {
+				local.R.ProductReviews = append(local.R.ProductReviews, foreign)
 				if foreign.R == nil {
-					foreign.R = &productR{}
+					foreign.R = &productReviewR{}
 				}
-				foreign.R.Author = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -815,54 +1025,449 @@ func (userL) LoadAuthorProducts(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// AddOrders adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Orders.
-// Sets related.R.User appropriately.
-func (o *User) AddOrders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Order) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"orders\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadAuthorProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			rel.UserID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			Orders: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.Orders = append(o.R.Orders, related...)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &orderR{
-				User: o,
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
-		} else {
-			rel.R.User = o
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.author_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuthorProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.Author = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AuthorID {
+				local.R.AuthorProducts = append(local.R.AuthorProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.Author = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActorStockMovements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorStockMovements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`stock_movements`),
+		qm.WhereIn(`stock_movements.actor_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load stock_movements")
+	}
+
+	var resultSlice []*StockMovement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice stock_movements")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on stock_movements")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for stock_movements")
+	}
+
+	if len(stockMovementAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorStockMovements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &stockMovementR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ActorID) {
+				local.R.ActorStockMovements = append(local.R.ActorStockMovements, foreign)
+				if foreign.R == nil {
+					foreign.R = &stockMovementR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWishlistItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWishlistItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`wishlist_items`),
+		qm.WhereIn(`wishlist_items.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load wishlist_items")
+	}
+
+	var resultSlice []*WishlistItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice wishlist_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on wishlist_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for wishlist_items")
+	}
+
+	if len(wishlistItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WishlistItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &wishlistItemR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WishlistItems = append(local.R.WishlistItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &wishlistItemR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAddresses adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Addresses.
+// Sets related.R.User appropriately.
+func (o *User) AddAddresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Address) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"addresses\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, addressPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Addresses: related,
+		}
+	} else {
+		o.R.Addresses = append(o.R.Addresses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &addressR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOrders adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Orders.
+// Sets related.R.User appropriately.
+func (o *User) AddOrders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Order) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"orders\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Orders: related,
+		}
+	} else {
+		o.R.Orders = append(o.R.Orders, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
 	}
 	return nil
@@ -921,6 +1526,59 @@ func (o *User) AddPayments(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddProductReviews adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ProductReviews.
+// Sets related.R.User appropriately.
+func (o *User) AddProductReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductReview) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_reviews\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productReviewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ProductReviews: related,
+		}
+	} else {
+		o.R.ProductReviews = append(o.R.ProductReviews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productReviewR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddAuthorProducts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.AuthorProducts.
@@ -974,6 +1632,186 @@ func (o *User) AddAuthorProducts(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddActorStockMovements adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorStockMovements.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorStockMovements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StockMovement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ActorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"stock_movements\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, stockMovementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ActorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorStockMovements: related,
+		}
+	} else {
+		o.R.ActorStockMovements = append(o.R.ActorStockMovements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &stockMovementR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// SetActorStockMovements removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Actor's ActorStockMovements accordingly.
+// Replaces o.R.ActorStockMovements with related.
+// Sets related.R.Actor's ActorStockMovements accordingly.
+func (o *User) SetActorStockMovements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StockMovement) error {
+	query := "update \"stock_movements\" set \"actor_id\" = null where \"actor_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ActorStockMovements {
+			queries.SetScanner(&rel.ActorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Actor = nil
+		}
+		o.R.ActorStockMovements = nil
+	}
+
+	return o.AddActorStockMovements(ctx, exec, insert, related...)
+}
+
+// RemoveActorStockMovements relationships from objects passed in.
+// Removes related items from R.ActorStockMovements (uses pointer comparison, removal does not keep order)
+// Sets related.R.Actor.
+func (o *User) RemoveActorStockMovements(ctx context.Context, exec boil.ContextExecutor, related ...*StockMovement) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ActorID, nil)
+		if rel.R != nil {
+			rel.R.Actor = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ActorStockMovements {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ActorStockMovements)
+			if ln > 1 && i < ln-1 {
+				o.R.ActorStockMovements[i] = o.R.ActorStockMovements[ln-1]
+			}
+			o.R.ActorStockMovements = o.R.ActorStockMovements[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWishlistItems adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WishlistItems.
+// Sets related.R.User appropriately.
+func (o *User) AddWishlistItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WishlistItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"wishlist_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, wishlistItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WishlistItems: related,
+		}
+	} else {
+		o.R.WishlistItems = append(o.R.WishlistItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &wishlistItemR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -0,0 +1,1319 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// VariantOptionValue is an object representing the database table.
+type VariantOptionValue struct {
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	VariantID    int       `boil:"variant_id" json:"variant_id" toml:"variant_id" yaml:"variant_id"`
+	OptionTypeID int       `boil:"option_type_id" json:"option_type_id" toml:"option_type_id" yaml:"option_type_id"`
+	Value        string    `boil:"value" json:"value" toml:"value" yaml:"value"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *variantOptionValueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L variantOptionValueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var VariantOptionValueColumns = struct {
+	ID           string
+	VariantID    string
+	OptionTypeID string
+	Value        string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	VariantID:    "variant_id",
+	OptionTypeID: "option_type_id",
+	Value:        "value",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var VariantOptionValueTableColumns = struct {
+	ID           string
+	VariantID    string
+	OptionTypeID string
+	Value        string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "variant_option_values.id",
+	VariantID:    "variant_option_values.variant_id",
+	OptionTypeID: "variant_option_values.option_type_id",
+	Value:        "variant_option_values.value",
+	CreatedAt:    "variant_option_values.created_at",
+	UpdatedAt:    "variant_option_values.updated_at",
+}
+
+// Generated where
+
+var VariantOptionValueWhere = struct {
+	ID           whereHelperint
+	VariantID    whereHelperint
+	OptionTypeID whereHelperint
+	Value        whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperint{field: "\"variant_option_values\".\"id\""},
+	VariantID:    whereHelperint{field: "\"variant_option_values\".\"variant_id\""},
+	OptionTypeID: whereHelperint{field: "\"variant_option_values\".\"option_type_id\""},
+	Value:        whereHelperstring{field: "\"variant_option_values\".\"value\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"variant_option_values\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"variant_option_values\".\"updated_at\""},
+}
+
+// VariantOptionValueRels is where relationship names are stored.
+var VariantOptionValueRels = struct {
+	OptionType string
+	Variant    string
+}{
+	OptionType: "OptionType",
+	Variant:    "Variant",
+}
+
+// variantOptionValueR is where relationships are stored.
+type variantOptionValueR struct {
+	OptionType *OptionType     `boil:"OptionType" json:"OptionType" toml:"OptionType" yaml:"OptionType"`
+	Variant    *ProductVariant `boil:"Variant" json:"Variant" toml:"Variant" yaml:"Variant"`
+}
+
+// NewStruct creates a new relationship struct
+func (*variantOptionValueR) NewStruct() *variantOptionValueR {
+	return &variantOptionValueR{}
+}
+
+func (r *variantOptionValueR) GetOptionType() *OptionType {
+	if r == nil {
+		return nil
+	}
+	return r.OptionType
+}
+
+func (r *variantOptionValueR) GetVariant() *ProductVariant {
+	if r == nil {
+		return nil
+	}
+	return r.Variant
+}
+
+// variantOptionValueL is where Load methods for each relationship are stored.
+type variantOptionValueL struct{}
+
+var (
+	variantOptionValueAllColumns            = []string{"id", "variant_id", "option_type_id", "value", "created_at", "updated_at"}
+	variantOptionValueColumnsWithoutDefault = []string{"variant_id", "option_type_id", "value"}
+	variantOptionValueColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	variantOptionValuePrimaryKeyColumns     = []string{"id"}
+	variantOptionValueGeneratedColumns      = []string{}
+)
+
+type (
+	// VariantOptionValueSlice is an alias for a slice of pointers to VariantOptionValue.
+	// This should almost always be used instead of []VariantOptionValue.
+	VariantOptionValueSlice []*VariantOptionValue
+	// VariantOptionValueHook is the signature for custom VariantOptionValue hook methods
+	VariantOptionValueHook func(context.Context, boil.ContextExecutor, *VariantOptionValue) error
+
+	variantOptionValueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	variantOptionValueType                 = reflect.TypeOf(&VariantOptionValue{})
+	variantOptionValueMapping              = queries.MakeStructMapping(variantOptionValueType)
+	variantOptionValuePrimaryKeyMapping, _ = queries.BindMapping(variantOptionValueType, variantOptionValueMapping, variantOptionValuePrimaryKeyColumns)
+	variantOptionValueInsertCacheMut       sync.RWMutex
+	variantOptionValueInsertCache          = make(map[string]insertCache)
+	variantOptionValueUpdateCacheMut       sync.RWMutex
+	variantOptionValueUpdateCache          = make(map[string]updateCache)
+	variantOptionValueUpsertCacheMut       sync.RWMutex
+	variantOptionValueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var variantOptionValueAfterSelectHooks []VariantOptionValueHook
+
+var variantOptionValueBeforeInsertHooks []VariantOptionValueHook
+var variantOptionValueAfterInsertHooks []VariantOptionValueHook
+
+var variantOptionValueBeforeUpdateHooks []VariantOptionValueHook
+var variantOptionValueAfterUpdateHooks []VariantOptionValueHook
+
+var variantOptionValueBeforeDeleteHooks []VariantOptionValueHook
+var variantOptionValueAfterDeleteHooks []VariantOptionValueHook
+
+var variantOptionValueBeforeUpsertHooks []VariantOptionValueHook
+var variantOptionValueAfterUpsertHooks []VariantOptionValueHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *VariantOptionValue) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *VariantOptionValue) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *VariantOptionValue) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *VariantOptionValue) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *VariantOptionValue) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *VariantOptionValue) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *VariantOptionValue) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *VariantOptionValue) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *VariantOptionValue) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range variantOptionValueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVariantOptionValueHook registers your hook function for all future operations.
+func AddVariantOptionValueHook(hookPoint boil.HookPoint, variantOptionValueHook VariantOptionValueHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		variantOptionValueAfterSelectHooks = append(variantOptionValueAfterSelectHooks, variantOptionValueHook)
+	case boil.BeforeInsertHook:
+		variantOptionValueBeforeInsertHooks = append(variantOptionValueBeforeInsertHooks, variantOptionValueHook)
+	case boil.AfterInsertHook:
+		variantOptionValueAfterInsertHooks = append(variantOptionValueAfterInsertHooks, variantOptionValueHook)
+	case boil.BeforeUpdateHook:
+		variantOptionValueBeforeUpdateHooks = append(variantOptionValueBeforeUpdateHooks, variantOptionValueHook)
+	case boil.AfterUpdateHook:
+		variantOptionValueAfterUpdateHooks = append(variantOptionValueAfterUpdateHooks, variantOptionValueHook)
+	case boil.BeforeDeleteHook:
+		variantOptionValueBeforeDeleteHooks = append(variantOptionValueBeforeDeleteHooks, variantOptionValueHook)
+	case boil.AfterDeleteHook:
+		variantOptionValueAfterDeleteHooks = append(variantOptionValueAfterDeleteHooks, variantOptionValueHook)
+	case boil.BeforeUpsertHook:
+		variantOptionValueBeforeUpsertHooks = append(variantOptionValueBeforeUpsertHooks, variantOptionValueHook)
+	case boil.AfterUpsertHook:
+		variantOptionValueAfterUpsertHooks = append(variantOptionValueAfterUpsertHooks, variantOptionValueHook)
+	}
+}
+
+// One returns a single variantOptionValue record from the query.
+func (q variantOptionValueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*VariantOptionValue, error) {
+	o := &VariantOptionValue{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for variant_option_values")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all VariantOptionValue records from the query.
+func (q variantOptionValueQuery) All(ctx context.Context, exec boil.ContextExecutor) (VariantOptionValueSlice, error) {
+	var o []*VariantOptionValue
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to VariantOptionValue slice")
+	}
+
+	if len(variantOptionValueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all VariantOptionValue records in the query.
+func (q variantOptionValueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count variant_option_values rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q variantOptionValueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if variant_option_values exists")
+	}
+
+	return count > 0, nil
+}
+
+// OptionType pointed to by the foreign key.
+func (o *VariantOptionValue) OptionType(mods ...qm.QueryMod) optionTypeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OptionTypeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OptionTypes(queryMods...)
+}
+
+// Variant pointed to by the foreign key.
+func (o *VariantOptionValue) Variant(mods ...qm.QueryMod) productVariantQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.VariantID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ProductVariants(queryMods...)
+}
+
+// LoadOptionType allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (variantOptionValueL) LoadOptionType(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVariantOptionValue interface{}, mods queries.Applicator) error {
+	var slice []*VariantOptionValue
+	var object *VariantOptionValue
+
+	if singular {
+		var ok bool
+		object, ok = maybeVariantOptionValue.(*VariantOptionValue)
+		if !ok {
+			object = new(VariantOptionValue)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVariantOptionValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVariantOptionValue))
+			}
+		}
+	} else {
+		s, ok := maybeVariantOptionValue.(*[]*VariantOptionValue)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVariantOptionValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVariantOptionValue))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &variantOptionValueR{}
+		}
+		args = append(args, object.OptionTypeID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &variantOptionValueR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OptionTypeID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OptionTypeID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`option_types`),
+		qm.WhereIn(`option_types.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OptionType")
+	}
+
+	var resultSlice []*OptionType
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OptionType")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for option_types")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for option_types")
+	}
+
+	if len(variantOptionValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OptionType = foreign
+		if foreign.R == nil {
+			foreign.R = &optionTypeR{}
+		}
+		foreign.R.VariantOptionValues = append(foreign.R.VariantOptionValues, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OptionTypeID == foreign.ID {
+				local.R.OptionType = foreign
+				if foreign.R == nil {
+					foreign.R = &optionTypeR{}
+				}
+				foreign.R.VariantOptionValues = append(foreign.R.VariantOptionValues, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVariant allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (variantOptionValueL) LoadVariant(ctx context.Context, e boil.ContextExecutor, singular bool, maybeVariantOptionValue interface{}, mods queries.Applicator) error {
+	var slice []*VariantOptionValue
+	var object *VariantOptionValue
+
+	if singular {
+		var ok bool
+		object, ok = maybeVariantOptionValue.(*VariantOptionValue)
+		if !ok {
+			object = new(VariantOptionValue)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeVariantOptionValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeVariantOptionValue))
+			}
+		}
+	} else {
+		s, ok := maybeVariantOptionValue.(*[]*VariantOptionValue)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeVariantOptionValue)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeVariantOptionValue))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &variantOptionValueR{}
+		}
+		args = append(args, object.VariantID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &variantOptionValueR{}
+			}
+
+			for _, a := range args {
+				if a == obj.VariantID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.VariantID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`product_variants`),
+		qm.WhereIn(`product_variants.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ProductVariant")
+	}
+
+	var resultSlice []*ProductVariant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ProductVariant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for product_variants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_variants")
+	}
+
+	if len(variantOptionValueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Variant = foreign
+		if foreign.R == nil {
+			foreign.R = &productVariantR{}
+		}
+		foreign.R.VariantVariantOptionValues = append(foreign.R.VariantVariantOptionValues, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.VariantID == foreign.ID {
+				local.R.Variant = foreign
+				if foreign.R == nil {
+					foreign.R = &productVariantR{}
+				}
+				foreign.R.VariantVariantOptionValues = append(foreign.R.VariantVariantOptionValues, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOptionType of the variantOptionValue to the related item.
+// Sets o.R.OptionType to related.
+// Adds o to related.R.VariantOptionValues.
+func (o *VariantOptionValue) SetOptionType(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OptionType) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"variant_option_values\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"option_type_id"}),
+		strmangle.WhereClause("\"", "\"", 2, variantOptionValuePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OptionTypeID = related.ID
+	if o.R == nil {
+		o.R = &variantOptionValueR{
+			OptionType: related,
+		}
+	} else {
+		o.R.OptionType = related
+	}
+
+	if related.R == nil {
+		related.R = &optionTypeR{
+			VariantOptionValues: VariantOptionValueSlice{o},
+		}
+	} else {
+		related.R.VariantOptionValues = append(related.R.VariantOptionValues, o)
+	}
+
+	return nil
+}
+
+// SetVariant of the variantOptionValue to the related item.
+// Sets o.R.Variant to related.
+// Adds o to related.R.VariantVariantOptionValues.
+func (o *VariantOptionValue) SetVariant(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ProductVariant) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"variant_option_values\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"variant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, variantOptionValuePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.VariantID = related.ID
+	if o.R == nil {
+		o.R = &variantOptionValueR{
+			Variant: related,
+		}
+	} else {
+		o.R.Variant = related
+	}
+
+	if related.R == nil {
+		related.R = &productVariantR{
+			VariantVariantOptionValues: VariantOptionValueSlice{o},
+		}
+	} else {
+		related.R.VariantVariantOptionValues = append(related.R.VariantVariantOptionValues, o)
+	}
+
+	return nil
+}
+
+// VariantOptionValues retrieves all the records using an executor.
+func VariantOptionValues(mods ...qm.QueryMod) variantOptionValueQuery {
+	mods = append(mods, qm.From("\"variant_option_values\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"variant_option_values\".*"})
+	}
+
+	return variantOptionValueQuery{q}
+}
+
+// FindVariantOptionValue retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindVariantOptionValue(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*VariantOptionValue, error) {
+	variantOptionValueObj := &VariantOptionValue{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"variant_option_values\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, variantOptionValueObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from variant_option_values")
+	}
+
+	if err = variantOptionValueObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return variantOptionValueObj, err
+	}
+
+	return variantOptionValueObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *VariantOptionValue) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no variant_option_values provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(variantOptionValueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	variantOptionValueInsertCacheMut.RLock()
+	cache, cached := variantOptionValueInsertCache[key]
+	variantOptionValueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			variantOptionValueAllColumns,
+			variantOptionValueColumnsWithDefault,
+			variantOptionValueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(variantOptionValueType, variantOptionValueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(variantOptionValueType, variantOptionValueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"variant_option_values\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"variant_option_values\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into variant_option_values")
+	}
+
+	if !cached {
+		variantOptionValueInsertCacheMut.Lock()
+		variantOptionValueInsertCache[key] = cache
+		variantOptionValueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the VariantOptionValue.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *VariantOptionValue) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	variantOptionValueUpdateCacheMut.RLock()
+	cache, cached := variantOptionValueUpdateCache[key]
+	variantOptionValueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			variantOptionValueAllColumns,
+			variantOptionValuePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update variant_option_values, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"variant_option_values\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, variantOptionValuePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(variantOptionValueType, variantOptionValueMapping, append(wl, variantOptionValuePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update variant_option_values row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for variant_option_values")
+	}
+
+	if !cached {
+		variantOptionValueUpdateCacheMut.Lock()
+		variantOptionValueUpdateCache[key] = cache
+		variantOptionValueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q variantOptionValueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for variant_option_values")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for variant_option_values")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o VariantOptionValueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), variantOptionValuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"variant_option_values\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, variantOptionValuePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in variantOptionValue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all variantOptionValue")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *VariantOptionValue) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no variant_option_values provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(variantOptionValueColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	variantOptionValueUpsertCacheMut.RLock()
+	cache, cached := variantOptionValueUpsertCache[key]
+	variantOptionValueUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			variantOptionValueAllColumns,
+			variantOptionValueColumnsWithDefault,
+			variantOptionValueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			variantOptionValueAllColumns,
+			variantOptionValuePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert variant_option_values, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(variantOptionValuePrimaryKeyColumns))
+			copy(conflict, variantOptionValuePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"variant_option_values\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(variantOptionValueType, variantOptionValueMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(variantOptionValueType, variantOptionValueMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert variant_option_values")
+	}
+
+	if !cached {
+		variantOptionValueUpsertCacheMut.Lock()
+		variantOptionValueUpsertCache[key] = cache
+		variantOptionValueUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single VariantOptionValue record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *VariantOptionValue) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no VariantOptionValue provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), variantOptionValuePrimaryKeyMapping)
+	sql := "DELETE FROM \"variant_option_values\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from variant_option_values")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for variant_option_values")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q variantOptionValueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no variantOptionValueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from variant_option_values")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for variant_option_values")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o VariantOptionValueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(variantOptionValueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), variantOptionValuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"variant_option_values\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, variantOptionValuePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from variantOptionValue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for variant_option_values")
+	}
+
+	if len(variantOptionValueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *VariantOptionValue) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindVariantOptionValue(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *VariantOptionValueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := VariantOptionValueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), variantOptionValuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"variant_option_values\".* FROM \"variant_option_values\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, variantOptionValuePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in VariantOptionValueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// VariantOptionValueExists checks if the VariantOptionValue row exists.
+func VariantOptionValueExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"variant_option_values\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if variant_option_values exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1319 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WarehouseStock is an object representing the database table.
+type WarehouseStock struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WarehouseID int       `boil:"warehouse_id" json:"warehouse_id" toml:"warehouse_id" yaml:"warehouse_id"`
+	ProductID   int       `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Quantity    int       `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *warehouseStockR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L warehouseStockL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WarehouseStockColumns = struct {
+	ID          string
+	WarehouseID string
+	ProductID   string
+	Quantity    string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	WarehouseID: "warehouse_id",
+	ProductID:   "product_id",
+	Quantity:    "quantity",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var WarehouseStockTableColumns = struct {
+	ID          string
+	WarehouseID string
+	ProductID   string
+	Quantity    string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "warehouse_stocks.id",
+	WarehouseID: "warehouse_stocks.warehouse_id",
+	ProductID:   "warehouse_stocks.product_id",
+	Quantity:    "warehouse_stocks.quantity",
+	CreatedAt:   "warehouse_stocks.created_at",
+	UpdatedAt:   "warehouse_stocks.updated_at",
+}
+
+// Generated where
+
+var WarehouseStockWhere = struct {
+	ID          whereHelperint
+	WarehouseID whereHelperint
+	ProductID   whereHelperint
+	Quantity    whereHelperint
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "\"warehouse_stocks\".\"id\""},
+	WarehouseID: whereHelperint{field: "\"warehouse_stocks\".\"warehouse_id\""},
+	ProductID:   whereHelperint{field: "\"warehouse_stocks\".\"product_id\""},
+	Quantity:    whereHelperint{field: "\"warehouse_stocks\".\"quantity\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"warehouse_stocks\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"warehouse_stocks\".\"updated_at\""},
+}
+
+// WarehouseStockRels is where relationship names are stored.
+var WarehouseStockRels = struct {
+	Product   string
+	Warehouse string
+}{
+	Product:   "Product",
+	Warehouse: "Warehouse",
+}
+
+// warehouseStockR is where relationships are stored.
+type warehouseStockR struct {
+	Product   *Product   `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+	Warehouse *Warehouse `boil:"Warehouse" json:"Warehouse" toml:"Warehouse" yaml:"Warehouse"`
+}
+
+// NewStruct creates a new relationship struct
+func (*warehouseStockR) NewStruct() *warehouseStockR {
+	return &warehouseStockR{}
+}
+
+func (r *warehouseStockR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+func (r *warehouseStockR) GetWarehouse() *Warehouse {
+	if r == nil {
+		return nil
+	}
+	return r.Warehouse
+}
+
+// warehouseStockL is where Load methods for each relationship are stored.
+type warehouseStockL struct{}
+
+var (
+	warehouseStockAllColumns            = []string{"id", "warehouse_id", "product_id", "quantity", "created_at", "updated_at"}
+	warehouseStockColumnsWithoutDefault = []string{"warehouse_id", "product_id"}
+	warehouseStockColumnsWithDefault    = []string{"id", "quantity", "created_at", "updated_at"}
+	warehouseStockPrimaryKeyColumns     = []string{"id"}
+	warehouseStockGeneratedColumns      = []string{}
+)
+
+type (
+	// WarehouseStockSlice is an alias for a slice of pointers to WarehouseStock.
+	// This should almost always be used instead of []WarehouseStock.
+	WarehouseStockSlice []*WarehouseStock
+	// WarehouseStockHook is the signature for custom WarehouseStock hook methods
+	WarehouseStockHook func(context.Context, boil.ContextExecutor, *WarehouseStock) error
+
+	warehouseStockQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	warehouseStockType                 = reflect.TypeOf(&WarehouseStock{})
+	warehouseStockMapping              = queries.MakeStructMapping(warehouseStockType)
+	warehouseStockPrimaryKeyMapping, _ = queries.BindMapping(warehouseStockType, warehouseStockMapping, warehouseStockPrimaryKeyColumns)
+	warehouseStockInsertCacheMut       sync.RWMutex
+	warehouseStockInsertCache          = make(map[string]insertCache)
+	warehouseStockUpdateCacheMut       sync.RWMutex
+	warehouseStockUpdateCache          = make(map[string]updateCache)
+	warehouseStockUpsertCacheMut       sync.RWMutex
+	warehouseStockUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var warehouseStockAfterSelectHooks []WarehouseStockHook
+
+var warehouseStockBeforeInsertHooks []WarehouseStockHook
+var warehouseStockAfterInsertHooks []WarehouseStockHook
+
+var warehouseStockBeforeUpdateHooks []WarehouseStockHook
+var warehouseStockAfterUpdateHooks []WarehouseStockHook
+
+var warehouseStockBeforeDeleteHooks []WarehouseStockHook
+var warehouseStockAfterDeleteHooks []WarehouseStockHook
+
+var warehouseStockBeforeUpsertHooks []WarehouseStockHook
+var warehouseStockAfterUpsertHooks []WarehouseStockHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WarehouseStock) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WarehouseStock) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WarehouseStock) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WarehouseStock) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WarehouseStock) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WarehouseStock) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WarehouseStock) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WarehouseStock) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WarehouseStock) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range warehouseStockAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWarehouseStockHook registers your hook function for all future operations.
+func AddWarehouseStockHook(hookPoint boil.HookPoint, warehouseStockHook WarehouseStockHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		warehouseStockAfterSelectHooks = append(warehouseStockAfterSelectHooks, warehouseStockHook)
+	case boil.BeforeInsertHook:
+		warehouseStockBeforeInsertHooks = append(warehouseStockBeforeInsertHooks, warehouseStockHook)
+	case boil.AfterInsertHook:
+		warehouseStockAfterInsertHooks = append(warehouseStockAfterInsertHooks, warehouseStockHook)
+	case boil.BeforeUpdateHook:
+		warehouseStockBeforeUpdateHooks = append(warehouseStockBeforeUpdateHooks, warehouseStockHook)
+	case boil.AfterUpdateHook:
+		warehouseStockAfterUpdateHooks = append(warehouseStockAfterUpdateHooks, warehouseStockHook)
+	case boil.BeforeDeleteHook:
+		warehouseStockBeforeDeleteHooks = append(warehouseStockBeforeDeleteHooks, warehouseStockHook)
+	case boil.AfterDeleteHook:
+		warehouseStockAfterDeleteHooks = append(warehouseStockAfterDeleteHooks, warehouseStockHook)
+	case boil.BeforeUpsertHook:
+		warehouseStockBeforeUpsertHooks = append(warehouseStockBeforeUpsertHooks, warehouseStockHook)
+	case boil.AfterUpsertHook:
+		warehouseStockAfterUpsertHooks = append(warehouseStockAfterUpsertHooks, warehouseStockHook)
+	}
+}
+
+// One returns a single warehouseStock record from the query.
+func (q warehouseStockQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WarehouseStock, error) {
+	o := &WarehouseStock{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for warehouse_stocks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WarehouseStock records from the query.
+func (q warehouseStockQuery) All(ctx context.Context, exec boil.ContextExecutor) (WarehouseStockSlice, error) {
+	var o []*WarehouseStock
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WarehouseStock slice")
+	}
+
+	if len(warehouseStockAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WarehouseStock records in the query.
+func (q warehouseStockQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count warehouse_stocks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q warehouseStockQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if warehouse_stocks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Product pointed to by the foreign key.
+func (o *WarehouseStock) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// Warehouse pointed to by the foreign key.
+func (o *WarehouseStock) Warehouse(mods ...qm.QueryMod) warehouseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WarehouseID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Warehouses(queryMods...)
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (warehouseStockL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWarehouseStock interface{}, mods queries.Applicator) error {
+	var slice []*WarehouseStock
+	var object *WarehouseStock
+
+	if singular {
+		var ok bool
+		object, ok = maybeWarehouseStock.(*WarehouseStock)
+		if !ok {
+			object = new(WarehouseStock)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWarehouseStock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWarehouseStock))
+			}
+		}
+	} else {
+		s, ok := maybeWarehouseStock.(*[]*WarehouseStock)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWarehouseStock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWarehouseStock))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &warehouseStockR{}
+		}
+		args = append(args, object.ProductID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &warehouseStockR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ProductID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ProductID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(warehouseStockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.WarehouseStocks = append(foreign.R.WarehouseStocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.WarehouseStocks = append(foreign.R.WarehouseStocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWarehouse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (warehouseStockL) LoadWarehouse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWarehouseStock interface{}, mods queries.Applicator) error {
+	var slice []*WarehouseStock
+	var object *WarehouseStock
+
+	if singular {
+		var ok bool
+		object, ok = maybeWarehouseStock.(*WarehouseStock)
+		if !ok {
+			object = new(WarehouseStock)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWarehouseStock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWarehouseStock))
+			}
+		}
+	} else {
+		s, ok := maybeWarehouseStock.(*[]*WarehouseStock)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWarehouseStock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWarehouseStock))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &warehouseStockR{}
+		}
+		args = append(args, object.WarehouseID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &warehouseStockR{}
+			}
+
+			for _, a := range args {
+				if a == obj.WarehouseID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.WarehouseID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`warehouses`),
+		qm.WhereIn(`warehouses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Warehouse")
+	}
+
+	var resultSlice []*Warehouse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Warehouse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for warehouses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for warehouses")
+	}
+
+	if len(warehouseStockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Warehouse = foreign
+		if foreign.R == nil {
+			foreign.R = &warehouseR{}
+		}
+		foreign.R.WarehouseStocks = append(foreign.R.WarehouseStocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WarehouseID == foreign.ID {
+				local.R.Warehouse = foreign
+				if foreign.R == nil {
+					foreign.R = &warehouseR{}
+				}
+				foreign.R.WarehouseStocks = append(foreign.R.WarehouseStocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProduct of the warehouseStock to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.WarehouseStocks.
+func (o *WarehouseStock) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"warehouse_stocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, warehouseStockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &warehouseStockR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			WarehouseStocks: WarehouseStockSlice{o},
+		}
+	} else {
+		related.R.WarehouseStocks = append(related.R.WarehouseStocks, o)
+	}
+
+	return nil
+}
+
+// SetWarehouse of the warehouseStock to the related item.
+// Sets o.R.Warehouse to related.
+// Adds o to related.R.WarehouseStocks.
+func (o *WarehouseStock) SetWarehouse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Warehouse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"warehouse_stocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"warehouse_id"}),
+		strmangle.WhereClause("\"", "\"", 2, warehouseStockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WarehouseID = related.ID
+	if o.R == nil {
+		o.R = &warehouseStockR{
+			Warehouse: related,
+		}
+	} else {
+		o.R.Warehouse = related
+	}
+
+	if related.R == nil {
+		related.R = &warehouseR{
+			WarehouseStocks: WarehouseStockSlice{o},
+		}
+	} else {
+		related.R.WarehouseStocks = append(related.R.WarehouseStocks, o)
+	}
+
+	return nil
+}
+
+// WarehouseStocks retrieves all the records using an executor.
+func WarehouseStocks(mods ...qm.QueryMod) warehouseStockQuery {
+	mods = append(mods, qm.From("\"warehouse_stocks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"warehouse_stocks\".*"})
+	}
+
+	return warehouseStockQuery{q}
+}
+
+// FindWarehouseStock retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWarehouseStock(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*WarehouseStock, error) {
+	warehouseStockObj := &WarehouseStock{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"warehouse_stocks\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, warehouseStockObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from warehouse_stocks")
+	}
+
+	if err = warehouseStockObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return warehouseStockObj, err
+	}
+
+	return warehouseStockObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WarehouseStock) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no warehouse_stocks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(warehouseStockColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	warehouseStockInsertCacheMut.RLock()
+	cache, cached := warehouseStockInsertCache[key]
+	warehouseStockInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			warehouseStockAllColumns,
+			warehouseStockColumnsWithDefault,
+			warehouseStockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(warehouseStockType, warehouseStockMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(warehouseStockType, warehouseStockMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"warehouse_stocks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"warehouse_stocks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into warehouse_stocks")
+	}
+
+	if !cached {
+		warehouseStockInsertCacheMut.Lock()
+		warehouseStockInsertCache[key] = cache
+		warehouseStockInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WarehouseStock.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WarehouseStock) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	warehouseStockUpdateCacheMut.RLock()
+	cache, cached := warehouseStockUpdateCache[key]
+	warehouseStockUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			warehouseStockAllColumns,
+			warehouseStockPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update warehouse_stocks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"warehouse_stocks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, warehouseStockPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(warehouseStockType, warehouseStockMapping, append(wl, warehouseStockPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update warehouse_stocks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for warehouse_stocks")
+	}
+
+	if !cached {
+		warehouseStockUpdateCacheMut.Lock()
+		warehouseStockUpdateCache[key] = cache
+		warehouseStockUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q warehouseStockQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for warehouse_stocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for warehouse_stocks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WarehouseStockSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), warehouseStockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"warehouse_stocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, warehouseStockPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in warehouseStock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all warehouseStock")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WarehouseStock) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no warehouse_stocks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(warehouseStockColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	warehouseStockUpsertCacheMut.RLock()
+	cache, cached := warehouseStockUpsertCache[key]
+	warehouseStockUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			warehouseStockAllColumns,
+			warehouseStockColumnsWithDefault,
+			warehouseStockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			warehouseStockAllColumns,
+			warehouseStockPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert warehouse_stocks, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(warehouseStockPrimaryKeyColumns))
+			copy(conflict, warehouseStockPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"warehouse_stocks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(warehouseStockType, warehouseStockMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(warehouseStockType, warehouseStockMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert warehouse_stocks")
+	}
+
+	if !cached {
+		warehouseStockUpsertCacheMut.Lock()
+		warehouseStockUpsertCache[key] = cache
+		warehouseStockUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WarehouseStock record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WarehouseStock) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WarehouseStock provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), warehouseStockPrimaryKeyMapping)
+	sql := "DELETE FROM \"warehouse_stocks\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from warehouse_stocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for warehouse_stocks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q warehouseStockQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no warehouseStockQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from warehouse_stocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for warehouse_stocks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WarehouseStockSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(warehouseStockBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), warehouseStockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"warehouse_stocks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, warehouseStockPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from warehouseStock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for warehouse_stocks")
+	}
+
+	if len(warehouseStockAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WarehouseStock) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWarehouseStock(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WarehouseStockSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WarehouseStockSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), warehouseStockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"warehouse_stocks\".* FROM \"warehouse_stocks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, warehouseStockPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WarehouseStockSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WarehouseStockExists checks if the WarehouseStock row exists.
+func WarehouseStockExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"warehouse_stocks\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if warehouse_stocks exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Address struct {
+	UserID        int
+	RecipientName string
+	Phone         string
+	Street        string
+	City          string
+	Region        string
+	PostalCode    string
+	Country       string
+	IsDefault     bool
+}
+
+// CreateAddress creates an address in the address book of a user.
+// If the address is the default one, the previous default address of the user is unset
+func (r *Repository) CreateAddress(ctx context.Context, aReq Address) (models.Address, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Address{}, err
+	}
+	defer tx.Rollback()
+
+	if aReq.IsDefault {
+		if _, err := models.Addresses(
+			qm.Where(fmt.Sprintf("%s = ?", models.AddressColumns.UserID), aReq.UserID),
+		).UpdateAll(ctx, tx, models.M{models.AddressColumns.IsDefault: false}); err != nil {
+			return models.Address{}, err
+		}
+	}
+
+	address := models.Address{
+		UserID:        aReq.UserID,
+		RecipientName: aReq.RecipientName,
+		Phone:         aReq.Phone,
+		Street:        aReq.Street,
+		City:          aReq.City,
+		Region:        aReq.Region,
+		PostalCode:    aReq.PostalCode,
+		Country:       aReq.Country,
+		IsDefault:     aReq.IsDefault,
+	}
+	if err := address.Insert(ctx, tx, boil.Infer()); err != nil {
+		return models.Address{}, pkgerrors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Address{}, err
+	}
+
+	return address, nil
+}
+
+// GetAddress retrieves an address in db by ID
+func (r *Repository) GetAddress(ctx context.Context, id int) (models.Address, error) {
+	address, err := models.FindAddress(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Address{}, ErrAddressNotFound
+		}
+		return models.Address{}, err
+	}
+
+	return *address, nil
+}
+
+// GetAddresses retrieves the address book of a user, the default address comes first
+func (r *Repository) GetAddresses(ctx context.Context, userID int) ([]models.Address, error) {
+	addresses, err := models.Addresses(
+		qm.Where(fmt.Sprintf("%s = ?", models.AddressColumns.UserID), userID),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s", models.AddressColumns.IsDefault, models.AddressColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Address
+	for _, a := range addresses {
+		result = append(result, *a)
+	}
+
+	return result, nil
+}
+
+// DeleteAddress deletes an address in db by ID
+func (r *Repository) DeleteAddress(ctx context.Context, id int) error {
+	address, err := r.GetAddress(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := address.Delete(ctx, boil.GetContextDB()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// analyticsCacheTTL is how long the results of the analytics queries are cached
+const analyticsCacheTTL = 5 * time.Minute
+
+// orderRateToVNDQuery is the SQL expression of the rate converting the prices of an order to VND.
+// The rate snapshotted on the order is used, or else the rate in effect when the order was created
+const orderRateToVNDQuery = `CASE WHEN orders.currency = 'VND' THEN 1 ELSE COALESCE(orders.exchange_rate, (` +
+	`SELECT exchange_rates.rate FROM exchange_rates WHERE exchange_rates.currency = orders.currency ` +
+	`AND exchange_rates.effective_from <= orders.created_at ORDER BY exchange_rates.effective_from DESC LIMIT 1)) END`
+
+type AnalyticsFilter struct {
+	// StartDate and EndDate are the first and last days of the range, both included
+	StartDate time.Time
+	EndDate   time.Time
+	// Statuses are the statuses of the orders counted as sales
+	Statuses []string
+}
+
+// cacheKey returns the part of the cache key identifying the filter
+func (f AnalyticsFilter) cacheKey() string {
+	return fmt.Sprintf("%s:%s:%s", f.StartDate.Format("2006-01-02"), f.EndDate.Format("2006-01-02"), strings.Join(f.Statuses, ","))
+}
+
+// analyticsQueryMods returns the where clauses of the date range and statuses of the orders
+func analyticsQueryMods(filter AnalyticsFilter) []qm.QueryMod {
+	orderTable := models.TableNames.Orders
+
+	queryMod := []qm.QueryMod{
+		qm.Where(fmt.Sprintf("%s.%s >= ?", orderTable, models.OrderColumns.CreatedAt), filter.StartDate),
+		// the end date is included
+		qm.Where(fmt.Sprintf("%s.%s < ?", orderTable, models.OrderColumns.CreatedAt), filter.EndDate.AddDate(0, 0, 1)),
+	}
+
+	if len(filter.Statuses) != 0 {
+		statuses := make([]interface{}, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, s)
+		}
+		queryMod = append(queryMod, qm.WhereIn(fmt.Sprintf("%s.%s IN ?", orderTable, models.OrderColumns.Status), statuses...))
+	}
+
+	return queryMod
+}
+
+type RevenuePeriod struct {
+	Period     time.Time       `boil:"period" json:"period"`
+	Revenue    decimal.Decimal `boil:"revenue" json:"revenue"`
+	OrderCount int             `boil:"order_count" json:"order_count"`
+}
+
+// GetRevenueByPeriod retrieves the revenue in VND and the number of orders of each day, week or month of the range.
+// The periods without orders are left out
+func (r *Repository) GetRevenueByPeriod(ctx context.Context, filter AnalyticsFilter, period string) ([]RevenuePeriod, error) {
+	cacheKey := fmt.Sprintf("analytics:revenue:%s:%s", period, filter.cacheKey())
+
+	var revenue []RevenuePeriod
+	if found, err := r.getCachedJSON(ctx, cacheKey, &revenue); err != nil || found {
+		return revenue, err
+	}
+
+	orderTable := models.TableNames.Orders
+	// the period is one of day, week or month which are validated in the controller layer
+	periodQuery := fmt.Sprintf("date_trunc('%s', %s.%s)", period, orderTable, models.OrderColumns.CreatedAt)
+
+	queryMod := []qm.QueryMod{
+		qm.Select(
+			fmt.Sprintf(`%s as "period"`, periodQuery),
+			fmt.Sprintf(`COALESCE(ROUND(SUM(%s.%s * %s), 2), 0) as "revenue"`, orderTable, models.OrderColumns.TotalPrice, orderRateToVNDQuery),
+			fmt.Sprintf(`COUNT(%s.%s) as "order_count"`, orderTable, models.OrderColumns.ID),
+		),
+		qm.GroupBy(periodQuery),
+		qm.OrderBy(periodQuery),
+	}
+	queryMod = append(queryMod, analyticsQueryMods(filter)...)
+
+	if err := models.Orders(queryMod...).Bind(ctx, boil.GetContextDB(), &revenue); err != nil {
+		return nil, err
+	}
+
+	if err := r.setCachedJSON(ctx, cacheKey, revenue, analyticsCacheTTL); err != nil {
+		return nil, err
+	}
+
+	return revenue, nil
+}
+
+type TopSeller struct {
+	ID      int             `boil:"id" json:"id"`
+	Name    string          `boil:"name" json:"name"`
+	Units   int             `boil:"units" json:"units"`
+	Revenue decimal.Decimal `boil:"revenue" json:"revenue"`
+}
+
+// GetTopProducts retrieves the products with the highest revenue in VND or the most units sold in the range.
+// The revenue of a product is the price of its items excluding tax and shipping
+func (r *Repository) GetTopProducts(ctx context.Context, filter AnalyticsFilter, sortBy string, limit int) ([]TopSeller, error) {
+	productTable := models.TableNames.Products
+	return r.getTopSellers(ctx, "products", filter, sortBy, limit,
+		fmt.Sprintf("%s.%s", productTable, models.ProductColumns.ID),
+		fmt.Sprintf("%s.%s", productTable, models.ProductColumns.Name),
+	)
+}
+
+// GetTopCategories retrieves the product categories with the highest revenue in VND or the most units sold in the range.
+// The revenue of a category is the price of the items of its products excluding tax and shipping
+func (r *Repository) GetTopCategories(ctx context.Context, filter AnalyticsFilter, sortBy string, limit int) ([]TopSeller, error) {
+	productCategoryTable := models.TableNames.ProductCategories
+	return r.getTopSellers(ctx, "categories", filter, sortBy, limit,
+		fmt.Sprintf("%s.%s", productCategoryTable, models.ProductCategoryColumns.ID),
+		fmt.Sprintf("%s.%s", productCategoryTable, models.ProductCategoryColumns.Name),
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", productCategoryTable, models.TableNames.Products, models.ProductColumns.CategoryID, productCategoryTable, models.ProductCategoryColumns.ID)),
+	)
+}
+
+// getTopSellers aggregates the order items of the range by the id and name columns, sorted by revenue or units.
+// The joins and where clauses are added to the join of order items, orders and products
+func (r *Repository) getTopSellers(ctx context.Context, kind string, filter AnalyticsFilter, sortBy string, limit int, idColumn, nameColumn string, joins ...qm.QueryMod) ([]TopSeller, error) {
+	cacheKey := fmt.Sprintf("analytics:top-%s:%s:%d:%s", kind, sortBy, limit, filter.cacheKey())
+
+	var topSellers []TopSeller
+	if found, err := r.getCachedJSON(ctx, cacheKey, &topSellers); err != nil || found {
+		return topSellers, err
+	}
+
+	orderTable := models.TableNames.Orders
+	orderItemTable := models.TableNames.OrderItems
+	productTable := models.TableNames.Products
+
+	// the sort is either revenue or units which are validated in the controller layer
+	queryMod := []qm.QueryMod{
+		qm.Select(
+			fmt.Sprintf(`%s as "id"`, idColumn),
+			fmt.Sprintf(`%s as "name"`, nameColumn),
+			fmt.Sprintf(`SUM(%s.%s) as "units"`, orderItemTable, models.OrderItemColumns.Quantity),
+			fmt.Sprintf(`COALESCE(ROUND(SUM(%s.%s * %s.%s * %s), 2), 0) as "revenue"`, orderItemTable, models.OrderItemColumns.Price, orderItemTable, models.OrderItemColumns.Quantity, orderRateToVNDQuery),
+		),
+		// INNER JOIN order_items and orders
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", orderTable, orderItemTable, models.OrderItemColumns.OrderID, orderTable, models.OrderColumns.ID)),
+		// INNER JOIN order_items and products
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", productTable, orderItemTable, models.OrderItemColumns.ProductID, productTable, models.ProductColumns.ID)),
+	}
+	queryMod = append(queryMod, joins...)
+	queryMod = append(queryMod, analyticsQueryMods(filter)...)
+	queryMod = append(queryMod,
+		qm.GroupBy(fmt.Sprintf("%s, %s", idColumn, nameColumn)),
+		qm.OrderBy(fmt.Sprintf(`"%s" DESC, %s`, sortBy, idColumn)),
+		qm.Limit(limit),
+	)
+
+	if err := models.OrderItems(queryMod...).Bind(ctx, boil.GetContextDB(), &topSellers); err != nil {
+		return nil, err
+	}
+
+	if err := r.setCachedJSON(ctx, cacheKey, topSellers, analyticsCacheTTL); err != nil {
+		return nil, err
+	}
+
+	return topSellers, nil
+}
+
+type OrderValueSummary struct {
+	OrderCount        int             `boil:"order_count" json:"order_count"`
+	Revenue           decimal.Decimal `boil:"revenue" json:"revenue"`
+	AverageOrderValue decimal.Decimal `boil:"average_order_value" json:"average_order_value"`
+}
+
+// GetOrderValueSummary retrieves the number of orders, the revenue and the average order value in VND of the range
+func (r *Repository) GetOrderValueSummary(ctx context.Context, filter AnalyticsFilter) (OrderValueSummary, error) {
+	cacheKey := fmt.Sprintf("analytics:order-value:%s", filter.cacheKey())
+
+	var summary OrderValueSummary
+	if found, err := r.getCachedJSON(ctx, cacheKey, &summary); err != nil || found {
+		return summary, err
+	}
+
+	orderTable := models.TableNames.Orders
+	orderValueQuery := fmt.Sprintf("%s.%s * %s", orderTable, models.OrderColumns.TotalPrice, orderRateToVNDQuery)
+
+	queryMod := []qm.QueryMod{
+		qm.Select(
+			fmt.Sprintf(`COUNT(%s.%s) as "order_count"`, orderTable, models.OrderColumns.ID),
+			fmt.Sprintf(`COALESCE(ROUND(SUM(%s), 2), 0) as "revenue"`, orderValueQuery),
+			fmt.Sprintf(`COALESCE(ROUND(AVG(%s), 2), 0) as "average_order_value"`, orderValueQuery),
+		),
+	}
+	queryMod = append(queryMod, analyticsQueryMods(filter)...)
+
+	if err := models.Orders(queryMod...).Bind(ctx, boil.GetContextDB(), &summary); err != nil {
+		return OrderValueSummary{}, err
+	}
+
+	if err := r.setCachedJSON(ctx, cacheKey, summary, analyticsCacheTTL); err != nil {
+		return OrderValueSummary{}, err
+	}
+
+	return summary, nil
+}
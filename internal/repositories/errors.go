@@ -3,10 +3,24 @@ package repositories
 import "errors"
 
 var (
-	ErrProductNotFound         = errors.New("product not found")
-	ErrUserNotFound            = errors.New("user not found")
-	ErrProductCategoryNotFound = errors.New("product category not found")
-	ErrOrderNotFound           = errors.New("order not found")
-	ErrOrderItemNotFound       = errors.New("order item not found")
-	ErrNilCache                = errors.New("cache is nil")
+	ErrProductNotFound             = errors.New("product not found")
+	ErrUserNotFound                = errors.New("user not found")
+	ErrProductCategoryNotFound     = errors.New("product category not found")
+	ErrOrderNotFound               = errors.New("order not found")
+	ErrOrderItemNotFound           = errors.New("order item not found")
+	ErrNilCache                    = errors.New("cache is nil")
+	ErrTaxRuleNotFound             = errors.New("tax rule not found")
+	ErrAddressNotFound             = errors.New("address not found")
+	ErrShippingMethodNotFound      = errors.New("shipping method not found")
+	ErrShipmentNotFound            = errors.New("shipment not found")
+	ErrExchangeRateNotFound        = errors.New("exchange rate not found")
+	ErrReturnRequestNotFound       = errors.New("return request not found")
+	ErrVariantNotFound             = errors.New("variant not found")
+	ErrProductImageNotFound        = errors.New("product image not found")
+	ErrProductPriceNotFound        = errors.New("product price not found")
+	ErrInsufficientStock           = errors.New("insufficient stock")
+	ErrWarehouseNotFound           = errors.New("warehouse not found")
+	ErrProductReviewNotFound       = errors.New("product review not found")
+	ErrProductSlugRedirectNotFound = errors.New("product slug redirect not found")
+	ErrWishlistItemNotFound        = errors.New("wishlist item not found")
 )
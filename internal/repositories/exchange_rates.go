@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ExchangeRate struct {
+	Currency      string
+	Rate          decimal.Decimal
+	EffectiveFrom time.Time
+}
+
+// CreateExchangeRate creates an exchange rate in db given by exchange rate model in parameter
+func (r *Repository) CreateExchangeRate(ctx context.Context, erReq ExchangeRate) error {
+	exchangeRate := models.ExchangeRate{
+		Currency:      erReq.Currency,
+		Rate:          erReq.Rate,
+		EffectiveFrom: erReq.EffectiveFrom,
+	}
+	if err := exchangeRate.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return nil
+}
+
+// UpsertExchangeRates inserts list of exchange rates in a transaction.
+// If a rate of the same currency and effective time already exists, only the rate is updated
+func (r *Repository) UpsertExchangeRates(ctx context.Context, erReqs []ExchangeRate) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, er := range erReqs {
+		exchangeRate := models.ExchangeRate{
+			Currency:      er.Currency,
+			Rate:          er.Rate,
+			EffectiveFrom: er.EffectiveFrom,
+		}
+		if err := exchangeRate.Upsert(ctx, tx, true,
+			[]string{models.ExchangeRateColumns.Currency, models.ExchangeRateColumns.EffectiveFrom},
+			boil.Whitelist(models.ExchangeRateColumns.Rate, models.ExchangeRateColumns.UpdatedAt),
+			boil.Infer(),
+		); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetExchangeRates retrieves all the exchange rates in db, filtered by currency if given
+func (r *Repository) GetExchangeRates(ctx context.Context, currency string) ([]models.ExchangeRate, error) {
+	var queryMod []qm.QueryMod
+
+	if currency != "" {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s = ?", models.ExchangeRateColumns.Currency), currency))
+	}
+
+	queryMod = append(queryMod, qm.OrderBy(fmt.Sprintf("%s, %s DESC", models.ExchangeRateColumns.Currency, models.ExchangeRateColumns.EffectiveFrom)))
+
+	exchangeRates, err := models.ExchangeRates(queryMod...).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ExchangeRate
+	for _, er := range exchangeRates {
+		result = append(result, *er)
+	}
+
+	return result, nil
+}
+
+// GetEffectiveExchangeRate retrieves the latest exchange rate of a currency which is in effect at the given time
+func (r *Repository) GetEffectiveExchangeRate(ctx context.Context, currency string, at time.Time) (models.ExchangeRate, error) {
+	exchangeRate, err := models.ExchangeRates(
+		qm.Where(fmt.Sprintf("%s = ?", models.ExchangeRateColumns.Currency), currency),
+		qm.Where(fmt.Sprintf("%s <= ?", models.ExchangeRateColumns.EffectiveFrom), at),
+		qm.OrderBy(fmt.Sprintf("%s DESC", models.ExchangeRateColumns.EffectiveFrom)),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ExchangeRate{}, ErrExchangeRateNotFound
+		}
+		return models.ExchangeRate{}, err
+	}
+
+	return *exchangeRate, nil
+}
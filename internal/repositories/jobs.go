@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// jobRunsKey is the redis hash holding the last run of each background job, keyed by job name
+const jobRunsKey = "jobs:last-run"
+
+// releaseLockScript deletes the lock only if it is still held by the given token,
+// so that an instance never releases a lock which has expired and been taken by another one
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// AcquireLock takes the lock of the given name for the ttl, it returns the token of the lock
+// or an empty token if the lock is held by someone else
+func (r *Repository) AcquireLock(ctx context.Context, name string, ttl time.Duration) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+
+	ok, err := r.Redis.SetNX(ctx, fmt.Sprintf("lock:%s", name), token, ttl).Result()
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", nil
+	}
+
+	return token, nil
+}
+
+// ReleaseLock releases the lock of the given name if it is still held by the token
+func (r *Repository) ReleaseLock(ctx context.Context, name, token string) error {
+	return releaseLockScript.Run(ctx, r.Redis, []string{fmt.Sprintf("lock:%s", name)}, token).Err()
+}
+
+type JobRun struct {
+	Name       string    `json:"name"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Success    bool      `json:"success"`
+	Result     string    `json:"result"`
+	Error      string    `json:"error"`
+}
+
+// SaveJobRun stores the run of a background job as its last run
+func (r *Repository) SaveJobRun(ctx context.Context, jobRun JobRun) error {
+	data, err := json.Marshal(jobRun)
+	if err != nil {
+		return err
+	}
+
+	return r.Redis.HSet(ctx, jobRunsKey, jobRun.Name, data).Err()
+}
+
+// GetJobRuns retrieves the last run of each background job, sorted by job name
+func (r *Repository) GetJobRuns(ctx context.Context) ([]JobRun, error) {
+	res, err := r.Redis.HGetAll(ctx, jobRunsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	jobRuns := make([]JobRun, 0, len(res))
+	for _, data := range res {
+		var jobRun JobRun
+		if err := json.Unmarshal([]byte(data), &jobRun); err != nil {
+			return nil, err
+		}
+		jobRuns = append(jobRuns, jobRun)
+	}
+
+	sort.Slice(jobRuns, func(i, j int) bool {
+		return jobRuns[i].Name < jobRuns[j].Name
+	})
+
+	return jobRuns, nil
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// syncLowStockAlert raises the low stock alert of a product if its quantity is below the threshold, the open alert
+// of the product is updated with the quantity instead of raising another one. The open alert is resolved once the quantity
+// is back to the threshold so that the next drop raises a new alert
+func syncLowStockAlert(ctx context.Context, ctxExec boil.ContextExecutor, productID, quantity, threshold int) error {
+	if quantity < threshold {
+		_, err := ctxExec.ExecContext(ctx, `
+			INSERT INTO low_stock_alerts (product_id, quantity, threshold) VALUES ($1, $2, $3)
+			ON CONFLICT (product_id) WHERE resolved_at IS NULL DO UPDATE SET quantity = EXCLUDED.quantity, threshold = EXCLUDED.threshold, updated_at = now()`,
+			productID, quantity, threshold)
+		return err
+	}
+
+	_, err := ctxExec.ExecContext(ctx,
+		`UPDATE low_stock_alerts SET resolved_at = now(), updated_at = now() WHERE product_id = $1 AND resolved_at IS NULL`,
+		productID)
+	return err
+}
+
+// GetLowStockProducts retrieves the active products whose quantity is below their reorder threshold,
+// the products furthest below their threshold first
+func (r *Repository) GetLowStockProducts(ctx context.Context) ([]models.Product, error) {
+	products, err := models.Products(
+		qm.Where(fmt.Sprintf("%s < %s", models.ProductColumns.Quantity, models.ProductColumns.ReorderThreshold)),
+		qm.Where(fmt.Sprintf("%s IS NULL", models.ProductColumns.DeletedAt)),
+		qm.OrderBy(fmt.Sprintf("%s - %s DESC, %s", models.ProductColumns.ReorderThreshold, models.ProductColumns.Quantity, models.ProductColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Product
+	for _, p := range products {
+		result = append(result, *p)
+	}
+
+	return result, nil
+}
+
+// GetUnnotifiedLowStockAlerts retrieves the open low stock alerts which have not been sent in a digest yet with their products
+func (r *Repository) GetUnnotifiedLowStockAlerts(ctx context.Context) ([]models.LowStockAlert, error) {
+	alerts, err := models.LowStockAlerts(
+		qm.Where(fmt.Sprintf("%s IS NULL", models.LowStockAlertColumns.ResolvedAt)),
+		qm.Where(fmt.Sprintf("%s IS NULL", models.LowStockAlertColumns.NotifiedAt)),
+		qm.Load(models.LowStockAlertRels.Product),
+		qm.OrderBy(models.LowStockAlertColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.LowStockAlert
+	for _, a := range alerts {
+		result = append(result, *a)
+	}
+
+	return result, nil
+}
+
+// MarkLowStockAlertsNotified sets the time the low stock alerts of the IDs have been sent in a digest
+func (r *Repository) MarkLowStockAlertsNotified(ctx context.Context, ids []int, at time.Time) error {
+	_, err := models.LowStockAlerts(
+		qm.Where(fmt.Sprintf("%s = ANY(?)", models.LowStockAlertColumns.ID), pq.Array(ids)),
+	).UpdateAll(ctx, boil.GetContextDB(), models.M{
+		models.LowStockAlertColumns.NotifiedAt: at,
+		models.LowStockAlertColumns.UpdatedAt:  at,
+	})
+	return err
+}
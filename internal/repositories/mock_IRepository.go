@@ -4,11 +4,12 @@ package repositories
 
 import (
 	context "context"
+	sql "database/sql"
+	time "time"
 
 	models "github.com/qthuy2k1/product-management/internal/models"
 	mock "github.com/stretchr/testify/mock"
-
-	sql "database/sql"
+	null "github.com/volatiletech/null/v8"
 )
 
 // MockIRepository is an autogenerated mock type for the IRepository type
@@ -16,25 +17,23 @@ type MockIRepository struct {
 	mock.Mock
 }
 
-// BeginTx provides a mock function with given fields: ctx
-func (_m *MockIRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
-	ret := _m.Called(ctx)
+// AcquireLock provides a mock function with given fields: ctx, name, ttl
+func (_m *MockIRepository) AcquireLock(ctx context.Context, name string, ttl time.Duration) (string, error) {
+	ret := _m.Called(ctx, name, ttl)
 
-	var r0 *sql.Tx
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*sql.Tx, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (string, error)); ok {
+		return rf(ctx, name, ttl)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *sql.Tx); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) string); ok {
+		r0 = rf(ctx, name, ttl)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sql.Tx)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, name, ttl)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,13 +41,13 @@ func (_m *MockIRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	return r0, r1
 }
 
-// CommitTx provides a mock function with given fields: tx
-func (_m *MockIRepository) CommitTx(tx *sql.Tx) error {
-	ret := _m.Called(tx)
+// AddProductRating provides a mock function with given fields: ctx, tx, productID, count, total
+func (_m *MockIRepository) AddProductRating(ctx context.Context, tx *sql.Tx, productID int, count int, total int) error {
+	ret := _m.Called(ctx, tx, productID, count, total)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*sql.Tx) error); ok {
-		r0 = rf(tx)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, int, int) error); ok {
+		r0 = rf(ctx, tx, productID, count, total)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -56,23 +55,23 @@ func (_m *MockIRepository) CommitTx(tx *sql.Tx) error {
 	return r0
 }
 
-// CreateOrder provides a mock function with given fields: ctx, tx, oReq
-func (_m *MockIRepository) CreateOrder(ctx context.Context, tx *sql.Tx, oReq Order) (models.Order, error) {
-	ret := _m.Called(ctx, tx, oReq)
+// AddWishlistItem provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIRepository) AddWishlistItem(ctx context.Context, userID int, productID int) (models.WishlistItem, error) {
+	ret := _m.Called(ctx, userID, productID)
 
-	var r0 models.Order
+	var r0 models.WishlistItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, Order) (models.Order, error)); ok {
-		return rf(ctx, tx, oReq)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (models.WishlistItem, error)); ok {
+		return rf(ctx, userID, productID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, Order) models.Order); ok {
-		r0 = rf(ctx, tx, oReq)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) models.WishlistItem); ok {
+		r0 = rf(ctx, userID, productID)
 	} else {
-		r0 = ret.Get(0).(models.Order)
+		r0 = ret.Get(0).(models.WishlistItem)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, Order) error); ok {
-		r1 = rf(ctx, tx, oReq)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, productID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -80,13 +79,13 @@ func (_m *MockIRepository) CreateOrder(ctx context.Context, tx *sql.Tx, oReq Ord
 	return r0, r1
 }
 
-// CreateOrderItem provides a mock function with given fields: ctx, tx, oiReq, order
-func (_m *MockIRepository) CreateOrderItem(ctx context.Context, tx *sql.Tx, oiReq []OrderItem, order models.Order) error {
-	ret := _m.Called(ctx, tx, oiReq, order)
+// ApplyProductPrice provides a mock function with given fields: ctx, tx, productPrice
+func (_m *MockIRepository) ApplyProductPrice(ctx context.Context, tx *sql.Tx, productPrice models.ProductPrice) error {
+	ret := _m.Called(ctx, tx, productPrice)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, []OrderItem, models.Order) error); ok {
-		r0 = rf(ctx, tx, oiReq, order)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.ProductPrice) error); ok {
+		r0 = rf(ctx, tx, productPrice)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -94,13 +93,13 @@ func (_m *MockIRepository) CreateOrderItem(ctx context.Context, tx *sql.Tx, oiRe
 	return r0
 }
 
-// CreateProduct provides a mock function with given fields: ctx, productRequest
-func (_m *MockIRepository) CreateProduct(ctx context.Context, productRequest Product) error {
-	ret := _m.Called(ctx, productRequest)
+// ArchiveProduct provides a mock function with given fields: ctx, tx, id
+func (_m *MockIRepository) ArchiveProduct(ctx context.Context, tx *sql.Tx, id int) error {
+	ret := _m.Called(ctx, tx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, Product) error); ok {
-		r0 = rf(ctx, productRequest)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) error); ok {
+		r0 = rf(ctx, tx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,27 +107,39 @@ func (_m *MockIRepository) CreateProduct(ctx context.Context, productRequest Pro
 	return r0
 }
 
-// CreateProductCategory provides a mock function with given fields: ctx, productCategory
-func (_m *MockIRepository) CreateProductCategory(ctx context.Context, productCategory ProductCategory) error {
-	ret := _m.Called(ctx, productCategory)
+// BeginTx provides a mock function with given fields: ctx
+func (_m *MockIRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductCategory) error); ok {
-		r0 = rf(ctx, productCategory)
+	var r0 *sql.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*sql.Tx, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *sql.Tx); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.Tx)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// CreateUser provides a mock function with given fields: ctx, user
-func (_m *MockIRepository) CreateUser(ctx context.Context, user User) error {
-	ret := _m.Called(ctx, user)
+// ClearWishlistItemsRestocked provides a mock function with given fields: ctx, ids
+func (_m *MockIRepository) ClearWishlistItemsRestocked(ctx context.Context, ids []int) error {
+	ret := _m.Called(ctx, ids)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, User) error); ok {
-		r0 = rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) error); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -136,13 +147,13 @@ func (_m *MockIRepository) CreateUser(ctx context.Context, user User) error {
 	return r0
 }
 
-// DeleteProduct provides a mock function with given fields: ctx, id
-func (_m *MockIRepository) DeleteProduct(ctx context.Context, id int) error {
-	ret := _m.Called(ctx, id)
+// CommitTx provides a mock function with given fields: tx
+func (_m *MockIRepository) CommitTx(tx *sql.Tx) error {
+	ret := _m.Called(tx)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(*sql.Tx) error); ok {
+		r0 = rf(tx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -150,23 +161,23 @@ func (_m *MockIRepository) DeleteProduct(ctx context.Context, id int) error {
 	return r0
 }
 
-// GetOrder provides a mock function with given fields: ctx, orderID
-func (_m *MockIRepository) GetOrder(ctx context.Context, orderID int) (models.Order, error) {
-	ret := _m.Called(ctx, orderID)
+// CountProductReviews provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) CountProductReviews(ctx context.Context, filter ProductReviewFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
 
-	var r0 models.Order
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Order, error)); ok {
-		return rf(ctx, orderID)
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewFilter) (int64, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) models.Order); ok {
-		r0 = rf(ctx, orderID)
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewFilter) int64); ok {
+		r0 = rf(ctx, filter)
 	} else {
-		r0 = ret.Get(0).(models.Order)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, orderID)
+	if rf, ok := ret.Get(1).(func(context.Context, ProductReviewFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,23 +185,23 @@ func (_m *MockIRepository) GetOrder(ctx context.Context, orderID int) (models.Or
 	return r0, r1
 }
 
-// GetOrderItem provides a mock function with given fields: ctx, id
-func (_m *MockIRepository) GetOrderItem(ctx context.Context, id int) (models.OrderItem, error) {
-	ret := _m.Called(ctx, id)
+// CountProductVariants provides a mock function with given fields: ctx, productID
+func (_m *MockIRepository) CountProductVariants(ctx context.Context, productID int) (int64, error) {
+	ret := _m.Called(ctx, productID)
 
-	var r0 models.OrderItem
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (models.OrderItem, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, productID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) models.OrderItem); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, productID)
 	} else {
-		r0 = ret.Get(0).(models.OrderItem)
+		r0 = ret.Get(0).(int64)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, productID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -198,56 +209,47 @@ func (_m *MockIRepository) GetOrderItem(ctx context.Context, id int) (models.Ord
 	return r0, r1
 }
 
-// GetOrders provides a mock function with given fields: ctx, filter
-func (_m *MockIRepository) GetOrders(ctx context.Context, filter OrderFilterRepo) ([]OrderOutputGraph, int64, error) {
+// CountProducts provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) CountProducts(ctx context.Context, filter ProductRepoFilter) (int64, error) {
 	ret := _m.Called(ctx, filter)
 
-	var r0 []OrderOutputGraph
-	var r1 int64
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterRepo) ([]OrderOutputGraph, int64, error)); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) (int64, error)); ok {
 		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterRepo) []OrderOutputGraph); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) int64); ok {
 		r0 = rf(ctx, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]OrderOutputGraph)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, OrderFilterRepo) int64); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, ProductRepoFilter) error); ok {
 		r1 = rf(ctx, filter)
 	} else {
-		r1 = ret.Get(1).(int64)
-	}
-
-	if rf, ok := ret.Get(2).(func(context.Context, OrderFilterRepo) error); ok {
-		r2 = rf(ctx, filter)
-	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// GetProduct provides a mock function with given fields: ctx, id
-func (_m *MockIRepository) GetProduct(ctx context.Context, id int) (models.Product, error) {
-	ret := _m.Called(ctx, id)
+// CreateAddress provides a mock function with given fields: ctx, aReq
+func (_m *MockIRepository) CreateAddress(ctx context.Context, aReq Address) (models.Address, error) {
+	ret := _m.Called(ctx, aReq)
 
-	var r0 models.Product
+	var r0 models.Address
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Product, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, Address) (models.Address, error)); ok {
+		return rf(ctx, aReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) models.Product); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, Address) models.Address); ok {
+		r0 = rf(ctx, aReq)
 	} else {
-		r0 = ret.Get(0).(models.Product)
+		r0 = ret.Get(0).(models.Address)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, Address) error); ok {
+		r1 = rf(ctx, aReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -255,23 +257,23 @@ func (_m *MockIRepository) GetProduct(ctx context.Context, id int) (models.Produ
 	return r0, r1
 }
 
-// GetProductCategory provides a mock function with given fields: ctx, id
-func (_m *MockIRepository) GetProductCategory(ctx context.Context, id int) (models.ProductCategory, error) {
-	ret := _m.Called(ctx, id)
+// CreateAttributeDefinition provides a mock function with given fields: ctx, adReq
+func (_m *MockIRepository) CreateAttributeDefinition(ctx context.Context, adReq AttributeDefinition) (models.AttributeDefinition, error) {
+	ret := _m.Called(ctx, adReq)
 
-	var r0 models.ProductCategory
+	var r0 models.AttributeDefinition
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ProductCategory, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, AttributeDefinition) (models.AttributeDefinition, error)); ok {
+		return rf(ctx, adReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) models.ProductCategory); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, AttributeDefinition) models.AttributeDefinition); ok {
+		r0 = rf(ctx, adReq)
 	} else {
-		r0 = ret.Get(0).(models.ProductCategory)
+		r0 = ret.Get(0).(models.AttributeDefinition)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, AttributeDefinition) error); ok {
+		r1 = rf(ctx, adReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -279,47 +281,37 @@ func (_m *MockIRepository) GetProductCategory(ctx context.Context, id int) (mode
 	return r0, r1
 }
 
-// GetProductCategoryByName provides a mock function with given fields: ctx, name
-func (_m *MockIRepository) GetProductCategoryByName(ctx context.Context, name string) (models.ProductCategory, error) {
-	ret := _m.Called(ctx, name)
-
-	var r0 models.ProductCategory
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (models.ProductCategory, error)); ok {
-		return rf(ctx, name)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) models.ProductCategory); ok {
-		r0 = rf(ctx, name)
-	} else {
-		r0 = ret.Get(0).(models.ProductCategory)
-	}
+// CreateExchangeRate provides a mock function with given fields: ctx, erReq
+func (_m *MockIRepository) CreateExchangeRate(ctx context.Context, erReq ExchangeRate) error {
+	ret := _m.Called(ctx, erReq)
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ExchangeRate) error); ok {
+		r0 = rf(ctx, erReq)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GetProductCategoryDefault provides a mock function with given fields: ctx
-func (_m *MockIRepository) GetProductCategoryDefault(ctx context.Context) (models.ProductCategory, error) {
-	ret := _m.Called(ctx)
+// CreateOptionType provides a mock function with given fields: ctx, tx, productID, name, position
+func (_m *MockIRepository) CreateOptionType(ctx context.Context, tx *sql.Tx, productID int, name string, position int) (models.OptionType, error) {
+	ret := _m.Called(ctx, tx, productID, name, position)
 
-	var r0 models.ProductCategory
+	var r0 models.OptionType
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (models.ProductCategory, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, string, int) (models.OptionType, error)); ok {
+		return rf(ctx, tx, productID, name, position)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) models.ProductCategory); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, string, int) models.OptionType); ok {
+		r0 = rf(ctx, tx, productID, name, position)
 	} else {
-		r0 = ret.Get(0).(models.ProductCategory)
+		r0 = ret.Get(0).(models.OptionType)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, int, string, int) error); ok {
+		r1 = rf(ctx, tx, productID, name, position)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,25 +319,23 @@ func (_m *MockIRepository) GetProductCategoryDefault(ctx context.Context) (model
 	return r0, r1
 }
 
-// GetProducts provides a mock function with given fields: ctx, filter
-func (_m *MockIRepository) GetProducts(ctx context.Context, filter ProductRepoFilter) ([]ProductOutput, error) {
-	ret := _m.Called(ctx, filter)
+// CreateOrder provides a mock function with given fields: ctx, tx, oReq
+func (_m *MockIRepository) CreateOrder(ctx context.Context, tx *sql.Tx, oReq Order) (models.Order, error) {
+	ret := _m.Called(ctx, tx, oReq)
 
-	var r0 []ProductOutput
+	var r0 models.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) ([]ProductOutput, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, Order) (models.Order, error)); ok {
+		return rf(ctx, tx, oReq)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) []ProductOutput); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, Order) models.Order); ok {
+		r0 = rf(ctx, tx, oReq)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ProductOutput)
-		}
+		r0 = ret.Get(0).(models.Order)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ProductRepoFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, Order) error); ok {
+		r1 = rf(ctx, tx, oReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -353,49 +343,2194 @@ func (_m *MockIRepository) GetProducts(ctx context.Context, filter ProductRepoFi
 	return r0, r1
 }
 
-// GetProductsGraph provides a mock function with given fields: ctx, filter
-func (_m *MockIRepository) GetProductsGraph(ctx context.Context, filter ProductRepoFilter) ([]GetProductsGraph, error) {
-	ret := _m.Called(ctx, filter)
+// CreateOrderItem provides a mock function with given fields: ctx, tx, oiReq, order
+func (_m *MockIRepository) CreateOrderItem(ctx context.Context, tx *sql.Tx, oiReq []OrderItem, order models.Order) error {
+	ret := _m.Called(ctx, tx, oiReq, order)
 
-	var r0 []GetProductsGraph
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) ([]GetProductsGraph, error)); ok {
-		return rf(ctx, filter)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) []GetProductsGraph); ok {
-		r0 = rf(ctx, filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, []OrderItem, models.Order) error); ok {
+		r0 = rf(ctx, tx, oiReq, order)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]GetProductsGraph)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ProductRepoFilter) error); ok {
-		r1 = rf(ctx, filter)
+	return r0
+}
+
+// CreateProduct provides a mock function with given fields: ctx, productRequest
+func (_m *MockIRepository) CreateProduct(ctx context.Context, productRequest Product) error {
+	ret := _m.Called(ctx, productRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, Product) error); ok {
+		r0 = rf(ctx, productRequest)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GetUser provides a mock function with given fields: ctx, id
-func (_m *MockIRepository) GetUser(ctx context.Context, id int) (models.User, error) {
-	ret := _m.Called(ctx, id)
+// CreateProductCategory provides a mock function with given fields: ctx, productCategory
+func (_m *MockIRepository) CreateProductCategory(ctx context.Context, productCategory ProductCategory) error {
+	ret := _m.Called(ctx, productCategory)
 
-	var r0 models.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (models.User, error)); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductCategory) error); ok {
+		r0 = rf(ctx, productCategory)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProductImage provides a mock function with given fields: ctx, iReq
+func (_m *MockIRepository) CreateProductImage(ctx context.Context, iReq ProductImage) (models.ProductImage, error) {
+	ret := _m.Called(ctx, iReq)
+
+	var r0 models.ProductImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductImage) (models.ProductImage, error)); ok {
+		return rf(ctx, iReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductImage) models.ProductImage); ok {
+		r0 = rf(ctx, iReq)
+	} else {
+		r0 = ret.Get(0).(models.ProductImage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductImage) error); ok {
+		r1 = rf(ctx, iReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateProductReview provides a mock function with given fields: ctx, prReq
+func (_m *MockIRepository) CreateProductReview(ctx context.Context, prReq ProductReview) (models.ProductReview, error) {
+	ret := _m.Called(ctx, prReq)
+
+	var r0 models.ProductReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReview) (models.ProductReview, error)); ok {
+		return rf(ctx, prReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReview) models.ProductReview); ok {
+		r0 = rf(ctx, prReq)
+	} else {
+		r0 = ret.Get(0).(models.ProductReview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductReview) error); ok {
+		r1 = rf(ctx, prReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReturnRequest provides a mock function with given fields: ctx, tx, rReq, riReq
+func (_m *MockIRepository) CreateReturnRequest(ctx context.Context, tx *sql.Tx, rReq ReturnRequest, riReq []ReturnItem) (models.ReturnRequest, error) {
+	ret := _m.Called(ctx, tx, rReq, riReq)
+
+	var r0 models.ReturnRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, ReturnRequest, []ReturnItem) (models.ReturnRequest, error)); ok {
+		return rf(ctx, tx, rReq, riReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, ReturnRequest, []ReturnItem) models.ReturnRequest); ok {
+		r0 = rf(ctx, tx, rReq, riReq)
+	} else {
+		r0 = ret.Get(0).(models.ReturnRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, ReturnRequest, []ReturnItem) error); ok {
+		r1 = rf(ctx, tx, rReq, riReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShipment provides a mock function with given fields: ctx, tx, sReq
+func (_m *MockIRepository) CreateShipment(ctx context.Context, tx *sql.Tx, sReq Shipment) (models.Shipment, error) {
+	ret := _m.Called(ctx, tx, sReq)
+
+	var r0 models.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, Shipment) (models.Shipment, error)); ok {
+		return rf(ctx, tx, sReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, Shipment) models.Shipment); ok {
+		r0 = rf(ctx, tx, sReq)
+	} else {
+		r0 = ret.Get(0).(models.Shipment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, Shipment) error); ok {
+		r1 = rf(ctx, tx, sReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShippingMethod provides a mock function with given fields: ctx, smReq
+func (_m *MockIRepository) CreateShippingMethod(ctx context.Context, smReq ShippingMethod) error {
+	ret := _m.Called(ctx, smReq)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ShippingMethod) error); ok {
+		r0 = rf(ctx, smReq)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTaxRule provides a mock function with given fields: ctx, trReq
+func (_m *MockIRepository) CreateTaxRule(ctx context.Context, trReq TaxRule) error {
+	ret := _m.Called(ctx, trReq)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, TaxRule) error); ok {
+		r0 = rf(ctx, trReq)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateUser provides a mock function with given fields: ctx, user
+func (_m *MockIRepository) CreateUser(ctx context.Context, user User) error {
+	ret := _m.Called(ctx, user)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, User) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVariant provides a mock function with given fields: ctx, tx, vReq
+func (_m *MockIRepository) CreateVariant(ctx context.Context, tx *sql.Tx, vReq ProductVariant) (models.ProductVariant, error) {
+	ret := _m.Called(ctx, tx, vReq)
+
+	var r0 models.ProductVariant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, ProductVariant) (models.ProductVariant, error)); ok {
+		return rf(ctx, tx, vReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, ProductVariant) models.ProductVariant); ok {
+		r0 = rf(ctx, tx, vReq)
+	} else {
+		r0 = ret.Get(0).(models.ProductVariant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, ProductVariant) error); ok {
+		r1 = rf(ctx, tx, vReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWarehouse provides a mock function with given fields: ctx, wReq
+func (_m *MockIRepository) CreateWarehouse(ctx context.Context, wReq Warehouse) (models.Warehouse, error) {
+	ret := _m.Called(ctx, wReq)
+
+	var r0 models.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Warehouse) (models.Warehouse, error)); ok {
+		return rf(ctx, wReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Warehouse) models.Warehouse); ok {
+		r0 = rf(ctx, wReq)
+	} else {
+		r0 = ret.Get(0).(models.Warehouse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Warehouse) error); ok {
+		r1 = rf(ctx, wReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAddress provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) DeleteAddress(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProduct provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) DeleteProduct(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProductCache provides a mock function with given fields: ctx, ids
+func (_m *MockIRepository) DeleteProductCache(ctx context.Context, ids ...int) error {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) error); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProductImage provides a mock function with given fields: ctx, tx, id
+func (_m *MockIRepository) DeleteProductImage(ctx context.Context, tx *sql.Tx, id int) error {
+	ret := _m.Called(ctx, tx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) error); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProductPrice provides a mock function with given fields: ctx, tx, id
+func (_m *MockIRepository) DeleteProductPrice(ctx context.Context, tx *sql.Tx, id int) error {
+	ret := _m.Called(ctx, tx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) error); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWishlistItem provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIRepository) DeleteWishlistItem(ctx context.Context, userID int, productID int) error {
+	ret := _m.Called(ctx, userID, productID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAddress provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetAddress(ctx context.Context, id int) (models.Address, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Address, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.Address); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Address)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAddresses provides a mock function with given fields: ctx, userID
+func (_m *MockIRepository) GetAddresses(ctx context.Context, userID int) ([]models.Address, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []models.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.Address, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.Address); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttributeDefinitions provides a mock function with given fields: ctx, categoryID
+func (_m *MockIRepository) GetAttributeDefinitions(ctx context.Context, categoryID int) ([]models.AttributeDefinition, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 []models.AttributeDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.AttributeDefinition, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.AttributeDefinition); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AttributeDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueProductPrices provides a mock function with given fields: ctx, at
+func (_m *MockIRepository) GetDueProductPrices(ctx context.Context, at time.Time) ([]models.ProductPrice, error) {
+	ret := _m.Called(ctx, at)
+
+	var r0 []models.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]models.ProductPrice, error)); ok {
+		return rf(ctx, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []models.ProductPrice); ok {
+		r0 = rf(ctx, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEffectiveExchangeRate provides a mock function with given fields: ctx, currency, at
+func (_m *MockIRepository) GetEffectiveExchangeRate(ctx context.Context, currency string, at time.Time) (models.ExchangeRate, error) {
+	ret := _m.Called(ctx, currency, at)
+
+	var r0 models.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (models.ExchangeRate, error)); ok {
+		return rf(ctx, currency, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) models.ExchangeRate); ok {
+		r0 = rf(ctx, currency, at)
+	} else {
+		r0 = ret.Get(0).(models.ExchangeRate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, currency, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEffectiveProductPrice provides a mock function with given fields: ctx, productID, at
+func (_m *MockIRepository) GetEffectiveProductPrice(ctx context.Context, productID int, at time.Time) (models.ProductPrice, error) {
+	ret := _m.Called(ctx, productID, at)
+
+	var r0 models.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (models.ProductPrice, error)); ok {
+		return rf(ctx, productID, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) models.ProductPrice); ok {
+		r0 = rf(ctx, productID, at)
+	} else {
+		r0 = ret.Get(0).(models.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, productID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEffectiveTaxRule provides a mock function with given fields: ctx, categoryID, region, at
+func (_m *MockIRepository) GetEffectiveTaxRule(ctx context.Context, categoryID int, region string, at time.Time) (models.TaxRule, error) {
+	ret := _m.Called(ctx, categoryID, region, at)
+
+	var r0 models.TaxRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, time.Time) (models.TaxRule, error)); ok {
+		return rf(ctx, categoryID, region, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, time.Time) models.TaxRule); ok {
+		r0 = rf(ctx, categoryID, region, at)
+	} else {
+		r0 = ret.Get(0).(models.TaxRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, time.Time) error); ok {
+		r1 = rf(ctx, categoryID, region, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExchangeRates provides a mock function with given fields: ctx, currency
+func (_m *MockIRepository) GetExchangeRates(ctx context.Context, currency string) ([]models.ExchangeRate, error) {
+	ret := _m.Called(ctx, currency)
+
+	var r0 []models.ExchangeRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ExchangeRate, error)); ok {
+		return rf(ctx, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ExchangeRate); ok {
+		r0 = rf(ctx, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExchangeRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJobRuns provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetJobRuns(ctx context.Context) ([]JobRun, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]JobRun, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []JobRun); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]JobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLowStockProducts provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetLowStockProducts(ctx context.Context) ([]models.Product, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Product, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Product); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOptionTypes provides a mock function with given fields: ctx, productID
+func (_m *MockIRepository) GetOptionTypes(ctx context.Context, productID int) ([]models.OptionType, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []models.OptionType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.OptionType, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.OptionType); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OptionType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrder provides a mock function with given fields: ctx, orderID
+func (_m *MockIRepository) GetOrder(ctx context.Context, orderID int) (models.Order, error) {
+	ret := _m.Called(ctx, orderID)
+
+	var r0 models.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Order, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.Order); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		r0 = ret.Get(0).(models.Order)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderExportRows provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetOrderExportRows(ctx context.Context, filter OrderFilterRepo) ([]OrderExportRow, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []OrderExportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterRepo) ([]OrderExportRow, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterRepo) []OrderExportRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OrderExportRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, OrderFilterRepo) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderItem provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetOrderItem(ctx context.Context, id int) (models.OrderItem, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.OrderItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.OrderItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.OrderItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.OrderItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderItemsByOrderID provides a mock function with given fields: ctx, orderID
+func (_m *MockIRepository) GetOrderItemsByOrderID(ctx context.Context, orderID int) (models.OrderItemSlice, error) {
+	ret := _m.Called(ctx, orderID)
+
+	var r0 models.OrderItemSlice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.OrderItemSlice, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.OrderItemSlice); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.OrderItemSlice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderValueSummary provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetOrderValueSummary(ctx context.Context, filter AnalyticsFilter) (OrderValueSummary, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 OrderValueSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter) (OrderValueSummary, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter) OrderValueSummary); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(OrderValueSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrders provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetOrders(ctx context.Context, filter OrderFilterRepo) ([]OrderOutputGraph, int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []OrderOutputGraph
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterRepo) ([]OrderOutputGraph, int64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, OrderFilterRepo) []OrderOutputGraph); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]OrderOutputGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, OrderFilterRepo) int64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, OrderFilterRepo) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetProduct provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetProduct(ctx context.Context, id int) (models.Product, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Product, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.Product); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAttributes provides a mock function with given fields: ctx, productIDs
+func (_m *MockIRepository) GetProductAttributes(ctx context.Context, productIDs ...int) ([]ProductAttributeOutput, error) {
+	_va := make([]interface{}, len(productIDs))
+	for _i := range productIDs {
+		_va[_i] = productIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []ProductAttributeOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) ([]ProductAttributeOutput, error)); ok {
+		return rf(ctx, productIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) []ProductAttributeOutput); ok {
+		r0 = rf(ctx, productIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductAttributeOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...int) error); ok {
+		r1 = rf(ctx, productIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductByName provides a mock function with given fields: ctx, name
+func (_m *MockIRepository) GetProductByName(ctx context.Context, name string) (models.Product, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Product, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Product); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(models.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductBySKU provides a mock function with given fields: ctx, sku
+func (_m *MockIRepository) GetProductBySKU(ctx context.Context, sku string) (models.Product, error) {
+	ret := _m.Called(ctx, sku)
+
+	var r0 models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Product, error)); ok {
+		return rf(ctx, sku)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Product); ok {
+		r0 = rf(ctx, sku)
+	} else {
+		r0 = ret.Get(0).(models.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sku)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductBySlug provides a mock function with given fields: ctx, slug
+func (_m *MockIRepository) GetProductBySlug(ctx context.Context, slug string) (models.Product, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Product, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Product); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(models.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCategory provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetProductCategory(ctx context.Context, id int) (models.ProductCategory, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ProductCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ProductCategory, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ProductCategory); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ProductCategory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCategoryByName provides a mock function with given fields: ctx, name
+func (_m *MockIRepository) GetProductCategoryByName(ctx context.Context, name string) (models.ProductCategory, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 models.ProductCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.ProductCategory, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.ProductCategory); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(models.ProductCategory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCategoryDefault provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetProductCategoryDefault(ctx context.Context) (models.ProductCategory, error) {
+	ret := _m.Called(ctx)
+
+	var r0 models.ProductCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (models.ProductCategory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) models.ProductCategory); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(models.ProductCategory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductCoPurchases provides a mock function with given fields: ctx, since, statuses, limit
+func (_m *MockIRepository) GetProductCoPurchases(ctx context.Context, since time.Time, statuses []string, limit int) ([]ProductCoPurchase, error) {
+	ret := _m.Called(ctx, since, statuses, limit)
+
+	var r0 []ProductCoPurchase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, []string, int) ([]ProductCoPurchase, error)); ok {
+		return rf(ctx, since, statuses, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, []string, int) []ProductCoPurchase); ok {
+		r0 = rf(ctx, since, statuses, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductCoPurchase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, []string, int) error); ok {
+		r1 = rf(ctx, since, statuses, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductIDs provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetProductIDs(ctx context.Context, filter ProductRepoFilter) ([]int, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) ([]int, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) []int); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductRepoFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductImage provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetProductImage(ctx context.Context, id int) (models.ProductImage, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ProductImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ProductImage, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ProductImage); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ProductImage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductImages provides a mock function with given fields: ctx, productIDs
+func (_m *MockIRepository) GetProductImages(ctx context.Context, productIDs ...int) ([]models.ProductImage, error) {
+	_va := make([]interface{}, len(productIDs))
+	for _i := range productIDs {
+		_va[_i] = productIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []models.ProductImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) ([]models.ProductImage, error)); ok {
+		return rf(ctx, productIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) []models.ProductImage); ok {
+		r0 = rf(ctx, productIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductImage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...int) error); ok {
+		r1 = rf(ctx, productIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductPrice provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetProductPrice(ctx context.Context, id int) (models.ProductPrice, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ProductPrice, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ProductPrice); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductPrices provides a mock function with given fields: ctx, productID
+func (_m *MockIRepository) GetProductPrices(ctx context.Context, productID int) ([]models.ProductPrice, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []models.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.ProductPrice, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.ProductPrice); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductReview provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetProductReview(ctx context.Context, id int) (models.ProductReview, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ProductReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ProductReview, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ProductReview); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ProductReview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductReviewByUser provides a mock function with given fields: ctx, productID, userID
+func (_m *MockIRepository) GetProductReviewByUser(ctx context.Context, productID int, userID int) (models.ProductReview, error) {
+	ret := _m.Called(ctx, productID, userID)
+
+	var r0 models.ProductReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (models.ProductReview, error)); ok {
+		return rf(ctx, productID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) models.ProductReview); ok {
+		r0 = rf(ctx, productID, userID)
+	} else {
+		r0 = ret.Get(0).(models.ProductReview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, productID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductReviews provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetProductReviews(ctx context.Context, filter ProductReviewFilter) ([]models.ProductReview, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []models.ProductReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewFilter) ([]models.ProductReview, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductReviewFilter) []models.ProductReview); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductReviewFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductSlugRedirect provides a mock function with given fields: ctx, slug
+func (_m *MockIRepository) GetProductSlugRedirect(ctx context.Context, slug string) (models.ProductSlugRedirect, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 models.ProductSlugRedirect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.ProductSlugRedirect, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.ProductSlugRedirect); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(models.ProductSlugRedirect)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductTags provides a mock function with given fields: ctx, productIDs
+func (_m *MockIRepository) GetProductTags(ctx context.Context, productIDs ...int) ([]models.ProductTag, error) {
+	_va := make([]interface{}, len(productIDs))
+	for _i := range productIDs {
+		_va[_i] = productIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []models.ProductTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) ([]models.ProductTag, error)); ok {
+		return rf(ctx, productIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) []models.ProductTag); ok {
+		r0 = rf(ctx, productIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProductTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...int) error); ok {
+		r1 = rf(ctx, productIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductVariants provides a mock function with given fields: ctx, productIDs
+func (_m *MockIRepository) GetProductVariants(ctx context.Context, productIDs ...int) ([]ProductVariantOutput, error) {
+	_va := make([]interface{}, len(productIDs))
+	for _i := range productIDs {
+		_va[_i] = productIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []ProductVariantOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) ([]ProductVariantOutput, error)); ok {
+		return rf(ctx, productIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) []ProductVariantOutput); ok {
+		r0 = rf(ctx, productIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductVariantOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...int) error); ok {
+		r1 = rf(ctx, productIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProducts provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetProducts(ctx context.Context, filter ProductRepoFilter) ([]ProductOutput, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []ProductOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) ([]ProductOutput, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) []ProductOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductRepoFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsByID provides a mock function with given fields: ctx, ids
+func (_m *MockIRepository) GetProductsByID(ctx context.Context, ids ...int) ([]models.Product, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) ([]models.Product, error)); ok {
+		return rf(ctx, ids...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...int) []models.Product); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...int) error); ok {
+		r1 = rf(ctx, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsGraph provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetProductsGraph(ctx context.Context, filter ProductRepoFilter) ([]GetProductsGraph, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []GetProductsGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) ([]GetProductsGraph, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductRepoFilter) []GetProductsGraph); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetProductsGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductRepoFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPurgeableProducts provides a mock function with given fields: ctx, archivedBefore
+func (_m *MockIRepository) GetPurgeableProducts(ctx context.Context, archivedBefore time.Time) ([]int, error) {
+	ret := _m.Called(ctx, archivedBefore)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]int, error)); ok {
+		return rf(ctx, archivedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []int); ok {
+		r0 = rf(ctx, archivedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, archivedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRelatedProductIDs provides a mock function with given fields: ctx, productID
+func (_m *MockIRepository) GetRelatedProductIDs(ctx context.Context, productID int) ([]int, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]int, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []int); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRestockedWishlistItems provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetRestockedWishlistItems(ctx context.Context) ([]models.WishlistItem, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.WishlistItem, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.WishlistItem); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnItems provides a mock function with given fields: ctx, returnRequestID
+func (_m *MockIRepository) GetReturnItems(ctx context.Context, returnRequestID int) ([]models.ReturnItem, error) {
+	ret := _m.Called(ctx, returnRequestID)
+
+	var r0 []models.ReturnItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.ReturnItem, error)); ok {
+		return rf(ctx, returnRequestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.ReturnItem); ok {
+		r0 = rf(ctx, returnRequestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReturnItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, returnRequestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnRequest provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetReturnRequest(ctx context.Context, id int) (models.ReturnRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ReturnRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ReturnRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ReturnRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ReturnRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnRequests provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetReturnRequests(ctx context.Context, filter ReturnRequestFilter) ([]ReturnRequestOutput, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []ReturnRequestOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ReturnRequestFilter) ([]ReturnRequestOutput, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ReturnRequestFilter) []ReturnRequestOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ReturnRequestOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ReturnRequestFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnedQuantities provides a mock function with given fields: ctx, orderID, excludedStatuses
+func (_m *MockIRepository) GetReturnedQuantities(ctx context.Context, orderID int, excludedStatuses []string) (map[int]int, error) {
+	ret := _m.Called(ctx, orderID, excludedStatuses)
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []string) (map[int]int, error)); ok {
+		return rf(ctx, orderID, excludedStatuses)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []string) map[int]int); ok {
+		r0 = rf(ctx, orderID, excludedStatuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []string) error); ok {
+		r1 = rf(ctx, orderID, excludedStatuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevenueByPeriod provides a mock function with given fields: ctx, filter, period
+func (_m *MockIRepository) GetRevenueByPeriod(ctx context.Context, filter AnalyticsFilter, period string) ([]RevenuePeriod, error) {
+	ret := _m.Called(ctx, filter, period)
+
+	var r0 []RevenuePeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, string) ([]RevenuePeriod, error)); ok {
+		return rf(ctx, filter, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, string) []RevenuePeriod); ok {
+		r0 = rf(ctx, filter, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]RevenuePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilter, string) error); ok {
+		r1 = rf(ctx, filter, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShipment provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetShipment(ctx context.Context, id int) (models.Shipment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Shipment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.Shipment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Shipment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShipments provides a mock function with given fields: ctx, orderID
+func (_m *MockIRepository) GetShipments(ctx context.Context, orderID int) ([]models.Shipment, error) {
+	ret := _m.Called(ctx, orderID)
+
+	var r0 []models.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.Shipment, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.Shipment); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Shipment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShippingMethod provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetShippingMethod(ctx context.Context, id int) (models.ShippingMethod, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ShippingMethod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ShippingMethod, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ShippingMethod); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ShippingMethod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShippingMethods provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetShippingMethods(ctx context.Context) ([]models.ShippingMethod, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []models.ShippingMethod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ShippingMethod, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ShippingMethod); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ShippingMethod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStaleOrders provides a mock function with given fields: ctx, status, createdBefore
+func (_m *MockIRepository) GetStaleOrders(ctx context.Context, status string, createdBefore time.Time) (models.OrderSlice, error) {
+	ret := _m.Called(ctx, status, createdBefore)
+
+	var r0 models.OrderSlice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (models.OrderSlice, error)); ok {
+		return rf(ctx, status, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) models.OrderSlice); ok {
+		r0 = rf(ctx, status, createdBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.OrderSlice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, status, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockMovements provides a mock function with given fields: ctx, productID, variantID
+func (_m *MockIRepository) GetStockMovements(ctx context.Context, productID int, variantID null.Int) ([]models.StockMovement, error) {
+	ret := _m.Called(ctx, productID, variantID)
+
+	var r0 []models.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, null.Int) ([]models.StockMovement, error)); ok {
+		return rf(ctx, productID, variantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, null.Int) []models.StockMovement); ok {
+		r0 = rf(ctx, productID, variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, null.Int) error); ok {
+		r1 = rf(ctx, productID, variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaxRules provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) GetTaxRules(ctx context.Context, filter TaxRuleFilter) ([]models.TaxRule, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []models.TaxRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TaxRuleFilter) ([]models.TaxRule, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TaxRuleFilter) []models.TaxRule); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TaxRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TaxRuleFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopCategories provides a mock function with given fields: ctx, filter, sortBy, limit
+func (_m *MockIRepository) GetTopCategories(ctx context.Context, filter AnalyticsFilter, sortBy string, limit int) ([]TopSeller, error) {
+	ret := _m.Called(ctx, filter, sortBy, limit)
+
+	var r0 []TopSeller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, string, int) ([]TopSeller, error)); ok {
+		return rf(ctx, filter, sortBy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, string, int) []TopSeller); ok {
+		r0 = rf(ctx, filter, sortBy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TopSeller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilter, string, int) error); ok {
+		r1 = rf(ctx, filter, sortBy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopProducts provides a mock function with given fields: ctx, filter, sortBy, limit
+func (_m *MockIRepository) GetTopProducts(ctx context.Context, filter AnalyticsFilter, sortBy string, limit int) ([]TopSeller, error) {
+	ret := _m.Called(ctx, filter, sortBy, limit)
+
+	var r0 []TopSeller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, string, int) ([]TopSeller, error)); ok {
+		return rf(ctx, filter, sortBy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, string, int) []TopSeller); ok {
+		r0 = rf(ctx, filter, sortBy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TopSeller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilter, string, int) error); ok {
+		r1 = rf(ctx, filter, sortBy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopProductsInCategory provides a mock function with given fields: ctx, filter, categoryID, limit
+func (_m *MockIRepository) GetTopProductsInCategory(ctx context.Context, filter AnalyticsFilter, categoryID int, limit int) ([]TopSeller, error) {
+	ret := _m.Called(ctx, filter, categoryID, limit)
+
+	var r0 []TopSeller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, int, int) ([]TopSeller, error)); ok {
+		return rf(ctx, filter, categoryID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, AnalyticsFilter, int, int) []TopSeller); ok {
+		r0 = rf(ctx, filter, categoryID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TopSeller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, AnalyticsFilter, int, int) error); ok {
+		r1 = rf(ctx, filter, categoryID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnnotifiedLowStockAlerts provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetUnnotifiedLowStockAlerts(ctx context.Context) ([]models.LowStockAlert, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []models.LowStockAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.LowStockAlert, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.LowStockAlert); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LowStockAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetUser(ctx context.Context, id int) (models.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserDefault provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetUserDefault(ctx context.Context) (models.User, error) {
+	ret := _m.Called(ctx)
+
+	var r0 models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (models.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) models.User); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(models.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsersByRole provides a mock function with given fields: ctx, role
+func (_m *MockIRepository) GetUsersByRole(ctx context.Context, role string) ([]models.User, error) {
+	ret := _m.Called(ctx, role)
+
+	var r0 []models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.User, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.User); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariant provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetVariant(ctx context.Context, id int) (models.ProductVariant, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.ProductVariant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.ProductVariant, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.ProductVariant); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ProductVariant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariantBySKU provides a mock function with given fields: ctx, sku
+func (_m *MockIRepository) GetVariantBySKU(ctx context.Context, sku string) (models.ProductVariant, error) {
+	ret := _m.Called(ctx, sku)
+
+	var r0 models.ProductVariant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.ProductVariant, error)); ok {
+		return rf(ctx, sku)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.ProductVariant); ok {
+		r0 = rf(ctx, sku)
+	} else {
+		r0 = ret.Get(0).(models.ProductVariant)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sku)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWarehouse provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) GetWarehouse(ctx context.Context, id int) (models.Warehouse, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (models.Warehouse, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) models.User); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) models.Warehouse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Warehouse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWarehouseByName provides a mock function with given fields: ctx, name
+func (_m *MockIRepository) GetWarehouseByName(ctx context.Context, name string) (models.Warehouse, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 models.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Warehouse, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Warehouse); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(models.Warehouse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWarehouseStocks provides a mock function with given fields: ctx, productID
+func (_m *MockIRepository) GetWarehouseStocks(ctx context.Context, productID int) ([]models.WarehouseStock, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []models.WarehouseStock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.WarehouseStock, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.WarehouseStock); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WarehouseStock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWarehouses provides a mock function with given fields: ctx
+func (_m *MockIRepository) GetWarehouses(ctx context.Context) ([]models.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []models.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistItem provides a mock function with given fields: ctx, userID, productID
+func (_m *MockIRepository) GetWishlistItem(ctx context.Context, userID int, productID int) (models.WishlistItem, error) {
+	ret := _m.Called(ctx, userID, productID)
+
+	var r0 models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (models.WishlistItem, error)); ok {
+		return rf(ctx, userID, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) models.WishlistItem); ok {
+		r0 = rf(ctx, userID, productID)
+	} else {
+		r0 = ret.Get(0).(models.WishlistItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistItems provides a mock function with given fields: ctx, userID
+func (_m *MockIRepository) GetWishlistItems(ctx context.Context, userID int) ([]models.WishlistItem, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []models.WishlistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.WishlistItem, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.WishlistItem); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WishlistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasOrderedProduct provides a mock function with given fields: ctx, userID, productID, statuses
+func (_m *MockIRepository) HasOrderedProduct(ctx context.Context, userID int, productID int, statuses []string) (bool, error) {
+	ret := _m.Called(ctx, userID, productID, statuses)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, []string) (bool, error)); ok {
+		return rf(ctx, userID, productID, statuses)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, []string) bool); ok {
+		r0 = rf(ctx, userID, productID, statuses)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, []string) error); ok {
+		r1 = rf(ctx, userID, productID, statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockOrder provides a mock function with given fields: ctx, tx, orderID
+func (_m *MockIRepository) LockOrder(ctx context.Context, tx *sql.Tx, orderID int) (models.Order, error) {
+	ret := _m.Called(ctx, tx, orderID)
+
+	var r0 models.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) (models.Order, error)); ok {
+		return rf(ctx, tx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) models.Order); ok {
+		r0 = rf(ctx, tx, orderID)
+	} else {
+		r0 = ret.Get(0).(models.Order)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, int) error); ok {
+		r1 = rf(ctx, tx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockProduct provides a mock function with given fields: ctx, tx, id
+func (_m *MockIRepository) LockProduct(ctx context.Context, tx *sql.Tx, id int) (models.Product, error) {
+	ret := _m.Called(ctx, tx, id)
+
+	var r0 models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) (models.Product, error)); ok {
+		return rf(ctx, tx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int) models.Product); ok {
+		r0 = rf(ctx, tx, id)
 	} else {
-		r0 = ret.Get(0).(models.User)
+		r0 = ret.Get(0).(models.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, int) error); ok {
+		r1 = rf(ctx, tx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -403,23 +2538,98 @@ func (_m *MockIRepository) GetUser(ctx context.Context, id int) (models.User, er
 	return r0, r1
 }
 
-// GetUserDefault provides a mock function with given fields: ctx
-func (_m *MockIRepository) GetUserDefault(ctx context.Context) (models.User, error) {
-	ret := _m.Called(ctx)
+// MarkLowStockAlertsNotified provides a mock function with given fields: ctx, ids, at
+func (_m *MockIRepository) MarkLowStockAlertsNotified(ctx context.Context, ids []int, at time.Time) error {
+	ret := _m.Called(ctx, ids, at)
 
-	var r0 models.User
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time) error); ok {
+		r0 = rf(ctx, ids, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkProductPriceApplied provides a mock function with given fields: ctx, tx, productPrice
+func (_m *MockIRepository) MarkProductPriceApplied(ctx context.Context, tx *sql.Tx, productPrice models.ProductPrice) error {
+	ret := _m.Called(ctx, tx, productPrice)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.ProductPrice) error); ok {
+		r0 = rf(ctx, tx, productPrice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkWishlistItemsNotified provides a mock function with given fields: ctx, ids, at
+func (_m *MockIRepository) MarkWishlistItemsNotified(ctx context.Context, ids []int, at time.Time) error {
+	ret := _m.Called(ctx, ids, at)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, time.Time) error); ok {
+		r0 = rf(ctx, ids, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeProducts provides a mock function with given fields: ctx, archivedBefore, ids
+func (_m *MockIRepository) PurgeProducts(ctx context.Context, archivedBefore time.Time, ids ...int) ([]int, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, archivedBefore)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (models.User, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, ...int) ([]int, error)); ok {
+		return rf(ctx, archivedBefore, ids...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) models.User); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, ...int) []int); ok {
+		r0 = rf(ctx, archivedBefore, ids...)
 	} else {
-		r0 = ret.Get(0).(models.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, ...int) error); ok {
+		r1 = rf(ctx, archivedBefore, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordStockMovement provides a mock function with given fields: ctx, tx, smReq
+func (_m *MockIRepository) RecordStockMovement(ctx context.Context, tx *sql.Tx, smReq StockMovement) (models.StockMovement, error) {
+	ret := _m.Called(ctx, tx, smReq)
+
+	var r0 models.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, StockMovement) (models.StockMovement, error)); ok {
+		return rf(ctx, tx, smReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, StockMovement) models.StockMovement); ok {
+		r0 = rf(ctx, tx, smReq)
+	} else {
+		r0 = ret.Get(0).(models.StockMovement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, StockMovement) error); ok {
+		r1 = rf(ctx, tx, smReq)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -427,6 +2637,34 @@ func (_m *MockIRepository) GetUserDefault(ctx context.Context) (models.User, err
 	return r0, r1
 }
 
+// ReleaseLock provides a mock function with given fields: ctx, name, token
+func (_m *MockIRepository) ReleaseLock(ctx context.Context, name string, token string) error {
+	ret := _m.Called(ctx, name, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, name, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreProduct provides a mock function with given fields: ctx, id
+func (_m *MockIRepository) RestoreProduct(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RollbackTx provides a mock function with given fields: tx
 func (_m *MockIRepository) RollbackTx(tx *sql.Tx) error {
 	ret := _m.Called(tx)
@@ -441,6 +2679,180 @@ func (_m *MockIRepository) RollbackTx(tx *sql.Tx) error {
 	return r0
 }
 
+// SaveJobRun provides a mock function with given fields: ctx, jobRun
+func (_m *MockIRepository) SaveJobRun(ctx context.Context, jobRun JobRun) error {
+	ret := _m.Called(ctx, jobRun)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, JobRun) error); ok {
+		r0 = rf(ctx, jobRun)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchProducts provides a mock function with given fields: ctx, filter
+func (_m *MockIRepository) SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]ProductSearchResult, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []ProductSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ProductSearchFilter) ([]ProductSearchResult, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ProductSearchFilter) []ProductSearchResult); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProductSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ProductSearchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetProductAttributes provides a mock function with given fields: ctx, tx, productID, values
+func (_m *MockIRepository) SetProductAttributes(ctx context.Context, tx *sql.Tx, productID int, values []ProductAttributeValue) error {
+	ret := _m.Called(ctx, tx, productID, values)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, []ProductAttributeValue) error); ok {
+		r0 = rf(ctx, tx, productID, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetProductImagePositions provides a mock function with given fields: ctx, tx, productID, imageIDs
+func (_m *MockIRepository) SetProductImagePositions(ctx context.Context, tx *sql.Tx, productID int, imageIDs []int) error {
+	ret := _m.Called(ctx, tx, productID, imageIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, []int) error); ok {
+		r0 = rf(ctx, tx, productID, imageIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetProductPrice provides a mock function with given fields: ctx, tx, ppReq
+func (_m *MockIRepository) SetProductPrice(ctx context.Context, tx *sql.Tx, ppReq ProductPrice) (models.ProductPrice, error) {
+	ret := _m.Called(ctx, tx, ppReq)
+
+	var r0 models.ProductPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, ProductPrice) (models.ProductPrice, error)); ok {
+		return rf(ctx, tx, ppReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, ProductPrice) models.ProductPrice); ok {
+		r0 = rf(ctx, tx, ppReq)
+	} else {
+		r0 = ret.Get(0).(models.ProductPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, ProductPrice) error); ok {
+		r1 = rf(ctx, tx, ppReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetProductTags provides a mock function with given fields: ctx, tx, productID, tags
+func (_m *MockIRepository) SetProductTags(ctx context.Context, tx *sql.Tx, productID int, tags []string) error {
+	ret := _m.Called(ctx, tx, productID, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, []string) error); ok {
+		r0 = rf(ctx, tx, productID, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetRelatedProducts provides a mock function with given fields: ctx, related
+func (_m *MockIRepository) SetRelatedProducts(ctx context.Context, related map[int][]int) error {
+	ret := _m.Called(ctx, related)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[int][]int) error); ok {
+		r0 = rf(ctx, related)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetVariantOptions provides a mock function with given fields: ctx, tx, variantID, options
+func (_m *MockIRepository) SetVariantOptions(ctx context.Context, tx *sql.Tx, variantID int, options map[int]string) error {
+	ret := _m.Called(ctx, tx, variantID, options)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, map[int]string) error); ok {
+		r0 = rf(ctx, tx, variantID, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetWishlistItemNotification provides a mock function with given fields: ctx, userID, productID, notify
+func (_m *MockIRepository) SetWishlistItemNotification(ctx context.Context, userID int, productID int, notify bool) error {
+	ret := _m.Called(ctx, userID, productID, notify)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, bool) error); ok {
+		r0 = rf(ctx, userID, productID, notify)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransferStock provides a mock function with given fields: ctx, tx, stReq
+func (_m *MockIRepository) TransferStock(ctx context.Context, tx *sql.Tx, stReq StockTransfer) ([]models.StockMovement, error) {
+	ret := _m.Called(ctx, tx, stReq)
+
+	var r0 []models.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, StockTransfer) ([]models.StockMovement, error)); ok {
+		return rf(ctx, tx, stReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, StockTransfer) []models.StockMovement); ok {
+		r0 = rf(ctx, tx, stReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, StockTransfer) error); ok {
+		r1 = rf(ctx, tx, stReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrder provides a mock function with given fields: ctx, tx, orderReq
 func (_m *MockIRepository) UpdateOrder(ctx context.Context, tx *sql.Tx, orderReq models.Order) error {
 	ret := _m.Called(ctx, tx, orderReq)
@@ -483,6 +2895,90 @@ func (_m *MockIRepository) UpdateProduct(ctx context.Context, tx *sql.Tx, pReq m
 	return r0
 }
 
+// UpdateProductColumns provides a mock function with given fields: ctx, tx, pReq, columns
+func (_m *MockIRepository) UpdateProductColumns(ctx context.Context, tx *sql.Tx, pReq models.Product, columns []string) error {
+	ret := _m.Called(ctx, tx, pReq, columns)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.Product, []string) error); ok {
+		r0 = rf(ctx, tx, pReq, columns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProductReviewStatus provides a mock function with given fields: ctx, tx, id, from, to, note
+func (_m *MockIRepository) UpdateProductReviewStatus(ctx context.Context, tx *sql.Tx, id int, from string, to string, note string) error {
+	ret := _m.Called(ctx, tx, id, from, to, note)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, int, string, string, string) error); ok {
+		r0 = rf(ctx, tx, id, from, to, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateReturnRequest provides a mock function with given fields: ctx, tx, rReq, from
+func (_m *MockIRepository) UpdateReturnRequest(ctx context.Context, tx *sql.Tx, rReq models.ReturnRequest, from string) error {
+	ret := _m.Called(ctx, tx, rReq, from)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.ReturnRequest, string) error); ok {
+		r0 = rf(ctx, tx, rReq, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateShipment provides a mock function with given fields: ctx, tx, sReq
+func (_m *MockIRepository) UpdateShipment(ctx context.Context, tx *sql.Tx, sReq models.Shipment) error {
+	ret := _m.Called(ctx, tx, sReq)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.Shipment) error); ok {
+		r0 = rf(ctx, tx, sReq)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVariant provides a mock function with given fields: ctx, tx, vReq
+func (_m *MockIRepository) UpdateVariant(ctx context.Context, tx *sql.Tx, vReq models.ProductVariant) error {
+	ret := _m.Called(ctx, tx, vReq)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, models.ProductVariant) error); ok {
+		r0 = rf(ctx, tx, vReq)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertExchangeRates provides a mock function with given fields: ctx, erReqs
+func (_m *MockIRepository) UpsertExchangeRates(ctx context.Context, erReqs []ExchangeRate) error {
+	ret := _m.Called(ctx, erReqs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ExchangeRate) error); ok {
+		r0 = rf(ctx, erReqs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpsertProducts provides a mock function with given fields: ctx, products
 func (_m *MockIRepository) UpsertProducts(ctx context.Context, products []Product) error {
 	ret := _m.Called(ctx, products)
@@ -3,9 +3,11 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/redis/go-redis/v9"
+	"github.com/volatiletech/null/v8"
 )
 
 type IRepository interface {
@@ -13,12 +15,42 @@ type IRepository interface {
 	CreateProduct(ctx context.Context, productRequest Product) error
 	// GetProduct retrieves a product in db by ID
 	GetProduct(ctx context.Context, id int) (models.Product, error)
+	// GetProductByName retrieves a product in db by name
+	GetProductByName(ctx context.Context, name string) (models.Product, error)
+	// GetProductBySKU retrieves a product in db by sku
+	GetProductBySKU(ctx context.Context, sku string) (models.Product, error)
+	// GetProductBySlug retrieves a product in db by its current slug
+	GetProductBySlug(ctx context.Context, slug string) (models.Product, error)
+	// GetProductSlugRedirect retrieves the redirect of an old slug of a product in db
+	GetProductSlugRedirect(ctx context.Context, slug string) (models.ProductSlugRedirect, error)
+	// LockProduct retrieves a product in db by id and locks its row until the end of the transaction
+	LockProduct(ctx context.Context, tx *sql.Tx, id int) (models.Product, error)
 	// UpdateProduct updates a product in db given by product model in parameter
 	UpdateProduct(ctx context.Context, tx *sql.Tx, pReq models.Product) error
-	// DeleteProduct deletes a product in db by ID
+	// DeleteProduct archives a product in db by ID, the product is kept so that the orders of it can still be resolved
 	DeleteProduct(ctx context.Context, id int) error
-	// GetProducts retrieves all the products in db
+	// ArchiveProduct archives an active product in db by ID in the transaction if given
+	ArchiveProduct(ctx context.Context, tx *sql.Tx, id int) error
+	// GetProductsByID retrieves the products of the IDs from db sorted by ID, the archived ones included
+	GetProductsByID(ctx context.Context, ids ...int) ([]models.Product, error)
+	// GetProductIDs retrieves the IDs of the products matching the filter sorted by ID, at most the limit of the filter if given
+	GetProductIDs(ctx context.Context, filter ProductRepoFilter) ([]int, error)
+	// UpdateProductColumns updates the columns of a product in db given by product model in parameter, the other columns are kept
+	UpdateProductColumns(ctx context.Context, tx *sql.Tx, pReq models.Product, columns []string) error
+	// DeleteProductCache removes the cached products of the IDs
+	DeleteProductCache(ctx context.Context, ids ...int) error
+	// RestoreProduct restores an archived product in db by ID
+	RestoreProduct(ctx context.Context, id int) error
+	// GetPurgeableProducts retrieves the IDs of the products archived before the time which have never been ordered
+	GetPurgeableProducts(ctx context.Context, archivedBefore time.Time) ([]int, error)
+	// PurgeProducts deletes the products of the IDs which are still purgeable for the time and returns the IDs of the deleted products
+	PurgeProducts(ctx context.Context, archivedBefore time.Time, ids ...int) ([]int, error)
+	// GetProducts retrieves the products in db matching the filter, a page of them if the limit is given
 	GetProducts(ctx context.Context, filter ProductRepoFilter) ([]ProductOutput, error)
+	// CountProducts counts the products matching the filter, the sorting and pagination of the filter are ignored
+	CountProducts(ctx context.Context, filter ProductRepoFilter) (int64, error)
+	// SearchProducts retrieves a page of the products whose name or description match the query, the best matches first
+	SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]ProductSearchResult, error)
 	// UpsertProducts updates list of products. If a product already exists in db, updates only changed values instead
 	UpsertProducts(ctx context.Context, products []Product) error
 	// GetProductsGraph retrieves all products in db and is used in GraphQL.
@@ -30,6 +62,8 @@ type IRepository interface {
 	GetUser(ctx context.Context, id int) (models.User, error)
 	// GetUserDefault gets the id of default user
 	GetUserDefault(ctx context.Context) (models.User, error)
+	// GetUsersByRole retrieves the users of a role
+	GetUsersByRole(ctx context.Context, role string) ([]models.User, error)
 
 	// CreateProductCategory creates a product category using given product category model in parameter
 	CreateProductCategory(ctx context.Context, productCategory ProductCategory) error
@@ -55,6 +89,212 @@ type IRepository interface {
 	GetOrder(ctx context.Context, orderID int) (models.Order, error)
 	// GetOrders retrieves all of order in db
 	GetOrders(ctx context.Context, filter OrderFilterRepo) ([]OrderOutputGraph, int64, error)
+	// GetOrderExportRows retrieves the line items of the orders matching the filter, one row per item
+	GetOrderExportRows(ctx context.Context, filter OrderFilterRepo) ([]OrderExportRow, error)
+
+	// CreateTaxRule creates a tax rule in db given by tax rule model in parameter
+	CreateTaxRule(ctx context.Context, trReq TaxRule) error
+	// GetTaxRules retrieves all the tax rules in db, filtered by category and region if given
+	GetTaxRules(ctx context.Context, filter TaxRuleFilter) ([]models.TaxRule, error)
+	// GetEffectiveTaxRule retrieves the tax rule of a category which is in effect at the given time
+	GetEffectiveTaxRule(ctx context.Context, categoryID int, region string, at time.Time) (models.TaxRule, error)
+
+	// CreateAddress creates an address in the address book of a user
+	CreateAddress(ctx context.Context, aReq Address) (models.Address, error)
+	// GetAddress retrieves an address in db by ID
+	GetAddress(ctx context.Context, id int) (models.Address, error)
+	// GetAddresses retrieves the address book of a user
+	GetAddresses(ctx context.Context, userID int) ([]models.Address, error)
+	// DeleteAddress deletes an address in db by ID
+	DeleteAddress(ctx context.Context, id int) error
+
+	// CreateShippingMethod creates a shipping method in db given by shipping method model in parameter
+	CreateShippingMethod(ctx context.Context, smReq ShippingMethod) error
+	// GetShippingMethod retrieves a shipping method in db by ID
+	GetShippingMethod(ctx context.Context, id int) (models.ShippingMethod, error)
+	// GetShippingMethods retrieves all the shipping methods in db
+	GetShippingMethods(ctx context.Context) ([]models.ShippingMethod, error)
+
+	// CreateShipment creates a shipment of an order in db given by shipment model in parameter
+	CreateShipment(ctx context.Context, tx *sql.Tx, sReq Shipment) (models.Shipment, error)
+	// UpdateShipment updates a shipment in db given by shipment model in parameter
+	UpdateShipment(ctx context.Context, tx *sql.Tx, sReq models.Shipment) error
+	// GetShipment retrieves a shipment in db by ID
+	GetShipment(ctx context.Context, id int) (models.Shipment, error)
+	// GetShipments retrieves all the shipments of an order
+	GetShipments(ctx context.Context, orderID int) ([]models.Shipment, error)
+
+	// CreateExchangeRate creates an exchange rate in db given by exchange rate model in parameter
+	CreateExchangeRate(ctx context.Context, erReq ExchangeRate) error
+	// UpsertExchangeRates inserts list of exchange rates, the rate is updated if it already exists
+	UpsertExchangeRates(ctx context.Context, erReqs []ExchangeRate) error
+	// GetExchangeRates retrieves all the exchange rates in db, filtered by currency if given
+	GetExchangeRates(ctx context.Context, currency string) ([]models.ExchangeRate, error)
+	// GetEffectiveExchangeRate retrieves the latest exchange rate of a currency which is in effect at the given time
+	GetEffectiveExchangeRate(ctx context.Context, currency string, at time.Time) (models.ExchangeRate, error)
+
+	// CreateReturnRequest creates a return request of an order with the order items to return
+	CreateReturnRequest(ctx context.Context, tx *sql.Tx, rReq ReturnRequest, riReq []ReturnItem) (models.ReturnRequest, error)
+	// UpdateReturnRequest updates a return request in db given by return request model in parameter if it is still in the from status
+	UpdateReturnRequest(ctx context.Context, tx *sql.Tx, rReq models.ReturnRequest, from string) error
+	// GetReturnRequest retrieves a return request in db by ID
+	GetReturnRequest(ctx context.Context, id int) (models.ReturnRequest, error)
+	// GetReturnItems retrieves the items of a return request
+	GetReturnItems(ctx context.Context, returnRequestID int) ([]models.ReturnItem, error)
+	// GetReturnRequests retrieves the return requests with their items, filtered by statuses and order if given
+	GetReturnRequests(ctx context.Context, filter ReturnRequestFilter) ([]ReturnRequestOutput, error)
+	// GetReturnedQuantities retrieves the quantity of each order item of an order which is already in a return request
+	GetReturnedQuantities(ctx context.Context, orderID int, excludedStatuses []string) (map[int]int, error)
+
+	// GetRevenueByPeriod retrieves the revenue in VND and the number of orders of each day, week or month of the range
+	GetRevenueByPeriod(ctx context.Context, filter AnalyticsFilter, period string) ([]RevenuePeriod, error)
+	// GetTopProducts retrieves the products with the highest revenue in VND or the most units sold in the range
+	GetTopProducts(ctx context.Context, filter AnalyticsFilter, sortBy string, limit int) ([]TopSeller, error)
+	// GetTopCategories retrieves the product categories with the highest revenue in VND or the most units sold in the range
+	GetTopCategories(ctx context.Context, filter AnalyticsFilter, sortBy string, limit int) ([]TopSeller, error)
+	// GetOrderValueSummary retrieves the number of orders, the revenue and the average order value in VND of the range
+	GetOrderValueSummary(ctx context.Context, filter AnalyticsFilter) (OrderValueSummary, error)
+
+	// GetStaleOrders retrieves the orders in the given status which were created before the given time
+	GetStaleOrders(ctx context.Context, status string, createdBefore time.Time) (models.OrderSlice, error)
+	// LockOrder retrieves an order in db by id and locks its row until the end of the transaction
+	LockOrder(ctx context.Context, tx *sql.Tx, orderID int) (models.Order, error)
+	// GetOrderItemsByOrderID retrieves the items of an order
+	GetOrderItemsByOrderID(ctx context.Context, orderID int) (models.OrderItemSlice, error)
+
+	// AcquireLock takes the lock of the given name for the ttl, it returns an empty token if the lock is held by someone else
+	AcquireLock(ctx context.Context, name string, ttl time.Duration) (string, error)
+	// ReleaseLock releases the lock of the given name if it is still held by the token
+	ReleaseLock(ctx context.Context, name, token string) error
+	// SaveJobRun stores the run of a background job as its last run
+	SaveJobRun(ctx context.Context, jobRun JobRun) error
+	// GetJobRuns retrieves the last run of each background job
+	GetJobRuns(ctx context.Context) ([]JobRun, error)
+
+	// GetOptionTypes retrieves the option types of a product sorted by position
+	GetOptionTypes(ctx context.Context, productID int) ([]models.OptionType, error)
+	// CreateOptionType creates an option type of a product such as Size or Color
+	CreateOptionType(ctx context.Context, tx *sql.Tx, productID int, name string, position int) (models.OptionType, error)
+	// CreateVariant creates a variant of a product with its option values
+	CreateVariant(ctx context.Context, tx *sql.Tx, vReq ProductVariant) (models.ProductVariant, error)
+	// SetVariantOptions sets the values of the options of a variant, the value of an option type the variant already has is replaced
+	SetVariantOptions(ctx context.Context, tx *sql.Tx, variantID int, options map[int]string) error
+	// GetVariant retrieves a variant in db by ID
+	GetVariant(ctx context.Context, id int) (models.ProductVariant, error)
+	// GetVariantBySKU retrieves a variant in db by SKU
+	GetVariantBySKU(ctx context.Context, sku string) (models.ProductVariant, error)
+	// UpdateVariant updates the price and quantity of a variant
+	UpdateVariant(ctx context.Context, tx *sql.Tx, vReq models.ProductVariant) error
+	// CountProductVariants counts the variants of a product
+	CountProductVariants(ctx context.Context, productID int) (int64, error)
+	// GetProductVariants retrieves the variants of the products with their options, sorted by product and SKU
+	GetProductVariants(ctx context.Context, productIDs ...int) ([]ProductVariantOutput, error)
+
+	// CreateProductImage adds an image to the gallery of a product
+	CreateProductImage(ctx context.Context, iReq ProductImage) (models.ProductImage, error)
+	// GetProductImage retrieves an image in db by ID
+	GetProductImage(ctx context.Context, id int) (models.ProductImage, error)
+	// GetProductImages retrieves the galleries of the products, sorted by product and position
+	GetProductImages(ctx context.Context, productIDs ...int) ([]models.ProductImage, error)
+	// SetProductImagePositions sets the position of each image of a product to its index in imageIDs
+	SetProductImagePositions(ctx context.Context, tx *sql.Tx, productID int, imageIDs []int) error
+	// DeleteProductImage deletes an image in db by ID
+	DeleteProductImage(ctx context.Context, tx *sql.Tx, id int) error
+
+	// SetProductPrice adds a price to the price history of a product from its effective time
+	SetProductPrice(ctx context.Context, tx *sql.Tx, ppReq ProductPrice) (models.ProductPrice, error)
+	// GetProductPrice retrieves a price of the price history in db by ID
+	GetProductPrice(ctx context.Context, id int) (models.ProductPrice, error)
+	// GetProductPrices retrieves the price history of a product sorted by effective time
+	GetProductPrices(ctx context.Context, productID int) ([]models.ProductPrice, error)
+	// GetEffectiveProductPrice retrieves the price of a product which is in effect at the given time
+	GetEffectiveProductPrice(ctx context.Context, productID int, at time.Time) (models.ProductPrice, error)
+	// GetDueProductPrices retrieves the scheduled prices which are effective at the time but have not been set on their products
+	GetDueProductPrices(ctx context.Context, at time.Time) ([]models.ProductPrice, error)
+	// ApplyProductPrice sets a price of the history on its product
+	ApplyProductPrice(ctx context.Context, tx *sql.Tx, productPrice models.ProductPrice) error
+
+	// MarkProductPriceApplied marks a price of the history as applied without setting it on its product
+	MarkProductPriceApplied(ctx context.Context, tx *sql.Tx, productPrice models.ProductPrice) error
+	// DeleteProductPrice removes a price from the price history of a product
+	DeleteProductPrice(ctx context.Context, tx *sql.Tx, id int) error
+
+	// RecordStockMovement adds the delta of a movement to the quantity of its variant or product and appends it to the stock ledger
+	RecordStockMovement(ctx context.Context, tx *sql.Tx, smReq StockMovement) (models.StockMovement, error)
+	// GetStockMovements retrieves the movements of the stock of a variant, or of the product if the variant is null
+	GetStockMovements(ctx context.Context, productID int, variantID null.Int) ([]models.StockMovement, error)
+	// GetLowStockProducts retrieves the active products whose quantity is below their reorder threshold
+	GetLowStockProducts(ctx context.Context) ([]models.Product, error)
+	// GetUnnotifiedLowStockAlerts retrieves the open low stock alerts which have not been sent in a digest yet with their products
+	GetUnnotifiedLowStockAlerts(ctx context.Context) ([]models.LowStockAlert, error)
+	// MarkLowStockAlertsNotified sets the time the low stock alerts of the IDs have been sent in a digest
+	MarkLowStockAlertsNotified(ctx context.Context, ids []int, at time.Time) error
+
+	// CreateWarehouse creates a warehouse in db given by warehouse model in parameter
+	CreateWarehouse(ctx context.Context, wReq Warehouse) (models.Warehouse, error)
+	// GetWarehouse retrieves a warehouse in db by ID
+	GetWarehouse(ctx context.Context, id int) (models.Warehouse, error)
+	// GetWarehouseByName retrieves a warehouse in db by name
+	GetWarehouseByName(ctx context.Context, name string) (models.Warehouse, error)
+	// GetWarehouses retrieves all the warehouses in db
+	GetWarehouses(ctx context.Context) ([]models.Warehouse, error)
+	// GetWarehouseStocks retrieves the stock of a product in each warehouse which has ever kept it, with the warehouses
+	GetWarehouseStocks(ctx context.Context, productID int) ([]models.WarehouseStock, error)
+	// TransferStock moves the quantity of a product from a warehouse to another and records the transfer on the stock ledger
+	TransferStock(ctx context.Context, tx *sql.Tx, stReq StockTransfer) ([]models.StockMovement, error)
+	// CreateAttributeDefinition creates an attribute definition of a category in db
+	CreateAttributeDefinition(ctx context.Context, adReq AttributeDefinition) (models.AttributeDefinition, error)
+	// GetAttributeDefinitions retrieves the attribute definitions of a category in db in the order they were created
+	GetAttributeDefinitions(ctx context.Context, categoryID int) ([]models.AttributeDefinition, error)
+	// SetProductAttributes replaces the values of the attributes of a product with the given ones
+	SetProductAttributes(ctx context.Context, tx *sql.Tx, productID int, values []ProductAttributeValue) error
+	// GetProductAttributes retrieves the values of the attributes of the products with their definitions
+	GetProductAttributes(ctx context.Context, productIDs ...int) ([]ProductAttributeOutput, error)
+	// SetProductTags replaces the tags of a product with the given ones
+	SetProductTags(ctx context.Context, tx *sql.Tx, productID int, tags []string) error
+	// GetProductTags retrieves the tags of the products sorted by product and tag
+	GetProductTags(ctx context.Context, productIDs ...int) ([]models.ProductTag, error)
+	// CreateProductReview creates a review of a product by a user
+	CreateProductReview(ctx context.Context, prReq ProductReview) (models.ProductReview, error)
+	// GetProductReview retrieves a review of a product in db by ID
+	GetProductReview(ctx context.Context, id int) (models.ProductReview, error)
+	// GetProductReviewByUser retrieves the review of a product by a user
+	GetProductReviewByUser(ctx context.Context, productID, userID int) (models.ProductReview, error)
+	// UpdateProductReviewStatus moves a review from a status to another if it is still in the from status
+	UpdateProductReviewStatus(ctx context.Context, tx *sql.Tx, id int, from, to, note string) error
+	// GetProductReviews retrieves the reviews of a product matching the filter, newest first
+	GetProductReviews(ctx context.Context, filter ProductReviewFilter) ([]models.ProductReview, error)
+	// CountProductReviews counts the reviews of a product matching the filter
+	CountProductReviews(ctx context.Context, filter ProductReviewFilter) (int64, error)
+	// AddProductRating adds a number of reviews and the total of their ratings to the rating of a product
+	AddProductRating(ctx context.Context, tx *sql.Tx, productID, count, total int) error
+	// HasOrderedProduct reports whether a user has an order in one of the statuses with an item of the product
+	HasOrderedProduct(ctx context.Context, userID, productID int, statuses []string) (bool, error)
+	// AddWishlistItem saves a product to the wishlist of a user
+	AddWishlistItem(ctx context.Context, userID, productID int) (models.WishlistItem, error)
+	// GetWishlistItem retrieves the item of a product in the wishlist of a user
+	GetWishlistItem(ctx context.Context, userID, productID int) (models.WishlistItem, error)
+	// GetWishlistItems retrieves the wishlist of a user with the products, the last saved first
+	GetWishlistItems(ctx context.Context, userID int) ([]models.WishlistItem, error)
+	// DeleteWishlistItem removes a product from the wishlist of a user with its back in stock subscription
+	DeleteWishlistItem(ctx context.Context, userID, productID int) error
+	// SetWishlistItemNotification subscribes or unsubscribes the item of a product in the wishlist of a user to the back in stock notification
+	SetWishlistItemNotification(ctx context.Context, userID, productID int, notify bool) error
+	// GetRestockedWishlistItems retrieves the subscribed wishlist items whose products have been restocked with the products and users
+	GetRestockedWishlistItems(ctx context.Context) ([]models.WishlistItem, error)
+	// MarkWishlistItemsNotified sets the time the users of the wishlist items of the IDs have been notified, the items are unsubscribed
+	MarkWishlistItemsNotified(ctx context.Context, ids []int, at time.Time) error
+	// ClearWishlistItemsRestocked clears the restock of the wishlist items of the IDs whose products are out of stock again
+	ClearWishlistItemsRestocked(ctx context.Context, ids []int) error
+	// GetProductCoPurchases counts for each pair of products the orders in the statuses created since the time which have items of both,
+	// only the limit pairs with the most orders of each product are kept
+	GetProductCoPurchases(ctx context.Context, since time.Time, statuses []string, limit int) ([]ProductCoPurchase, error)
+	// SetRelatedProducts replaces the related products of all the products with the given ones, keyed by product ID
+	SetRelatedProducts(ctx context.Context, related map[int][]int) error
+	// GetRelatedProductIDs retrieves the IDs of the related products of a product, most related first
+	GetRelatedProductIDs(ctx context.Context, productID int) ([]int, error)
+	// GetTopProductsInCategory retrieves the active products of a category with the most units sold in the range
+	GetTopProductsInCategory(ctx context.Context, filter AnalyticsFilter, categoryID, limit int) ([]TopSeller, error)
 
 	// BeginTx begins a transaction with the current global database handle
 	BeginTx(ctx context.Context) (*sql.Tx, error)
@@ -6,18 +6,25 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type OrderItem struct {
-	ID        int             `redis:"id"`
-	OrderID   int             `redis:"order_id"`
-	ProductID int             `redis:"product_id"`
-	Quantity  int             `redis:"quantity"`
-	Price     decimal.Decimal `redis:"price"`
+	ID          int             `redis:"id"`
+	OrderID     int             `redis:"order_id"`
+	ProductID   int             `redis:"product_id"`
+	VariantID   int             `redis:"variant_id"`   // 0 if the product has no variants
+	WarehouseID int             `redis:"warehouse_id"` // the warehouse the item is fulfilled from, 0 for a variant
+	Quantity    int             `redis:"quantity"`
+	Price       decimal.Decimal `redis:"price"`
+	TaxRate     decimal.Decimal `redis:"tax_rate"`
+	TaxAmount   decimal.Decimal `redis:"tax_amount"`
 }
 
 // CreateOrderItem creates an order item in db given by order item model in parameter
@@ -29,11 +36,20 @@ func (r *Repository) CreateOrderItem(ctx context.Context, tx *sql.Tx, oiReq []Or
 
 	var orderItems []*models.OrderItem
 	for _, oi := range oiReq {
-		orderItems = append(orderItems, &models.OrderItem{
+		orderItem := &models.OrderItem{
 			ProductID: oi.ProductID,
 			Quantity:  oi.Quantity,
 			Price:     oi.Price,
-		})
+			TaxRate:   oi.TaxRate,
+			TaxAmount: oi.TaxAmount,
+		}
+		if oi.VariantID != 0 {
+			orderItem.VariantID = null.IntFrom(oi.VariantID)
+		}
+		if oi.WarehouseID != 0 {
+			orderItem.WarehouseID = null.IntFrom(oi.WarehouseID)
+		}
+		orderItems = append(orderItems, orderItem)
 	}
 
 	if err := order.AddOrderItems(ctx, ctxExec, true, orderItems...); err != nil {
@@ -56,23 +72,22 @@ func (r *Repository) UpdateOrderItem(ctx context.Context, tx *sql.Tx, id int, oi
 		ProductID: oiReq.ProductID,
 		Quantity:  oiReq.Quantity,
 		Price:     oiReq.Price,
+		TaxRate:   oiReq.TaxRate,
+		TaxAmount: oiReq.TaxAmount,
+	}
+	if oiReq.VariantID != 0 {
+		orderItem.VariantID = null.IntFrom(oiReq.VariantID)
+	}
+	if oiReq.WarehouseID != 0 {
+		orderItem.WarehouseID = null.IntFrom(oiReq.WarehouseID)
 	}
 
 	if _, err := orderItem.Update(ctx, ctxExec, boil.Blacklist("id", "created_at")); err != nil {
 		return pkgerrors.WithStack(err)
 	}
 
-	if err := r.Redis.HSet(ctx, fmt.Sprintf("orderItem:%d", oiReq.ID), map[string]interface{}{
-		"id":         oiReq.ID,
-		"order_id":   oiReq.OrderID,
-		"product_id": oiReq.ProductID,
-		"quantity":   oiReq.Quantity,
-		"price":      oiReq.Price.String(),
-	}); err != nil {
-		return err.Err()
-	}
-
-	return nil
+	// the cached order item is removed rather than replaced so that an update which is rolled back is not cached
+	return r.Redis.Del(ctx, fmt.Sprintf("orderItem:%d", id)).Err()
 }
 
 // GetOrderItem retrieves an order item in db by ID
@@ -88,11 +103,15 @@ func (r *Repository) GetOrderItem(ctx context.Context, id int) (models.OrderItem
 		}
 
 		if errCache := r.Redis.HSet(ctx, fmt.Sprintf("orderItem:%d", id), map[string]interface{}{
-			"id":         orderItem.ID,
-			"order_id":   orderItem.OrderID,
-			"product_id": orderItem.ProductID,
-			"quantity":   orderItem.Quantity,
-			"price":      orderItem.Price.String(),
+			"id":           orderItem.ID,
+			"order_id":     orderItem.OrderID,
+			"product_id":   orderItem.ProductID,
+			"variant_id":   orderItem.VariantID.Int,
+			"warehouse_id": orderItem.WarehouseID.Int,
+			"quantity":     orderItem.Quantity,
+			"price":        orderItem.Price.String(),
+			"tax_rate":     orderItem.TaxRate.String(),
+			"tax_amount":   orderItem.TaxAmount.String(),
 		}); errCache.Err() != nil {
 			return models.OrderItem{}, errCache.Err()
 		}
@@ -106,10 +125,37 @@ func (r *Repository) GetOrderItem(ctx context.Context, id int) (models.OrderItem
 	}
 
 	return models.OrderItem{
-		ID:        orderItemScan.ID,
-		OrderID:   orderItemScan.OrderID,
-		ProductID: orderItemScan.ProductID,
-		Price:     orderItemScan.Price,
-		Quantity:  orderItemScan.Quantity,
+		ID:          orderItemScan.ID,
+		OrderID:     orderItemScan.OrderID,
+		ProductID:   orderItemScan.ProductID,
+		VariantID:   null.NewInt(orderItemScan.VariantID, orderItemScan.VariantID != 0),
+		WarehouseID: null.NewInt(orderItemScan.WarehouseID, orderItemScan.WarehouseID != 0),
+		Price:       orderItemScan.Price,
+		Quantity:    orderItemScan.Quantity,
+		TaxRate:     orderItemScan.TaxRate,
+		TaxAmount:   orderItemScan.TaxAmount,
 	}, nil
 }
+
+// GetOrderItemsByOrderID retrieves the items of an order
+func (r *Repository) GetOrderItemsByOrderID(ctx context.Context, orderID int) (models.OrderItemSlice, error) {
+	orderItemTable := models.TableNames.OrderItems
+
+	return models.OrderItems(
+		qm.Where(fmt.Sprintf("%s.%s = ?", orderItemTable, models.OrderItemColumns.OrderID), orderID),
+		qm.OrderBy(fmt.Sprintf("%s.%s", orderItemTable, models.OrderItemColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+}
+
+// HasOrderedProduct reports whether a user has an order in one of the statuses with an item of the product
+func (r *Repository) HasOrderedProduct(ctx context.Context, userID, productID int, statuses []string) (bool, error) {
+	orderTable := models.TableNames.Orders
+	orderItemTable := models.TableNames.OrderItems
+
+	return models.OrderItems(
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s = %s.%s", orderTable, orderTable, models.OrderColumns.ID, orderItemTable, models.OrderItemColumns.OrderID)),
+		qm.Where(fmt.Sprintf("%s.%s = ?", orderItemTable, models.OrderItemColumns.ProductID), productID),
+		qm.Where(fmt.Sprintf("%s.%s = ?", orderTable, models.OrderColumns.UserID), userID),
+		qm.Where(fmt.Sprintf("%s.%s = ANY(?)", orderTable, models.OrderColumns.Status), pq.Array(statuses)),
+	).Exists(ctx, boil.GetContextDB())
+}
@@ -10,17 +10,27 @@ import (
 
 	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type Order struct {
-	ID         int
-	UserID     int
-	Status     string
-	TotalPrice decimal.NullDecimal
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID               int                 `redis:"id"`
+	UserID           int                 `redis:"user_id"`
+	Status           string              `redis:"status"`
+	Region           string              `redis:"region"`
+	SubtotalPrice    decimal.NullDecimal `redis:"subtotal_price"`
+	TaxPrice         decimal.NullDecimal `redis:"tax_price"`
+	AddressID        null.Int            `redis:"address_id"`
+	ShippingMethodID null.Int            `redis:"shipping_method_id"`
+	ShippingPrice    decimal.NullDecimal `redis:"shipping_price"`
+	TotalPrice       decimal.NullDecimal `redis:"total_price"`
+	Currency         string              `redis:"currency"`
+	ExchangeRate     decimal.NullDecimal `redis:"exchange_rate"`
+	CreatedAt        time.Time           `redis:"created_at"`
+	UpdatedAt        time.Time           `redis:"updated_at"`
+	Version          int                 `redis:"version"`
 }
 
 // CreateOrder creates an order in db given by order model in parameter
@@ -31,9 +41,17 @@ func (r *Repository) CreateOrder(ctx context.Context, tx *sql.Tx, oReq Order) (m
 	}
 
 	order := models.Order{
-		UserID:     oReq.UserID,
-		Status:     oReq.Status,
-		TotalPrice: oReq.TotalPrice,
+		UserID:           oReq.UserID,
+		Status:           oReq.Status,
+		Region:           oReq.Region,
+		SubtotalPrice:    oReq.SubtotalPrice,
+		TaxPrice:         oReq.TaxPrice,
+		AddressID:        oReq.AddressID,
+		ShippingMethodID: oReq.ShippingMethodID,
+		ShippingPrice:    oReq.ShippingPrice,
+		TotalPrice:       oReq.TotalPrice,
+		Currency:         oReq.Currency,
+		ExchangeRate:     oReq.ExchangeRate,
 	}
 	if err := order.Insert(ctx, ctxExec, boil.Infer()); err != nil {
 		return models.Order{}, err
@@ -42,29 +60,19 @@ func (r *Repository) CreateOrder(ctx context.Context, tx *sql.Tx, oReq Order) (m
 	return order, nil
 }
 
-// UpdateOrder updates an order in db given by product model in parameter
+// UpdateOrder updates an order in db given by product model in parameter, the version is bumped by db on every update of the row
 func (r *Repository) UpdateOrder(ctx context.Context, tx *sql.Tx, oReq models.Order) error {
 	ctxExec := boil.GetContextDB()
 	if tx != nil {
 		ctxExec = tx
 	}
 
-	if _, err := oReq.Update(ctx, ctxExec, boil.Blacklist("id", "created_at")); err != nil {
+	if _, err := oReq.Update(ctx, ctxExec, boil.Blacklist("id", "created_at", "version")); err != nil {
 		return err
 	}
 
-	if err := r.Redis.HSet(ctx, fmt.Sprintf("order:%d", oReq.ID), map[string]interface{}{
-		"id":          oReq.ID,
-		"user_id":     oReq.UserID,
-		"status":      oReq.Status,
-		"total_price": oReq.TotalPrice.Decimal.String(),
-		"created_at":  oReq.CreatedAt,
-		"updated_at":  oReq.UpdatedAt,
-	}); err != nil {
-		return err.Err()
-	}
-
-	return nil
+	// the cached order is removed rather than replaced as the version given is out of date
+	return r.Redis.Del(ctx, fmt.Sprintf("order:%d", oReq.ID)).Err()
 }
 
 // GetOrder retrieves an order in db by id
@@ -80,12 +88,21 @@ func (r *Repository) GetOrder(ctx context.Context, orderID int) (models.Order, e
 		}
 
 		if errCache := r.Redis.HSet(ctx, fmt.Sprintf("order:%d", orderID), map[string]interface{}{
-			"id":          order.ID,
-			"user_id":     order.UserID,
-			"status":      order.Status,
-			"total_price": order.TotalPrice.Decimal.String(),
-			"created_at":  order.CreatedAt,
-			"updated_at":  order.UpdatedAt,
+			"id":                 order.ID,
+			"user_id":            order.UserID,
+			"status":             order.Status,
+			"region":             order.Region,
+			"subtotal_price":     nullDecimalString(order.SubtotalPrice),
+			"tax_price":          nullDecimalString(order.TaxPrice),
+			"address_id":         nullIntString(order.AddressID),
+			"shipping_method_id": nullIntString(order.ShippingMethodID),
+			"shipping_price":     nullDecimalString(order.ShippingPrice),
+			"total_price":        nullDecimalString(order.TotalPrice),
+			"currency":           order.Currency,
+			"exchange_rate":      nullDecimalString(order.ExchangeRate),
+			"created_at":         order.CreatedAt,
+			"updated_at":         order.UpdatedAt,
+			"version":            order.Version,
 		}); errCache.Err() != nil {
 			return models.Order{}, errCache.Err()
 		}
@@ -99,32 +116,51 @@ func (r *Repository) GetOrder(ctx context.Context, orderID int) (models.Order, e
 	}
 
 	return models.Order{
-		ID:         orderScan.ID,
-		UserID:     orderScan.UserID,
-		Status:     orderScan.Status,
-		TotalPrice: orderScan.TotalPrice,
-		CreatedAt:  orderScan.CreatedAt,
-		UpdatedAt:  orderScan.UpdatedAt,
+		ID:               orderScan.ID,
+		UserID:           orderScan.UserID,
+		Status:           orderScan.Status,
+		Region:           orderScan.Region,
+		SubtotalPrice:    orderScan.SubtotalPrice,
+		TaxPrice:         orderScan.TaxPrice,
+		AddressID:        orderScan.AddressID,
+		ShippingMethodID: orderScan.ShippingMethodID,
+		ShippingPrice:    orderScan.ShippingPrice,
+		TotalPrice:       orderScan.TotalPrice,
+		Currency:         orderScan.Currency,
+		ExchangeRate:     orderScan.ExchangeRate,
+		CreatedAt:        orderScan.CreatedAt,
+		UpdatedAt:        orderScan.UpdatedAt,
+		Version:          orderScan.Version,
 	}, nil
 }
 
 type OrderOutputGraph struct {
-	ID          int             `boil:"orders.id"`
-	UserName    string          `boil:"users.name"`
-	UserEmail   string          `boil:"users.email"`
-	Status      string          `boil:"orders.status"`
-	TotalPrice  decimal.Decimal `boil:"orders.total_price"`
-	CreatedAt   time.Time       `boil:"orders.created_at"`
-	ItemID      string          `boil:"items_id"`
-	ProductName string          `boil:"products_name"`
-	Quantity    string          `boil:"items_quantity"`
-	ItemPrice   string          `boil:"items_price"`
+	ID            int                 `boil:"orders.id"`
+	UserName      string              `boil:"users.name"`
+	UserEmail     string              `boil:"users.email"`
+	Status        string              `boil:"orders.status"`
+	Region        string              `boil:"orders.region"`
+	SubtotalPrice decimal.NullDecimal `boil:"orders.subtotal_price"`
+	TaxPrice      decimal.NullDecimal `boil:"orders.tax_price"`
+	ShippingPrice decimal.NullDecimal `boil:"orders.shipping_price"`
+	TotalPrice    decimal.Decimal     `boil:"orders.total_price"`
+	Currency      string              `boil:"orders.currency"`
+	ExchangeRate  decimal.NullDecimal `boil:"orders.exchange_rate"`
+	CreatedAt     time.Time           `boil:"orders.created_at"`
+	Version       int                 `boil:"orders.version"`
+	ItemID        string              `boil:"items_id"`
+	ProductName   string              `boil:"products_name"`
+	Quantity      string              `boil:"items_quantity"`
+	ItemPrice     string              `boil:"items_price"`
+	ItemTaxRate   string              `boil:"items_tax_rate"`
+	ItemTaxAmount string              `boil:"items_tax_amount"`
 }
 
 type OrderFilterRepo struct {
 	Sorting    []Sorting
 	Pagination Pagination
 	FilterDate FilterDate
+	Status     string
 }
 
 type Pagination struct {
@@ -154,14 +190,23 @@ func (r *Repository) GetOrders(ctx context.Context, filter OrderFilterRepo) ([]O
 		qm.Select(
 			fmt.Sprintf("%s.id", orderTable),
 			fmt.Sprintf("%s.status", orderTable),
+			fmt.Sprintf("%s.region", orderTable),
+			fmt.Sprintf("%s.subtotal_price", orderTable),
+			fmt.Sprintf("%s.tax_price", orderTable),
+			fmt.Sprintf("%s.shipping_price", orderTable),
 			fmt.Sprintf("%s.total_price", orderTable),
+			fmt.Sprintf("%s.currency", orderTable),
+			fmt.Sprintf("%s.exchange_rate", orderTable),
 			fmt.Sprintf("%s.created_at", orderTable),
+			fmt.Sprintf("%s.version", orderTable),
 			fmt.Sprintf("%s.name", userTable),
 			fmt.Sprintf("%s.email", userTable),
 			fmt.Sprintf(`array_agg(%s.id) as "items_id"`, orderItemTable),
 			fmt.Sprintf(`array_agg(%s.name) as "products_name"`, productTable),
 			fmt.Sprintf(`array_agg(%s.price) as "items_price"`, orderItemTable),
 			fmt.Sprintf(`array_agg(%s.quantity) as "items_quantity"`, orderItemTable),
+			fmt.Sprintf(`array_agg(%s.tax_rate) as "items_tax_rate"`, orderItemTable),
+			fmt.Sprintf(`array_agg(%s.tax_amount) as "items_tax_amount"`, orderItemTable),
 		),
 		// GROUP BY
 		qm.GroupBy(fmt.Sprintf("%s.id, %s.name, %s.email", orderTable, userTable, userTable)),
@@ -176,17 +221,11 @@ func (r *Repository) GetOrders(ctx context.Context, filter OrderFilterRepo) ([]O
 		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", userTable, orderTable, models.OrderColumns.UserID, userTable, models.UserColumns.ID)),
 	}
 
-	// filter the date range by start and end
-	if filter.FilterDate.StartDate != "" && filter.FilterDate.EndDate != "" {
-		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.created_at BETWEEN TO_TIMESTAMP('%s 00:00:00', 'DD MM YYYY HH24:MI:SS') AND TO_TIMESTAMP('%s 23:59:59', 'DD MM YYYY HH24:MI:SS')", orderTable, filter.FilterDate.StartDate, filter.FilterDate.EndDate)))
-	}
+	// filter the date range and status
+	queryMod = append(queryMod, orderFilterQueryMods(filter)...)
 
 	// sorting
-	var orderByQuery []string
-	for _, s := range filter.Sorting {
-		orderByQuery = append(orderByQuery, fmt.Sprintf("%s.%s %s", orderTable, s.ColumnName, s.SortOrder))
-	}
-	queryMod = append(queryMod, qm.OrderBy(strings.Join(orderByQuery, ",")))
+	queryMod = append(queryMod, qm.OrderBy(strings.Join(orderSortingQuery(filter.Sorting), ",")))
 
 	// pagination
 	queryMod = append(queryMod, qm.Limit(filter.Pagination.Limit))
@@ -205,6 +244,146 @@ func (r *Repository) GetOrders(ctx context.Context, filter OrderFilterRepo) ([]O
 	return orders, totalCount, nil
 }
 
+// orderFilterQueryMods returns the where clauses of the date range and status filters of orders
+func orderFilterQueryMods(filter OrderFilterRepo) []qm.QueryMod {
+	orderTable := models.TableNames.Orders
+
+	var queryMod []qm.QueryMod
+	// filter the date range by start and end
+	if filter.FilterDate.StartDate != "" && filter.FilterDate.EndDate != "" {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.created_at BETWEEN TO_TIMESTAMP('%s 00:00:00', 'DD MM YYYY HH24:MI:SS') AND TO_TIMESTAMP('%s 23:59:59', 'DD MM YYYY HH24:MI:SS')", orderTable, filter.FilterDate.StartDate, filter.FilterDate.EndDate)))
+	}
+
+	if filter.Status != "" {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s = ?", orderTable, models.OrderColumns.Status), filter.Status))
+	}
+
+	return queryMod
+}
+
+// orderSortingQuery returns the order by expressions of the sorting of orders
+func orderSortingQuery(sorting []Sorting) []string {
+	var orderByQuery []string
+	for _, s := range sorting {
+		orderByQuery = append(orderByQuery, fmt.Sprintf("%s.%s %s", models.TableNames.Orders, s.ColumnName, s.SortOrder))
+	}
+
+	return orderByQuery
+}
+
+type OrderExportRow struct {
+	OrderID       int                 `boil:"order_id"`
+	UserName      string              `boil:"user_name"`
+	UserEmail     string              `boil:"user_email"`
+	Status        string              `boil:"status"`
+	Region        string              `boil:"region"`
+	SubtotalPrice decimal.NullDecimal `boil:"subtotal_price"`
+	TaxPrice      decimal.NullDecimal `boil:"tax_price"`
+	ShippingPrice decimal.NullDecimal `boil:"shipping_price"`
+	TotalPrice    decimal.NullDecimal `boil:"total_price"`
+	Currency      string              `boil:"currency"`
+	CreatedAt     time.Time           `boil:"created_at"`
+	ItemID        int                 `boil:"item_id"`
+	ProductID     int                 `boil:"product_id"`
+	ProductName   string              `boil:"product_name"`
+	// SKU is the sku of the variant of the item, or of its product if the item is not a variant
+	SKU       string          `boil:"sku"`
+	Quantity  int             `boil:"quantity"`
+	Price     decimal.Decimal `boil:"price"`
+	TaxRate   decimal.Decimal `boil:"tax_rate"`
+	TaxAmount decimal.Decimal `boil:"tax_amount"`
+}
+
+// GetOrderExportRows retrieves the line items of the orders matching the filter, one row per item with
+// the fields of its order. The rows are not paginated
+func (r *Repository) GetOrderExportRows(ctx context.Context, filter OrderFilterRepo) ([]OrderExportRow, error) {
+	orderTable := models.TableNames.Orders
+	orderItemTable := models.TableNames.OrderItems
+	productTable := models.TableNames.Products
+	userTable := models.TableNames.Users
+	variantTable := models.TableNames.ProductVariants
+
+	queryMod := []qm.QueryMod{
+		// SELECT
+		qm.Select(
+			fmt.Sprintf(`%s.id as "order_id"`, orderTable),
+			fmt.Sprintf(`%s.name as "user_name"`, userTable),
+			fmt.Sprintf(`%s.email as "user_email"`, userTable),
+			fmt.Sprintf("%s.status", orderTable),
+			fmt.Sprintf("%s.region", orderTable),
+			fmt.Sprintf("%s.subtotal_price", orderTable),
+			fmt.Sprintf("%s.tax_price", orderTable),
+			fmt.Sprintf("%s.shipping_price", orderTable),
+			fmt.Sprintf("%s.total_price", orderTable),
+			fmt.Sprintf("%s.currency", orderTable),
+			fmt.Sprintf("%s.created_at", orderTable),
+			fmt.Sprintf(`%s.id as "item_id"`, orderItemTable),
+			fmt.Sprintf("%s.product_id", orderItemTable),
+			fmt.Sprintf(`%s.name as "product_name"`, productTable),
+			fmt.Sprintf(`COALESCE(%s.sku, %s.sku) as "sku"`, variantTable, productTable),
+			fmt.Sprintf("%s.quantity", orderItemTable),
+			fmt.Sprintf("%s.price", orderItemTable),
+			fmt.Sprintf("%s.tax_rate", orderItemTable),
+			fmt.Sprintf("%s.tax_amount", orderItemTable),
+		),
+
+		// INNER JOIN orders and order_items
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", orderItemTable, orderItemTable, models.OrderItemColumns.OrderID, orderTable, models.OrderColumns.ID)),
+
+		// INNER JOIN order_items and products
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", productTable, orderItemTable, models.OrderItemColumns.ProductID, productTable, models.ProductColumns.ID)),
+
+		// LEFT JOIN order_items and product_variants
+		qm.LeftOuterJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", variantTable, orderItemTable, models.OrderItemColumns.VariantID, variantTable, models.ProductVariantColumns.ID)),
+
+		// INNER JOIN orders and users
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", userTable, orderTable, models.OrderColumns.UserID, userTable, models.UserColumns.ID)),
+	}
+
+	// filter the date range and status
+	queryMod = append(queryMod, orderFilterQueryMods(filter)...)
+
+	// sorting, the items of an order stay together in the order they were added
+	orderByQuery := orderSortingQuery(filter.Sorting)
+	orderByQuery = append(orderByQuery, fmt.Sprintf("%s.id", orderTable), fmt.Sprintf("%s.id", orderItemTable))
+	queryMod = append(queryMod, qm.OrderBy(strings.Join(orderByQuery, ",")))
+
+	var rows []OrderExportRow
+	if err := models.Orders(queryMod...).Bind(ctx, boil.GetContextDB(), &rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// GetStaleOrders retrieves the orders in the given status which were created before the given time
+func (r *Repository) GetStaleOrders(ctx context.Context, status string, createdBefore time.Time) (models.OrderSlice, error) {
+	orderTable := models.TableNames.Orders
+
+	return models.Orders(
+		qm.Where(fmt.Sprintf("%s.%s = ?", orderTable, models.OrderColumns.Status), status),
+		qm.Where(fmt.Sprintf("%s.%s < ?", orderTable, models.OrderColumns.CreatedAt), createdBefore),
+		qm.OrderBy(fmt.Sprintf("%s.%s", orderTable, models.OrderColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+}
+
+// LockOrder retrieves an order in db by id and locks its row until the end of the transaction,
+// the cache is skipped so that the order is up to date
+func (r *Repository) LockOrder(ctx context.Context, tx *sql.Tx, orderID int) (models.Order, error) {
+	order, err := models.Orders(
+		qm.Where(fmt.Sprintf("%s = ?", models.OrderColumns.ID), orderID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Order{}, ErrOrderNotFound
+		}
+		return models.Order{}, err
+	}
+
+	return *order, nil
+}
+
 // BeginTx begins a transaction with the current global database handle
 func (r *Repository) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	tx, err := boil.BeginTx(ctx, nil)
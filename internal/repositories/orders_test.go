@@ -2,11 +2,13 @@ package repositories
 
 import (
 	context "context"
+	"fmt"
 	"math/big"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,26 +26,30 @@ func Test_OrderRepository_GetOrders(t *testing.T) {
 		"get orders successfully": {
 			expResult: []OrderOutputGraph{
 				{
-					ID:          1000,
-					UserName:    "Quang Thuy",
-					UserEmail:   "qthuy1000@gmail.com",
-					Status:      "Created",
-					TotalPrice:  decimal.NewFromBigInt(big.NewInt(120000), -2),
-					ItemID:      "{1000,1001}",
-					ProductName: `{"iPhone 14 1000","iPhone 14 1001"}`,
-					Quantity:    "{1,1}",
-					ItemPrice:   "{1200.00,1200.00}",
+					ID:            1000,
+					UserName:      "Quang Thuy",
+					UserEmail:     "qthuy1000@gmail.com",
+					Status:        "Created",
+					TotalPrice:    decimal.NewFromBigInt(big.NewInt(120000), -2),
+					ItemID:        "{1000,1001}",
+					ProductName:   `{"iPhone 14 1000","iPhone 14 1001"}`,
+					Quantity:      "{1,1}",
+					ItemPrice:     "{1200.00,1200.00}",
+					ItemTaxRate:   "{0.0000,0.0000}",
+					ItemTaxAmount: "{0.00,0.00}",
 				},
 				{
-					ID:          1001,
-					UserName:    "Quang Thuy",
-					UserEmail:   "qthuy1000@gmail.com",
-					Status:      "Created",
-					TotalPrice:  decimal.NewFromBigInt(big.NewInt(120000), -2),
-					ItemID:      "{1002,1003}",
-					ProductName: `{"iPhone 14 1000","iPhone 14 1001"}`,
-					Quantity:    "{1,1}",
-					ItemPrice:   "{1200.00,1200.00}",
+					ID:            1001,
+					UserName:      "Quang Thuy",
+					UserEmail:     "qthuy1000@gmail.com",
+					Status:        "Created",
+					TotalPrice:    decimal.NewFromBigInt(big.NewInt(120000), -2),
+					ItemID:        "{1002,1003}",
+					ProductName:   `{"iPhone 14 1000","iPhone 14 1001"}`,
+					Quantity:      "{1,1}",
+					ItemPrice:     "{1200.00,1200.00}",
+					ItemTaxRate:   "{0.0000,0.0000}",
+					ItemTaxAmount: "{0.00,0.00}",
 				},
 			},
 			totalCount: 2,
@@ -52,26 +58,30 @@ func Test_OrderRepository_GetOrders(t *testing.T) {
 		"get orders with sort order filter successfully": {
 			expResult: []OrderOutputGraph{
 				{
-					ID:          1001,
-					UserName:    "Quang Thuy",
-					UserEmail:   "qthuy1000@gmail.com",
-					Status:      "Updated",
-					TotalPrice:  decimal.NewFromBigInt(big.NewInt(120000), -2),
-					ItemID:      "{1002,1003}",
-					ProductName: `{"iPhone 14 1000","iPhone 14 1001"}`,
-					Quantity:    "{1,1}",
-					ItemPrice:   "{1200.00,1200.00}",
+					ID:            1001,
+					UserName:      "Quang Thuy",
+					UserEmail:     "qthuy1000@gmail.com",
+					Status:        "Updated",
+					TotalPrice:    decimal.NewFromBigInt(big.NewInt(120000), -2),
+					ItemID:        "{1002,1003}",
+					ProductName:   `{"iPhone 14 1000","iPhone 14 1001"}`,
+					Quantity:      "{1,1}",
+					ItemPrice:     "{1200.00,1200.00}",
+					ItemTaxRate:   "{0.0000,0.0000}",
+					ItemTaxAmount: "{0.00,0.00}",
 				},
 				{
-					ID:          1000,
-					UserName:    "Quang Thuy",
-					UserEmail:   "qthuy1000@gmail.com",
-					Status:      "Created",
-					TotalPrice:  decimal.NewFromBigInt(big.NewInt(120000), -2),
-					ItemID:      "{1000,1001}",
-					ProductName: `{"iPhone 14 1000","iPhone 14 1001"}`,
-					Quantity:    "{1,1}",
-					ItemPrice:   "{1200.00,1200.00}",
+					ID:            1000,
+					UserName:      "Quang Thuy",
+					UserEmail:     "qthuy1000@gmail.com",
+					Status:        "Created",
+					TotalPrice:    decimal.NewFromBigInt(big.NewInt(120000), -2),
+					ItemID:        "{1000,1001}",
+					ProductName:   `{"iPhone 14 1000","iPhone 14 1001"}`,
+					Quantity:      "{1,1}",
+					ItemPrice:     "{1200.00,1200.00}",
+					ItemTaxRate:   "{0.0000,0.0000}",
+					ItemTaxAmount: "{0.00,0.00}",
 				},
 			},
 			totalCount: 2,
@@ -80,15 +90,17 @@ func Test_OrderRepository_GetOrders(t *testing.T) {
 		"get orders with page size is 1, page number is 1 and sort status order is desc": {
 			expResult: []OrderOutputGraph{
 				{
-					ID:          1001,
-					UserName:    "Quang Thuy",
-					UserEmail:   "qthuy1000@gmail.com",
-					Status:      "Updated",
-					TotalPrice:  decimal.NewFromBigInt(big.NewInt(120000), -2),
-					ItemID:      "{1002,1003}",
-					ProductName: `{"iPhone 14 1000","iPhone 14 1001"}`,
-					Quantity:    "{1,1}",
-					ItemPrice:   "{1200.00,1200.00}",
+					ID:            1001,
+					UserName:      "Quang Thuy",
+					UserEmail:     "qthuy1000@gmail.com",
+					Status:        "Updated",
+					TotalPrice:    decimal.NewFromBigInt(big.NewInt(120000), -2),
+					ItemID:        "{1002,1003}",
+					ProductName:   `{"iPhone 14 1000","iPhone 14 1001"}`,
+					Quantity:      "{1,1}",
+					ItemPrice:     "{1200.00,1200.00}",
+					ItemTaxRate:   "{0.0000,0.0000}",
+					ItemTaxAmount: "{0.00,0.00}",
 				},
 			},
 			totalCount: 2,
@@ -131,3 +143,66 @@ func Test_OrderRepository_GetOrders(t *testing.T) {
 		})
 	}
 }
+
+func Test_OrderRepository_GetOrder(t *testing.T) {
+	// test cases
+	testCases := map[string]struct {
+		input       int
+		givenFn     string
+		expRate     decimal.NullDecimal
+		expShipping decimal.NullDecimal
+	}{
+		"order without exchange rate and shipping": {
+			input: 1000,
+		},
+		"order with exchange rate and shipping": {
+			input:       1001,
+			givenFn:     "UPDATE orders SET exchange_rate = 24000, shipping_price = 5 WHERE id = 1001",
+			expRate:     decimal.NewNullDecimal(decimal.New(24000, 0)),
+			expShipping: decimal.NewNullDecimal(decimal.New(5, 0)),
+		},
+	}
+
+	for desc, tc := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			// Given
+			db, dbErr := Initialize(os.Getenv("DB_URL"))
+			assert.NoError(t, dbErr)
+			boil.SetDB(db)
+
+			redis := RedisInitialize(os.Getenv("REDIS_PORT"), os.Getenv("REDIS_PASS"))
+
+			err := runSQLTest(db, "./datatest/orders/insert_order.sql")
+			assert.NoError(t, err)
+
+			defer runSQLTest(db, "./datatest/orders/rollback_insert_order.sql")
+
+			if tc.givenFn != "" {
+				_, err = db.Exec(tc.givenFn)
+				require.NoError(t, err)
+			}
+
+			repo := NewRepository(db, redis)
+			require.NoError(t, redis.Del(context.Background(), fmt.Sprintf("order:%d", tc.input)).Err())
+			defer redis.Del(context.Background(), fmt.Sprintf("order:%d", tc.input))
+
+			// When
+			// the first read caches the order, the second one reads it back from the cache
+			fromDB, err := repo.GetOrder(context.Background(), tc.input)
+			require.NoError(t, err)
+			fromCache, err := repo.GetOrder(context.Background(), tc.input)
+			require.NoError(t, err)
+
+			// Then
+			for _, result := range []models.Order{fromDB, fromCache} {
+				require.Equal(t, tc.expRate.Valid, result.ExchangeRate.Valid)
+				require.True(t, tc.expRate.Decimal.Equal(result.ExchangeRate.Decimal))
+				require.Equal(t, tc.expShipping.Valid, result.ShippingPrice.Valid)
+				require.True(t, tc.expShipping.Decimal.Equal(result.ShippingPrice.Decimal))
+				// the subtotal and tax are not set on the orders of the test data
+				require.False(t, result.SubtotalPrice.Valid)
+				require.False(t, result.TaxPrice.Valid)
+			}
+		})
+	}
+}
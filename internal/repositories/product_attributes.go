@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// types of the attributes of products
+const (
+	AttributeTypeText    = "text"
+	AttributeTypeNumber  = "number"
+	AttributeTypeBoolean = "boolean"
+)
+
+// AttributeDefinition is an attribute the products of a category can be described with, such as the RAM size of a smartphone
+type AttributeDefinition struct {
+	CategoryID int
+	Name       string
+	Type       string
+	Unit       string
+}
+
+// ProductAttributeValue is the value of an attribute of a product, ValueNumber is also set for a number attribute
+type ProductAttributeValue struct {
+	AttributeID int
+	Value       string
+	ValueNumber decimal.NullDecimal
+}
+
+// ProductAttributeOutput is the value of an attribute of a product with the definition of the attribute
+type ProductAttributeOutput struct {
+	ProductID   int    `boil:"product_id"`
+	AttributeID int    `boil:"attribute_id"`
+	Name        string `boil:"name"`
+	Type        string `boil:"type"`
+	Unit        string `boil:"unit"`
+	Value       string `boil:"value"`
+}
+
+// AttributeFilter matches the products which have the value of the attribute of the name, or a number value in the range.
+// The value is compared case-insensitively, and with the number value of the attribute if it is a number
+type AttributeFilter struct {
+	Name        string
+	Value       string
+	ValueNumber decimal.NullDecimal
+	Min         decimal.NullDecimal
+	Max         decimal.NullDecimal
+}
+
+// CreateAttributeDefinition creates an attribute definition of a category in db
+func (r *Repository) CreateAttributeDefinition(ctx context.Context, adReq AttributeDefinition) (models.AttributeDefinition, error) {
+	definition := models.AttributeDefinition{
+		CategoryID: adReq.CategoryID,
+		Name:       adReq.Name,
+		Type:       adReq.Type,
+		Unit:       adReq.Unit,
+	}
+
+	if err := definition.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return models.AttributeDefinition{}, pkgerrors.WithStack(err)
+	}
+
+	return definition, nil
+}
+
+// GetAttributeDefinitions retrieves the attribute definitions of a category in db in the order they were created
+func (r *Repository) GetAttributeDefinitions(ctx context.Context, categoryID int) ([]models.AttributeDefinition, error) {
+	definitions, err := models.AttributeDefinitions(
+		qm.Where(fmt.Sprintf("%s = ?", models.AttributeDefinitionColumns.CategoryID), categoryID),
+		qm.OrderBy(models.AttributeDefinitionColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.AttributeDefinition
+	for _, d := range definitions {
+		result = append(result, *d)
+	}
+
+	return result, nil
+}
+
+// SetProductAttributes replaces the values of the attributes of a product with the given ones
+func (r *Repository) SetProductAttributes(ctx context.Context, tx *sql.Tx, productID int, values []ProductAttributeValue) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	if _, err := models.ProductAttributeValues(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductAttributeValueColumns.ProductID), productID),
+	).DeleteAll(ctx, ctxExec); err != nil {
+		return err
+	}
+
+	for _, v := range values {
+		value := models.ProductAttributeValue{
+			ProductID:   productID,
+			AttributeID: v.AttributeID,
+			Value:       v.Value,
+			ValueNumber: v.ValueNumber,
+		}
+		if err := value.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// GetProductAttributes retrieves the values of the attributes of the products with their definitions, sorted by product and
+// by the order the attributes were defined. The values of the attributes of another category than the one of the product
+// are kept in db, in case the category of the product is changed back, but they are not retrieved
+func (r *Repository) GetProductAttributes(ctx context.Context, productIDs ...int) ([]ProductAttributeOutput, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	valueTable, definitionTable, productTable := models.TableNames.ProductAttributeValues, models.TableNames.AttributeDefinitions, models.TableNames.Products
+
+	var attributes []ProductAttributeOutput
+	if err := models.ProductAttributeValues(
+		qm.Select(
+			fmt.Sprintf("%s.%s as product_id", valueTable, models.ProductAttributeValueColumns.ProductID),
+			fmt.Sprintf("%s.%s as attribute_id", valueTable, models.ProductAttributeValueColumns.AttributeID),
+			fmt.Sprintf("%s.%s as name", definitionTable, models.AttributeDefinitionColumns.Name),
+			fmt.Sprintf("%s.%s as type", definitionTable, models.AttributeDefinitionColumns.Type),
+			fmt.Sprintf("%s.%s as unit", definitionTable, models.AttributeDefinitionColumns.Unit),
+			fmt.Sprintf("%s.%s as value", valueTable, models.ProductAttributeValueColumns.Value),
+		),
+		qm.InnerJoin(fmt.Sprintf("%s on %s.%s = %s.%s", definitionTable, definitionTable, models.AttributeDefinitionColumns.ID, valueTable, models.ProductAttributeValueColumns.AttributeID)),
+		qm.InnerJoin(fmt.Sprintf("%s on %s.%s = %s.%s AND %s.%s = %s.%s", productTable, productTable, models.ProductColumns.ID, valueTable, models.ProductAttributeValueColumns.ProductID, productTable, models.ProductColumns.CategoryID, definitionTable, models.AttributeDefinitionColumns.CategoryID)),
+		qm.Where(fmt.Sprintf("%s.%s = ANY(?)", valueTable, models.ProductAttributeValueColumns.ProductID), pq.Array(productIDs)),
+		qm.OrderBy(fmt.Sprintf("%s.%s, %s.%s", valueTable, models.ProductAttributeValueColumns.ProductID, definitionTable, models.AttributeDefinitionColumns.ID)),
+	).Bind(ctx, boil.GetContextDB(), &attributes); err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// attributeFilterQueryMod returns the where clause of the products which have a value of the attribute of the filter
+// matching it, only the attributes of the category of the product are matched
+func attributeFilterQueryMod(filter AttributeFilter) qm.QueryMod {
+	valueTable, definitionTable, productTable := models.TableNames.ProductAttributeValues, models.TableNames.AttributeDefinitions, models.TableNames.Products
+
+	clause := fmt.Sprintf("EXISTS (SELECT 1 FROM %s INNER JOIN %s ON %s.%s = %s.%s WHERE %s.%s = %s.%s AND %s.%s = %s.%s AND lower(%s.%s) = lower(?)",
+		valueTable, definitionTable, definitionTable, models.AttributeDefinitionColumns.ID, valueTable, models.ProductAttributeValueColumns.AttributeID,
+		valueTable, models.ProductAttributeValueColumns.ProductID, productTable, models.ProductColumns.ID,
+		definitionTable, models.AttributeDefinitionColumns.CategoryID, productTable, models.ProductColumns.CategoryID,
+		definitionTable, models.AttributeDefinitionColumns.Name)
+	args := []interface{}{filter.Name}
+
+	if filter.Value != "" {
+		clause += fmt.Sprintf(" AND (lower(%s.%s) = lower(?) OR %s.%s = ?)", valueTable, models.ProductAttributeValueColumns.Value, valueTable, models.ProductAttributeValueColumns.ValueNumber)
+		args = append(args, filter.Value, filter.ValueNumber)
+	}
+	if filter.Min.Valid {
+		clause += fmt.Sprintf(" AND %s.%s >= ?", valueTable, models.ProductAttributeValueColumns.ValueNumber)
+		args = append(args, filter.Min.Decimal)
+	}
+	if filter.Max.Valid {
+		clause += fmt.Sprintf(" AND %s.%s <= ?", valueTable, models.ProductAttributeValueColumns.ValueNumber)
+		args = append(args, filter.Max.Decimal)
+	}
+
+	return qm.Where(clause+")", args...)
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ProductImage struct {
+	ProductID   int
+	Position    int
+	StorageKey  string
+	ContentType string
+	Size        int
+	Width       int
+	Height      int
+}
+
+// CreateProductImage adds an image to the gallery of a product
+func (r *Repository) CreateProductImage(ctx context.Context, iReq ProductImage) (models.ProductImage, error) {
+	image := models.ProductImage{
+		ProductID:   iReq.ProductID,
+		Position:    iReq.Position,
+		StorageKey:  iReq.StorageKey,
+		ContentType: iReq.ContentType,
+		Size:        iReq.Size,
+		Width:       iReq.Width,
+		Height:      iReq.Height,
+	}
+	if err := image.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return models.ProductImage{}, err
+	}
+
+	return image, nil
+}
+
+// GetProductImage retrieves an image in db by ID
+func (r *Repository) GetProductImage(ctx context.Context, id int) (models.ProductImage, error) {
+	image, err := models.FindProductImage(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductImage{}, ErrProductImageNotFound
+		}
+		return models.ProductImage{}, err
+	}
+
+	return *image, nil
+}
+
+// GetProductImages retrieves the galleries of the products, sorted by product and position
+func (r *Repository) GetProductImages(ctx context.Context, productIDs ...int) ([]models.ProductImage, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]interface{}, 0, len(productIDs))
+	for _, id := range productIDs {
+		ids = append(ids, id)
+	}
+
+	images, err := models.ProductImages(
+		qm.WhereIn(fmt.Sprintf("%s IN ?", models.ProductImageColumns.ProductID), ids...),
+		qm.OrderBy(fmt.Sprintf("%s, %s, %s", models.ProductImageColumns.ProductID, models.ProductImageColumns.Position, models.ProductImageColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ProductImage
+	for _, i := range images {
+		result = append(result, *i)
+	}
+
+	return result, nil
+}
+
+// SetProductImagePositions sets the position of each image of a product to its index in imageIDs
+func (r *Repository) SetProductImagePositions(ctx context.Context, tx *sql.Tx, productID int, imageIDs []int) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	for position, id := range imageIDs {
+		if _, err := models.ProductImages(
+			qm.Where(fmt.Sprintf("%s = ?", models.ProductImageColumns.ID), id),
+			qm.Where(fmt.Sprintf("%s = ?", models.ProductImageColumns.ProductID), productID),
+		).UpdateAll(ctx, ctxExec, models.M{models.ProductImageColumns.Position: position}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteProductImage deletes an image in db by ID
+func (r *Repository) DeleteProductImage(ctx context.Context, tx *sql.Tx, id int) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	if _, err := models.ProductImages(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductImageColumns.ID), id),
+	).DeleteAll(ctx, ctxExec); err != nil {
+		return err
+	}
+	return nil
+}
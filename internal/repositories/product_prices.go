@@ -0,0 +1,277 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ProductPrice struct {
+	ProductID     int
+	Price         decimal.Decimal
+	Currency      string
+	EffectiveFrom time.Time
+	// AppliedAt is when the price has been set on the product, it is null for a price scheduled in the future
+	AppliedAt null.Time
+}
+
+// SetProductPrice adds a price to the price history of a product from the effective time. The price in effect at that time
+// ends there and the new price lasts until the next price of the history, a price starting at the same time is replaced
+func (r *Repository) SetProductPrice(ctx context.Context, tx *sql.Tx, ppReq ProductPrice) (models.ProductPrice, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	// the time is stored with a precision of microseconds
+	at := ppReq.EffectiveFrom.Truncate(time.Microsecond)
+
+	current, err := models.ProductPrices(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.ProductID), ppReq.ProductID),
+		qm.Where(fmt.Sprintf("%s <= ?", models.ProductPriceColumns.EffectiveFrom), at),
+		qm.Where(fmt.Sprintf("(%s IS NULL OR %s > ?)", models.ProductPriceColumns.EffectiveTo, models.ProductPriceColumns.EffectiveTo), at),
+		qm.OrderBy(fmt.Sprintf("%s DESC", models.ProductPriceColumns.EffectiveFrom)),
+		qm.For("UPDATE"),
+	).One(ctx, ctxExec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return models.ProductPrice{}, err
+	}
+
+	if current != nil && current.EffectiveFrom.Equal(at) {
+		current.Price = ppReq.Price
+		current.Currency = ppReq.Currency
+		current.AppliedAt = ppReq.AppliedAt
+		if _, err := current.Update(ctx, ctxExec, boil.Whitelist(
+			models.ProductPriceColumns.Price,
+			models.ProductPriceColumns.Currency,
+			models.ProductPriceColumns.AppliedAt,
+			models.ProductPriceColumns.UpdatedAt,
+		)); err != nil {
+			return models.ProductPrice{}, err
+		}
+		return *current, nil
+	}
+
+	productPrice := models.ProductPrice{
+		ProductID:     ppReq.ProductID,
+		Price:         ppReq.Price,
+		Currency:      ppReq.Currency,
+		EffectiveFrom: at,
+		AppliedAt:     ppReq.AppliedAt,
+	}
+
+	if current != nil {
+		productPrice.EffectiveTo = current.EffectiveTo
+		current.EffectiveTo = null.TimeFrom(at)
+		if _, err := current.Update(ctx, ctxExec, boil.Whitelist(models.ProductPriceColumns.EffectiveTo, models.ProductPriceColumns.UpdatedAt)); err != nil {
+			return models.ProductPrice{}, err
+		}
+	} else {
+		// the price is before the start of the history, it lasts until the first price
+		next, err := models.ProductPrices(
+			qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.ProductID), ppReq.ProductID),
+			qm.Where(fmt.Sprintf("%s > ?", models.ProductPriceColumns.EffectiveFrom), at),
+			qm.OrderBy(models.ProductPriceColumns.EffectiveFrom),
+		).One(ctx, ctxExec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return models.ProductPrice{}, err
+		}
+		if next != nil {
+			productPrice.EffectiveTo = null.TimeFrom(next.EffectiveFrom)
+		}
+	}
+
+	if err := productPrice.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+		return models.ProductPrice{}, pkgerrors.WithStack(err)
+	}
+
+	return productPrice, nil
+}
+
+// GetProductPrice retrieves a price of the price history in db by ID
+func (r *Repository) GetProductPrice(ctx context.Context, id int) (models.ProductPrice, error) {
+	productPrice, err := models.FindProductPrice(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductPrice{}, ErrProductPriceNotFound
+		}
+		return models.ProductPrice{}, err
+	}
+
+	return *productPrice, nil
+}
+
+// GetProductPrices retrieves the price history of a product sorted by effective time, the scheduled prices included
+func (r *Repository) GetProductPrices(ctx context.Context, productID int) ([]models.ProductPrice, error) {
+	productPrices, err := models.ProductPrices(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.ProductID), productID),
+		qm.OrderBy(models.ProductPriceColumns.EffectiveFrom),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ProductPrice
+	for _, pp := range productPrices {
+		result = append(result, *pp)
+	}
+
+	return result, nil
+}
+
+// GetEffectiveProductPrice retrieves the price of a product which is in effect at the given time
+func (r *Repository) GetEffectiveProductPrice(ctx context.Context, productID int, at time.Time) (models.ProductPrice, error) {
+	productPrice, err := models.ProductPrices(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.ProductID), productID),
+		qm.Where(fmt.Sprintf("%s <= ?", models.ProductPriceColumns.EffectiveFrom), at),
+		qm.Where(fmt.Sprintf("(%s IS NULL OR %s > ?)", models.ProductPriceColumns.EffectiveTo, models.ProductPriceColumns.EffectiveTo), at),
+		qm.OrderBy(fmt.Sprintf("%s DESC", models.ProductPriceColumns.EffectiveFrom)),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductPrice{}, ErrProductPriceNotFound
+		}
+		return models.ProductPrice{}, err
+	}
+
+	return *productPrice, nil
+}
+
+// GetDueProductPrices retrieves the scheduled prices which are effective at the time but have not been set on their products,
+// sorted by product and effective time
+func (r *Repository) GetDueProductPrices(ctx context.Context, at time.Time) ([]models.ProductPrice, error) {
+	productPrices, err := models.ProductPrices(
+		qm.Where(fmt.Sprintf("%s IS NULL", models.ProductPriceColumns.AppliedAt)),
+		qm.Where(fmt.Sprintf("%s <= ?", models.ProductPriceColumns.EffectiveFrom), at),
+		qm.OrderBy(fmt.Sprintf("%s, %s", models.ProductPriceColumns.ProductID, models.ProductPriceColumns.EffectiveFrom)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ProductPrice
+	for _, pp := range productPrices {
+		result = append(result, *pp)
+	}
+
+	return result, nil
+}
+
+// ApplyProductPrice sets a price of the history on its product, the prices of the product up to it which have not been set
+// are marked as applied as well. The cached product is not changed, it is removed by DeleteProductCache once the price is committed
+func (r *Repository) ApplyProductPrice(ctx context.Context, tx *sql.Tx, productPrice models.ProductPrice) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	now := time.Now()
+	if _, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.ID), productPrice.ProductID),
+	).UpdateAll(ctx, ctxExec, models.M{
+		models.ProductColumns.Price:     productPrice.Price,
+		models.ProductColumns.Currency:  productPrice.Currency,
+		models.ProductColumns.UpdatedAt: now,
+	}); err != nil {
+		return err
+	}
+
+	return r.MarkProductPriceApplied(ctx, tx, productPrice)
+}
+
+// MarkProductPriceApplied marks a price of the history and the prices of the product up to it which have not been set as applied,
+// the product is not changed
+func (r *Repository) MarkProductPriceApplied(ctx context.Context, tx *sql.Tx, productPrice models.ProductPrice) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	now := time.Now()
+	_, err := models.ProductPrices(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.ProductID), productPrice.ProductID),
+		qm.Where(fmt.Sprintf("%s <= ?", models.ProductPriceColumns.EffectiveFrom), productPrice.EffectiveFrom),
+		qm.Where(fmt.Sprintf("%s IS NULL", models.ProductPriceColumns.AppliedAt)),
+	).UpdateAll(ctx, ctxExec, models.M{
+		models.ProductPriceColumns.AppliedAt: now,
+		models.ProductPriceColumns.UpdatedAt: now,
+	})
+	return err
+}
+
+// DeleteProductPrice removes a price from the price history of a product, the price before it lasts until the next price instead
+func (r *Repository) DeleteProductPrice(ctx context.Context, tx *sql.Tx, id int) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	productPrice, err := models.FindProductPrice(ctx, ctxExec, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrProductPriceNotFound
+		}
+		return err
+	}
+
+	if _, err := models.ProductPrices(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.ProductID), productPrice.ProductID),
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductPriceColumns.EffectiveTo), productPrice.EffectiveFrom),
+	).UpdateAll(ctx, ctxExec, models.M{
+		models.ProductPriceColumns.EffectiveTo: productPrice.EffectiveTo,
+		models.ProductPriceColumns.UpdatedAt:   time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := productPrice.Delete(ctx, ctxExec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recordProductPriceChanges adds the current price of the products of the names to their price history if it is not
+// the price in effect at the time, it is used when the products are updated in bulk
+func (r *Repository) recordProductPriceChanges(ctx context.Context, tx *sql.Tx, names []string, at time.Time) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT p.id, p.price, p.currency FROM products p
+		LEFT JOIN product_prices pp ON pp.product_id = p.id AND pp.effective_from <= $2 AND (pp.effective_to IS NULL OR pp.effective_to > $2)
+		WHERE p.name = ANY($1) AND (pp.id IS NULL OR pp.price <> p.price OR pp.currency <> p.currency)`,
+		pq.Array(names), at)
+	if err != nil {
+		return err
+	}
+
+	var changed []ProductPrice
+	for rows.Next() {
+		pp := ProductPrice{EffectiveFrom: at, AppliedAt: null.TimeFrom(at)}
+		if err := rows.Scan(&pp.ProductID, &pp.Price, &pp.Currency); err != nil {
+			rows.Close()
+			return err
+		}
+		changed = append(changed, pp)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, pp := range changed {
+		if _, err := r.SetProductPrice(ctx, tx, pp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
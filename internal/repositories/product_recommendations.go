@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/redis/go-redis/v9"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// relatedProductsKey is the redis hash holding the IDs of the related products of each product, keyed by product ID
+const relatedProductsKey = "products:related"
+
+type ProductCoPurchase struct {
+	ProductID        int `boil:"product_id"`
+	RelatedProductID int `boil:"related_product_id"`
+	OrderCount       int `boil:"order_count"`
+}
+
+// GetProductCoPurchases counts for each pair of products the orders in the statuses created since the time which have items of both.
+// Only the limit pairs with the most orders of each product are kept, sorted by product, number of orders and related product.
+// The archived products are left out as related products
+func (r *Repository) GetProductCoPurchases(ctx context.Context, since time.Time, statuses []string, limit int) ([]ProductCoPurchase, error) {
+	orderItemTable := models.TableNames.OrderItems
+	orderTable := models.TableNames.Orders
+	productTable := models.TableNames.Products
+
+	query := fmt.Sprintf(`SELECT product_id, related_product_id, order_count FROM (
+		SELECT a.%[4]s AS product_id, b.%[4]s AS related_product_id, COUNT(DISTINCT a.%[5]s) AS order_count,
+			ROW_NUMBER() OVER (PARTITION BY a.%[4]s ORDER BY COUNT(DISTINCT a.%[5]s) DESC, b.%[4]s) AS rank
+		FROM %[1]s a
+		INNER JOIN %[1]s b ON b.%[5]s = a.%[5]s AND b.%[4]s <> a.%[4]s
+		INNER JOIN %[2]s ON %[2]s.%[6]s = a.%[5]s
+		INNER JOIN %[3]s ON %[3]s.%[7]s = b.%[4]s
+		WHERE %[2]s.%[8]s >= $1 AND %[2]s.%[9]s = ANY($2) AND %[3]s.%[10]s IS NULL
+		GROUP BY a.%[4]s, b.%[4]s
+	) pairs WHERE rank <= $3 ORDER BY product_id, order_count DESC, related_product_id`,
+		orderItemTable, orderTable, productTable,
+		models.OrderItemColumns.ProductID, models.OrderItemColumns.OrderID,
+		models.OrderColumns.ID, models.ProductColumns.ID,
+		models.OrderColumns.CreatedAt, models.OrderColumns.Status, models.ProductColumns.DeletedAt)
+
+	var coPurchases []ProductCoPurchase
+	if err := queries.Raw(query, since, pq.Array(statuses), limit).Bind(ctx, boil.GetContextDB(), &coPurchases); err != nil {
+		return nil, err
+	}
+
+	return coPurchases, nil
+}
+
+// SetRelatedProducts replaces the related products of all the products with the given ones, keyed by product ID.
+// The new ones are written aside and swapped in at once so that the products are never read without related products
+func (r *Repository) SetRelatedProducts(ctx context.Context, related map[int][]int) error {
+	newKey := relatedProductsKey + ":new"
+
+	pipe := r.Redis.TxPipeline()
+	pipe.Del(ctx, newKey)
+	for productID, ids := range related {
+		data, err := json.Marshal(ids)
+		if err != nil {
+			return err
+		}
+		pipe.HSet(ctx, newKey, strconv.Itoa(productID), data)
+	}
+	if len(related) == 0 {
+		pipe.Del(ctx, relatedProductsKey)
+	} else {
+		pipe.Rename(ctx, newKey, relatedProductsKey)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetRelatedProductIDs retrieves the IDs of the related products of a product, most related first.
+// No IDs are returned if the product has no related products
+func (r *Repository) GetRelatedProductIDs(ctx context.Context, productID int) ([]int, error) {
+	data, err := r.Redis.HGet(ctx, relatedProductsKey, strconv.Itoa(productID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var ids []int
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// GetTopProductsInCategory retrieves the active products of a category with the most units sold in the range
+func (r *Repository) GetTopProductsInCategory(ctx context.Context, filter AnalyticsFilter, categoryID, limit int) ([]TopSeller, error) {
+	productTable := models.TableNames.Products
+	return r.getTopSellers(ctx, fmt.Sprintf("products-of-category-%d", categoryID), filter, "units", limit,
+		fmt.Sprintf("%s.%s", productTable, models.ProductColumns.ID),
+		fmt.Sprintf("%s.%s", productTable, models.ProductColumns.Name),
+		qm.Where(fmt.Sprintf("%s.%s = ?", productTable, models.ProductColumns.CategoryID), categoryID),
+		qm.Where(fmt.Sprintf("%s.%s IS NULL", productTable, models.ProductColumns.DeletedAt)),
+	)
+}
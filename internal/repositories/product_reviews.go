@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ProductReview struct {
+	ProductID int
+	UserID    int
+	Rating    int
+	Title     string
+	Body      string
+	Status    string
+}
+
+// CreateProductReview creates a review of a product by a user
+func (r *Repository) CreateProductReview(ctx context.Context, prReq ProductReview) (models.ProductReview, error) {
+	review := models.ProductReview{
+		ProductID: prReq.ProductID,
+		UserID:    prReq.UserID,
+		Rating:    prReq.Rating,
+		Title:     prReq.Title,
+		Body:      prReq.Body,
+		Status:    prReq.Status,
+	}
+	if err := review.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return models.ProductReview{}, err
+	}
+
+	return review, nil
+}
+
+// GetProductReview retrieves a review of a product in db by ID
+func (r *Repository) GetProductReview(ctx context.Context, id int) (models.ProductReview, error) {
+	review, err := models.FindProductReview(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductReview{}, ErrProductReviewNotFound
+		}
+		return models.ProductReview{}, err
+	}
+
+	return *review, nil
+}
+
+// GetProductReviewByUser retrieves the review of a product by a user, a user reviews a product once
+func (r *Repository) GetProductReviewByUser(ctx context.Context, productID, userID int) (models.ProductReview, error) {
+	review, err := models.ProductReviews(
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.ProductReviewColumns.ProductID, models.ProductReviewColumns.UserID), productID, userID),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductReview{}, ErrProductReviewNotFound
+		}
+		return models.ProductReview{}, err
+	}
+
+	return *review, nil
+}
+
+// UpdateProductReviewStatus moves a review from a status to another with the note of the moderator, in the transaction
+// if given. ErrProductReviewNotFound is returned if the review is no longer in the from status so that a review
+// moderated concurrently is not counted twice in the rating of its product
+func (r *Repository) UpdateProductReviewStatus(ctx context.Context, tx *sql.Tx, id int, from, to, note string) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	now := time.Now()
+	rowsAff, err := models.ProductReviews(
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.ProductReviewColumns.ID, models.ProductReviewColumns.Status), id, from),
+	).UpdateAll(ctx, ctxExec, models.M{
+		models.ProductReviewColumns.Status:      to,
+		models.ProductReviewColumns.Note:        note,
+		models.ProductReviewColumns.ModeratedAt: now,
+		models.ProductReviewColumns.UpdatedAt:   now,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrProductReviewNotFound
+	}
+
+	return nil
+}
+
+type ProductReviewFilter struct {
+	ProductID int
+	// Statuses returns the reviews in any of the statuses, all of them if empty
+	Statuses []string
+	// Limit is the maximum number of reviews returned, all of them are returned if it is 0
+	Limit  int
+	Offset int
+}
+
+// productReviewFilterQueryMods returns the where clauses of the filter of reviews
+func productReviewFilterQueryMods(filter ProductReviewFilter) []qm.QueryMod {
+	queryMod := []qm.QueryMod{
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductReviewColumns.ProductID), filter.ProductID),
+	}
+	if len(filter.Statuses) != 0 {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s = ANY(?)", models.ProductReviewColumns.Status), pq.Array(filter.Statuses)))
+	}
+
+	return queryMod
+}
+
+// GetProductReviews retrieves the reviews of a product matching the filter, newest first
+func (r *Repository) GetProductReviews(ctx context.Context, filter ProductReviewFilter) ([]models.ProductReview, error) {
+	queryMod := append(productReviewFilterQueryMods(filter),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", models.ProductReviewColumns.CreatedAt, models.ProductReviewColumns.ID)),
+	)
+	if filter.Limit > 0 {
+		queryMod = append(queryMod, qm.Limit(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		queryMod = append(queryMod, qm.Offset(filter.Offset))
+	}
+
+	reviews, err := models.ProductReviews(queryMod...).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ProductReview
+	for _, pr := range reviews {
+		result = append(result, *pr)
+	}
+
+	return result, nil
+}
+
+// CountProductReviews counts the reviews of a product matching the filter, the pagination of the filter is ignored
+func (r *Repository) CountProductReviews(ctx context.Context, filter ProductReviewFilter) (int64, error) {
+	return models.ProductReviews(productReviewFilterQueryMods(filter)...).Count(ctx, boil.GetContextDB())
+}
+
+// AddProductRating adds a number of reviews and the total of their ratings to the rating of a product, the negative
+// values remove them. The average is computed from the running totals so that the reviews are not read again
+func (r *Repository) AddProductRating(ctx context.Context, tx *sql.Tx, productID, count, total int) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	// the expressions of the SET clause read the values of the row before the update
+	res, err := ctxExec.ExecContext(ctx, fmt.Sprintf(
+		`UPDATE "%s" SET review_count = review_count + $2, rating_total = rating_total + $3,
+		rating_average = CASE WHEN review_count + $2 > 0 THEN round((rating_total + $3)::numeric / (review_count + $2), 2) ELSE 0 END
+		WHERE id = $1`, models.TableNames.Products), productID, count, total)
+	if err != nil {
+		return err
+	}
+	rowsAff, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// productSearchConfig is the text search configuration of the products, it unaccents the words so that
+// accented Vietnamese text matches unaccented queries
+const productSearchConfig = "product_search"
+
+// productNameSearchQuery is the SQL expression of the unaccented name of a product, it is indexed with pg_trgm
+const productNameSearchQuery = "lower(immutable_unaccent(products.name))"
+
+// the highlighted words are wrapped in <b> tags, the snippet is made of the best fragments of the description
+const (
+	productNameHighlightOptions = "HighlightAll=true, StartSel=<b>, StopSel=</b>"
+	productSnippetOptions       = "StartSel=<b>, StopSel=</b>, MaxWords=35, MinWords=15, MaxFragments=2"
+)
+
+type ProductSearchFilter struct {
+	Query  string
+	Limit  int
+	Offset int
+}
+
+type ProductSearchResult struct {
+	ProductOutput `boil:",bind"`
+	// Rank is the full-text rank of the product plus the similarity of its name to the query
+	Rank          float64 `boil:"rank"`
+	NameHighlight string  `boil:"name_highlight"`
+	Snippet       string  `boil:"snippet"`
+	// TotalCount is the number of products matching the query in all pages
+	TotalCount int64 `boil:"total_count"`
+}
+
+// SearchProducts retrieves a page of the products whose name or description match the query, the best matches first.
+// A product also matches if its name is similar to the query so that typos are tolerated
+func (r *Repository) SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]ProductSearchResult, error) {
+	productTable := models.TableNames.Products
+	productCategoryTable := models.TableNames.ProductCategories
+
+	queryMod := []qm.QueryMod{
+		qm.Select(
+			fmt.Sprintf("%s.%s as id, %s.%s as name, %s.%s as description, %s.%s, %s.%s, %s.%s, %s.%s as category, %s.%s as currency, %s.%s as created_at, %s.%s as updated_at, %s.%s, %s.%s",
+				productTable, models.ProductColumns.ID, productTable, models.ProductColumns.Name, productTable, models.ProductColumns.Description,
+				productTable, models.ProductColumns.Price, productTable, models.ProductColumns.Quantity, productTable, models.ProductColumns.AuthorID,
+				productCategoryTable, models.ProductCategoryColumns.Name, productTable, models.ProductColumns.Currency,
+				productTable, models.ProductColumns.CreatedAt, productTable, models.ProductColumns.UpdatedAt,
+				productTable, models.ProductColumns.ReviewCount, productTable, models.ProductColumns.RatingAverage),
+			fmt.Sprintf("ts_rank(%s.search_vector, search.query) + word_similarity(search.term, %s) as rank", productTable, productNameSearchQuery),
+			fmt.Sprintf("ts_headline('%s', %s.%s, search.query, '%s') as name_highlight", productSearchConfig, productTable, models.ProductColumns.Name, productNameHighlightOptions),
+			fmt.Sprintf("ts_headline('%s', %s.%s, search.query, '%s') as snippet", productSearchConfig, productTable, models.ProductColumns.Description, productSnippetOptions),
+			"count(*) OVER() as total_count",
+		),
+		// the query is parsed once and joined to every product
+		qm.InnerJoin(fmt.Sprintf("(SELECT websearch_to_tsquery('%s', ?) as query, lower(immutable_unaccent(?)) as term) search ON TRUE", productSearchConfig), filter.Query, filter.Query),
+		qm.InnerJoin(fmt.Sprintf("%s on %s.%s = %s.%s", productCategoryTable, productTable, models.ProductColumns.CategoryID, productCategoryTable, models.ProductCategoryColumns.ID)),
+		qm.Where(fmt.Sprintf("(%s.search_vector @@ search.query OR search.term <%% %s)", productTable, productNameSearchQuery)),
+		qm.Where(fmt.Sprintf("%s.%s IS NULL", productTable, models.ProductColumns.DeletedAt)),
+		qm.OrderBy(fmt.Sprintf("rank DESC, %s.%s", productTable, models.ProductColumns.ID)),
+		qm.Limit(filter.Limit),
+		qm.Offset(filter.Offset),
+	}
+
+	var results []ProductSearchResult
+	if err := models.Products(queryMod...).Bind(ctx, boil.GetContextDB(), &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// SetProductTags replaces the tags of a product with the given ones
+func (r *Repository) SetProductTags(ctx context.Context, tx *sql.Tx, productID int, tags []string) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	if _, err := models.ProductTags(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductTagColumns.ProductID), productID),
+	).DeleteAll(ctx, ctxExec); err != nil {
+		return err
+	}
+
+	for _, t := range tags {
+		tag := models.ProductTag{ProductID: productID, Tag: t}
+		if err := tag.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// GetProductTags retrieves the tags of the products sorted by product and tag
+func (r *Repository) GetProductTags(ctx context.Context, productIDs ...int) ([]models.ProductTag, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	tags, err := models.ProductTags(
+		qm.Where(fmt.Sprintf("%s = ANY(?)", models.ProductTagColumns.ProductID), pq.Array(productIDs)),
+		qm.OrderBy(fmt.Sprintf("%s, %s", models.ProductTagColumns.ProductID, models.ProductTagColumns.Tag)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ProductTag
+	for _, t := range tags {
+		result = append(result, *t)
+	}
+
+	return result, nil
+}
+
+// tagFilterQueryMod returns the where clause of the products which have all the tags
+func tagFilterQueryMod(tags []string) qm.QueryMod {
+	return qm.Where(fmt.Sprintf("%s.%s IN (SELECT %s FROM %s WHERE %s = ANY(?) GROUP BY %s HAVING count(*) = ?)",
+		models.TableNames.Products, models.ProductColumns.ID,
+		models.ProductTagColumns.ProductID, models.TableNames.ProductTags, models.ProductTagColumns.Tag, models.ProductTagColumns.ProductID),
+		pq.Array(tags), len(tags))
+}
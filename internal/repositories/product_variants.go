@@ -0,0 +1,239 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ProductVariant struct {
+	ProductID int
+	SKU       string
+	// Price overrides the price of the product if valid
+	Price    decimal.NullDecimal
+	Quantity int
+	// Options maps the ID of an option type of the product to the value of the variant
+	Options map[int]string
+}
+
+type VariantOption struct {
+	OptionTypeID int
+	Name         string
+	Value        string
+}
+
+type ProductVariantOutput struct {
+	Variant models.ProductVariant
+	// Options are sorted by the position of their option type
+	Options []VariantOption
+}
+
+// GetOptionTypes retrieves the option types of a product sorted by position
+func (r *Repository) GetOptionTypes(ctx context.Context, productID int) ([]models.OptionType, error) {
+	optionTypes, err := models.OptionTypes(
+		qm.Where(fmt.Sprintf("%s = ?", models.OptionTypeColumns.ProductID), productID),
+		qm.OrderBy(fmt.Sprintf("%s, %s", models.OptionTypeColumns.Position, models.OptionTypeColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.OptionType
+	for _, ot := range optionTypes {
+		result = append(result, *ot)
+	}
+
+	return result, nil
+}
+
+// CreateOptionType creates an option type of a product such as Size or Color
+func (r *Repository) CreateOptionType(ctx context.Context, tx *sql.Tx, productID int, name string, position int) (models.OptionType, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	optionType := models.OptionType{
+		ProductID: productID,
+		Name:      name,
+		Position:  position,
+	}
+	if err := optionType.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+		return models.OptionType{}, err
+	}
+
+	return optionType, nil
+}
+
+// CreateVariant creates a variant of a product with its option values
+func (r *Repository) CreateVariant(ctx context.Context, tx *sql.Tx, vReq ProductVariant) (models.ProductVariant, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	variant := models.ProductVariant{
+		ProductID: vReq.ProductID,
+		Sku:       vReq.SKU,
+		Price:     vReq.Price,
+		Quantity:  vReq.Quantity,
+	}
+	if err := variant.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+		return models.ProductVariant{}, err
+	}
+
+	// the quantity of the variant opens its stock ledger
+	if variant.Quantity != 0 {
+		if _, err := insertStockMovement(ctx, ctxExec, StockMovement{
+			ProductID: variant.ProductID,
+			VariantID: null.IntFrom(variant.ID),
+			Delta:     variant.Quantity,
+			Reason:    StockReasonAdjustment,
+			Note:      "initial stock",
+		}, variant.Quantity); err != nil {
+			return models.ProductVariant{}, err
+		}
+	}
+
+	if err := r.SetVariantOptions(ctx, tx, variant.ID, vReq.Options); err != nil {
+		return models.ProductVariant{}, err
+	}
+
+	return variant, nil
+}
+
+// SetVariantOptions sets the values of the options of a variant, the value of an option type the variant already has is replaced
+func (r *Repository) SetVariantOptions(ctx context.Context, tx *sql.Tx, variantID int, options map[int]string) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	for optionTypeID, value := range options {
+		optionValue := models.VariantOptionValue{
+			VariantID:    variantID,
+			OptionTypeID: optionTypeID,
+			Value:        value,
+		}
+		if err := optionValue.Upsert(ctx, ctxExec, true,
+			[]string{models.VariantOptionValueColumns.VariantID, models.VariantOptionValueColumns.OptionTypeID},
+			boil.Whitelist(models.VariantOptionValueColumns.Value, models.VariantOptionValueColumns.UpdatedAt),
+			boil.Infer(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetVariant retrieves a variant in db by ID
+func (r *Repository) GetVariant(ctx context.Context, id int) (models.ProductVariant, error) {
+	variant, err := models.FindProductVariant(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductVariant{}, ErrVariantNotFound
+		}
+		return models.ProductVariant{}, err
+	}
+
+	return *variant, nil
+}
+
+// GetVariantBySKU retrieves a variant in db by SKU
+func (r *Repository) GetVariantBySKU(ctx context.Context, sku string) (models.ProductVariant, error) {
+	variant, err := models.ProductVariants(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductVariantColumns.Sku), sku),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductVariant{}, ErrVariantNotFound
+		}
+		return models.ProductVariant{}, err
+	}
+
+	return *variant, nil
+}
+
+// UpdateVariant updates the price of a variant, the product and SKU of a variant never change and the quantity
+// only changes by the movements of the stock ledger
+func (r *Repository) UpdateVariant(ctx context.Context, tx *sql.Tx, vReq models.ProductVariant) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	if _, err := vReq.Update(ctx, ctxExec, boil.Blacklist("id", "product_id", "sku", "quantity", "created_at")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CountProductVariants counts the variants of a product
+func (r *Repository) CountProductVariants(ctx context.Context, productID int) (int64, error) {
+	return models.ProductVariants(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductVariantColumns.ProductID), productID),
+	).Count(ctx, boil.GetContextDB())
+}
+
+// GetProductVariants retrieves the variants of the products with their options, sorted by product and SKU
+func (r *Repository) GetProductVariants(ctx context.Context, productIDs ...int) ([]ProductVariantOutput, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]interface{}, 0, len(productIDs))
+	for _, id := range productIDs {
+		ids = append(ids, id)
+	}
+
+	variants, err := models.ProductVariants(
+		qm.WhereIn(fmt.Sprintf("%s IN ?", models.ProductVariantColumns.ProductID), ids...),
+		qm.Load(fmt.Sprintf("%s.%s", models.ProductVariantRels.VariantVariantOptionValues, models.VariantOptionValueRels.OptionType)),
+		qm.OrderBy(fmt.Sprintf("%s, %s", models.ProductVariantColumns.ProductID, models.ProductVariantColumns.Sku)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ProductVariantOutput
+	for _, v := range variants {
+		output := ProductVariantOutput{Variant: *v}
+
+		var optionValues []*models.VariantOptionValue
+		if v.R != nil {
+			for _, ov := range v.R.VariantVariantOptionValues {
+				if ov.R != nil && ov.R.OptionType != nil {
+					optionValues = append(optionValues, ov)
+				}
+			}
+		}
+		sort.Slice(optionValues, func(i, j int) bool {
+			if optionValues[i].R.OptionType.Position != optionValues[j].R.OptionType.Position {
+				return optionValues[i].R.OptionType.Position < optionValues[j].R.OptionType.Position
+			}
+			return optionValues[i].OptionTypeID < optionValues[j].OptionTypeID
+		})
+		for _, ov := range optionValues {
+			output.Options = append(output.Options, VariantOption{
+				OptionTypeID: ov.OptionTypeID,
+				Name:         ov.R.OptionType.Name,
+				Value:        ov.Value,
+			})
+		}
+
+		// the relationships are not part of the output
+		output.Variant.R = nil
+		result = append(result, output)
+	}
+
+	return result, nil
+}
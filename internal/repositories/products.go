@@ -8,44 +8,141 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type Product struct {
-	ID          int             `redis:"id"`
-	Name        string          `redis:"name"`
-	Description string          `redis:"description"`
-	Price       decimal.Decimal `redis:"price"`
-	Quantity    int             `redis:"quantity"`
-	AuthorID    int             `redis:"author_id"`
-	CategoryID  int             `redis:"category_id"`
-	CreatedAt   time.Time       `redis:"created_at"`
-	UpdatedAt   time.Time       `redis:"updated_at"`
+	ID               int             `redis:"id"`
+	Name             string          `redis:"name"`
+	Description      string          `redis:"description"`
+	Price            decimal.Decimal `redis:"price"`
+	Quantity         int             `redis:"quantity"`
+	AuthorID         int             `redis:"author_id"`
+	CategoryID       int             `redis:"category_id"`
+	Weight           decimal.Decimal `redis:"weight"`
+	Currency         string          `redis:"currency"`
+	CreatedAt        time.Time       `redis:"created_at"`
+	UpdatedAt        time.Time       `redis:"updated_at"`
+	DeletedAt        null.Time       `redis:"deleted_at"`
+	ReorderThreshold int             `redis:"reorder_threshold"`
+	ReviewCount      int             `redis:"review_count"`
+	RatingTotal      int             `redis:"rating_total"`
+	RatingAverage    decimal.Decimal `redis:"rating_average"`
+	Version          int             `redis:"version"`
+	SKU              string          `redis:"sku"`
+	Slug             string          `redis:"slug"`
+	// WarehouseID is the warehouse the quantity of a created or imported product is kept in, the default warehouse if 0
+	WarehouseID int `redis:"-"`
+	// Tags and Attributes replace the ones of an imported product if not nil, they are kept otherwise
+	Tags       []string                `redis:"-"`
+	Attributes []ProductAttributeValue `redis:"-"`
+}
+
+// productCacheKey is the key of the hash of a product in redis
+func productCacheKey(id int) string {
+	return fmt.Sprintf("product:%d", id)
+}
+
+// productCacheFields returns the fields of the hash of a product in redis
+func productCacheFields(product models.Product) map[string]interface{} {
+	// an active product has an empty deleted_at which is scanned as null
+	deletedAt := ""
+	if product.DeletedAt.Valid {
+		deletedAt = product.DeletedAt.Time.Format(time.RFC3339Nano)
+	}
+
+	return map[string]interface{}{
+		"id":                product.ID,
+		"name":              product.Name,
+		"description":       product.Description,
+		"price":             product.Price.String(),
+		"quantity":          product.Quantity,
+		"author_id":         product.AuthorID,
+		"category_id":       product.CategoryID,
+		"weight":            product.Weight.String(),
+		"currency":          product.Currency,
+		"created_at":        product.CreatedAt,
+		"updated_at":        product.UpdatedAt,
+		"deleted_at":        deletedAt,
+		"reorder_threshold": product.ReorderThreshold,
+		"review_count":      product.ReviewCount,
+		"rating_total":      product.RatingTotal,
+		"rating_average":    product.RatingAverage.String(),
+		"version":           product.Version,
+		"sku":               product.Sku,
+		"slug":              product.Slug,
+	}
 }
 
 // CreateProduct creates a product in db given by product model in parameter
 func (r *Repository) CreateProduct(ctx context.Context, pReq Product) error {
 	product := models.Product{
-		Name:        pReq.Name,
-		Description: pReq.Description,
-		Price:       pReq.Price,
-		Quantity:    pReq.Quantity,
-		AuthorID:    pReq.AuthorID,
-		CategoryID:  pReq.CategoryID,
-	}
-	if err := product.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		Sku:              pReq.SKU,
+		Name:             pReq.Name,
+		Description:      pReq.Description,
+		Price:            pReq.Price,
+		AuthorID:         pReq.AuthorID,
+		CategoryID:       pReq.CategoryID,
+		Weight:           pReq.Weight,
+		Currency:         pReq.Currency,
+		ReorderThreshold: pReq.ReorderThreshold,
+	}
+
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := product.Insert(ctx, tx, boil.Infer()); err != nil {
 		return pkgerrors.WithStack(err)
 	}
-	return nil
+
+	// the quantity of the product opens its stock ledger in its warehouse
+	if pReq.Quantity != 0 {
+		if _, err := r.RecordStockMovement(ctx, tx, StockMovement{
+			ProductID:   product.ID,
+			WarehouseID: null.NewInt(pReq.WarehouseID, pReq.WarehouseID != 0),
+			Delta:       pReq.Quantity,
+			Reason:      StockReasonAdjustment,
+			ActorID:     null.IntFrom(product.AuthorID),
+			Note:        "initial stock",
+		}); err != nil {
+			return err
+		}
+	}
+
+	// the price of the product starts its price history
+	if _, err := r.SetProductPrice(ctx, tx, ProductPrice{
+		ProductID:     product.ID,
+		Price:         product.Price,
+		Currency:      product.Currency,
+		EffectiveFrom: product.CreatedAt,
+		AppliedAt:     null.TimeFrom(product.CreatedAt),
+	}); err != nil {
+		return err
+	}
+
+	if err := r.SetProductTags(ctx, tx, product.ID, pReq.Tags); err != nil {
+		return err
+	}
+
+	if err := r.SetProductAttributes(ctx, tx, product.ID, pReq.Attributes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-// GetProduct retrieves a product in db by ID
+// GetProduct retrieves a product in db by ID, an archived product is also retrieved so that it can be resolved from the orders
 func (r *Repository) GetProduct(ctx context.Context, id int) (models.Product, error) {
-	res := r.Redis.HGetAll(ctx, fmt.Sprintf("product:%d", id))
+	res := r.Redis.HGetAll(ctx, productCacheKey(id))
 	if len(res.Val()) == 0 {
 		product, err := models.FindProduct(ctx, boil.GetContextDB(), id)
 		if err != nil {
@@ -56,17 +153,7 @@ func (r *Repository) GetProduct(ctx context.Context, id int) (models.Product, er
 		}
 
 		// set cache
-		if errCache := r.Redis.HSet(ctx, fmt.Sprintf("product:%d", id), map[string]interface{}{
-			"id":          product.ID,
-			"name":        product.Name,
-			"description": product.Description,
-			"price":       product.Price.String(),
-			"quantity":    product.Quantity,
-			"author_id":   product.AuthorID,
-			"category_id": product.CategoryID,
-			"created_at":  product.CreatedAt,
-			"updated_at":  product.UpdatedAt,
-		}); errCache.Err() != nil {
+		if errCache := r.Redis.HSet(ctx, productCacheKey(id), productCacheFields(*product)); errCache.Err() != nil {
 			return models.Product{}, errCache.Err()
 		}
 
@@ -79,102 +166,463 @@ func (r *Repository) GetProduct(ctx context.Context, id int) (models.Product, er
 	}
 
 	return models.Product{
-		ID:          productScan.ID,
-		Name:        productScan.Name,
-		Description: productScan.Description,
-		Price:       productScan.Price,
-		Quantity:    productScan.Quantity,
-		AuthorID:    productScan.AuthorID,
-		CategoryID:  productScan.CategoryID,
-		CreatedAt:   productScan.CreatedAt,
-		UpdatedAt:   productScan.UpdatedAt,
+		ID:               productScan.ID,
+		Name:             productScan.Name,
+		Description:      productScan.Description,
+		Price:            productScan.Price,
+		Quantity:         productScan.Quantity,
+		AuthorID:         productScan.AuthorID,
+		CategoryID:       productScan.CategoryID,
+		Weight:           productScan.Weight,
+		Currency:         productScan.Currency,
+		CreatedAt:        productScan.CreatedAt,
+		UpdatedAt:        productScan.UpdatedAt,
+		DeletedAt:        productScan.DeletedAt,
+		ReorderThreshold: productScan.ReorderThreshold,
+		ReviewCount:      productScan.ReviewCount,
+		RatingTotal:      productScan.RatingTotal,
+		RatingAverage:    productScan.RatingAverage,
+		Version:          productScan.Version,
+		Sku:              productScan.SKU,
+		Slug:             productScan.Slug,
 	}, nil
 }
 
-// UpdateProduct updates a product in db given by product model in parameter
+// GetProductByName retrieves a product in db by name
+func (r *Repository) GetProductByName(ctx context.Context, name string) (models.Product, error) {
+	product, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.Name), name),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Product{}, ErrProductNotFound
+		}
+		return models.Product{}, err
+	}
+
+	return *product, nil
+}
+
+// GetProductBySKU retrieves a product in db by sku
+func (r *Repository) GetProductBySKU(ctx context.Context, sku string) (models.Product, error) {
+	product, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.Sku), sku),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Product{}, ErrProductNotFound
+		}
+		return models.Product{}, err
+	}
+
+	return *product, nil
+}
+
+// GetProductBySlug retrieves a product in db by its current slug
+func (r *Repository) GetProductBySlug(ctx context.Context, slug string) (models.Product, error) {
+	product, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.Slug), slug),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Product{}, ErrProductNotFound
+		}
+		return models.Product{}, err
+	}
+
+	return *product, nil
+}
+
+// GetProductSlugRedirect retrieves the redirect of an old slug of a product in db, the old slugs are added by db
+// when a product is renamed
+func (r *Repository) GetProductSlugRedirect(ctx context.Context, slug string) (models.ProductSlugRedirect, error) {
+	redirect, err := models.ProductSlugRedirects(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductSlugRedirectColumns.Slug), slug),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductSlugRedirect{}, ErrProductSlugRedirectNotFound
+		}
+		return models.ProductSlugRedirect{}, err
+	}
+
+	return *redirect, nil
+}
+
+// LockProduct retrieves a product in db by id and locks its row until the end of the transaction,
+// the cache is skipped so that the version of the product is up to date
+func (r *Repository) LockProduct(ctx context.Context, tx *sql.Tx, id int) (models.Product, error) {
+	product, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.ID), id),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Product{}, ErrProductNotFound
+		}
+		return models.Product{}, err
+	}
+
+	return *product, nil
+}
+
+// UpdateProduct updates a product in db given by product model in parameter. The quantity is not updated,
+// it only changes by the movements of the stock ledger, nor is the rating which only changes by the moderation of the reviews.
+// The version is bumped by db on every update of the row, the sku never changes and the slug is changed by db with the name.
+// The cached product is not changed, it is removed by DeleteProductCache once the update is committed
 func (r *Repository) UpdateProduct(ctx context.Context, tx *sql.Tx, pReq models.Product) error {
 	ctxExec := boil.GetContextDB()
 	if tx != nil {
 		ctxExec = tx
 	}
 
-	if _, err := pReq.Update(ctx, ctxExec, boil.Blacklist("id", "created_at")); err != nil {
-		return err
-	}
+	_, err := pReq.Update(ctx, ctxExec, boil.Blacklist("id", "created_at", "quantity", "review_count", "rating_total", "rating_average", "version", "sku", "slug"))
+	return err
+}
 
-	if err := r.Redis.HSet(ctx, fmt.Sprintf("product:%d", pReq.ID), map[string]interface{}{
-		"id":          pReq.ID,
-		"name":        pReq.Name,
-		"description": pReq.Description,
-		"price":       pReq.Price.String(),
-		"quantity":    pReq.Quantity,
-		"author_id":   pReq.AuthorID,
-		"category_id": pReq.CategoryID,
-		"created_at":  pReq.CreatedAt,
-		"updated_at":  pReq.UpdatedAt,
-	}); err != nil {
-		return err.Err()
+// UpdateProductColumns updates the columns of a product in db given by product model in parameter, the other columns
+// are kept. The cached product is not changed, it is removed by DeleteProductCache once the update is committed
+func (r *Repository) UpdateProductColumns(ctx context.Context, tx *sql.Tx, pReq models.Product, columns []string) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
 	}
 
-	return nil
+	_, err := pReq.Update(ctx, ctxExec, boil.Whitelist(append(columns, models.ProductColumns.UpdatedAt)...))
+	return err
 }
 
-// DeleteProduct deletes a product in db by ID
+// DeleteProduct archives a product in db by ID, the product is kept so that the orders of it can still be resolved
 func (r *Repository) DeleteProduct(ctx context.Context, id int) error {
-	product, err := r.GetProduct(ctx, id)
+	return r.ArchiveProduct(ctx, nil, id)
+}
+
+// ArchiveProduct archives an active product in db by ID in the transaction if given
+func (r *Repository) ArchiveProduct(ctx context.Context, tx *sql.Tx, id int) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	rowsAff, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.ID), id),
+		qm.Where(fmt.Sprintf("%s IS NULL", models.ProductColumns.DeletedAt)),
+	).UpdateAll(ctx, ctxExec, models.M{models.ProductColumns.DeletedAt: time.Now()})
 	if err != nil {
 		return err
 	}
+	if rowsAff == 0 {
+		return ErrProductNotFound
+	}
+
+	return r.Redis.Del(ctx, productCacheKey(id)).Err()
+}
+
+// GetProductsByID retrieves the products of the IDs from db sorted by ID, the archived ones included. The cache is not used
+// so that the products are up to date
+func (r *Repository) GetProductsByID(ctx context.Context, ids ...int) ([]models.Product, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	products, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ANY(?)", models.ProductColumns.ID), pq.Array(ids)),
+		qm.OrderBy(models.ProductColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Product
+	for _, p := range products {
+		result = append(result, *p)
+	}
+
+	return result, nil
+}
+
+// GetProductIDs retrieves the IDs of the products matching the filter sorted by ID, at most the limit of the filter if given.
+// The sorting and offset of the filter are ignored
+func (r *Repository) GetProductIDs(ctx context.Context, filter ProductRepoFilter) ([]int, error) {
+	queryMod := append(productFilterQueryMods(filter),
+		qm.Select(fmt.Sprintf("%s.%s", models.TableNames.Products, models.ProductColumns.ID)),
+		qm.OrderBy(fmt.Sprintf("%s.%s", models.TableNames.Products, models.ProductColumns.ID)),
+	)
+	if filter.Limit > 0 {
+		queryMod = append(queryMod, qm.Limit(filter.Limit))
+	}
+
+	products, err := models.Products(queryMod...).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+
+	return ids, nil
+}
+
+// DeleteProductCache removes the cached products of the IDs, they are read from db the next time
+func (r *Repository) DeleteProductCache(ctx context.Context, ids ...int) error {
+	if len(ids) == 0 {
+		return nil
+	}
 
-	pModel := models.Product{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Quantity:    product.Quantity,
-		AuthorID:    product.AuthorID,
-		CategoryID:  product.CategoryID,
-		CreatedAt:   product.CreatedAt,
-		UpdatedAt:   product.UpdatedAt,
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, productCacheKey(id))
 	}
 
-	if _, err = pModel.Delete(ctx, boil.GetContextDB()); err != nil {
+	return r.Redis.Del(ctx, keys...).Err()
+}
+
+// RestoreProduct restores an archived product in db by ID
+func (r *Repository) RestoreProduct(ctx context.Context, id int) error {
+	rowsAff, err := models.Products(
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.ID), id),
+		qm.Where(fmt.Sprintf("%s IS NOT NULL", models.ProductColumns.DeletedAt)),
+	).UpdateAll(ctx, boil.GetContextDB(), models.M{models.ProductColumns.DeletedAt: nil})
+	if err != nil {
 		return err
 	}
-	return nil
+	if rowsAff == 0 {
+		return ErrProductNotFound
+	}
+
+	return r.Redis.Del(ctx, productCacheKey(id)).Err()
+}
+
+// purgeableProductsQueryMods returns the where clauses of the products archived before the time which have never been ordered,
+// the ordered products are kept for the orders
+func purgeableProductsQueryMods(archivedBefore time.Time) []qm.QueryMod {
+	productTable := models.TableNames.Products
+
+	return []qm.QueryMod{
+		qm.Where(fmt.Sprintf("%s.%s < ?", productTable, models.ProductColumns.DeletedAt), archivedBefore),
+		qm.Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s)", models.TableNames.OrderItems, models.TableNames.OrderItems, models.OrderItemColumns.ProductID, productTable, models.ProductColumns.ID)),
+	}
+}
+
+// GetPurgeableProducts retrieves the IDs of the products archived before the time which have never been ordered
+func (r *Repository) GetPurgeableProducts(ctx context.Context, archivedBefore time.Time) ([]int, error) {
+	products, err := models.Products(
+		append(purgeableProductsQueryMods(archivedBefore), qm.Select(models.ProductColumns.ID))...,
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+
+	return ids, nil
+}
+
+// PurgeProducts deletes the products of the IDs which are still purgeable for the time and returns the IDs of the deleted products.
+// Their variants and images are deleted with them
+func (r *Repository) PurgeProducts(ctx context.Context, archivedBefore time.Time, ids ...int) ([]int, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	productTable := models.TableNames.Products
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = ANY($1) AND %s < $2 AND NOT EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s) RETURNING %s`,
+		productTable, models.ProductColumns.ID, models.ProductColumns.DeletedAt,
+		models.TableNames.OrderItems, models.TableNames.OrderItems, models.OrderItemColumns.ProductID, productTable, models.ProductColumns.ID,
+		models.ProductColumns.ID)
+
+	rows, err := boil.GetContextDB().QueryContext(ctx, query, pq.Array(ids), archivedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deleted []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range deleted {
+		if err := r.Redis.Del(ctx, productCacheKey(id)).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return deleted, nil
 }
 
 type ProductRepoFilter struct {
-	Name string
-	Date string
+	Name        string
+	Date        string
+	MinPrice    decimal.NullDecimal
+	MaxPrice    decimal.NullDecimal
+	CategoryID  int
+	AuthorID    int
+	InStock     bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Tags returns the products which have all the tags
+	Tags []string
+	// Attributes returns the products matching all the attribute filters
+	Attributes []AttributeFilter
+	// Archived returns the archived products instead of the active ones
+	Archived bool
+	// SortBy is one of the columns name, price, quantity or created_at, the products are sorted by id if empty.
+	// The id breaks the ties of the other columns so that the order is stable between pages
+	SortBy   string
+	SortDesc bool
+	// Limit is the maximum number of products returned, all of them are returned if it is 0
+	Limit  int
+	Offset int
+	// After is the position of the last product of the previous page, the products after it are returned
+	After *ProductCursor
 }
 
-type ProductOutput struct {
-	ID           int             `boil:"id"`
-	Name         string          `boil:"name"`
-	Description  string          `boil:"description"`
-	Price        decimal.Decimal `boil:"price"`
-	Quantity     int             `boil:"quantity"`
-	AuthorID     int             `boil:"author_id"`
-	CategoryName string          `boil:"category"`
-	CreatedAt    time.Time       `boil:"created_at"`
-	UpdatedAt    time.Time       `boil:"updated_at"`
-}
-
-// GetProducts retrieves all the products in db
-func (r *Repository) GetProducts(ctx context.Context, filter ProductRepoFilter) ([]ProductOutput, error) {
-	var queryMod []qm.QueryMod
+// ProductCursor is the position of a product in the sort order, the value of the sorted column and the id of the product
+type ProductCursor struct {
+	Value string
+	ID    int
+}
+
+// productFilterQueryMods returns the where clauses of the filters of products
+func productFilterQueryMods(filter ProductRepoFilter) []qm.QueryMod {
+	productTable := models.TableNames.Products
 
-	queryMod = append(queryMod, qm.Select(fmt.Sprintf("%s.%s as id, %s.%s as name, %s.%s as description, %s, %s, %s, %s.%s as category, %s.%s as created_at, %s.%s as updated_at", models.TableNames.Products, models.ProductColumns.ID, models.TableNames.Products, models.ProductColumns.Name, models.TableNames.Products, models.ProductColumns.Description, models.ProductColumns.Price, models.ProductColumns.Quantity, models.ProductColumns.AuthorID, models.TableNames.ProductCategories, models.ProductCategoryColumns.Name, models.TableNames.Products, models.ProductColumns.CreatedAt, models.TableNames.Products, models.ProductColumns.UpdatedAt)))
+	var queryMod []qm.QueryMod
+	if filter.Archived {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s IS NOT NULL", productTable, models.ProductColumns.DeletedAt)))
+	} else {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s IS NULL", productTable, models.ProductColumns.DeletedAt)))
+	}
 
 	if filter.Name != "" {
-		queryMod = append(queryMod, qm.Where(fmt.Sprintf("UPPER(%s.%s) LIKE UPPER(?)", models.TableNames.Products, models.ProductColumns.Name), "%"+filter.Name+"%"))
+		// the name is matched unaccented so that the pg_trgm index is used
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s LIKE lower(immutable_unaccent(?))", productNameSearchQuery), "%"+filter.Name+"%"))
 	}
 
 	if filter.Date != "" {
-		queryMod = append(queryMod, qm.Where(fmt.Sprintf("DATE(%s.%s) = ?", models.TableNames.Products, models.ProductColumns.CreatedAt), filter.Date))
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("DATE(%s.%s) = ?", productTable, models.ProductColumns.CreatedAt), filter.Date))
+	}
+
+	if filter.MinPrice.Valid {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s >= ?", productTable, models.ProductColumns.Price), filter.MinPrice.Decimal))
+	}
+
+	if filter.MaxPrice.Valid {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s <= ?", productTable, models.ProductColumns.Price), filter.MaxPrice.Decimal))
 	}
 
+	if filter.CategoryID != 0 {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s = ?", productTable, models.ProductColumns.CategoryID), filter.CategoryID))
+	}
+
+	if filter.AuthorID != 0 {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s = ?", productTable, models.ProductColumns.AuthorID), filter.AuthorID))
+	}
+
+	if filter.InStock {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s > 0", productTable, models.ProductColumns.Quantity)))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s >= ?", productTable, models.ProductColumns.CreatedAt), filter.CreatedFrom))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		// the end date is included
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s.%s < ?", productTable, models.ProductColumns.CreatedAt), filter.CreatedTo.AddDate(0, 0, 1)))
+	}
+
+	if len(filter.Tags) != 0 {
+		queryMod = append(queryMod, tagFilterQueryMod(filter.Tags))
+	}
+
+	for _, a := range filter.Attributes {
+		queryMod = append(queryMod, attributeFilterQueryMod(a))
+	}
+
+	return queryMod
+}
+
+// productPageQueryMods returns the order by, cursor and limit clauses of a page of products
+func productPageQueryMods(filter ProductRepoFilter) []qm.QueryMod {
+	productTable := models.TableNames.Products
+	idColumn := fmt.Sprintf("%s.%s", productTable, models.ProductColumns.ID)
+
+	order, comparison := "ASC", ">"
+	if filter.SortDesc {
+		order, comparison = "DESC", "<"
+	}
+
+	var queryMod []qm.QueryMod
+	if filter.SortBy == "" || filter.SortBy == models.ProductColumns.ID {
+		if filter.After != nil {
+			queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s %s ?", idColumn, comparison), filter.After.ID))
+		}
+		queryMod = append(queryMod, qm.OrderBy(fmt.Sprintf("%s %s", idColumn, order)))
+	} else {
+		// the sorted column is one of name, price, quantity or created_at which are validated in the controller layer
+		sortColumn := fmt.Sprintf("%s.%s", productTable, filter.SortBy)
+		if filter.After != nil {
+			queryMod = append(queryMod, qm.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn, idColumn, comparison), filter.After.Value, filter.After.ID))
+		}
+		queryMod = append(queryMod, qm.OrderBy(fmt.Sprintf("%s %s, %s %s", sortColumn, order, idColumn, order)))
+	}
+
+	if filter.Limit > 0 {
+		queryMod = append(queryMod, qm.Limit(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		queryMod = append(queryMod, qm.Offset(filter.Offset))
+	}
+
+	return queryMod
+}
+
+// CountProducts counts the products matching the filter, the sorting and pagination of the filter are ignored
+func (r *Repository) CountProducts(ctx context.Context, filter ProductRepoFilter) (int64, error) {
+	return models.Products(productFilterQueryMods(filter)...).Count(ctx, boil.GetContextDB())
+}
+
+type ProductOutput struct {
+	ID            int             `boil:"id"`
+	Name          string          `boil:"name"`
+	Description   string          `boil:"description"`
+	Price         decimal.Decimal `boil:"price"`
+	Quantity      int             `boil:"quantity"`
+	AuthorID      int             `boil:"author_id"`
+	CategoryName  string          `boil:"category"`
+	Currency      string          `boil:"currency"`
+	CreatedAt     time.Time       `boil:"created_at"`
+	UpdatedAt     time.Time       `boil:"updated_at"`
+	DeletedAt     null.Time       `boil:"deleted_at"`
+	ReviewCount   int             `boil:"review_count"`
+	RatingAverage decimal.Decimal `boil:"rating_average"`
+	SKU           string          `boil:"sku"`
+	Slug          string          `boil:"slug"`
+}
+
+// GetProducts retrieves the products in db matching the filter, a page of them if the limit is given
+func (r *Repository) GetProducts(ctx context.Context, filter ProductRepoFilter) ([]ProductOutput, error) {
+	var queryMod []qm.QueryMod
+
+	queryMod = append(queryMod, qm.Select(fmt.Sprintf("%s.%s as id, %s.%s as name, %s.%s as description, %s, %s, %s, %s.%s as category, %s.%s as currency, %s.%s as created_at, %s.%s as updated_at, %s.%s as deleted_at, %s, %s, %s, %s", models.TableNames.Products, models.ProductColumns.ID, models.TableNames.Products, models.ProductColumns.Name, models.TableNames.Products, models.ProductColumns.Description, models.ProductColumns.Price, models.ProductColumns.Quantity, models.ProductColumns.AuthorID, models.TableNames.ProductCategories, models.ProductCategoryColumns.Name, models.TableNames.Products, models.ProductColumns.Currency, models.TableNames.Products, models.ProductColumns.CreatedAt, models.TableNames.Products, models.ProductColumns.UpdatedAt, models.TableNames.Products, models.ProductColumns.DeletedAt, models.ProductColumns.ReviewCount, models.ProductColumns.RatingAverage, models.ProductColumns.Sku, models.ProductColumns.Slug)))
+
+	queryMod = append(queryMod, productFilterQueryMods(filter)...)
+	queryMod = append(queryMod, productPageQueryMods(filter)...)
+
 	queryMod = append(queryMod, qm.InnerJoin(fmt.Sprintf("%s on %s.%s = %s.%s", models.TableNames.ProductCategories, models.TableNames.Products, models.ProductColumns.CategoryID, models.TableNames.ProductCategories, models.ProductColumns.ID)))
 
 	var products []ProductOutput
@@ -191,7 +639,7 @@ type GetProductsGraph struct {
 	PCate   models.ProductCategory `boil:"product_categories,bind"`
 }
 
-// GetProductsGraph retrieves all products in db and is used in GraphQL.
+// GetProductsGraph retrieves the products in db matching the filter with their author and category, it is used in GraphQL.
 func (r *Repository) GetProductsGraph(ctx context.Context, filter ProductRepoFilter) ([]GetProductsGraph, error) {
 	var queryMod []qm.QueryMod
 
@@ -207,8 +655,16 @@ func (r *Repository) GetProductsGraph(ctx context.Context, filter ProductRepoFil
 			productsTable+".description",
 			productsTable+".price",
 			productsTable+".quantity",
+			productsTable+".weight",
+			productsTable+".currency",
 			productsTable+".created_at",
 			productsTable+".updated_at",
+			productsTable+".deleted_at",
+			productsTable+".review_count",
+			productsTable+".rating_average",
+			productsTable+".version",
+			productsTable+".sku",
+			productsTable+".slug",
 			usersTable+".id",
 			usersTable+".name",
 			usersTable+".email",
@@ -224,15 +680,9 @@ func (r *Repository) GetProductsGraph(ctx context.Context, filter ProductRepoFil
 		),
 	)
 
-	// filter query
-	if filter.Name != "" {
-		queryMod = append(queryMod, qm.Where(fmt.Sprintf("UPPER(%s.%s) LIKE UPPER(?)", models.TableNames.Products, models.ProductColumns.Name), "%"+filter.Name+"%"))
-	}
-
-	// filter query
-	if filter.Date != "" {
-		queryMod = append(queryMod, qm.Where(fmt.Sprintf("DATE(%s.%s) = ?", models.TableNames.Products, models.ProductColumns.CreatedAt), filter.Date))
-	}
+	// filter, sorting and pagination query
+	queryMod = append(queryMod, productFilterQueryMods(filter)...)
+	queryMod = append(queryMod, productPageQueryMods(filter)...)
 
 	// inner join product categories table
 	queryMod = append(queryMod, qm.InnerJoin(fmt.Sprintf("%s on %s.%s = %s.%s", models.TableNames.ProductCategories, models.TableNames.Products, models.ProductColumns.CategoryID, models.TableNames.ProductCategories, models.ProductCategoryColumns.ID)))
@@ -248,35 +698,168 @@ func (r *Repository) GetProductsGraph(ctx context.Context, filter ProductRepoFil
 	return products, nil
 }
 
-// UpsertProducts updates list of products. If a product already exists in db, updates only changed values instead
+// UpsertProducts updates list of products. If a product already exists in db, updates only changed values instead.
+// A product with a sku is matched by its sku so that it can be renamed, a product without a sku is matched by its name.
+// The quantity of a product is set in its warehouse, the quantity of the product follows its warehouses
 func (r *Repository) UpsertProducts(ctx context.Context, products []Product) error {
-	// prepare statement
-	query := []string{`INSERT INTO products(name, description, price, quantity, category_id, author_id) VALUES `}
+	var withSKU, withoutSKU []Product
 	for _, p := range products {
-		query = append(query, fmt.Sprintf(`('%s', '%s', %v, %d, %d, %d),`, p.Name, p.Description, p.Price, p.Quantity, p.CategoryID, p.AuthorID))
+		if p.SKU != "" {
+			withSKU = append(withSKU, p)
+		} else {
+			withoutSKU = append(withoutSKU, p)
+		}
+	}
+
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var names []string
+	for _, p := range products {
+		names = append(names, p.Name)
+	}
+
+	fmt.Println("start query", time.Now())
+	if len(withSKU) != 0 {
+		query, args := upsertProductsQuery(withSKU, models.ProductColumns.Sku)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	if len(withoutSKU) != 0 {
+		query, args := upsertProductsQuery(withoutSKU, models.ProductColumns.Name)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	if err := r.recordProductPriceChanges(ctx, tx, names, time.Now()); err != nil {
+		return err
+	}
+
+	ids, err := getProductIDsByName(ctx, tx, names)
+	if err != nil {
+		return err
+	}
+
+	if err := r.recordImportedStock(ctx, tx, products, ids); err != nil {
+		return err
+	}
+
+	if err := r.setImportedTagsAndAttributes(ctx, tx, products, ids); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// the cached products are removed as they have been changed in db
+	for _, id := range ids {
+		if err := r.Redis.Del(ctx, productCacheKey(id)).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsertProductsQuery returns the statement with its args which inserts the products or updates the product of the conflict
+// column, the name of a product is updated if it is matched by sku. The sku of a product without one is generated by db
+func upsertProductsQuery(products []Product, conflict string) (string, []interface{}) {
+	// the quantities are moved into the warehouses after the products exist
+	query := []string{`INSERT INTO products(sku, name, description, price, quantity, category_id, author_id) VALUES `}
+	args := make([]interface{}, 0, len(products)*6)
+	for _, p := range products {
+		n := len(args)
+		query = append(query, fmt.Sprintf(`($%d, $%d, $%d, $%d, 0, $%d, $%d),`, n+1, n+2, n+3, n+4, n+5, n+6))
+		args = append(args, p.SKU, p.Name, p.Description, p.Price, p.CategoryID, p.AuthorID)
 	}
 
 	// remove the "," character at the end of the last query
 	query[len(query)-1] = strings.TrimSuffix(query[len(query)-1], ",")
 
 	// if conflict then update
-	query = append(query, ` ON CONFLICT(name) DO UPDATE SET description=EXCLUDED.description, price=EXCLUDED.price, quantity=EXCLUDED.quantity, category_id=EXCLUDED.category_id, author_id=EXCLUDED.author_id `)
+	set := `description=EXCLUDED.description, price=EXCLUDED.price, category_id=EXCLUDED.category_id, author_id=EXCLUDED.author_id`
+	if conflict == models.ProductColumns.Sku {
+		set = `name=EXCLUDED.name, ` + set
+	}
+	query = append(query, fmt.Sprintf(` ON CONFLICT(%s) DO UPDATE SET %s `, conflict, set))
 
-	tx, err := boil.BeginTx(ctx, nil)
+	return strings.Join(query, " "), args
+}
+
+// getProductIDsByName retrieves the IDs of the products of the names by name
+func getProductIDsByName(ctx context.Context, ctxExec boil.ContextExecutor, names []string) (map[string]int, error) {
+	products, err := models.Products(
+		qm.Select(models.ProductColumns.ID, models.ProductColumns.Name),
+		qm.Where(fmt.Sprintf("%s = ANY(?)", models.ProductColumns.Name), pq.Array(names)),
+	).All(ctx, ctxExec)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer tx.Rollback()
 
-	stmt, err := tx.PrepareContext(ctx, strings.Join(query, " "))
+	ids := make(map[string]int)
+	for _, p := range products {
+		ids[p.Name] = p.ID
+	}
+
+	return ids, nil
+}
+
+// recordImportedStock sets the quantity of each imported product in its warehouse, the difference to the quantity
+// before the import is recorded on the stock ledger with the author of the product as the actor
+func (r *Repository) recordImportedStock(ctx context.Context, tx *sql.Tx, products []Product, ids map[string]int) error {
+	defaultWarehouseID, err := getDefaultWarehouseID(ctx, tx)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println("start query", time.Now())
-	if _, err := stmt.ExecContext(ctx); err != nil {
-		return err
+	for _, p := range products {
+		warehouseID := p.WarehouseID
+		if warehouseID == 0 {
+			warehouseID = defaultWarehouseID
+		}
+
+		before, err := getWarehouseQuantity(ctx, tx, warehouseID, ids[p.Name])
+		if err != nil {
+			return err
+		}
+		if p.Quantity == before {
+			continue
+		}
+
+		if _, err := r.RecordStockMovement(ctx, tx, StockMovement{
+			ProductID:   ids[p.Name],
+			WarehouseID: null.IntFrom(warehouseID),
+			Delta:       p.Quantity - before,
+			Reason:      StockReasonImport,
+			ActorID:     null.IntFrom(p.AuthorID),
+		}); err != nil {
+			return err
+		}
 	}
 
-	return tx.Commit()
+	return nil
+}
+
+// setImportedTagsAndAttributes replaces the tags and the attributes of each imported product which are given
+func (r *Repository) setImportedTagsAndAttributes(ctx context.Context, tx *sql.Tx, products []Product, ids map[string]int) error {
+	for _, p := range products {
+		if p.Tags != nil {
+			if err := r.SetProductTags(ctx, tx, ids[p.Name], p.Tags); err != nil {
+				return err
+			}
+		}
+		if p.Attributes != nil {
+			if err := r.SetProductAttributes(ctx, tx, ids[p.Name], p.Attributes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
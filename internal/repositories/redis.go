@@ -2,10 +2,16 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 )
 
 func RedisInitialize(port, password string) *redis.Client {
@@ -21,3 +27,46 @@ func RedisInitialize(port, password string) *redis.Client {
 
 	return client
 }
+
+// nullIntString returns the cache value of a nullable int, an empty string is stored for NULL
+func nullIntString(n null.Int) string {
+	if !n.Valid {
+		return ""
+	}
+	return strconv.Itoa(n.Int)
+}
+
+// nullDecimalString returns the cache value of a nullable decimal, an empty string is stored for NULL
+func nullDecimalString(d decimal.NullDecimal) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Decimal.String()
+}
+
+// getCachedJSON decodes the JSON value cached under the key into dest, it reports whether the key was cached
+func (r *Repository) getCachedJSON(ctx context.Context, key string, dest interface{}) (bool, error) {
+	data, err := r.Redis.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// setCachedJSON caches the value encoded as JSON under the key, the key expires after the ttl
+func (r *Repository) setCachedJSON(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return r.Redis.Set(ctx, key, data, ttl).Err()
+}
@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ReturnRequest struct {
+	OrderID int
+	Status  string
+	Reason  string
+}
+
+type ReturnItem struct {
+	OrderItemID int
+	Quantity    int
+}
+
+// CreateReturnRequest creates a return request of an order with the order items to return
+func (r *Repository) CreateReturnRequest(ctx context.Context, tx *sql.Tx, rReq ReturnRequest, riReq []ReturnItem) (models.ReturnRequest, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	returnRequest := models.ReturnRequest{
+		OrderID: rReq.OrderID,
+		Status:  rReq.Status,
+		Reason:  rReq.Reason,
+	}
+	if err := returnRequest.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+		return models.ReturnRequest{}, err
+	}
+
+	var returnItems []*models.ReturnItem
+	for _, ri := range riReq {
+		returnItems = append(returnItems, &models.ReturnItem{
+			OrderItemID: ri.OrderItemID,
+			Quantity:    ri.Quantity,
+		})
+	}
+	if err := returnRequest.AddReturnItems(ctx, ctxExec, true, returnItems...); err != nil {
+		return models.ReturnRequest{}, err
+	}
+
+	return returnRequest, nil
+}
+
+// UpdateReturnRequest updates a return request in db given by return request model in parameter if it is still in the
+// from status. ErrReturnRequestNotFound is returned if the request has moved from it so that a request moved concurrently
+// is not restocked or replaced twice
+func (r *Repository) UpdateReturnRequest(ctx context.Context, tx *sql.Tx, rReq models.ReturnRequest, from string) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	rowsAff, err := models.ReturnRequests(
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.ReturnRequestColumns.ID, models.ReturnRequestColumns.Status), rReq.ID, from),
+	).UpdateAll(ctx, ctxExec, models.M{
+		models.ReturnRequestColumns.Status:             rReq.Status,
+		models.ReturnRequestColumns.Note:               rReq.Note,
+		models.ReturnRequestColumns.RefundAmount:       rReq.RefundAmount,
+		models.ReturnRequestColumns.ReplacementOrderID: rReq.ReplacementOrderID,
+		models.ReturnRequestColumns.ReceivedAt:         rReq.ReceivedAt,
+		models.ReturnRequestColumns.ResolvedAt:         rReq.ResolvedAt,
+		models.ReturnRequestColumns.UpdatedAt:          time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrReturnRequestNotFound
+	}
+
+	return nil
+}
+
+// GetReturnRequest retrieves a return request in db by ID
+func (r *Repository) GetReturnRequest(ctx context.Context, id int) (models.ReturnRequest, error) {
+	returnRequest, err := models.FindReturnRequest(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ReturnRequest{}, ErrReturnRequestNotFound
+		}
+		return models.ReturnRequest{}, err
+	}
+
+	return *returnRequest, nil
+}
+
+// GetReturnItems retrieves the items of a return request
+func (r *Repository) GetReturnItems(ctx context.Context, returnRequestID int) ([]models.ReturnItem, error) {
+	returnItems, err := models.ReturnItems(
+		qm.Where(fmt.Sprintf("%s = ?", models.ReturnItemColumns.ReturnRequestID), returnRequestID),
+		qm.OrderBy(models.ReturnItemColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ReturnItem
+	for _, ri := range returnItems {
+		result = append(result, *ri)
+	}
+
+	return result, nil
+}
+
+type ReturnRequestFilter struct {
+	Statuses []string
+	OrderID  int
+}
+
+type ReturnRequestOutput struct {
+	ReturnRequest models.ReturnRequest
+	Items         []models.ReturnItem
+}
+
+// GetReturnRequests retrieves the return requests with their items, filtered by statuses and order if given.
+// The oldest requests come first
+func (r *Repository) GetReturnRequests(ctx context.Context, filter ReturnRequestFilter) ([]ReturnRequestOutput, error) {
+	queryMod := []qm.QueryMod{
+		qm.Load(models.ReturnRequestRels.ReturnItems, qm.OrderBy(models.ReturnItemColumns.ID)),
+		qm.OrderBy(fmt.Sprintf("%s, %s", models.ReturnRequestColumns.CreatedAt, models.ReturnRequestColumns.ID)),
+	}
+
+	if len(filter.Statuses) != 0 {
+		statuses := make([]interface{}, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, s)
+		}
+		queryMod = append(queryMod, qm.WhereIn(fmt.Sprintf("%s IN ?", models.ReturnRequestColumns.Status), statuses...))
+	}
+
+	if filter.OrderID != 0 {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s = ?", models.ReturnRequestColumns.OrderID), filter.OrderID))
+	}
+
+	returnRequests, err := models.ReturnRequests(queryMod...).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ReturnRequestOutput
+	for _, rr := range returnRequests {
+		output := ReturnRequestOutput{ReturnRequest: *rr}
+		if rr.R != nil {
+			for _, ri := range rr.R.ReturnItems {
+				output.Items = append(output.Items, *ri)
+			}
+		}
+		result = append(result, output)
+	}
+
+	return result, nil
+}
+
+// GetReturnedQuantities retrieves the quantity of each order item of an order which is already in a return request,
+// the requests in the excluded statuses are not counted
+func (r *Repository) GetReturnedQuantities(ctx context.Context, orderID int, excludedStatuses []string) (map[int]int, error) {
+	returnRequestTable := models.TableNames.ReturnRequests
+	returnItemTable := models.TableNames.ReturnItems
+
+	queryMod := []qm.QueryMod{
+		qm.Select(
+			fmt.Sprintf(`%s.%s as "order_item_id"`, returnItemTable, models.ReturnItemColumns.OrderItemID),
+			fmt.Sprintf(`SUM(%s.%s) as "quantity"`, returnItemTable, models.ReturnItemColumns.Quantity),
+		),
+		qm.InnerJoin(fmt.Sprintf("%s ON %s.%s=%s.%s", returnRequestTable, returnRequestTable, models.ReturnRequestColumns.ID, returnItemTable, models.ReturnItemColumns.ReturnRequestID)),
+		qm.Where(fmt.Sprintf("%s.%s = ?", returnRequestTable, models.ReturnRequestColumns.OrderID), orderID),
+		qm.GroupBy(fmt.Sprintf("%s.%s", returnItemTable, models.ReturnItemColumns.OrderItemID)),
+	}
+
+	if len(excludedStatuses) != 0 {
+		statuses := make([]interface{}, 0, len(excludedStatuses))
+		for _, s := range excludedStatuses {
+			statuses = append(statuses, s)
+		}
+		queryMod = append(queryMod, qm.WhereNotIn(fmt.Sprintf("%s.%s NOT IN ?", returnRequestTable, models.ReturnRequestColumns.Status), statuses...))
+	}
+
+	var rows []struct {
+		OrderItemID int `boil:"order_item_id"`
+		Quantity    int `boil:"quantity"`
+	}
+	if err := models.ReturnItems(queryMod...).Bind(ctx, boil.GetContextDB(), &rows); err != nil {
+		return nil, err
+	}
+
+	quantities := make(map[int]int, len(rows))
+	for _, row := range rows {
+		quantities[row.OrderItemID] = row.Quantity
+	}
+
+	return quantities, nil
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Shipment struct {
+	OrderID        int
+	Carrier        string
+	TrackingNumber string
+	Status         string
+	ShippedAt      null.Time
+}
+
+// CreateShipment creates a shipment of an order in db given by shipment model in parameter
+func (r *Repository) CreateShipment(ctx context.Context, tx *sql.Tx, sReq Shipment) (models.Shipment, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	shipment := models.Shipment{
+		OrderID:        sReq.OrderID,
+		Carrier:        sReq.Carrier,
+		TrackingNumber: sReq.TrackingNumber,
+		Status:         sReq.Status,
+		ShippedAt:      sReq.ShippedAt,
+	}
+	if err := shipment.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+		return models.Shipment{}, err
+	}
+
+	return shipment, nil
+}
+
+// UpdateShipment updates a shipment in db given by shipment model in parameter
+func (r *Repository) UpdateShipment(ctx context.Context, tx *sql.Tx, sReq models.Shipment) error {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	if _, err := sReq.Update(ctx, ctxExec, boil.Blacklist("id", "created_at")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetShipment retrieves a shipment in db by ID
+func (r *Repository) GetShipment(ctx context.Context, id int) (models.Shipment, error) {
+	shipment, err := models.FindShipment(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Shipment{}, ErrShipmentNotFound
+		}
+		return models.Shipment{}, err
+	}
+
+	return *shipment, nil
+}
+
+// GetShipments retrieves all the shipments of an order
+func (r *Repository) GetShipments(ctx context.Context, orderID int) ([]models.Shipment, error) {
+	shipments, err := models.Shipments(
+		qm.Where(fmt.Sprintf("%s = ?", models.ShipmentColumns.OrderID), orderID),
+		qm.OrderBy(models.ShipmentColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Shipment
+	for _, s := range shipments {
+		result = append(result, *s)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ShippingMethod struct {
+	Name      string
+	Carrier   string
+	RateType  string
+	BaseRate  decimal.Decimal
+	RatePerKG decimal.Decimal
+}
+
+// CreateShippingMethod creates a shipping method in db given by shipping method model in parameter
+func (r *Repository) CreateShippingMethod(ctx context.Context, smReq ShippingMethod) error {
+	shippingMethod := models.ShippingMethod{
+		Name:      smReq.Name,
+		Carrier:   smReq.Carrier,
+		RateType:  smReq.RateType,
+		BaseRate:  smReq.BaseRate,
+		RatePerKG: smReq.RatePerKG,
+	}
+	if err := shippingMethod.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return nil
+}
+
+// GetShippingMethod retrieves a shipping method in db by ID
+func (r *Repository) GetShippingMethod(ctx context.Context, id int) (models.ShippingMethod, error) {
+	shippingMethod, err := models.FindShippingMethod(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ShippingMethod{}, ErrShippingMethodNotFound
+		}
+		return models.ShippingMethod{}, err
+	}
+
+	return *shippingMethod, nil
+}
+
+// GetShippingMethods retrieves all the shipping methods in db
+func (r *Repository) GetShippingMethods(ctx context.Context) ([]models.ShippingMethod, error) {
+	shippingMethods, err := models.ShippingMethods(qm.OrderBy(models.ShippingMethodColumns.ID)).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ShippingMethod
+	for _, sm := range shippingMethods {
+		result = append(result, *sm)
+	}
+
+	return result, nil
+}
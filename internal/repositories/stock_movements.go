@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// reasons of the stock movements
+const (
+	StockReasonSale         = "sale"
+	StockReasonReturn       = "return"
+	StockReasonAdjustment   = "adjustment"
+	StockReasonImport       = "import"
+	StockReasonCancellation = "cancellation"
+	StockReasonTransfer     = "transfer"
+)
+
+type StockMovement struct {
+	ProductID int
+	// VariantID is the variant whose stock moves, the stock of the product moves if it is null
+	VariantID null.Int
+	// WarehouseID is the warehouse the stock of the product moves in, the default warehouse if it is null.
+	// The stock of a variant is not kept by warehouse
+	WarehouseID null.Int
+	Delta       int
+	Reason      string
+	ReferenceID null.Int
+	ActorID     null.Int
+	Note        string
+}
+
+// RecordStockMovement adds the delta of a movement to the quantity of its variant or product and appends the movement
+// to the stock ledger with the quantity after it, the stock of a product also moves in its warehouse. The low stock alert
+// of the product is raised or resolved by the new quantity and its back in stock subscriptions are marked restocked if it is
+// back above 0. ErrInsufficientStock is returned if the quantity would be negative. The cached product is not changed,
+// it is removed by DeleteProductCache once the movement is committed
+func (r *Repository) RecordStockMovement(ctx context.Context, tx *sql.Tx, smReq StockMovement) (models.StockMovement, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	// the stock of a product moves in its warehouse first, the stock of a variant is not kept by warehouse
+	if !smReq.VariantID.Valid {
+		if !smReq.WarehouseID.Valid {
+			warehouseID, err := getDefaultWarehouseID(ctx, ctxExec)
+			if err != nil {
+				return models.StockMovement{}, err
+			}
+			smReq.WarehouseID = null.IntFrom(warehouseID)
+		}
+		if err := moveWarehouseStock(ctx, ctxExec, smReq.WarehouseID.Int, smReq.ProductID, smReq.Delta); err != nil {
+			return models.StockMovement{}, err
+		}
+	} else {
+		smReq.WarehouseID = null.Int{}
+	}
+
+	// the quantity is changed by the delta in db so that concurrent movements are not lost
+	var quantity struct {
+		Quantity         int `boil:"quantity"`
+		ReorderThreshold int `boil:"reorder_threshold"`
+	}
+	query := fmt.Sprintf(`UPDATE "%s" SET quantity = quantity + $1, updated_at = now() WHERE id = $2 AND quantity + $1 >= 0 RETURNING quantity, reorder_threshold`, models.TableNames.Products)
+	id := smReq.ProductID
+	if smReq.VariantID.Valid {
+		query = fmt.Sprintf(`UPDATE "%s" SET quantity = quantity + $1, updated_at = now() WHERE id = $2 AND quantity + $1 >= 0 RETURNING quantity`, models.TableNames.ProductVariants)
+		id = smReq.VariantID.Int
+	}
+
+	if err := queries.Raw(query, smReq.Delta, id).Bind(ctx, ctxExec, &quantity); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.StockMovement{}, ErrInsufficientStock
+		}
+		return models.StockMovement{}, err
+	}
+
+	stockMovement, err := insertStockMovement(ctx, ctxExec, smReq, quantity.Quantity)
+	if err != nil {
+		return models.StockMovement{}, err
+	}
+
+	if !smReq.VariantID.Valid {
+		if err := syncLowStockAlert(ctx, ctxExec, smReq.ProductID, quantity.Quantity, quantity.ReorderThreshold); err != nil {
+			return models.StockMovement{}, err
+		}
+
+		if err := markWishlistItemsRestocked(ctx, ctxExec, smReq, quantity.Quantity); err != nil {
+			return models.StockMovement{}, err
+		}
+	}
+
+	return stockMovement, nil
+}
+
+// insertStockMovement appends a movement to the stock ledger, the quantity of the variant or product has already been changed
+func insertStockMovement(ctx context.Context, ctxExec boil.ContextExecutor, smReq StockMovement, quantityAfter int) (models.StockMovement, error) {
+	stockMovement := models.StockMovement{
+		ProductID:     smReq.ProductID,
+		VariantID:     smReq.VariantID,
+		WarehouseID:   smReq.WarehouseID,
+		Delta:         smReq.Delta,
+		Reason:        smReq.Reason,
+		ReferenceID:   smReq.ReferenceID,
+		ActorID:       smReq.ActorID,
+		Note:          smReq.Note,
+		QuantityAfter: quantityAfter,
+	}
+	if err := stockMovement.Insert(ctx, ctxExec, boil.Infer()); err != nil {
+		return models.StockMovement{}, pkgerrors.WithStack(err)
+	}
+
+	return stockMovement, nil
+}
+
+// GetStockMovements retrieves the movements of the stock of a variant, or of the product if the variant is null,
+// in the order they happened
+func (r *Repository) GetStockMovements(ctx context.Context, productID int, variantID null.Int) ([]models.StockMovement, error) {
+	queryMods := []qm.QueryMod{
+		qm.Where(fmt.Sprintf("%s = ?", models.StockMovementColumns.ProductID), productID),
+	}
+	if variantID.Valid {
+		queryMods = append(queryMods, qm.Where(fmt.Sprintf("%s = ?", models.StockMovementColumns.VariantID), variantID.Int))
+	} else {
+		queryMods = append(queryMods, qm.Where(fmt.Sprintf("%s IS NULL", models.StockMovementColumns.VariantID)))
+	}
+	queryMods = append(queryMods, qm.OrderBy(models.StockMovementColumns.ID))
+
+	stockMovements, err := models.StockMovements(queryMods...).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.StockMovement
+	for _, sm := range stockMovements {
+		result = append(result, *sm)
+	}
+
+	return result, nil
+}
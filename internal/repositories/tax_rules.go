@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TaxRule struct {
+	ID            int
+	CategoryID    int
+	Region        string
+	Rate          decimal.Decimal
+	EffectiveFrom time.Time
+	EffectiveTo   null.Time
+}
+
+// CreateTaxRule creates a tax rule in db given by tax rule model in parameter
+func (r *Repository) CreateTaxRule(ctx context.Context, trReq TaxRule) error {
+	taxRule := models.TaxRule{
+		CategoryID:    trReq.CategoryID,
+		Region:        trReq.Region,
+		Rate:          trReq.Rate,
+		EffectiveFrom: trReq.EffectiveFrom,
+		EffectiveTo:   trReq.EffectiveTo,
+	}
+	if err := taxRule.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return nil
+}
+
+type TaxRuleFilter struct {
+	CategoryID int
+	Region     string
+}
+
+// GetTaxRules retrieves all the tax rules in db, filtered by category and region if given
+func (r *Repository) GetTaxRules(ctx context.Context, filter TaxRuleFilter) ([]models.TaxRule, error) {
+	var queryMod []qm.QueryMod
+
+	if filter.CategoryID != 0 {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s = ?", models.TaxRuleColumns.CategoryID), filter.CategoryID))
+	}
+
+	if filter.Region != "" {
+		queryMod = append(queryMod, qm.Where(fmt.Sprintf("%s = ?", models.TaxRuleColumns.Region), filter.Region))
+	}
+
+	queryMod = append(queryMod, qm.OrderBy(fmt.Sprintf("%s, %s, %s DESC", models.TaxRuleColumns.CategoryID, models.TaxRuleColumns.Region, models.TaxRuleColumns.EffectiveFrom)))
+
+	taxRules, err := models.TaxRules(queryMod...).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.TaxRule
+	for _, tr := range taxRules {
+		result = append(result, *tr)
+	}
+
+	return result, nil
+}
+
+// GetEffectiveTaxRule retrieves the tax rule of a category which is in effect at the given time.
+// A rule of the given region takes precedence over the rule that applies to every region (empty region)
+func (r *Repository) GetEffectiveTaxRule(ctx context.Context, categoryID int, region string, at time.Time) (models.TaxRule, error) {
+	taxRule, err := models.TaxRules(
+		qm.Where(fmt.Sprintf("%s = ?", models.TaxRuleColumns.CategoryID), categoryID),
+		qm.Where(fmt.Sprintf("(%s = ? OR %s = '')", models.TaxRuleColumns.Region, models.TaxRuleColumns.Region), region),
+		qm.Where(fmt.Sprintf("%s <= ?", models.TaxRuleColumns.EffectiveFrom), at),
+		qm.Where(fmt.Sprintf("(%s IS NULL OR %s > ?)", models.TaxRuleColumns.EffectiveTo, models.TaxRuleColumns.EffectiveTo), at),
+		// the region-specific rule comes first, then the most recent one
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", models.TaxRuleColumns.Region, models.TaxRuleColumns.EffectiveFrom)),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.TaxRule{}, ErrTaxRuleNotFound
+		}
+		return models.TaxRule{}, err
+	}
+
+	return *taxRule, nil
+}
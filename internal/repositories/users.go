@@ -9,6 +9,7 @@ import (
 
 	"github.com/qthuy2k1/product-management/internal/models"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type User struct {
@@ -164,3 +165,21 @@ func (r *Repository) GetUserDefault(ctx context.Context) (models.User, error) {
 		Status:   userScan.Status,
 	}, nil
 }
+
+// GetUsersByRole retrieves the users of a role
+func (r *Repository) GetUsersByRole(ctx context.Context, role string) ([]models.User, error) {
+	users, err := models.Users(
+		qm.Where(fmt.Sprintf("%s = ?", models.UserColumns.Role), role),
+		qm.OrderBy(models.UserColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.User
+	for _, u := range users {
+		result = append(result, *u)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,240 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Warehouse struct {
+	Name    string
+	City    string
+	Region  string
+	Country string
+	// IsDefault makes the warehouse the one keeping the stock which is not given a warehouse, instead of the current default
+	IsDefault bool
+}
+
+// StockTransfer moves a quantity of a product from a warehouse to another, the quantity of the product does not change
+type StockTransfer struct {
+	ProductID       int
+	FromWarehouseID int
+	ToWarehouseID   int
+	Quantity        int
+	ActorID         null.Int
+	Note            string
+}
+
+// CreateWarehouse creates a warehouse in db given by warehouse model in parameter
+func (r *Repository) CreateWarehouse(ctx context.Context, wReq Warehouse) (models.Warehouse, error) {
+	warehouse := models.Warehouse{
+		Name:      wReq.Name,
+		City:      wReq.City,
+		Region:    wReq.Region,
+		Country:   wReq.Country,
+		IsDefault: wReq.IsDefault,
+	}
+
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Warehouse{}, err
+	}
+	defer tx.Rollback()
+
+	// there is only one default warehouse
+	if warehouse.IsDefault {
+		if _, err := models.Warehouses(
+			qm.Where(fmt.Sprintf("%s = true", models.WarehouseColumns.IsDefault)),
+		).UpdateAll(ctx, tx, models.M{models.WarehouseColumns.IsDefault: false}); err != nil {
+			return models.Warehouse{}, err
+		}
+	}
+
+	if err := warehouse.Insert(ctx, tx, boil.Infer()); err != nil {
+		return models.Warehouse{}, pkgerrors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Warehouse{}, err
+	}
+
+	return warehouse, nil
+}
+
+// GetWarehouse retrieves a warehouse in db by ID
+func (r *Repository) GetWarehouse(ctx context.Context, id int) (models.Warehouse, error) {
+	warehouse, err := models.FindWarehouse(ctx, boil.GetContextDB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Warehouse{}, ErrWarehouseNotFound
+		}
+		return models.Warehouse{}, err
+	}
+
+	return *warehouse, nil
+}
+
+// GetWarehouseByName retrieves a warehouse in db by name
+func (r *Repository) GetWarehouseByName(ctx context.Context, name string) (models.Warehouse, error) {
+	warehouse, err := models.Warehouses(qm.Where(fmt.Sprintf("%s = ?", models.WarehouseColumns.Name), name)).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Warehouse{}, ErrWarehouseNotFound
+		}
+		return models.Warehouse{}, err
+	}
+
+	return *warehouse, nil
+}
+
+// GetWarehouses retrieves all the warehouses in db
+func (r *Repository) GetWarehouses(ctx context.Context) ([]models.Warehouse, error) {
+	warehouses, err := models.Warehouses(qm.OrderBy(models.WarehouseColumns.ID)).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Warehouse
+	for _, w := range warehouses {
+		result = append(result, *w)
+	}
+
+	return result, nil
+}
+
+// GetWarehouseStocks retrieves the stock of a product in each warehouse which has ever kept it, with the warehouses
+func (r *Repository) GetWarehouseStocks(ctx context.Context, productID int) ([]models.WarehouseStock, error) {
+	stocks, err := models.WarehouseStocks(
+		qm.Where(fmt.Sprintf("%s = ?", models.WarehouseStockColumns.ProductID), productID),
+		qm.Load(models.WarehouseStockRels.Warehouse),
+		qm.OrderBy(models.WarehouseStockColumns.WarehouseID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.WarehouseStock
+	for _, s := range stocks {
+		result = append(result, *s)
+	}
+
+	return result, nil
+}
+
+// TransferStock moves the quantity of a product from a warehouse to another and appends a transfer movement to the
+// stock ledger for each of them. ErrInsufficientStock is returned if the source warehouse does not have the quantity
+func (r *Repository) TransferStock(ctx context.Context, tx *sql.Tx, stReq StockTransfer) ([]models.StockMovement, error) {
+	ctxExec := boil.GetContextDB()
+	if tx != nil {
+		ctxExec = tx
+	}
+
+	var product struct {
+		Quantity int `boil:"quantity"`
+	}
+	if err := models.Products(
+		qm.Select(models.ProductColumns.Quantity),
+		qm.Where(fmt.Sprintf("%s = ?", models.ProductColumns.ID), stReq.ProductID),
+	).Bind(ctx, ctxExec, &product); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	var stockMovements []models.StockMovement
+	for _, move := range []struct {
+		warehouseID int
+		delta       int
+	}{
+		{stReq.FromWarehouseID, -stReq.Quantity},
+		{stReq.ToWarehouseID, stReq.Quantity},
+	} {
+		if err := moveWarehouseStock(ctx, ctxExec, move.warehouseID, stReq.ProductID, move.delta); err != nil {
+			return nil, err
+		}
+
+		stockMovement, err := insertStockMovement(ctx, ctxExec, StockMovement{
+			ProductID:   stReq.ProductID,
+			WarehouseID: null.IntFrom(move.warehouseID),
+			Delta:       move.delta,
+			Reason:      StockReasonTransfer,
+			ActorID:     stReq.ActorID,
+			Note:        stReq.Note,
+		}, product.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		stockMovements = append(stockMovements, stockMovement)
+	}
+
+	return stockMovements, nil
+}
+
+// getDefaultWarehouseID retrieves the ID of the warehouse keeping the stock which is not given a warehouse
+func getDefaultWarehouseID(ctx context.Context, ctxExec boil.ContextExecutor) (int, error) {
+	warehouse, err := models.Warehouses(
+		qm.Select(models.WarehouseColumns.ID),
+		qm.Where(fmt.Sprintf("%s = true", models.WarehouseColumns.IsDefault)),
+	).One(ctx, ctxExec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrWarehouseNotFound
+		}
+		return 0, err
+	}
+
+	return warehouse.ID, nil
+}
+
+// moveWarehouseStock adds the delta to the quantity of a product in a warehouse, the quantity of the product itself
+// is not changed. ErrInsufficientStock is returned if the quantity in the warehouse would be negative
+func moveWarehouseStock(ctx context.Context, ctxExec boil.ContextExecutor, warehouseID, productID, delta int) error {
+	// the first stock of a product in a warehouse opens its row
+	if delta > 0 {
+		if _, err := ctxExec.ExecContext(ctx, fmt.Sprintf(
+			`INSERT INTO "%s" (warehouse_id, product_id) VALUES ($1, $2) ON CONFLICT (warehouse_id, product_id) DO NOTHING`,
+			models.TableNames.WarehouseStocks), warehouseID, productID); err != nil {
+			return err
+		}
+	}
+
+	res, err := ctxExec.ExecContext(ctx, fmt.Sprintf(
+		`UPDATE "%s" SET quantity = quantity + $3, updated_at = now() WHERE warehouse_id = $1 AND product_id = $2 AND quantity + $3 >= 0`,
+		models.TableNames.WarehouseStocks), warehouseID, productID, delta)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrInsufficientStock
+	}
+
+	return nil
+}
+
+// getWarehouseQuantity retrieves the quantity of a product in a warehouse, 0 if the warehouse has never kept it
+func getWarehouseQuantity(ctx context.Context, ctxExec boil.ContextExecutor, warehouseID, productID int) (int, error) {
+	stock, err := models.WarehouseStocks(
+		qm.Select(models.WarehouseStockColumns.Quantity),
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.WarehouseStockColumns.WarehouseID, models.WarehouseStockColumns.ProductID), warehouseID, productID),
+	).One(ctx, ctxExec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return stock.Quantity, nil
+}
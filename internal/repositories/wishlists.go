@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/qthuy2k1/product-management/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// restockReasons are the reasons of the stock movements which dispatch the back in stock notifications of a product
+// when they bring its quantity back above 0
+var restockReasons = map[string]bool{
+	StockReasonAdjustment:   true,
+	StockReasonImport:       true,
+	StockReasonCancellation: true,
+}
+
+// markWishlistItemsRestocked sets the restock time on the wishlist items subscribed to the back in stock notification
+// of a product if the movement brings its quantity from 0 back above 0, the back in stock job then emails their users
+func markWishlistItemsRestocked(ctx context.Context, ctxExec boil.ContextExecutor, smReq StockMovement, quantity int) error {
+	if !restockReasons[smReq.Reason] || quantity <= 0 || quantity-smReq.Delta > 0 {
+		return nil
+	}
+
+	_, err := ctxExec.ExecContext(ctx,
+		`UPDATE wishlist_items SET restocked_at = now(), updated_at = now() WHERE product_id = $1 AND notify_back_in_stock AND restocked_at IS NULL`,
+		smReq.ProductID)
+	return err
+}
+
+// AddWishlistItem saves a product to the wishlist of a user
+func (r *Repository) AddWishlistItem(ctx context.Context, userID, productID int) (models.WishlistItem, error) {
+	item := models.WishlistItem{
+		UserID:    userID,
+		ProductID: productID,
+	}
+	if err := item.Insert(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		return models.WishlistItem{}, err
+	}
+
+	return item, nil
+}
+
+// GetWishlistItem retrieves the item of a product in the wishlist of a user
+func (r *Repository) GetWishlistItem(ctx context.Context, userID, productID int) (models.WishlistItem, error) {
+	item, err := models.WishlistItems(
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.WishlistItemColumns.UserID, models.WishlistItemColumns.ProductID), userID, productID),
+	).One(ctx, boil.GetContextDB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.WishlistItem{}, ErrWishlistItemNotFound
+		}
+		return models.WishlistItem{}, err
+	}
+
+	return *item, nil
+}
+
+// GetWishlistItems retrieves the wishlist of a user with the products, the last saved first
+func (r *Repository) GetWishlistItems(ctx context.Context, userID int) ([]models.WishlistItem, error) {
+	items, err := models.WishlistItems(
+		qm.Where(fmt.Sprintf("%s = ?", models.WishlistItemColumns.UserID), userID),
+		qm.Load(models.WishlistItemRels.Product),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", models.WishlistItemColumns.CreatedAt, models.WishlistItemColumns.ID)),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.WishlistItem
+	for _, i := range items {
+		result = append(result, *i)
+	}
+
+	return result, nil
+}
+
+// DeleteWishlistItem removes a product from the wishlist of a user with its back in stock subscription
+func (r *Repository) DeleteWishlistItem(ctx context.Context, userID, productID int) error {
+	rowsAff, err := models.WishlistItems(
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.WishlistItemColumns.UserID, models.WishlistItemColumns.ProductID), userID, productID),
+	).DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrWishlistItemNotFound
+	}
+
+	return nil
+}
+
+// SetWishlistItemNotification subscribes or unsubscribes the item of a product in the wishlist of a user to the back in stock
+// notification of the product. A restock which has not been notified yet is cleared
+func (r *Repository) SetWishlistItemNotification(ctx context.Context, userID, productID int, notify bool) error {
+	rowsAff, err := models.WishlistItems(
+		qm.Where(fmt.Sprintf("%s = ? AND %s = ?", models.WishlistItemColumns.UserID, models.WishlistItemColumns.ProductID), userID, productID),
+	).UpdateAll(ctx, boil.GetContextDB(), models.M{
+		models.WishlistItemColumns.NotifyBackInStock: notify,
+		models.WishlistItemColumns.RestockedAt:       null.Time{},
+		models.WishlistItemColumns.UpdatedAt:         time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrWishlistItemNotFound
+	}
+
+	return nil
+}
+
+// GetRestockedWishlistItems retrieves the subscribed wishlist items whose products have been restocked with the products and users
+func (r *Repository) GetRestockedWishlistItems(ctx context.Context) ([]models.WishlistItem, error) {
+	items, err := models.WishlistItems(
+		qm.Where(models.WishlistItemColumns.NotifyBackInStock),
+		qm.Where(fmt.Sprintf("%s IS NOT NULL", models.WishlistItemColumns.RestockedAt)),
+		qm.Load(models.WishlistItemRels.Product),
+		qm.Load(models.WishlistItemRels.User),
+		qm.OrderBy(models.WishlistItemColumns.ID),
+	).All(ctx, boil.GetContextDB())
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.WishlistItem
+	for _, i := range items {
+		result = append(result, *i)
+	}
+
+	return result, nil
+}
+
+// MarkWishlistItemsNotified sets the time the users of the wishlist items of the IDs have been notified that their products
+// are back in stock, the items are unsubscribed
+func (r *Repository) MarkWishlistItemsNotified(ctx context.Context, ids []int, at time.Time) error {
+	_, err := models.WishlistItems(
+		qm.Where(fmt.Sprintf("%s = ANY(?)", models.WishlistItemColumns.ID), pq.Array(ids)),
+	).UpdateAll(ctx, boil.GetContextDB(), models.M{
+		models.WishlistItemColumns.NotifyBackInStock: false,
+		models.WishlistItemColumns.RestockedAt:       null.Time{},
+		models.WishlistItemColumns.NotifiedAt:        at,
+		models.WishlistItemColumns.UpdatedAt:         at,
+	})
+	return err
+}
+
+// ClearWishlistItemsRestocked clears the restock of the wishlist items of the IDs whose products are out of stock again
+// before their users are notified, the items stay subscribed to the next restock
+func (r *Repository) ClearWishlistItemsRestocked(ctx context.Context, ids []int) error {
+	_, err := models.WishlistItems(
+		qm.Where(fmt.Sprintf("%s = ANY(?)", models.WishlistItemColumns.ID), pq.Array(ids)),
+	).UpdateAll(ctx, boil.GetContextDB(), models.M{
+		models.WishlistItemColumns.RestockedAt: null.Time{},
+		models.WishlistItemColumns.UpdatedAt:   time.Now(),
+	})
+	return err
+}
@@ -46,16 +46,6 @@ func NewMessage(s, b string) *Message {
 
 // WriteEmailContent write the header, body and file attachments to a bytes.Buffer and return a slice of byte
 func (m *Message) WriteEmailContent() []byte {
-	// creates a bytes.Buffer and read from io.Reader
-	buf := &bytes.Buffer{}
-
-	if _, err := buf.ReadFrom(m.Attachments); err != nil {
-		return []byte{}
-	}
-
-	// retrieve a byte slice from bytes.Buffer
-	data := buf.Bytes()
-
 	content := bytes.NewBuffer(nil)
 
 	// write to header
@@ -73,6 +63,22 @@ func (m *Message) WriteEmailContent() []byte {
 	// write to body
 	content.WriteString(m.Body)
 
+	// the message has no attachment
+	if m.Attachments == nil {
+		content.WriteString(fmt.Sprintf("\n--%s--", boundary))
+		return content.Bytes()
+	}
+
+	// creates a bytes.Buffer and read from io.Reader
+	buf := &bytes.Buffer{}
+
+	if _, err := buf.ReadFrom(m.Attachments); err != nil {
+		return []byte{}
+	}
+
+	// retrieve a byte slice from bytes.Buffer
+	data := buf.Bytes()
+
 	// attach csv file
 	content.WriteString(fmt.Sprintf("\n\n--%s\n", boundary))
 	content.WriteString(fmt.Sprintf("Content-Type: %s\n", http.DetectContentType(data)))
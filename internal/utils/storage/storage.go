@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// the uploaded files are stored in data/uploads and served under /uploads if not configured
+const (
+	defaultLocalDir     = "data/uploads"
+	defaultLocalBaseURL = "/uploads"
+)
+
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// Storage stores the uploaded files by key, such as products/1/3f2a.jpg. Only the local filesystem is
+// supported for now, an S3 backend can implement the same interface
+type Storage interface {
+	// Save writes the content of r to the file of the key, the file is replaced if it exists
+	Save(ctx context.Context, key string, r io.Reader) error
+	// Delete removes the file of the key, it does nothing if the file does not exist
+	Delete(ctx context.Context, key string) error
+	// URL returns the URL the file of the key is served at
+	URL(key string) string
+}
+
+// LocalStorage stores the files in a directory of the local filesystem
+type LocalStorage struct {
+	dir     string
+	baseURL string
+	// prefix is the path of the base URL, the base URL may be absolute when the files are served behind a CDN
+	prefix string
+}
+
+// NewLocalStorage creates a LocalStorage storing the files in dir, the files are served under baseURL
+func NewLocalStorage(dir, baseURL string) *LocalStorage {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	prefix := baseURL
+	if u, err := url.Parse(baseURL); err == nil {
+		prefix = strings.TrimSuffix(u.Path, "/")
+	}
+
+	return &LocalStorage{dir: dir, baseURL: baseURL, prefix: prefix}
+}
+
+// NewLocalStorageFromEnv creates a LocalStorage from the UPLOAD_DIR and UPLOAD_BASE_URL environment variables
+func NewLocalStorageFromEnv() *LocalStorage {
+	dir := os.Getenv("UPLOAD_DIR")
+	if dir == "" {
+		dir = defaultLocalDir
+	}
+	baseURL := os.Getenv("UPLOAD_BASE_URL")
+	if baseURL == "" {
+		baseURL = defaultLocalBaseURL
+	}
+
+	return NewLocalStorage(dir, baseURL)
+}
+
+// Save writes the content of r to the file of the key, the file is written to a temporary file first so that
+// a partly written file is never served
+func (s *LocalStorage) Save(ctx context.Context, key string, r io.Reader) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filePath)
+}
+
+// Delete removes the file of the key, it does nothing if the file does not exist
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// URL returns the URL the file of the key is served at
+func (s *LocalStorage) URL(key string) string {
+	return fmt.Sprintf("%s/%s", s.baseURL, key)
+}
+
+// Prefix returns the URL path the files are served under
+func (s *LocalStorage) Prefix() string {
+	return s.prefix
+}
+
+// Handler serves the stored files, it must be mounted at the prefix. The directories are not listed
+func (s *LocalStorage) Handler() http.Handler {
+	fileServer := http.StripPrefix(s.prefix+"/", http.FileServer(http.Dir(s.dir)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		fileServer.ServeHTTP(w, r)
+	})
+}
+
+// path returns the path of the file of the key, the key must be a relative path inside the directory
+func (s *LocalStorage) path(key string) (string, error) {
+	cleaned := path.Clean(key)
+	if key == "" || cleaned != key || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}